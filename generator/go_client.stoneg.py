@@ -2,8 +2,13 @@ import os
 
 from stone.backend import CodeBackend
 from stone.ir import (
+    is_boolean_type,
+    is_list_type,
+    is_string_type,
+    is_union_type,
     is_void_type,
-    is_struct_type
+    is_struct_type,
+    unwrap_nullable,
 )
 
 from go_helpers import (
@@ -16,9 +21,13 @@ from go_helpers import (
 
 class GoClientBackend(CodeBackend):
     def generate(self, api):
+        self._sentinels = _sentinel_unions(api)
         for namespace in api.namespaces.values():
             if len(namespace.routes) > 0:
                 self._generate_client(namespace)
+                self._generate_iterators(namespace)
+                self._generate_jobs(namespace)
+                self._generate_fake(namespace)
 
     def _generate_client(self, namespace):
         file_name = os.path.join(self.target_folder_path, namespace.name,
@@ -34,8 +43,14 @@ class GoClientBackend(CodeBackend):
                 for route in namespace.routes:
                     generate_doc(self, route)
                     self.emit(self._generate_route_signature(namespace, route))
+                    self.emit('// %s is like %s but takes a context for '
+                              'cancellation and deadlines.' %
+                              (self._fn_name(route, ctx=True), self._fn_name(route)))
+                    self.emit(self._generate_route_signature(namespace, route, ctx=True))
             self.emit()
 
+            self._generate_sentinels(namespace)
+
             self.emit('type apiImpl dropbox.Context')
             for route in namespace.routes:
                 self._generate_route(namespace, route)
@@ -44,27 +59,117 @@ class GoClientBackend(CodeBackend):
                 self.emit('ctx := apiImpl(dropbox.NewContext(c))')
                 self.emit('return &ctx')
 
-    def _generate_route_signature(self, namespace, route):
-        req = fmt_type(route.arg_data_type, namespace)
-        res = fmt_type(route.result_data_type, namespace, use_interface=True)
+    def _generate_sentinels(self, namespace):
+        unions = [data_type for data_type in namespace.linearize_data_types()
+                  if (namespace.name, data_type.name) in self._sentinels]
+        if not unions:
+            return
+
+        out = self.emit
+        out('// Sentinel errors for the tags of unions that are nested in the errors of')
+        out('// many routes. The errors of all routes match them with errors.Is if their')
+        out('// endpoint error contains the union with the tag.')
+        with self.block('var', delim=('(', ')')):
+            for union in unions:
+                for field in union.all_fields:
+                    if field.name == 'other':
+                        continue
+                    out('Err%s%s error = endpointErrorTag{"%s", %s}' %
+                        (fmt_var(union.name[:-len('Error')]), fmt_var(field.name),
+                         union.name, _tag_const(union, field.name, namespace)))
+        out()
+
+        out('// endpointErrorTag matches the unions of a type with a tag.')
+        with self.block('type endpointErrorTag struct'):
+            out('union string')
+            out('tag   string')
+        out()
+        with self.block('func (e endpointErrorTag) Error() string'):
+            out('return "%s: " + e.union + " " + e.tag' % namespace.name)
+        out()
+        out('// MatchEndpointError implements dropbox.EndpointErrorMatcher.')
+        with self.block('func (e endpointErrorTag) MatchEndpointError(endpointError interface{}) bool'):
+            with self.block('switch err := endpointError.(type)'):
+                for union in unions:
+                    with self.block('case *%s:' % union.name, delim=(None, None)):
+                        out('return e.union == "%s" && err.Tag == e.tag' % union.name)
+            out('return false')
+        out()
+
+    def _generate_is(self, route, fn):
+        out = self.emit
+        err, _ = unwrap_nullable(route.error_data_type)
+        paths = []
+        if is_struct_type(err) or is_union_type(err):
+            paths = [path for path, _ in _error_paths(err)]
+
+        out('// Is reports whether target is a dropbox.EndpointErrorMatcher matching')
+        out('// the endpoint error, or a union nested in it.')
+        with self.block('func (e {fn}APIError) Is(target error) bool'.format(fn=fn)):
+            out('m, ok := target.(dropbox.EndpointErrorMatcher)')
+            with self.block('if !ok || e.EndpointError == nil'):
+                out('return false')
+            if not paths:
+                out('return m.MatchEndpointError(e.EndpointError)')
+            else:
+                out('err := e.EndpointError')
+                matches = ['m.MatchEndpointError(err)']
+                for path in paths:
+                    checks = ['err.%s != nil' % '.'.join(path[:i + 1])
+                              for i in range(len(path))]
+                    matches.append(' && '.join(checks) +
+                                   ' && m.MatchEndpointError(err.%s)' % '.'.join(path))
+                out('return %s ||' % matches[0])
+                with self.indent():
+                    for match in matches[1:-1]:
+                        out(match + ' ||')
+                    out(matches[-1])
+        out()
+
+    def _fn_name(self, route, ctx=False):
         fn = fmt_var(route.name)
         if route.version != 1:
             fn += 'V%d' % route.version
+        if ctx:
+            fn += 'Context'
+        return fn
+
+    def _generate_route_signature(self, namespace, route, ctx=False):
+        req = fmt_type(route.arg_data_type, namespace)
+        res = fmt_type(route.result_data_type, namespace, use_interface=True)
+        fn = self._fn_name(route, ctx)
         style = route.attrs.get('style', 'rpc')
 
-        arg = '' if is_void_type(route.arg_data_type) else 'arg {req}'
+        args = []
+        if ctx:
+            args.append('ctx context.Context')
+        if not is_void_type(route.arg_data_type):
+            args.append('arg {req}')
+        if style == 'upload':
+            args.append('content io.Reader')
+        args.append('opts ...dropbox.CallOption')
+        arg = ', '.join(args)
         ret = '(err error)' if is_void_type(route.result_data_type) else \
             '(res {res}, err error)'
-        signature = '{fn}(' + arg + ') ' + ret
         if style == 'download':
-            signature = '{fn}(' + arg + \
-                ') (res {res}, content io.ReadCloser, err error)'
-        elif style == 'upload':
-            signature = '{fn}(' + arg + ', content io.Reader) ' + ret
-            if is_void_type(route.arg_data_type):
-                signature = '{fn}(content io.Reader) ' + ret
+            ret = '(res {res}, content io.ReadCloser, err error)'
+        signature = '{fn}(' + arg + ') ' + ret
         return signature.format(fn=fn, req=req, res=res)
 
+    def _accepts_content_hash(self, route):
+        if route.attrs.get('style', 'rpc') != 'upload' or not is_struct_type(route.arg_data_type):
+            return False
+        return any(f.name == 'content_hash' for f in route.arg_data_type.all_fields)
+
+    def _generate_route_call_args(self, route):
+        args = ['context.Background()']
+        if not is_void_type(route.arg_data_type):
+            args.append('arg')
+        if route.attrs.get('style', 'rpc') == 'upload':
+            args.append('content')
+        args.append('opts...')
+        return ', '.join(args)
+
 
     def _generate_route(self, namespace, route):
         out = self.emit
@@ -73,9 +178,7 @@ class GoClientBackend(CodeBackend):
         if route.version != 1:
             route_name += '_v%d' % route.version
 
-        fn = fmt_var(route.name)
-        if route.version != 1:
-            fn += 'V%d' % route.version
+        fn = self._fn_name(route)
 
         err = fmt_type(route.error_data_type, namespace)
         out('//%sAPIError is an error-wrapper for the %s route' %
@@ -85,8 +188,23 @@ class GoClientBackend(CodeBackend):
             out('EndpointError {err} `json:"error"`'.format(err=err))
         out()
 
+        out('// SetAPIError sets the error summary and request metadata of e.')
+        with self.block('func (e *{fn}APIError) SetAPIError(base dropbox.APIError)'.format(fn=fn)):
+            out('e.APIError = base')
+        out()
+
+        if not is_void_type(route.error_data_type):
+            self._generate_is(route, fn)
+
         signature = 'func (dbx *apiImpl) ' + self._generate_route_signature(
             namespace, route)
+        with self.block(signature):
+            out('return dbx.%s(%s)' % (self._fn_name(route, ctx=True),
+                                       self._generate_route_call_args(route)))
+        out()
+
+        signature = 'func (dbx *apiImpl) ' + self._generate_route_signature(
+            namespace, route, ctx=True)
         with self.block(signature):
             if route.deprecated is not None:
                 out('log.Printf("WARNING: API `%s` is deprecated")' % fn)
@@ -112,11 +230,14 @@ class GoClientBackend(CodeBackend):
                 out("Arg: {arg},".format(arg="arg" if not is_void_type(route.arg_data_type) else "nil"))
                 out("ExtraHeaders: {headers},".format(
                     headers="arg.ExtraHeaders" if fmt_var(route.name) == "Download" else "nil"))
+                if self._accepts_content_hash(route):
+                    out("AcceptsContentHash: true,")
+                out("Options: opts,")
             out()
 
             out("var resp []byte")
             out("var respBody io.ReadCloser")
-            out("resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, {body})".format(
+            out("resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, {body})".format(
                 body="content" if route.attrs.get('style', '') == 'upload' else "nil"))
             with self.block("if err != nil"):
                 out("var appErr {fn}APIError".format(fn=fn))
@@ -150,3 +271,496 @@ class GoClientBackend(CodeBackend):
                 out("_ = respBody")
             out('return')
         out()
+
+    def _generate_fake(self, namespace):
+        file_name = os.path.join(self.target_folder_path, namespace.name,
+                                 'fake.go')
+        with self.output_to_relative_path(file_name):
+            self.emit_raw(HEADER)
+            self.emit()
+            self.emit('package %s' % namespace.name)
+            self.emit()
+
+            self.emit('// Fake is a Client for tests. The methods of a route call its stub, the')
+            self.emit('// field named after the route with a Func suffix, and fail with')
+            self.emit('// dropbox.ErrNotImplemented if it is nil. All calls are recorded.')
+            with self.block('type Fake struct'):
+                self.emit('dropbox.FakeCalls')
+                self.emit()
+                for route in namespace.routes:
+                    sig = self._generate_route_signature(namespace, route, ctx=True)
+                    self.emit('%sFunc func%s' % (self._fn_name(route),
+                                                 sig[len(self._fn_name(route, ctx=True)):]))
+            self.emit()
+            self.emit('var _ Client = (*Fake)(nil)')
+            self.emit()
+            for route in namespace.routes:
+                self._generate_fake_route(namespace, route)
+
+    def _generate_fake_route(self, namespace, route):
+        out = self.emit
+        fn = self._fn_name(route)
+        style = route.attrs.get('style', 'rpc')
+
+        out('// %s implements Client.' % fn)
+        with self.block('func (f *Fake) ' + self._generate_route_signature(namespace, route)):
+            out('return f.%s(%s)' % (self._fn_name(route, ctx=True),
+                                     self._generate_route_call_args(route)))
+        out()
+
+        args = []
+        if not is_void_type(route.arg_data_type):
+            args.append('arg')
+        if style == 'upload':
+            args.append('content')
+        out('// %s implements Client.' % self._fn_name(route, ctx=True))
+        with self.block('func (f *Fake) ' + self._generate_route_signature(
+                namespace, route, ctx=True)):
+            out('f.Record("%s", %s)' % (fn, ', '.join(['opts'] + args)))
+            with self.block('if f.%sFunc == nil' % fn):
+                out('err = dropbox.NotImplemented("%s", "%s")' % (namespace.name, fn))
+                out('return')
+            out('return f.%sFunc(%s)' % (fn, ', '.join(['ctx'] + args + ['opts...'])))
+        out()
+
+    def _generate_iterators(self, namespace):
+        pagers = [p for p in (self._find_pager(namespace, route)
+                              for route in namespace.routes) if p is not None]
+        if len(pagers) == 0:
+            return
+
+        file_name = os.path.join(self.target_folder_path, namespace.name,
+                                 'iterators.go')
+        with self.output_to_relative_path(file_name):
+            self.emit_raw(HEADER)
+            self.emit()
+            self.emit('package %s' % namespace.name)
+            for route, cont in pagers:
+                self.emit()
+                self._generate_iterator(namespace, route, cont)
+
+    def _find_pager(self, namespace, route):
+        """
+        Returns `(route, continue_route)` if the results of `route` can be
+        iterated over. `continue_route` is the `X/continue` route fetching
+        further pages, or None if `route` itself takes the cursor of the next
+        page as optional argument.
+
+        A route is pageable if its result has a `cursor`, an optional
+        `has_more` flag and exactly one list field holding the entries.
+        """
+        if route.name.endswith('continue'):
+            return None
+        if _page_fields(route.result_data_type) is None:
+            return None
+
+        conts = [r for r in namespace.routes
+                 if r.name in (route.name + '/continue', route.name + '_continue') and
+                 r.result_data_type is route.result_data_type and
+                 _takes_cursor(r.arg_data_type)]
+        if len(conts) > 0:
+            conts.sort(key=lambda r: r.version != route.version)
+            return route, conts[0]
+
+        cursor = _field(route.arg_data_type, 'cursor')
+        if cursor is not None and _is_nullable_string(cursor.data_type):
+            return route, None
+        return None
+
+    def _generate_iterator(self, namespace, route, cont):
+        out = self.emit
+        fn = self._fn_name(route)
+        name = fn + 'Iterator'
+        arg = fmt_type(route.arg_data_type, namespace)
+        res = fmt_type(route.result_data_type, namespace)
+        entries, cursor, has_more = _page_fields(route.result_data_type)
+        entries_type, _ = unwrap_nullable(entries.data_type)
+        entry = fmt_type(entries_type.data_type, namespace, use_interface=True)
+
+        if cont is not None:
+            doc = ('%s iterates over the entries returned by `%s`, fetching '
+                   'further pages with `%s`.' % (name, fn, self._fn_name(cont)))
+        else:
+            doc = ('%s iterates over the entries returned by `%s`, fetching '
+                   'further pages by calling it again with the cursor of the '
+                   'previous page.' % (name, fn))
+        self.emit_wrapped_text(doc, prefix='// ')
+        if route.deprecated is not None:
+            out('//')
+            out('// Deprecated: `%s` is deprecated.' % fn)
+        with self.block('type %s struct' % name):
+            out('ctx        context.Context')
+            out('dbx        Client')
+            out('opts       []dropbox.CallOption')
+            out('arg        %s' % arg)
+            out('prevCursor string')
+            out('cursor     string')
+            out('more       bool')
+            out('entries    []%s' % entry)
+            out('index      int')
+            out('entry      %s' % entry)
+            out('err        error')
+        out()
+
+        self.emit_wrapped_text('New%s returns an iterator over the entries of '
+                               '`%s` called with arg. Every page is fetched with '
+                               'opts.' % (name, fn), prefix='// ')
+        with self.block('func New%s(ctx context.Context, dbx Client, arg %s, '
+                        'opts ...dropbox.CallOption) *%s' % (name, arg, name)):
+            if cont is not None:
+                out('return &%s{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}' % name)
+            else:
+                out('a := *arg')
+                out('return &%s{ctx: ctx, dbx: dbx, opts: opts, arg: &a, cursor: a.Cursor, more: true}' % name)
+        out()
+
+        self.emit_wrapped_text('Resume%s returns an iterator continuing at cursor, '
+                               'as returned by `%s.Cursor`.' % (name, name), prefix='// ')
+        if cont is not None:
+            with self.block('func Resume%s(ctx context.Context, dbx Client, cursor string, '
+                            'opts ...dropbox.CallOption) *%s' % (name, name)):
+                out('return &%s{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}' % name)
+        else:
+            with self.block('func Resume%s(ctx context.Context, dbx Client, arg %s, cursor string, '
+                            'opts ...dropbox.CallOption) *%s' % (name, arg, name)):
+                out('it := New%s(ctx, dbx, arg, opts...)' % name)
+                out('it.cursor = cursor')
+                out('return it')
+        out()
+
+        out('// Next advances the iterator to the next entry, fetching the next page if')
+        out('// needed. It returns false once all entries were returned or an error')
+        out('// occurred.')
+        with self.block('func (it *%s) Next() bool' % name):
+            with self.block('for it.index >= len(it.entries)'):
+                with self.block('if it.err != nil || !it.more'):
+                    out('return false')
+                out('it.fetch()')
+            out('it.entry = it.entries[it.index]')
+            out('it.index++')
+            out('return true')
+        out()
+
+        out('// Entry returns the entry the iterator is positioned at.')
+        with self.block('func (it *%s) Entry() %s' % (name, entry)):
+            out('return it.entry')
+        out()
+
+        out('// Err returns the error that stopped the iteration, if any.')
+        with self.block('func (it *%s) Err() error' % name):
+            out('return it.err')
+        out()
+
+        out('// Cursor returns a cursor from which the iteration can be resumed without')
+        out('// skipping entries. Call it after processing the current entry. While the')
+        out('// current page has entries left, it is the cursor the page was fetched with,')
+        out('// so these entries are returned again after resuming. It is empty if the')
+        out('// iteration can not be resumed.')
+        with self.block('func (it *%s) Cursor() string' % name):
+            with self.block('if it.index < len(it.entries)'):
+                out('return it.prevCursor')
+            out('return it.cursor')
+        out()
+
+        with self.block('func (it *%s) fetch()' % name):
+            out('var res %s' % res)
+            out('var err error')
+            if cont is not None:
+                out('if it.arg != nil {')
+                with self.indent():
+                    out('res, err = it.dbx.%s(it.ctx, it.arg, it.opts...)' % self._fn_name(route, ctx=True))
+                    out('it.arg = nil')
+                out('} else {')
+                with self.indent():
+                    out('res, err = it.dbx.%s(it.ctx, New%s(it.cursor), it.opts...)' %
+                        (self._fn_name(cont, ctx=True),
+                         fmt_type(cont.arg_data_type, namespace).lstrip('*')))
+                out('}')
+            else:
+                out('it.arg.Cursor = it.cursor')
+                out('res, err = it.dbx.%s(it.ctx, it.arg, it.opts...)' % self._fn_name(route, ctx=True))
+            with self.block('if err != nil'):
+                out('it.err = err')
+                out('return')
+            out()
+            out('it.prevCursor = it.cursor')
+            if is_struct_type(unwrap_nullable(cursor.data_type)[0]):
+                out('it.cursor = ""')
+                with self.block('if res.%s != nil' % fmt_var(cursor.name)):
+                    out('it.cursor = res.%s.Value' % fmt_var(cursor.name))
+            else:
+                out('it.cursor = res.%s' % fmt_var(cursor.name))
+            if has_more is not None:
+                out('it.more = res.%s' % fmt_var(has_more.name))
+            else:
+                out('it.more = it.cursor != ""')
+            out('it.entries = res.%s' % fmt_var(entries.name))
+            out('it.index = 0')
+
+
+    def _generate_jobs(self, namespace):
+        jobs = [j for j in (self._find_job(namespace, route)
+                            for route in namespace.routes) if j is not None]
+        if len(jobs) == 0:
+            return
+
+        file_name = os.path.join(self.target_folder_path, namespace.name,
+                                 'jobs.go')
+        with self.output_to_relative_path(file_name):
+            self.emit_raw(HEADER)
+            self.emit()
+            self.emit('package %s' % namespace.name)
+            for route, check in jobs:
+                self.emit()
+                self._generate_job(namespace, route, check)
+
+    def _find_job(self, namespace, route):
+        """
+        Returns `(route, check_route)` if `route` launches an asynchronous job
+        whose status is polled with `check_route`.
+        """
+        launch, _ = unwrap_nullable(route.result_data_type)
+        job_id = _field(launch, 'async_job_id')
+        if is_void_type(route.arg_data_type) or job_id is None:
+            return None
+        # The job ID is either a member of a union, or a string field of a
+        # struct, e.g. `team.GroupMembersChangeResult`.
+        if is_struct_type(launch) and not is_string_type(unwrap_nullable(job_id.data_type)[0]):
+            return None
+
+        names = _check_routes.get(namespace.name, {}).get(route.name)
+        if names is None:
+            names = [route.name + suffix for suffix in _check_suffixes]
+        else:
+            names = [names]
+        for check in namespace.routes:
+            if check.name not in names or check.version != route.version:
+                continue
+            arg, _ = unwrap_nullable(check.arg_data_type)
+            status, _ = unwrap_nullable(check.result_data_type)
+            if arg.name != 'PollArg' or arg.namespace.name != 'async' or \
+                    not is_union_type(status) or _field(status, 'in_progress') is None or \
+                    _field(status, 'complete') is None:
+                continue
+            # Every outcome of the launch, but its catch-all, must map onto
+            # the status.
+            if is_struct_type(launch) or all(f.name == 'async_job_id' or f is launch.catch_all_field or
+                   (_field(status, f.name) is not None and
+                    fmt_type(_field(status, f.name).data_type) == fmt_type(f.data_type))
+                   for f in launch.all_fields):
+                return route, check
+        return None
+
+    def _generate_job(self, namespace, route, check):
+        launch, _ = unwrap_nullable(route.result_data_type)
+        if is_struct_type(launch):
+            self._generate_struct_job(namespace, route, check)
+            return
+
+        out = self.emit
+        fn = self._fn_name(route)
+        arg = fmt_type(route.arg_data_type, namespace)
+        status, _ = unwrap_nullable(check.result_data_type)
+        res = fmt_type(status, namespace)
+
+        self.emit_wrapped_text(
+            '%sAndWait calls `%s`, then polls `%s` with poller until the job '
+            'is done. A nil poller polls with the default intervals. It returns '
+            'the final status of the job, and an `async.JobFailedError` if the '
+            'job did not complete. All calls are made with opts.' %
+            (fn, fn, self._fn_name(check)), prefix='// ')
+        with self.block('func %sAndWait(ctx context.Context, dbx Client, arg %s, '
+                        'poller *async.Poller, opts ...dropbox.CallOption) (res %s, err error)' %
+                        (fn, arg, res)):
+            out('launch, err := dbx.%s(ctx, arg, opts...)' % self._fn_name(route, ctx=True))
+            with self.block('if err != nil'):
+                out('return')
+            out()
+
+            out('if launch.Tag == %s {' % _tag_const(launch, 'async_job_id', namespace))
+            with self.indent():
+                with self.block('err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error)',
+                                after=')'):
+                    out('res, err = dbx.%s(ctx, async.NewPollArg(launch.AsyncJobId), opts...)' %
+                        self._fn_name(check, ctx=True))
+                    out('return err == nil && res.Tag != %s, err' %
+                        _tag_const(status, 'in_progress', namespace))
+                with self.block('if err != nil'):
+                    out('return nil, err')
+            out('} else {')
+            with self.indent():
+                fields = ['Tagged: launch.Tagged']
+                for f in launch.all_fields:
+                    if f.name != 'async_job_id' and not is_void_type(f.data_type):
+                        fields.append('{0}: launch.{0}'.format(fmt_var(f.name)))
+                out('res = &%s{%s}' % (res.lstrip('*'), ', '.join(fields)))
+            out('}')
+            out()
+
+            with self.block('if res.Tag != %s' % _tag_const(status, 'complete', namespace)):
+                out('err = async.JobFailedError{Tag: res.Tag, Status: res}')
+            out('return')
+
+    def _generate_struct_job(self, namespace, route, check):
+        out = self.emit
+        fn = self._fn_name(route)
+        arg = fmt_type(route.arg_data_type, namespace)
+        res = fmt_type(route.result_data_type, namespace)
+        status, _ = unwrap_nullable(check.result_data_type)
+
+        self.emit_wrapped_text(
+            '%sAndWait calls `%s`, then polls `%s` with poller until the job '
+            'is done, unless the job ID of the result is blank. A nil poller '
+            'polls with the default intervals. It returns the result of `%s`, '
+            'and an `async.JobFailedError` if the job did not complete. All '
+            'calls are made with opts.' %
+            (fn, fn, self._fn_name(check), fn), prefix='// ')
+        with self.block('func %sAndWait(ctx context.Context, dbx Client, arg %s, '
+                        'poller *async.Poller, opts ...dropbox.CallOption) (res %s, err error)' %
+                        (fn, arg, res)):
+            out('res, err = dbx.%s(ctx, arg, opts...)' % self._fn_name(route, ctx=True))
+            with self.block('if err != nil || strings.TrimSpace(res.AsyncJobId) == ""'):
+                out('return')
+            out()
+
+            out('var status %s' % fmt_type(status, namespace))
+            with self.block('err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error)',
+                            after=')'):
+                out('status, err = dbx.%s(ctx, async.NewPollArg(res.AsyncJobId), opts...)' %
+                    self._fn_name(check, ctx=True))
+                out('return err == nil && status.Tag != %s, err' %
+                    _tag_const(status, 'in_progress', namespace))
+            with self.block('if err != nil'):
+                out('return nil, err')
+            with self.block('if status.Tag != %s' % _tag_const(status, 'complete', namespace)):
+                out('err = async.JobFailedError{Tag: status.Tag, Status: status}')
+            out('return')
+
+
+# Launch routes whose check route is not named after them, see `_check_suffixes`.
+_check_routes = {
+    'sharing': {
+        'relinquish_folder_membership': 'check_job_status',
+        'remove_folder_member': 'check_remove_member_job_status',
+        'set_access_inheritance': 'check_share_job_status',
+        'share_folder': 'check_share_job_status',
+        'unshare_folder': 'check_job_status',
+    },
+    'team': {
+        'groups/delete': 'groups/job_status/get',
+        'groups/members/add': 'groups/job_status/get',
+        'groups/members/remove': 'groups/job_status/get',
+    },
+}
+
+_check_suffixes = ['/check', '/check_job_status', '/job_status/check', '/job_status/get']
+
+
+# Sentinel errors are generated for the tags of unions named `...Error` that
+# are nested in the endpoint errors of at least this many routes.
+_SENTINEL_MIN_ROUTES = 5
+
+
+def _sentinel_unions(api):
+    """
+    Returns the `(namespace, name)` of the unions to generate sentinel errors
+    for.
+    """
+    routes = {}
+    for namespace in api.namespaces.values():
+        for route in namespace.routes:
+            err, _ = unwrap_nullable(route.error_data_type)
+            if not (is_struct_type(err) or is_union_type(err)):
+                continue
+            for _, union in _error_paths(err):
+                if union.name.endswith('Error'):
+                    key = (union.namespace.name, union.name)
+                    routes.setdefault(key, set()).add(
+                        (namespace.name, route.name, route.version))
+    return {key for key, users in routes.items()
+            if len(users) >= _SENTINEL_MIN_ROUTES}
+
+
+def _error_paths(data_type, seen=()):
+    """
+    Yields the Go field path, e.g. `['Path', 'Reason']`, and the type of each
+    union nested in an endpoint error of type data_type. Structs are only
+    followed into their union fields.
+    """
+    for field in data_type.all_fields:
+        field_type, _ = unwrap_nullable(field.data_type)
+        if is_struct_type(field_type):
+            if is_struct_type(data_type) or field_type.has_enumerated_subtypes():
+                continue
+        elif not is_union_type(field_type):
+            continue
+        if field_type in seen:
+            continue
+        name = fmt_var(field.name)
+        if is_union_type(field_type):
+            yield [name], field_type
+        for path, union in _error_paths(field_type, seen + (data_type, field_type)):
+            yield [name] + path, union
+
+
+def _tag_const(union, tag, namespace):
+    name = fmt_var(union.name) + fmt_var(tag)
+    if union.namespace.name != namespace.name:
+        name = union.namespace.name + '.' + name
+    return name
+
+
+def _field(data_type, name):
+    data_type, _ = unwrap_nullable(data_type)
+    if not (is_struct_type(data_type) or is_union_type(data_type)):
+        return None
+    for field in data_type.all_fields:
+        if field.name == name:
+            return field
+    return None
+
+
+def _is_nullable_string(data_type):
+    data_type, nullable = unwrap_nullable(data_type)
+    return nullable and is_string_type(data_type)
+
+
+def _takes_cursor(data_type):
+    data_type, _ = unwrap_nullable(data_type)
+    if not is_struct_type(data_type):
+        return False
+    required = data_type.all_required_fields
+    return (len(required) == 1 and required[0].name == 'cursor' and
+            is_string_type(unwrap_nullable(required[0].data_type)[0]))
+
+
+def _page_fields(data_type):
+    """
+    Returns the `(entries, cursor, has_more)` fields of a page of results, or
+    None if data_type is not a page. has_more may be None, in which case the
+    presence of a cursor signals further pages.
+    """
+    data_type, _ = unwrap_nullable(data_type)
+    if not is_struct_type(data_type) or data_type.has_enumerated_subtypes():
+        return None
+
+    cursor = _field(data_type, 'cursor')
+    if cursor is None:
+        return None
+    cursor_type, _ = unwrap_nullable(cursor.data_type)
+    if is_struct_type(cursor_type):
+        value = _field(cursor_type, 'value')
+        if value is None or not is_string_type(unwrap_nullable(value.data_type)[0]):
+            return None
+    elif not is_string_type(cursor_type):
+        return None
+
+    has_more = _field(data_type, 'has_more')
+    if has_more is not None and not is_boolean_type(unwrap_nullable(has_more.data_type)[0]):
+        return None
+
+    lists = [f for f in data_type.all_fields
+             if is_list_type(unwrap_nullable(f.data_type)[0])]
+    if len(lists) != 1:
+        return None
+    return lists[0], cursor, has_more
@@ -0,0 +1,93 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotImplemented is returned by the methods of the generated fakes, e.g.
+// files.Fake, whose stub is not set.
+var ErrNotImplemented = errors.New("not implemented by fake")
+
+// NotImplemented returns the error of a fake method without stub.
+func NotImplemented(namespace, method string) error {
+	return fmt.Errorf("%s.%s: %w", namespace, method, ErrNotImplemented)
+}
+
+// FakeCall is a call of a generated fake.
+type FakeCall struct {
+	// Method called, without its Context suffix, e.g. "GetMetadata"
+	Method string
+	// Arguments of the call, without its context and options: the argument
+	// of the route if it takes one, followed by the content of uploads
+	Args []interface{}
+	// Options of the call
+	Options []CallOption
+}
+
+// FakeCalls records the calls of a generated fake. It is safe for concurrent
+// use.
+type FakeCalls struct {
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+// Record records a call of method.
+func (f *FakeCalls) Record(method string, opts []CallOption, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Args: args, Options: opts})
+}
+
+// Calls returns all calls recorded so far, in order.
+func (f *FakeCalls) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// CallsTo returns the calls of method recorded so far, in order.
+func (f *FakeCalls) CallsTo(method string) []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []FakeCall
+	for _, c := range f.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// CallCount returns the number of calls of method recorded so far.
+func (f *FakeCalls) CallCount(method string) int {
+	return len(f.CallsTo(method))
+}
+
+// ResetCalls forgets the calls recorded so far.
+func (f *FakeCalls) ResetCalls() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
@@ -0,0 +1,174 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+)
+
+// redacted replaces the values of sensitive fields in logged arguments.
+const redacted = "[REDACTED]"
+
+// StructuredLogger logs messages with attributes, given as alternating keys
+// and values. It is implemented by *slog.Logger, so on Go 1.21 or later a
+// Config can log through log/slog:
+//
+//	config.StructuredLogger = slog.Default()
+type StructuredLogger interface {
+	DebugContext(ctx context.Context, msg string, args ...interface{})
+	InfoContext(ctx context.Context, msg string, args ...interface{})
+	WarnContext(ctx context.Context, msg string, args ...interface{})
+}
+
+// logCalls wraps next to log every call to Config.StructuredLogger: its
+// argument before it is sent, at debug level and if sampled, and its
+// outcome.
+func (c *Context) logCalls(next Handler) Handler {
+	logger := c.Config.StructuredLogger
+	return func(ctx context.Context, req Request, body io.Reader) (*Response, error) {
+		opts := c.callOptions(req)
+		attrs := []interface{}{"route", req.Namespace + "/" + req.Route}
+		if opts.asMemberID != "" {
+			attrs = append(attrs, "member_id", opts.asMemberID)
+		}
+		if opts.asAdminID != "" {
+			attrs = append(attrs, "admin_id", opts.asAdminID)
+		}
+
+		if c.sampleDebugLog() {
+			logger.DebugContext(ctx, "Dropbox API request",
+				append(attrs, "style", req.Style, "arg", loggedArg{c, req.Arg})...)
+		}
+
+		start := time.Now()
+		res, err := next(ctx, req, body)
+		attrs = append(attrs, "duration", time.Since(start))
+		if res != nil {
+			attrs = append(attrs, "status", res.StatusCode, "attempts", res.Attempts)
+			if id := res.Header.Get("X-Dropbox-Request-Id"); id != "" {
+				attrs = append(attrs, "request_id", id)
+			}
+			if res.ErrorSummary != "" {
+				attrs = append(attrs, "error_summary", res.ErrorSummary)
+			}
+		}
+		if err != nil {
+			logger.WarnContext(ctx, "Dropbox API call failed", append(attrs, "error", err)...)
+		} else {
+			logger.InfoContext(ctx, "Dropbox API call", attrs...)
+		}
+		return res, err
+	}
+}
+
+// loggedArg formats the argument of a call with its sensitive fields
+// redacted. It is only encoded if the logger writes the message, and is
+// encoded as a string, e.g. by the handlers of log/slog.
+type loggedArg struct {
+	c   *Context
+	arg interface{}
+}
+
+func (a loggedArg) String() string {
+	arg, err := a.c.redactArg(a.arg)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return arg
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (a loggedArg) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// sampleDebugLog reports whether the argument of a call is logged.
+func (c *Context) sampleDebugLog() bool {
+	rate := c.Config.DebugLogSampleRate
+	return rate <= 0 || rate >= 1 || rand.Float64() < rate
+}
+
+// redactArg returns the JSON encoding of arg with the values of sensitive
+// fields replaced, however deeply nested.
+func (c *Context) redactArg(arg interface{}) (string, error) {
+	if arg == nil {
+		return "null", nil
+	}
+	b, err := json.Marshal(arg)
+	if err != nil {
+		return "", err
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if err = d.Decode(&v); err != nil {
+		return "", err
+	}
+	b, err = json.Marshal(c.redact(v))
+	return string(b), err
+}
+
+func (c *Context) redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if c.isSensitive(k) {
+				v[k] = redacted
+			} else {
+				v[k] = c.redact(field)
+			}
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = c.redact(elem)
+		}
+	}
+	return v
+}
+
+func (c *Context) isSensitive(field string) bool {
+	if sensitiveFields[field] {
+		return true
+	}
+	for _, f := range c.Config.RedactFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// logStructured logs a message of the SDK to Config.StructuredLogger.
+func (c *Config) logStructured(l LogLevel, format string, v ...interface{}) {
+	msg := fmt.Sprintf(format, v...)
+	if l == LogDebug {
+		c.StructuredLogger.DebugContext(context.Background(), msg)
+	} else {
+		c.StructuredLogger.InfoContext(context.Background(), msg)
+	}
+}
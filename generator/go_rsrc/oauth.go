@@ -0,0 +1,227 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// OfflineAccessOption is an option for oauth2.Config.AuthCodeURL that
+// requests a refresh token along with the short-lived access token, as needed
+// for Config.RefreshToken.
+var OfflineAccessOption = oauth2.SetAuthURLParam("token_access_type", "offline")
+
+// ErrRejectedToken is returned when Config.TokenSource yields the access token
+// the server has just rejected as expired, instead of a new one.
+var ErrRejectedToken = errors.New("dropbox: token source returned the access token rejected as expired")
+
+// NewCodeVerifier returns a random code verifier for the authorization code
+// flow with PKCE (RFC 7636). The verifier must be kept until the
+// authorization code is exchanged.
+//
+// PKCE allows apps that can not keep their app secret confidential, like
+// desktop or mobile apps, to obtain tokens with only their app key:
+//
+//	conf := &oauth2.Config{ClientID: appKey, Endpoint: dropbox.OAuthEndpoint("")}
+//	verifier, err := dropbox.NewCodeVerifier()
+//	opts := append(dropbox.ChallengeOptions(verifier), dropbox.OfflineAccessOption)
+//	url := conf.AuthCodeURL(state, opts...)
+//	// Send the user to url and receive the authorization code
+//	tok, err := conf.Exchange(ctx, code, dropbox.VerifierOption(verifier))
+func NewCodeVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge returns the S256 code challenge for verifier.
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// ChallengeOptions returns the options for oauth2.Config.AuthCodeURL that
+// send the code challenge for verifier.
+func ChallengeOptions(verifier string) []oauth2.AuthCodeOption {
+	return []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", CodeChallenge(verifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+}
+
+// VerifierOption returns the option for oauth2.Config.Exchange that sends
+// verifier along with the authorization code.
+func VerifierOption(verifier string) oauth2.AuthCodeOption {
+	return oauth2.SetAuthURLParam("code_verifier", verifier)
+}
+
+// tokenSource caches the access tokens of src until they expire, or until
+// the API rejects them. With a TokenStore, tokens are loaded from the store
+// before falling back to src, and tokens obtained from src are saved to it.
+type tokenSource struct {
+	// mu is shared by all sources using the same TokenLocker and ID, so
+	// that only one of them refreshes the token at a time.
+	mu       sync.Locker
+	src      oauth2.TokenSource
+	tok      *oauth2.Token
+	store    TokenStore
+	id       string
+	rejected string
+}
+
+// newTokenSource returns the refreshable source of access tokens configured
+// by c, or nil if c only has a static token.
+func newTokenSource(c Config, domain string) *tokenSource {
+	s := &tokenSource{mu: new(sync.Mutex), src: c.TokenSource, store: c.TokenStore, id: c.TokenStoreID}
+	if s.src == nil {
+		if c.RefreshToken == "" && c.TokenStore == nil {
+			return nil
+		}
+
+		conf := &oauth2.Config{
+			ClientID:     c.AppKey,
+			ClientSecret: c.AppSecret,
+			Endpoint:     OAuthEndpoint(domain),
+		}
+		if c.AppSecret == "" {
+			conf.Endpoint.AuthStyle = oauth2.AuthStyleInParams
+		}
+		ctx := context.Background()
+		if c.Client != nil {
+			ctx = context.WithValue(ctx, oauth2.HTTPClient, c.Client)
+		}
+
+		s.src = &refresher{ctx: ctx, conf: conf, refreshToken: c.RefreshToken}
+		if c.Token != "" {
+			s.tok = &oauth2.Token{AccessToken: c.Token, RefreshToken: c.RefreshToken}
+		}
+	}
+	if l, ok := s.store.(TokenLocker); ok {
+		s.mu = l.TokenLock(s.id)
+	}
+	return s
+}
+
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tok.Valid() {
+		return s.tok, nil
+	}
+
+	if s.store != nil {
+		tok, err := s.store.Load(s.id)
+		if err != nil {
+			return nil, err
+		}
+		if tok != nil {
+			if r, ok := s.src.(*refresher); ok && tok.RefreshToken != "" {
+				r.refreshToken = tok.RefreshToken
+			}
+			// Another source may have refreshed the token already.
+			if tok.Valid() && tok.AccessToken != s.rejected {
+				s.tok = tok
+				return tok, nil
+			}
+		}
+	}
+
+	tok, err := s.src.Token()
+	if err != nil {
+		return nil, err
+	}
+	if s.rejected != "" && tok.AccessToken == s.rejected {
+		return nil, ErrRejectedToken
+	}
+	if s.store != nil {
+		if err = s.store.Save(s.id, tok); err != nil {
+			return nil, err
+		}
+	}
+	s.tok = tok
+	return tok, nil
+}
+
+// invalidate discards the cached token if it is the rejected access token,
+// so that the next call to Token obtains a new one.
+func (s *tokenSource) invalidate(rejected string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tok != nil && (rejected == "" || s.tok.AccessToken == rejected) {
+		s.rejected = s.tok.AccessToken
+		s.tok = nil
+	}
+}
+
+// refresher obtains a new access token for every call to Token.
+type refresher struct {
+	ctx          context.Context
+	conf         *oauth2.Config
+	refreshToken string
+}
+
+func (r *refresher) Token() (*oauth2.Token, error) {
+	if r.refreshToken == "" {
+		return nil, errors.New("dropbox: no refresh token to obtain a new access token")
+	}
+	// A token without access token is always refreshed.
+	tok, err := r.conf.TokenSource(r.ctx, &oauth2.Token{RefreshToken: r.refreshToken}).Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok.RefreshToken != "" {
+		r.refreshToken = tok.RefreshToken
+	}
+	return tok, nil
+}
+
+// isExpiredToken reports whether a 401 response body is an
+// `expired_access_token` error.
+func isExpiredToken(body []byte) bool {
+	var authErr struct {
+		Error struct {
+			Tag string `json:".tag"`
+		} `json:"error"`
+	}
+	return json.Unmarshal(body, &authErr) == nil && authErr.Error.Tag == "expired_access_token"
+}
+
+// sentToken returns the access token sent with the request of resp.
+func sentToken(resp *http.Response) string {
+	if resp.Request == nil {
+		return ""
+	}
+	return strings.TrimPrefix(resp.Request.Header.Get("Authorization"), "Bearer ")
+}
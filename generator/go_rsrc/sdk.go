@@ -23,6 +23,8 @@ package dropbox
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -31,6 +33,8 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"golang.org/x/oauth2"
 )
@@ -55,38 +59,151 @@ type Tagged struct {
 	Tag string `json:".tag"`
 }
 
-// APIError is the base type for endpoint-specific errors.
+// APIError is the base type for endpoint-specific errors. It is embedded in
+// the errors of all failed API calls, and its As method allows errors.As to
+// extract it from any of them, e.g. to log the request ID:
+//
+//	var apiErr dropbox.APIError
+//	if errors.As(err, &apiErr) {
+//		log.Printf("%s failed, request ID %s", apiErr.Route, apiErr.RequestID)
+//	}
 type APIError struct {
 	ErrorSummary string `json:"error_summary"`
+	// Route of the failed call, e.g. "files/download"
+	Route string `json:"-"`
+	// HTTP status code of the response
+	StatusCode int `json:"-"`
+	// ID of the request, to be quoted when contacting Dropbox support
+	RequestID string `json:"-"`
 }
 
 func (e APIError) Error() string {
 	return e.ErrorSummary
 }
 
+// As sets target to e if it is an *APIError.
+func (e APIError) As(target interface{}) bool {
+	if t, ok := target.(*APIError); ok {
+		*t = e
+		return true
+	}
+	return false
+}
+
+// SDKInternalError is returned for failed API calls whose response could not
+// be interpreted. Namespace clients convert it into the errors documented by
+// the API, e.g. auth.RateLimitAPIError.
 type SDKInternalError struct {
 	StatusCode int
 	Content    string
+	// Route of the failed call, e.g. "files/download"
+	Route string
+	// ID of the request, to be quoted when contacting Dropbox support
+	RequestID string
 }
 
 func (e SDKInternalError) Error() string {
 	return fmt.Sprintf("Unexpected error: %v (code: %v)", e.Content, e.StatusCode)
 }
 
+// APIError returns an APIError with the content and request metadata of e.
+func (e SDKInternalError) APIError() APIError {
+	return APIError{
+		ErrorSummary: e.Content,
+		Route:        e.Route,
+		StatusCode:   e.StatusCode,
+		RequestID:    e.RequestID,
+	}
+}
+
+// As sets target to e.APIError() if it is an *APIError.
+func (e SDKInternalError) As(target interface{}) bool {
+	return e.APIError().As(target)
+}
+
+// EndpointErrorMatcher is implemented by sentinel errors matching the
+// endpoint errors of routes, like files.ErrLookupNotFound. The errors of all
+// routes match them with errors.Is.
+type EndpointErrorMatcher interface {
+	error
+	// MatchEndpointError reports whether endpointError, the EndpointError of
+	// a route's error or a union nested in it, matches.
+	MatchEndpointError(endpointError interface{}) bool
+}
+
+// MissingCredentialError is returned for routes that accept none of the
+// credentials in the Config, e.g. a route using app auth without AppKey and
+// AppSecret.
+type MissingCredentialError struct {
+	Namespace string
+	Route     string
+	// Auth types accepted by the route, e.g. "app, user"
+	Auth string
+}
+
+func (e MissingCredentialError) Error() string {
+	return fmt.Sprintf("no credential configured for %s/%s, which requires %s auth", e.Namespace, e.Route, e.Auth)
+}
+
 // Config contains parameters for configuring the SDK.
 type Config struct {
 	// OAuth2 access token
 	Token string
+	// OAuth2 refresh token. If set, a new access token is obtained with AppKey
+	// and AppSecret whenever Token is missing or has expired.
+	RefreshToken string
+	// App key, required with RefreshToken and for routes using app auth
+	AppKey string
+	// App secret, required for routes using app auth, and with RefreshToken
+	// unless the refresh token was obtained using PKCE
+	AppSecret string
+	// Source of OAuth2 access tokens, e.g. from oauth2.Config.TokenSource.
+	// Takes precedence over Token and RefreshToken. After the server rejected
+	// an access token as expired, the source must return a new one; a source
+	// that keeps returning the cached token fails with ErrRejectedToken.
+	TokenSource oauth2.TokenSource
+	// Store consulted for access and refresh tokens before a new access token
+	// is obtained, and updated with every new token
+	TokenStore TokenStore
+	// Account or team ID under which tokens are kept in TokenStore
+	TokenStoreID string
 	// Logging level for SDK generated logs
 	LogLevel LogLevel
 	// Logging target for verbose SDK logging
 	Logger *log.Logger
+	// Structured logging target, e.g. a *slog.Logger. If set, SDK logs are
+	// written to it instead of Logger, filtered by its level rather than
+	// LogLevel, and every call is logged with its route, member, status,
+	// duration and request ID. Arguments of calls are logged at debug level,
+	// with passwords and tokens redacted.
+	StructuredLogger StructuredLogger
+	// Fraction in (0, 1] of calls whose arguments are logged at debug level
+	// to StructuredLogger. Zero logs the arguments of all calls.
+	DebugLogSampleRate float64
+	// Names of argument fields, e.g. "path", redacted from logs in addition
+	// to the passwords and tokens of the API
+	RedactFields []string
 	// Used with APIs that support operations as another user
 	AsMemberID string
 	// Used with APIs that support operations as an admin
 	AsAdminID string
 	// Path relative to which action should be taken
 	PathRoot string
+	// Policy for retrying rate limited and failed requests. Requests are not
+	// retried if nil.
+	RetryPolicy *RetryPolicy
+	// Limits on the number of concurrent requests. No limits apply if nil.
+	Governor *Governor
+	// Circuit breaker failing calls fast while the API is failing. Calls are
+	// not rejected if nil.
+	CircuitBreaker *CircuitBreaker
+	// Middleware wrapping the execution of every call, the first one
+	// outermost
+	Middleware []Middleware
+	// If set, upload routes that accept a content hash send the content hash
+	// of their body, unless the argument already specifies one. Only bodies
+	// implementing io.Seeker are hashed, as they are read twice.
+	ComputeContentHash bool
 	// No need to set -- for testing only
 	Domain string
 	// No need to set -- for testing only
@@ -115,6 +232,10 @@ func (l LogLevel) shouldLog(v LogLevel) bool {
 }
 
 func (c *Config) doLog(l LogLevel, format string, v ...interface{}) {
+	if c.StructuredLogger != nil {
+		c.logStructured(l, format, v...)
+		return
+	}
 	if !c.LogLevel.shouldLog(l) {
 		return
 	}
@@ -138,12 +259,12 @@ func (c *Config) LogInfo(format string, v ...interface{}) {
 
 // Ergonomic methods to set namespace relative to which action should be taken
 func (c Config) WithNamespaceID(nsID string) Config {
-	c.PathRoot = fmt.Sprintf(`{".tag": "namespace_id", "namespace_id": "%s"}`, nsID)
+	c.PathRoot = namespacePathRoot(nsID)
 	return c
 }
 
 func (c Config) WithRoot(nsID string) Config {
-	c.PathRoot = fmt.Sprintf(`{".tag": "root", "root": "%s"}`, nsID)
+	c.PathRoot = rootPathRoot(nsID)
 	return c
 }
 
@@ -154,6 +275,11 @@ type Context struct {
 	NoAuthClient    *http.Client
 	HeaderGenerator func(hostType string, namespace string, route string) map[string]string
 	URLGenerator    func(hostType string, namespace string, route string) string
+
+	tokens *tokenSource
+	// Identity of the credentials, distinguishing their users in the
+	// Governor when no member is selected
+	tokenID string
 }
 
 type Request struct {
@@ -165,18 +291,307 @@ type Request struct {
 
 	Arg          interface{}
 	ExtraHeaders map[string]string
+	// Whether Arg has a `content_hash` field for the body
+	AcceptsContentHash bool
+	// Options of the call, overriding the Config
+	Options []CallOption
 }
 
+// Execute is like ExecuteContext, using context.Background.
 func (c *Context) Execute(req Request, body io.Reader) ([]byte, io.ReadCloser, error) {
-	url := c.URLGenerator(req.Host, req.Namespace, req.Route)
-	httpReq, err := http.NewRequest("POST", url, body)
+	return c.ExecuteContext(context.Background(), req, body)
+}
+
+// ExecuteContext sends req to the API, through the middleware of the Config.
+// Cancelling ctx aborts the request, and for download routes also the read of
+// the returned body.
+func (c *Context) ExecuteContext(ctx context.Context, req Request, body io.Reader) ([]byte, io.ReadCloser, error) {
+	res, err := c.handler()(ctx, req, body)
+	if res != nil {
+		for _, fn := range c.callOptions(req).onResponse {
+			fn(res)
+		}
+	}
 	if err != nil {
 		return nil, nil, err
 	}
+	if res == nil {
+		return nil, nil, errors.New("Expected response from middleware, got nil")
+	}
+	return res.Result, res.Body, nil
+}
+
+// execute sends req to the API, retrying it as configured.
+func (c *Context) execute(ctx context.Context, req Request, body io.Reader) (*Response, error) {
+	var serializedArg []byte
+	if req.Arg != nil {
+		var err error
+		serializedArg, err = json.Marshal(req.Arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.Style == "rpc" && body != nil {
+			return nil, errors.New("RPC style requests can not have body")
+		}
+
+		if c.Config.ComputeContentHash && req.AcceptsContentHash {
+			serializedArg, err = addContentHash(serializedArg, body)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	cred, err := c.credential(req)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := c.Config.RetryPolicy
+	refresh := c.tokens != nil && cred == credToken
+	var rewindable *rewindableBody
+	if (policy != nil || refresh) && body != nil {
+		rewindable = newRewindableBody(body)
+		if rewindable == nil {
+			// The body can only be sent once.
+			policy = nil
+			refresh = false
+		}
+	}
+
+	var sent int64
+	var rateLimits []time.Duration
+	response := func(resp *http.Response, attempts int) *Response {
+		return &Response{StatusCode: resp.StatusCode, Header: resp.Header, Auth: authType(req, cred), Attempts: attempts,
+			BytesSent: atomic.LoadInt64(&sent), RateLimits: rateLimits}
+	}
+	member, namespace := c.governorKeys(req, cred)
+	for attempt := 1; ; attempt++ {
+		report, err := c.Config.CircuitBreaker.allow(req.Host)
+		if err != nil {
+			return nil, err
+		}
+		release, err := c.Config.Governor.acquire(ctx, member, namespace)
+		if err != nil {
+			report(requestIgnored)
+			return nil, err
+		}
+		done := func(resp *http.Response, body []byte) {
+			release(resp, body)
+			report(requestOutcome(ctx, resp))
+		}
+		resp, err := c.send(ctx, req, cred, body, rewindable, serializedArg, &sent)
+		if err != nil {
+			done(nil, nil)
+			if ctx.Err() == nil && policy.shouldRetry(attempt, RetryOnNetworkError) {
+				if err = c.waitToRetry(ctx, req, rewindable, attempt, policy.backoff(attempt, 0)); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
+			report(requestOutcome(ctx, resp))
+			switch req.Style {
+			case "rpc", "upload":
+				release(resp, nil)
+				if resp.Body == nil {
+					return nil, errors.New("Expected body in RPC response, got nil")
+				}
+
+				b, err := ioutil.ReadAll(resp.Body)
+				resp.Body.Close()
+				if err != nil {
+					return nil, err
+				}
+
+				res := response(resp, attempt)
+				res.Result = b
+				return res, nil
+			case "download":
+				res := response(resp, attempt)
+				res.Result = []byte(resp.Header.Get("Dropbox-API-Result"))
+				res.Body = resp.Body
+				if c.Config.Governor != nil {
+					// Downloads keep their place until the body is closed.
+					res.Body = &releasingBody{ReadCloser: resp.Body, release: func() { release(resp, nil) }}
+				}
+				return res, nil
+			}
+		}
+
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		done(resp, b)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			rateLimits = append(rateLimits, retryAfter(resp.Header, b))
+		}
+
+		if refresh && resp.StatusCode == http.StatusUnauthorized && isExpiredToken(b) {
+			// Retry once with a new access token.
+			c.Config.LogInfo("Refreshing expired access token for %s/%s", req.Namespace, req.Route)
+			refresh = false
+			c.tokens.invalidate(sentToken(resp))
+			if rewindable != nil {
+				if err = rewindable.rewind(); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		if policy.shouldRetry(attempt, retryClass(resp.StatusCode)) {
+			delay := policy.backoff(attempt, retryAfter(resp.Header, b))
+			if err = c.waitToRetry(ctx, req, rewindable, attempt, delay); err != nil {
+				return nil, err
+			}
+			continue
+		}
 
+		res := response(resp, attempt)
+		res.ErrorSummary, res.ErrorTag = parseError(b)
+		return res, SDKInternalError{
+			StatusCode: resp.StatusCode,
+			Content:    string(b),
+			Route:      req.Namespace + "/" + req.Route,
+			RequestID:  resp.Header.Get("X-Dropbox-Request-Id"),
+		}
+	}
+}
+
+func (c *Context) waitToRetry(ctx context.Context, req Request, body *rewindableBody, attempt int, delay time.Duration) error {
+	c.Config.LogInfo("Retrying %s/%s in %v (attempt %d failed)", req.Namespace, req.Route, delay, attempt)
+	if err := sleep(ctx, delay); err != nil {
+		return err
+	}
+	if body != nil {
+		return body.rewind()
+	}
+	return nil
+}
+
+// Credentials a request can be authenticated with
+const (
+	// OAuth2 access token of a user or team
+	credToken = "token"
+	// App key and secret
+	credApp  = "app"
+	credNone = "noauth"
+)
+
+// credential selects the credential for req among those accepted by its
+// route, preferring the access token unless WithAppAuth is set.
+func (c *Context) credential(req Request) (string, error) {
+	var token, app, none bool
+	for _, auth := range strings.Split(req.Auth, ",") {
+		switch strings.TrimSpace(auth) {
+		case "user", "team", "":
+			token = true
+		case "app":
+			app = true
+		case "noauth":
+			none = true
+		}
+	}
+
+	// A custom Client may authenticate requests by itself.
+	custom := c.Config.Client != nil
+	hasToken := c.Config.Token != "" || c.tokens != nil || custom
+	hasApp := c.Config.AppKey != "" && c.Config.AppSecret != ""
+	if c.callOptions(req).appAuth {
+		token, none = false, false
+	}
+	switch {
+	case token && hasToken:
+		return credToken, nil
+	case app && (hasApp || custom):
+		return credApp, nil
+	case none:
+		return credNone, nil
+	}
+	return "", MissingCredentialError{Namespace: req.Namespace, Route: req.Route, Auth: req.Auth}
+}
+
+// authType returns the auth type of the route that cred stands for, e.g.
+// "team" for the access token of a route with team auth.
+func authType(req Request, cred string) string {
+	if cred != credToken {
+		return cred
+	}
+	for _, auth := range strings.Split(req.Auth, ",") {
+		if auth = strings.TrimSpace(auth); auth == "user" || auth == "team" {
+			return auth
+		}
+	}
+	return "user"
+}
+
+// contexts numbers the Contexts whose credentials have no identity.
+var contexts int64
+
+// tokenID returns an identity of the credentials of c, which does not reveal
+// them: the ID of the token in the TokenStore, a hash of the token, or a
+// number unique to the Context for other token sources.
+func tokenID(c Config) string {
+	switch {
+	case c.TokenStore != nil:
+		return "store:" + c.TokenStoreID
+	case c.RefreshToken != "":
+		return "token:" + hashToken(c.RefreshToken)
+	case c.Token != "" && c.TokenSource == nil:
+		return "token:" + hashToken(c.Token)
+	}
+	return fmt.Sprintf("context:%d", atomic.AddInt64(&contexts, 1))
+}
+
+func hashToken(tok string) string {
+	sum := sha256.Sum256([]byte(tok))
+	return hex.EncodeToString(sum[:8])
+}
+
+// governorKeys returns the member and namespace a request is made for, as
+// distinguished by the Governor.
+func (c *Context) governorKeys(req Request, cred string) (member, namespace string) {
+	opts := c.callOptions(req)
+	switch {
+	case cred == credToken && req.Auth != "team" && opts.asMemberID != "":
+		member = opts.asMemberID
+	case cred == credToken:
+		member = c.tokenID
+	case cred == credApp:
+		member = "app:" + c.Config.AppKey
+	}
+	if opts.pathRoot != "" {
+		return member, opts.pathRoot
+	}
+	return member, "home:" + member
+}
+
+// send performs a single attempt of req, authenticated with cred, and adds the
+// number of bytes of the request body sent to sent.
+func (c *Context) send(ctx context.Context, req Request, cred string, body io.Reader, rewindable *rewindableBody, serializedArg []byte, sent *int64) (*http.Response, error) {
+	url := c.URLGenerator(req.Host, req.Namespace, req.Route)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+	if rewindable != nil {
+		rewindable.setBody(httpReq)
+	}
+
+	opts := c.callOptions(req)
 	for k, v := range req.ExtraHeaders {
 		httpReq.Header.Add(k, v)
 	}
+	for k, v := range opts.headers {
+		httpReq.Header.Set(k, v)
+	}
 
 	for k, v := range c.HeaderGenerator(req.Host, req.Namespace, req.Route) {
 		httpReq.Header.Add(k, v)
@@ -186,31 +601,27 @@ func (c *Context) Execute(req Request, body io.Reader) ([]byte, io.ReadCloser, e
 		httpReq.Host = httpReq.Header.Get("Host")
 	}
 
-	if req.Auth == "noauth" {
+	switch cred {
+	case credApp:
+		if c.Config.AppKey != "" && c.Config.AppSecret != "" {
+			httpReq.SetBasicAuth(c.Config.AppKey, c.Config.AppSecret)
+		}
+	case credNone:
 		httpReq.Header.Del("Authorization")
 	}
-	if req.Auth != "team" && c.Config.AsMemberID != "" {
-		httpReq.Header.Add("Dropbox-API-Select-User", c.Config.AsMemberID)
+	if cred == credToken && req.Auth != "team" && opts.asMemberID != "" {
+		httpReq.Header.Add("Dropbox-API-Select-User", opts.asMemberID)
 	}
-	if req.Auth != "team" && c.Config.AsAdminID != "" {
-		httpReq.Header.Add("Dropbox-API-Select-Admin", c.Config.AsAdminID)
+	if cred == credToken && req.Auth != "team" && opts.asAdminID != "" {
+		httpReq.Header.Add("Dropbox-API-Select-Admin", opts.asAdminID)
 	}
-	if c.Config.PathRoot != "" {
-		httpReq.Header.Add("Dropbox-API-Path-Root", c.Config.PathRoot)
+	if opts.pathRoot != "" {
+		httpReq.Header.Add("Dropbox-API-Path-Root", opts.pathRoot)
 	}
 
-	if req.Arg != nil {
-		serializedArg, err := json.Marshal(req.Arg)
-		if err != nil {
-			return nil, nil, err
-		}
-
+	if serializedArg != nil {
 		switch req.Style {
 		case "rpc":
-			if body != nil {
-				return nil, nil, errors.New("RPC style requests can not have body")
-			}
-
 			httpReq.Header.Set("Content-Type", "application/json")
 			httpReq.Body = ioutil.NopCloser(bytes.NewReader(serializedArg))
 			httpReq.ContentLength = int64(len(serializedArg))
@@ -220,46 +631,16 @@ func (c *Context) Execute(req Request, body io.Reader) ([]byte, io.ReadCloser, e
 		}
 	}
 
-	client := c.Client
-	if req.Auth == "noauth" {
-		client = c.NoAuthClient
+	if httpReq.Body != nil && httpReq.Body != http.NoBody {
+		httpReq.Body = &countingBody{httpReq.Body, sent}
 	}
 
-	resp, err := client.Do(httpReq)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
-		switch req.Style {
-		case "rpc", "upload":
-			if resp.Body == nil {
-				return nil, nil, errors.New("Expected body in RPC response, got nil")
-			}
-
-			b, err := ioutil.ReadAll(resp.Body)
-			resp.Body.Close()
-			if err != nil {
-				return nil, nil, err
-			}
-
-			return b, nil, nil
-		case "download":
-			b := []byte(resp.Header.Get("Dropbox-API-Result"))
-			return b, resp.Body, nil
-		}
-	}
-
-	b, err := ioutil.ReadAll(resp.Body)
-	resp.Body.Close()
-	if err != nil {
-		return nil, nil, err
+	client := c.Client
+	if cred != credToken {
+		client = c.NoAuthClient
 	}
 
-	return nil, nil, SDKInternalError{
-		StatusCode: resp.StatusCode,
-		Content:    string(b),
-	}
+	return client.Do(httpReq)
 }
 
 // NewContext returns a new Context with the given Config.
@@ -269,8 +650,18 @@ func NewContext(c Config) Context {
 		domain = defaultDomain
 	}
 
+	tokens := newTokenSource(c, domain)
 	client := c.Client
-	if client == nil {
+	switch {
+	case tokens != nil:
+		base := http.DefaultClient
+		if c.Client != nil {
+			base = c.Client
+		}
+		authClient := *base
+		authClient.Transport = &oauth2.Transport{Source: tokens, Base: base.Transport}
+		client = &authClient
+	case client == nil:
 		var conf = &oauth2.Config{Endpoint: OAuthEndpoint(domain)}
 		tok := &oauth2.Token{AccessToken: c.Token}
 		client = conf.Client(context.Background(), tok)
@@ -301,7 +692,7 @@ func NewContext(c Config) Context {
 		}
 	}
 
-	return Context{c, client, noAuthClient, headerGenerator, urlGenerator}
+	return Context{c, client, noAuthClient, headerGenerator, urlGenerator, tokens, tokenID(c)}
 }
 
 // OAuthEndpoint constructs an `oauth2.Endpoint` for the given domain
@@ -0,0 +1,215 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenStore persists OAuth2 tokens by account or team ID. It is consulted
+// before the SDK obtains a new access token, and receives every token the SDK
+// obtains, so that refreshed tokens survive restarts and are shared by all
+// clients using the same store.
+//
+// Refreshes of the token for an ID are serialized across all clients in the
+// process that use the same store, provided the store implements TokenLocker.
+type TokenStore interface {
+	// Load returns the token stored for id, or nil if there is none.
+	Load(id string) (*oauth2.Token, error)
+	// Save stores tok for id.
+	Save(id string, tok *oauth2.Token) error
+}
+
+// TokenLocker is implemented by TokenStores that serialize the refreshes of
+// the token for an ID by all clients using the store, like MemoryTokenStore
+// and FileTokenStore.
+type TokenLocker interface {
+	// TokenLock returns the lock held while the token for id is refreshed.
+	TokenLock(id string) sync.Locker
+}
+
+// tokenLocks holds the locks of the tokens of a store by ID. Its zero value
+// is ready to use.
+type tokenLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// TokenLock implements TokenLocker.
+func (l *tokenLocks) TokenLock(id string) sync.Locker {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.locks == nil {
+		l.locks = map[string]*sync.Mutex{}
+	}
+	mu := l.locks[id]
+	if mu == nil {
+		mu = new(sync.Mutex)
+		l.locks[id] = mu
+	}
+	return mu
+}
+
+// MemoryTokenStore is a TokenStore keeping tokens in memory.
+type MemoryTokenStore struct {
+	tokenLocks
+
+	mu     sync.Mutex
+	tokens map[string]oauth2.Token
+}
+
+// NewMemoryTokenStore returns an empty MemoryTokenStore.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: map[string]oauth2.Token{}}
+}
+
+// Load implements TokenStore.
+func (s *MemoryTokenStore) Load(id string) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tok, ok := s.tokens[id]
+	if !ok {
+		return nil, nil
+	}
+	return &tok, nil
+}
+
+// Save implements TokenStore.
+func (s *MemoryTokenStore) Save(id string, tok *oauth2.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[id] = *tok
+	return nil
+}
+
+// FileTokenStore is a TokenStore keeping the tokens of all IDs in a single
+// file, encrypted with AES-GCM. The file is replaced atomically on every
+// save, and is only readable by its owner.
+type FileTokenStore struct {
+	tokenLocks
+
+	path string
+	aead cipher.AEAD
+	mu   sync.Mutex
+}
+
+// NewFileTokenStore returns a FileTokenStore for the file at path, which is
+// created on the first save. key must be 16, 24 or 32 bytes long, to select
+// AES-128, AES-192 or AES-256.
+func NewFileTokenStore(path string, key []byte) (*FileTokenStore, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &FileTokenStore{path: path, aead: aead}, nil
+}
+
+// Load implements TokenStore.
+func (s *FileTokenStore) Load(id string) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return tokens[id], nil
+}
+
+// Save implements TokenStore.
+func (s *FileTokenStore) Save(id string, tok *oauth2.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.read()
+	if err != nil {
+		return err
+	}
+	tokens[id] = tok
+	return s.write(tokens)
+}
+
+func (s *FileTokenStore) read() (map[string]*oauth2.Token, error) {
+	tokens := map[string]*oauth2.Token{}
+	b, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	n := s.aead.NonceSize()
+	if len(b) < n {
+		return nil, errors.New("dropbox: token file is corrupt")
+	}
+	plain, err := s.aead.Open(nil, b[:n], b[n:], nil)
+	if err != nil {
+		return nil, errors.New("dropbox: token file is corrupt or encrypted with another key")
+	}
+	if err = json.Unmarshal(plain, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (s *FileTokenStore) write(tokens map[string]*oauth2.Token) error {
+	plain, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	b := s.aead.Seal(nonce, nonce, plain, nil)
+
+	f, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
@@ -11,6 +11,8 @@ from stone.ir import (
     is_struct_type,
     is_union_type,
     is_void_type,
+    unwrap_aliases,
+    unwrap_nullable,
 )
 
 from go_helpers import (
@@ -23,13 +25,47 @@ from go_helpers import (
 )
 
 
+# Words marking string fields whose values must not be logged
+_SENSITIVE_WORDS = {'password', 'secret', 'token'}
+
+
 class GoTypesBackend(CodeBackend):
     def generate(self, api):
         rsrc_folder = os.path.join(os.path.dirname(__file__), 'go_rsrc')
-        shutil.copy(os.path.join(rsrc_folder, 'sdk.go'),
-                    self.target_folder_path)
+        for rsrc in os.listdir(rsrc_folder):
+            shutil.copy(os.path.join(rsrc_folder, rsrc),
+                        self.target_folder_path)
         for namespace in api.namespaces.values():
             self._generate_namespace(namespace)
+        self._generate_sensitive_fields(api)
+
+    def _generate_sensitive_fields(self, api):
+        names = set()
+        for namespace in api.namespaces.values():
+            for data_type in namespace.linearize_data_types():
+                for field in data_type.all_fields:
+                    field_type, _ = unwrap_nullable(field.data_type)
+                    field_type, _ = unwrap_aliases(field_type)
+                    words = set(field.name.split('_'))
+                    if is_string_type(field_type) and words & _SENSITIVE_WORDS:
+                        names.add(field.name)
+
+        file_name = os.path.join(self.target_folder_path, 'sensitive_fields.go')
+        with self.output_to_relative_path(file_name):
+            self.emit_raw(HEADER)
+            self.emit()
+            self.emit('package dropbox')
+            self.emit()
+            self.emit('// sensitiveFields are the names of the string fields of the API that hold')
+            self.emit('// passwords, tokens or secrets. They are redacted from logged arguments.')
+            with self.block('var sensitiveFields = map[string]bool'):
+                for name in sorted(names):
+                    self.emit('"%s": true,' % name)
+            self.emit()
+            self.emit('// IsSensitiveField reports whether the field of the API with the given name')
+            self.emit('// holds a password, token or secret.')
+            with self.block('func IsSensitiveField(name string) bool'):
+                self.emit('return sensitiveFields[name]')
 
     def _generate_namespace(self, namespace):
         file_name = os.path.join(self.target_folder_path, namespace.name,
@@ -123,6 +159,20 @@ class GoTypesBackend(CodeBackend):
                     else:
                         self.emit("u.{0} = w.{0}".format(fn))
                 self.emit('return nil')
+        if struct.parent_type and struct.parent_type.has_enumerated_subtypes():
+            self._generate_subtype_marshal(struct)
+
+    def _generate_subtype_marshal(self, struct):
+        tag = [f.name for f in struct.parent_type.get_enumerated_subtypes()
+               if f.data_type == struct][0]
+        self.emit('// MarshalJSON serializes a %s instance, including its `.tag`' % struct.name)
+        with self.block('func (u *%s) MarshalJSON() ([]byte, error)' % struct.name):
+            self.emit('type wrap %s' % struct.name)
+            with self.block('return json.Marshal(struct',
+                            after='{dropbox.Tagged{Tag: "%s"}, (*wrap)(u)})' % tag):
+                self.emit('dropbox.Tagged')
+                self.emit('*wrap')
+        self.emit()
 
     def _generate_struct_builder(self, struct):
         fields = ["%s %s" % (fmt_var(field.name),
@@ -230,3 +280,32 @@ class GoTypesBackend(CodeBackend):
                             self.emit('u.{0} = w.{0}'.format(field_name))
             self.emit('return nil')
         self.emit()
+
+        # Polymorphic structs are serialized through their subtypes
+        if not is_struct_type(u):
+            self._generate_union_marshal(name, fields, namespace)
+
+    def _generate_union_marshal(self, name, fields, namespace):
+        self.emit('// MarshalJSON serializes a %s instance' % name)
+        with self.block('func (u *%s) MarshalJSON() ([]byte, error)' % name):
+            with self.block('switch u.Tag'):
+                for field in fields:
+                    if is_void_type(field.data_type):
+                        continue
+                    field_name = fmt_var(field.name)
+                    with self.block('case "%s":' % field.name, delim=(None, None)):
+                        if is_struct_type(field.data_type) and not _needs_base_type(field.data_type):
+                            # pure structures are flattened into the union json blob
+                            self.emit('type wrap %s' % fmt_type(field.data_type, namespace).lstrip('*'))
+                            with self.block('return json.Marshal(struct',
+                                            after='{u.Tagged, (*wrap)(u.%s)})' % field_name):
+                                self.emit('dropbox.Tagged')
+                                self.emit('*wrap')
+                        else:
+                            type_name = fmt_type(field.data_type, namespace, use_interface=True)
+                            with self.block('return json.Marshal(struct',
+                                            after='{u.Tagged, u.%s})' % field_name):
+                                self.emit('dropbox.Tagged')
+                                self.emit('%s %s `json:"%s"`' % (field_name, type_name, field.name))
+            self.emit('return json.Marshal(u.Tagged)')
+        self.emit()
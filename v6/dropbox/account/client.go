@@ -21,6 +21,7 @@
 package account
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -31,7 +32,9 @@ import (
 // Client interface describes all routes in this namespace
 type Client interface {
 	// SetProfilePhoto : Sets a user's profile photo.
-	SetProfilePhoto(arg *SetProfilePhotoArg) (res *SetProfilePhotoResult, err error)
+	SetProfilePhoto(arg *SetProfilePhotoArg, opts ...dropbox.CallOption) (res *SetProfilePhotoResult, err error)
+	// SetProfilePhotoContext is like SetProfilePhoto but takes a context for cancellation and deadlines.
+	SetProfilePhotoContext(ctx context.Context, arg *SetProfilePhotoArg, opts ...dropbox.CallOption) (res *SetProfilePhotoResult, err error)
 }
 
 type apiImpl dropbox.Context
@@ -42,7 +45,26 @@ type SetProfilePhotoAPIError struct {
 	EndpointError *SetProfilePhotoError `json:"error"`
 }
 
-func (dbx *apiImpl) SetProfilePhoto(arg *SetProfilePhotoArg) (res *SetProfilePhotoResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *SetProfilePhotoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e SetProfilePhotoAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) SetProfilePhoto(arg *SetProfilePhotoArg, opts ...dropbox.CallOption) (res *SetProfilePhotoResult, err error) {
+	return dbx.SetProfilePhotoContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) SetProfilePhotoContext(ctx context.Context, arg *SetProfilePhotoArg, opts ...dropbox.CallOption) (res *SetProfilePhotoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "account",
@@ -51,11 +73,12 @@ func (dbx *apiImpl) SetProfilePhoto(arg *SetProfilePhotoArg) (res *SetProfilePho
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr SetProfilePhotoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -0,0 +1,53 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package account
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	SetProfilePhotoFunc func(ctx context.Context, arg *SetProfilePhotoArg, opts ...dropbox.CallOption) (res *SetProfilePhotoResult, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// SetProfilePhoto implements Client.
+func (f *Fake) SetProfilePhoto(arg *SetProfilePhotoArg, opts ...dropbox.CallOption) (res *SetProfilePhotoResult, err error) {
+	return f.SetProfilePhotoContext(context.Background(), arg, opts...)
+}
+
+// SetProfilePhotoContext implements Client.
+func (f *Fake) SetProfilePhotoContext(ctx context.Context, arg *SetProfilePhotoArg, opts ...dropbox.CallOption) (res *SetProfilePhotoResult, err error) {
+	f.Record("SetProfilePhoto", opts, arg)
+	if f.SetProfilePhotoFunc == nil {
+		err = dropbox.NotImplemented("account", "SetProfilePhoto")
+		return
+	}
+	return f.SetProfilePhotoFunc(ctx, arg, opts...)
+}
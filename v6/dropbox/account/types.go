@@ -61,6 +61,18 @@ func (u *PhotoSourceArg) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PhotoSourceArg instance
+func (u *PhotoSourceArg) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "base64_data":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Base64Data string `json:"base64_data"`
+		}{u.Tagged, u.Base64Data})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SetProfilePhotoArg : has no documentation (yet)
 type SetProfilePhotoArg struct {
 	// Photo : Image to set as the user's new profile photo.
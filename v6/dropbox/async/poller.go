@@ -0,0 +1,102 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package async
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultInitialInterval = 500 * time.Millisecond
+	defaultMaxInterval     = 10 * time.Second
+	defaultMultiplier      = 1.5
+)
+
+// Poller configures how the status of an asynchronous job is polled. The zero
+// value, as well as a nil *Poller, uses the defaults documented below.
+type Poller struct {
+	// Delay before the first status check. Defaults to 500ms.
+	InitialInterval time.Duration
+	// Upper bound for the delay between two status checks. Defaults to 10s.
+	MaxInterval time.Duration
+	// Factor by which the delay grows after each status check. Defaults to
+	// 1.5; values below 1 are treated as 1.
+	Multiplier float64
+}
+
+// Poll calls check until it reports that the job is done or fails, waiting
+// between calls as configured. It returns early if ctx is done.
+//
+// The generated `...AndWait` helpers of each namespace use Poll with the
+// job's check route.
+func (p *Poller) Poll(ctx context.Context, check func(ctx context.Context) (done bool, err error)) error {
+	interval, maxInterval, multiplier := defaultInitialInterval, defaultMaxInterval, defaultMultiplier
+	if p != nil {
+		if p.InitialInterval > 0 {
+			interval = p.InitialInterval
+		}
+		if p.MaxInterval > 0 {
+			maxInterval = p.MaxInterval
+		}
+		if p.Multiplier > 0 {
+			multiplier = p.Multiplier
+		}
+	}
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	t := time.NewTimer(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+		t.Reset(interval)
+	}
+}
+
+// JobFailedError is returned by the `...AndWait` helpers if an asynchronous
+// job finished without completing, e.g. with a `failed` status.
+type JobFailedError struct {
+	// Tag of the final status
+	Tag string
+	// Final status of the job, e.g. a *files.DeleteBatchJobStatus
+	Status interface{}
+}
+
+func (e JobFailedError) Error() string {
+	return fmt.Sprintf("async job did not complete: %s", e.Tag)
+}
@@ -68,6 +68,18 @@ func (u *LaunchResultBase) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LaunchResultBase instance
+func (u *LaunchResultBase) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // LaunchEmptyResult : Result returned by methods that may either launch an
 // asynchronous job or complete synchronously. Upon synchronous completion of
 // the job, no additional information is returned.
@@ -108,6 +120,18 @@ func (u *LaunchEmptyResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LaunchEmptyResult instance
+func (u *LaunchEmptyResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PollArg : Arguments for methods that poll the status of an asynchronous job.
 type PollArg struct {
 	// AsyncJobId : Id of the asynchronous job. This is the value of a response
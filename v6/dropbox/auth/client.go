@@ -21,6 +21,7 @@
 package auth
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -31,12 +32,16 @@ import (
 type Client interface {
 	// TokenFromOauth1 : Creates an OAuth 2.0 access token from the supplied
 	// OAuth 1.0 access token.
-	TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error)
+	TokenFromOauth1(arg *TokenFromOAuth1Arg, opts ...dropbox.CallOption) (res *TokenFromOAuth1Result, err error)
+	// TokenFromOauth1Context is like TokenFromOauth1 but takes a context for cancellation and deadlines.
+	TokenFromOauth1Context(ctx context.Context, arg *TokenFromOAuth1Arg, opts ...dropbox.CallOption) (res *TokenFromOAuth1Result, err error)
 	// TokenRevoke : Disables the access token used to authenticate the call. If
 	// there is a corresponding refresh token for the access token, this
 	// disables that refresh token, as well as any other access tokens for that
 	// refresh token.
-	TokenRevoke() (err error)
+	TokenRevoke(opts ...dropbox.CallOption) (err error)
+	// TokenRevokeContext is like TokenRevoke but takes a context for cancellation and deadlines.
+	TokenRevokeContext(ctx context.Context, opts ...dropbox.CallOption) (err error)
 }
 
 type apiImpl dropbox.Context
@@ -47,7 +52,26 @@ type TokenFromOauth1APIError struct {
 	EndpointError *TokenFromOAuth1Error `json:"error"`
 }
 
-func (dbx *apiImpl) TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAuth1Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TokenFromOauth1APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TokenFromOauth1APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TokenFromOauth1(arg *TokenFromOAuth1Arg, opts ...dropbox.CallOption) (res *TokenFromOAuth1Result, err error) {
+	return dbx.TokenFromOauth1Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TokenFromOauth1Context(ctx context.Context, arg *TokenFromOAuth1Arg, opts ...dropbox.CallOption) (res *TokenFromOAuth1Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "auth",
@@ -56,11 +80,12 @@ func (dbx *apiImpl) TokenFromOauth1(arg *TokenFromOAuth1Arg) (res *TokenFromOAut
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TokenFromOauth1APIError
 		err = ParseError(err, &appErr)
@@ -85,7 +110,16 @@ type TokenRevokeAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) TokenRevoke() (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TokenRevokeAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) TokenRevoke(opts ...dropbox.CallOption) (err error) {
+	return dbx.TokenRevokeContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) TokenRevokeContext(ctx context.Context, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "auth",
@@ -94,11 +128,12 @@ func (dbx *apiImpl) TokenRevoke() (err error) {
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TokenRevokeAPIError
 		err = ParseError(err, &appErr)
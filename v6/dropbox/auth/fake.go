@@ -0,0 +1,69 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	TokenFromOauth1Func func(ctx context.Context, arg *TokenFromOAuth1Arg, opts ...dropbox.CallOption) (res *TokenFromOAuth1Result, err error)
+	TokenRevokeFunc     func(ctx context.Context, opts ...dropbox.CallOption) (err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// TokenFromOauth1 implements Client.
+func (f *Fake) TokenFromOauth1(arg *TokenFromOAuth1Arg, opts ...dropbox.CallOption) (res *TokenFromOAuth1Result, err error) {
+	return f.TokenFromOauth1Context(context.Background(), arg, opts...)
+}
+
+// TokenFromOauth1Context implements Client.
+func (f *Fake) TokenFromOauth1Context(ctx context.Context, arg *TokenFromOAuth1Arg, opts ...dropbox.CallOption) (res *TokenFromOAuth1Result, err error) {
+	f.Record("TokenFromOauth1", opts, arg)
+	if f.TokenFromOauth1Func == nil {
+		err = dropbox.NotImplemented("auth", "TokenFromOauth1")
+		return
+	}
+	return f.TokenFromOauth1Func(ctx, arg, opts...)
+}
+
+// TokenRevoke implements Client.
+func (f *Fake) TokenRevoke(opts ...dropbox.CallOption) (err error) {
+	return f.TokenRevokeContext(context.Background(), opts...)
+}
+
+// TokenRevokeContext implements Client.
+func (f *Fake) TokenRevokeContext(ctx context.Context, opts ...dropbox.CallOption) (err error) {
+	f.Record("TokenRevoke", opts)
+	if f.TokenRevokeFunc == nil {
+		err = dropbox.NotImplemented("auth", "TokenRevoke")
+		return
+	}
+	return f.TokenRevokeFunc(ctx, opts...)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"golang.org/x/oauth2"
+)
+
+// ErrStateMismatch is returned by LoopbackFlow.Run if the redirect carries a
+// different `state` than the authorize URL, e.g. because it was forged.
+var ErrStateMismatch = errors.New("auth: OAuth state mismatch")
+
+// LoopbackFlow runs the OAuth2 authorization code flow for command-line
+// tools: it serves the redirect URI on a loopback address, lets the user
+// authorize the app in a browser and exchanges the authorization code for
+// a token.
+//
+// The flow uses PKCE and requests offline access, so the returned token has
+// a refresh token usable with Config.RefreshToken.
+type LoopbackFlow struct {
+	// Config of the app. AppKey is required; AppSecret may be empty. Domain
+	// and Client are used to reach the token endpoint.
+	Config dropbox.Config
+	// Scopes to request. If empty, the scopes configured for the app are
+	// granted.
+	Scopes []string
+	// Address to listen on for the redirect. It must be a loopback address
+	// registered as redirect URI of the app, as `http://<Addr>/`. Defaults
+	// to an ephemeral port on 127.0.0.1.
+	Addr string
+	// Called with the authorize URL the user has to visit, e.g. to open it
+	// in a browser. Defaults to printing the URL to standard error.
+	OpenURL func(url string) error
+}
+
+// LoopbackResult is the outcome of a LoopbackFlow.
+type LoopbackResult struct {
+	// Access and refresh token
+	Token *oauth2.Token
+	// ID of the account that authorized the app
+	AccountID string
+	// ID of the team that authorized the app, for team apps
+	TeamID string
+}
+
+type redirect struct {
+	code string
+	err  error
+}
+
+// Run performs the flow and returns the token once the user authorized the
+// app, or an error if the user denied access or ctx is done first.
+func (f *LoopbackFlow) Run(ctx context.Context) (*LoopbackResult, error) {
+	verifier, err := dropbox.NewCodeVerifier()
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomState()
+	if err != nil {
+		return nil, err
+	}
+
+	addr := f.Addr
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &oauth2.Config{
+		ClientID:     f.Config.AppKey,
+		ClientSecret: f.Config.AppSecret,
+		Endpoint:     dropbox.OAuthEndpoint(f.Config.Domain),
+		RedirectURL:  fmt.Sprintf("http://%s/", ln.Addr()),
+		Scopes:       f.Scopes,
+	}
+	if f.Config.AppSecret == "" {
+		conf.Endpoint.AuthStyle = oauth2.AuthStyleInParams
+	}
+
+	redirects := make(chan redirect, 1)
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Other requests, e.g. for /favicon.ico, do not end the flow.
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		var res redirect
+		switch {
+		case q.Get("state") != state:
+			res.err = ErrStateMismatch
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("auth: authorization failed: %s: %s", q.Get("error"), q.Get("error_description"))
+		case q.Get("code") == "":
+			res.err = errors.New("auth: redirect without authorization code")
+		default:
+			res.code = q.Get("code")
+		}
+
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Authorization complete. You can close this window.")
+		}
+		select {
+		case redirects <- res:
+		default:
+		}
+	})}
+	go srv.Serve(ln)
+	defer srv.Close()
+
+	opts := append(dropbox.ChallengeOptions(verifier), dropbox.OfflineAccessOption)
+	authURL := conf.AuthCodeURL(state, opts...)
+	openURL := f.OpenURL
+	if openURL == nil {
+		openURL = func(url string) error {
+			_, err := fmt.Fprintf(os.Stderr, "Visit the following URL to authorize the app:\n%s\n", url)
+			return err
+		}
+	}
+	if err = openURL(authURL); err != nil {
+		return nil, err
+	}
+
+	var res redirect
+	select {
+	case res = <-redirects:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if res.err != nil {
+		return nil, res.err
+	}
+
+	if f.Config.Client != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, f.Config.Client)
+	}
+	tok, err := conf.Exchange(ctx, res.code, dropbox.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LoopbackResult{Token: tok}
+	result.AccountID, _ = tok.Extra("account_id").(string)
+	result.TeamID, _ = tok.Extra("team_id").(string)
+	return result, nil
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+)
+
+// redirectTransport sends every request to the host of url.
+type redirectTransport struct {
+	url  *url.URL
+	base http.RoundTripper
+}
+
+func (t redirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.URL.Scheme, r.URL.Host = t.url.Scheme, t.url.Host
+	return t.base.RoundTrip(r)
+}
+
+// tokenServer is a fake token endpoint accepting the code "code" along with
+// the verifier matching challenge.
+func tokenServer(t *testing.T, challenge *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/1/oauth2/token" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.FormValue("code") != "code" || r.FormValue("client_id") != "key" ||
+			dropbox.CodeChallenge(r.FormValue("code_verifier")) != *challenge {
+			t.Errorf("Unexpected token request: %v", r.Form)
+			http.Error(w, `{"error": "invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "access", "token_type": "bearer", "expires_in": 14400,
+			"refresh_token": "refresh", "account_id": "dbid:1", "uid": "1"}`))
+	}))
+}
+
+func newFlow(ts *httptest.Server) *auth.LoopbackFlow {
+	u, _ := url.Parse(ts.URL)
+	return &auth.LoopbackFlow{
+		Config: dropbox.Config{
+			AppKey: "key",
+			Domain: ".test",
+			Client: &http.Client{Transport: redirectTransport{u, ts.Client().Transport}},
+		},
+		Scopes: []string{"account_info.read", "files.content.read"},
+	}
+}
+
+func TestLoopbackFlow(t *testing.T) {
+	var challenge string
+	ts := tokenServer(t, &challenge)
+	defer ts.Close()
+
+	flow := newFlow(ts)
+	flow.OpenURL = func(authURL string) error {
+		u, err := url.Parse(authURL)
+		if err != nil {
+			return err
+		}
+		q := u.Query()
+		if u.Host != "meta.test" || q.Get("client_id") != "key" || q.Get("token_access_type") != "offline" ||
+			q.Get("scope") != "account_info.read files.content.read" || q.Get("code_challenge_method") != "S256" {
+			t.Errorf("Unexpected authorize URL %s", authURL)
+		}
+		challenge = q.Get("code_challenge")
+
+		// Simulate the browser fetching its icon, then following the redirect.
+		go func() {
+			resp, err := http.Get(q.Get("redirect_uri") + "favicon.ico")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusNotFound {
+				t.Errorf("Unexpected status %d for favicon", resp.StatusCode)
+			}
+			resp, err = http.Get(q.Get("redirect_uri") + "?code=code&state=" + url.QueryEscape(q.Get("state")))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := flow.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Token.AccessToken != "access" || res.Token.RefreshToken != "refresh" || res.AccountID != "dbid:1" {
+		t.Errorf("Unexpected result %+v", res)
+	}
+}
+
+func TestLoopbackFlowStateMismatch(t *testing.T) {
+	var challenge string
+	ts := tokenServer(t, &challenge)
+	defer ts.Close()
+
+	flow := newFlow(ts)
+	flow.OpenURL = func(authURL string) error {
+		u, _ := url.Parse(authURL)
+		go func() {
+			resp, err := http.Get(u.Query().Get("redirect_uri") + "?code=code&state=forged")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := flow.Run(ctx); err != auth.ErrStateMismatch {
+		t.Errorf("Expected state mismatch, got %v", err)
+	}
+}
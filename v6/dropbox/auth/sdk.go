@@ -13,18 +13,33 @@ type AuthAPIError struct {
 	AuthError *AuthError `json:"error"`
 }
 
+// SetAPIError sets the error summary and request metadata of e.
+func (e *AuthAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
 // AccessAPIError wraps AccessError
 type AccessAPIError struct {
 	dropbox.APIError
 	AccessError *AccessError `json:"error"`
 }
 
+// SetAPIError sets the error summary and request metadata of e.
+func (e *AccessAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
 // RateLimitAPIError wraps RateLimitError
 type RateLimitAPIError struct {
 	dropbox.APIError
 	RateLimitError *RateLimitError `json:"error"`
 }
 
+// SetAPIError sets the error summary and request metadata of e.
+func (e *RateLimitAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
 // Bad input parameter.
 type BadRequest struct {
 	dropbox.APIError
@@ -37,6 +52,15 @@ type ServerError struct {
 	StatusCode int
 }
 
+// apiErrorSetter is implemented by pointers to the error wrappers of routes.
+type apiErrorSetter interface {
+	SetAPIError(base dropbox.APIError)
+}
+
+// ParseError converts an error returned by dropbox.Context.Execute into the
+// error documented for its HTTP status, e.g. AuthAPIError for 401, and into
+// appError for route specific errors. The request metadata of err is copied
+// to the dropbox.APIError embedded in the result.
 func ParseError(err error, appError error) error {
 	sdkErr, ok := err.(dropbox.SDKInternalError)
 	if !ok {
@@ -45,47 +69,46 @@ func ParseError(err error, appError error) error {
 
 	if sdkErr.StatusCode >= 500 && sdkErr.StatusCode <= 599 {
 		return ServerError{
-			APIError: dropbox.APIError{
-				ErrorSummary: sdkErr.Content,
-			},
+			APIError:   sdkErr.APIError(),
+			StatusCode: sdkErr.StatusCode,
 		}
 	}
 
+	var apiError error
 	switch sdkErr.StatusCode {
 	case http.StatusBadRequest:
 		return BadRequest{
-			APIError: dropbox.APIError{
-				ErrorSummary: sdkErr.Content,
-			},
+			APIError: sdkErr.APIError(),
 		}
 	case http.StatusUnauthorized:
-		var apiError AuthAPIError
-		if pErr := json.Unmarshal([]byte(sdkErr.Content), &apiError); pErr != nil {
-			return pErr
-		}
-
-		return apiError
+		apiError = &AuthAPIError{}
 	case http.StatusForbidden:
-		var apiError AccessAPIError
-		if pErr := json.Unmarshal([]byte(sdkErr.Content), &apiError); pErr != nil {
-			return pErr
-		}
-
-		return apiError
+		apiError = &AccessAPIError{}
 	case http.StatusTooManyRequests:
-		var apiError RateLimitAPIError
-		if pErr := json.Unmarshal([]byte(sdkErr.Content), &apiError); pErr != nil {
-			return pErr
-		}
-
-		return apiError
+		apiError = &RateLimitAPIError{}
 	case http.StatusConflict:
-		if pErr := json.Unmarshal([]byte(sdkErr.Content), appError); pErr != nil {
-			return pErr
-		}
+		apiError = appError
+	default:
+		return err
+	}
 
-		return appError
+	if setter, ok := apiError.(apiErrorSetter); ok {
+		// The summary is decoded from the content below.
+		base := sdkErr.APIError()
+		base.ErrorSummary = ""
+		setter.SetAPIError(base)
+	}
+	if pErr := json.Unmarshal([]byte(sdkErr.Content), apiError); pErr != nil {
+		return pErr
 	}
 
-	return err
+	switch e := apiError.(type) {
+	case *AuthAPIError:
+		return *e
+	case *AccessAPIError:
+		return *e
+	case *RateLimitAPIError:
+		return *e
+	}
+	return apiError
 }
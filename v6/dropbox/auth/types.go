@@ -70,6 +70,23 @@ func (u *AccessError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AccessError instance
+func (u *AccessError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "invalid_account_type":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidAccountType *InvalidAccountTypeError `json:"invalid_account_type"`
+		}{u.Tagged, u.InvalidAccountType})
+	case "paper_access_denied":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PaperAccessDenied *PaperAccessError `json:"paper_access_denied"`
+		}{u.Tagged, u.PaperAccessDenied})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AuthError : Errors occurred during authentication.
 type AuthError struct {
 	dropbox.Tagged
@@ -111,6 +128,19 @@ func (u *AuthError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AuthError instance
+func (u *AuthError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "missing_scope":
+		type wrap TokenScopeError
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MissingScope)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // InvalidAccountTypeError : has no documentation (yet)
 type InvalidAccountTypeError struct {
 	dropbox.Tagged
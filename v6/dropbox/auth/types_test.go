@@ -0,0 +1,70 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+)
+
+func TestRoundTripJSON(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		value  func() interface{}
+		golden string
+	}{
+		{
+			name:   "RateLimitError",
+			value:  func() interface{} { return new(auth.RateLimitError) },
+			golden: `{"reason": {".tag": "too_many_write_operations"}, "retry_after": 1}`,
+		},
+		{
+			name:   "AccessError",
+			value:  func() interface{} { return new(auth.AccessError) },
+			golden: `{".tag": "paper_access_denied", "paper_access_denied": {".tag": "not_paper_user"}}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			v := test.value()
+			if err := json.Unmarshal([]byte(test.golden), v); err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var want, got interface{}
+			if err = json.Unmarshal([]byte(test.golden), &want); err != nil {
+				t.Fatal(err)
+			}
+			if err = json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("Want %s got %s", test.golden, b)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen matches the CircuitOpenError of calls rejected by a
+// CircuitBreaker with errors.Is.
+var ErrCircuitOpen = errors.New("dropbox: circuit open")
+
+// CircuitOpenError is returned for calls to a host whose circuit is open.
+type CircuitOpenError struct {
+	// Host type of the call, e.g. "api"
+	Host string
+	// Time at which the circuit lets a request through again
+	Until time.Time
+}
+
+func (e CircuitOpenError) Error() string {
+	return fmt.Sprintf("dropbox: circuit for %s host is open until %s", e.Host, e.Until.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrCircuitOpen.
+func (e CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitState is the state of the circuit of a host.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests.
+	CircuitOpen
+	// CircuitHalfOpen lets a few requests through to probe whether the host
+	// has recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitBreaker fails calls fast with a CircuitOpenError while a host is
+// failing, instead of adding to its load. It keeps a circuit per host type,
+// i.e. "api", "content" and "notify". A circuit opens once the ratio of
+// failed requests, i.e. network errors and 5xx responses, reaches
+// FailureRatio within a Window. After OpenTimeout it lets HalfOpenRequests
+// requests through, and closes again once they all succeeded.
+//
+// Set a CircuitBreaker as Config.CircuitBreaker, possibly sharing it between
+// several Configs. Every attempt of a call, including retries, counts. Zero
+// fields take their default values.
+type CircuitBreaker struct {
+	// Ratio of failed requests opening the circuit. Defaults to 0.5.
+	FailureRatio float64
+	// Minimum number of requests within a Window for the circuit to open.
+	// Defaults to 10.
+	MinRequests int
+	// Period over which requests are counted. Defaults to 10 seconds.
+	Window time.Duration
+	// Time for which an open circuit rejects requests. Defaults to 30
+	// seconds.
+	OpenTimeout time.Duration
+	// Number of requests let through by a half-open circuit. Defaults to 1.
+	HalfOpenRequests int
+	// Called on every state change of a circuit, e.g. to update metrics. It
+	// must not block.
+	OnStateChange func(host string, from, to CircuitState)
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// circuit is the state of a host.
+type circuit struct {
+	state       CircuitState
+	generation  uint64
+	since       time.Time
+	windowStart time.Time
+	requests    int
+	failures    int
+	probes      int
+	successes   int
+}
+
+// Outcomes of requests
+const (
+	requestSucceeded = iota
+	requestFailed
+	requestIgnored
+)
+
+// requestOutcome classifies the response of a request, or nil if it failed
+// without one.
+func requestOutcome(ctx context.Context, resp *http.Response) int {
+	switch {
+	case resp == nil && ctx.Err() != nil:
+		return requestIgnored
+	case resp == nil || resp.StatusCode >= 500:
+		return requestFailed
+	}
+	return requestSucceeded
+}
+
+func (b *CircuitBreaker) failureRatio() float64 {
+	if b.FailureRatio > 0 {
+		return b.FailureRatio
+	}
+	return 0.5
+}
+
+func (b *CircuitBreaker) minRequests() int {
+	if b.MinRequests > 0 {
+		return b.MinRequests
+	}
+	return 10
+}
+
+func (b *CircuitBreaker) window() time.Duration {
+	if b.Window > 0 {
+		return b.Window
+	}
+	return 10 * time.Second
+}
+
+func (b *CircuitBreaker) openTimeout() time.Duration {
+	if b.OpenTimeout > 0 {
+		return b.OpenTimeout
+	}
+	return 30 * time.Second
+}
+
+func (b *CircuitBreaker) halfOpenRequests() int {
+	if b.HalfOpenRequests > 0 {
+		return b.HalfOpenRequests
+	}
+	return 1
+}
+
+// State returns the state of the circuit of host, e.g. "api".
+func (b *CircuitBreaker) State(host string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c := b.circuits[host]; c != nil {
+		return c.state
+	}
+	return CircuitClosed
+}
+
+// circuit returns the circuit of host. b.mu must be held.
+func (b *CircuitBreaker) circuit(host string, now time.Time) *circuit {
+	if b.circuits == nil {
+		b.circuits = map[string]*circuit{}
+	}
+	c := b.circuits[host]
+	if c == nil {
+		c = &circuit{since: now, windowStart: now}
+		b.circuits[host] = c
+	}
+	return c
+}
+
+// setState changes the state of c and returns the function notifying
+// OnStateChange, to be called once b.mu is released. b.mu must be held.
+func (b *CircuitBreaker) setState(host string, c *circuit, state CircuitState, now time.Time) func() {
+	from := c.state
+	*c = circuit{state: state, generation: c.generation + 1, since: now, windowStart: now}
+	if b.OnStateChange == nil {
+		return func() {}
+	}
+	return func() { b.OnStateChange(host, from, state) }
+}
+
+// allow returns a CircuitOpenError if a request to host must not be made.
+// Otherwise the returned function has to be called with the outcome of the
+// request.
+func (b *CircuitBreaker) allow(host string) (func(outcome int), error) {
+	if b == nil {
+		return func(int) {}, nil
+	}
+
+	notify := func() {}
+	defer func() { notify() }()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	c := b.circuit(host, now)
+	switch c.state {
+	case CircuitOpen:
+		until := c.since.Add(b.openTimeout())
+		if now.Before(until) {
+			return nil, CircuitOpenError{Host: host, Until: until}
+		}
+		notify = b.setState(host, c, CircuitHalfOpen, now)
+		c.probes++
+	case CircuitHalfOpen:
+		if c.probes >= b.halfOpenRequests() {
+			return nil, CircuitOpenError{Host: host, Until: now}
+		}
+		c.probes++
+	}
+
+	generation := c.generation
+	return func(outcome int) {
+		b.record(host, generation, outcome)
+	}, nil
+}
+
+// record counts the outcome of a request allowed in the given generation of
+// the circuit of host.
+func (b *CircuitBreaker) record(host string, generation uint64, outcome int) {
+	notify := func() {}
+	defer func() { notify() }()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	c := b.circuit(host, now)
+	if c.generation != generation {
+		// The circuit changed its state since the request was made.
+		return
+	}
+
+	switch c.state {
+	case CircuitClosed:
+		if outcome == requestIgnored {
+			return
+		}
+		if now.Sub(c.windowStart) >= b.window() {
+			c.windowStart, c.requests, c.failures = now, 0, 0
+		}
+		c.requests++
+		if outcome == requestFailed {
+			c.failures++
+		}
+		if c.requests >= b.minRequests() && float64(c.failures) >= b.failureRatio()*float64(c.requests) {
+			notify = b.setState(host, c, CircuitOpen, now)
+		}
+	case CircuitHalfOpen:
+		switch outcome {
+		case requestIgnored:
+			c.probes--
+		case requestFailed:
+			notify = b.setState(host, c, CircuitOpen, now)
+		case requestSucceeded:
+			c.successes++
+			if c.successes >= b.halfOpenRequests() {
+				notify = b.setState(host, c, CircuitClosed, now)
+			}
+		}
+	}
+}
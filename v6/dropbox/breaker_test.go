@@ -0,0 +1,86 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/check"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var requests, failing int32 = 0, 1
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			if atomic.LoadInt32(&failing) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			_, _ = w.Write([]byte(`{"result": ""}`))
+		}))
+	defer ts.Close()
+
+	var changes []string
+	breaker := &dropbox.CircuitBreaker{MinRequests: 4, OpenTimeout: 50 * time.Millisecond,
+		OnStateChange: func(host string, from, to dropbox.CircuitState) {
+			changes = append(changes, host+": "+from.String()+" -> "+to.String())
+		}}
+	config := dropbox.Config{Token: "token", CircuitBreaker: breaker,
+		RetryPolicy: &dropbox.RetryPolicy{MaxAttempts: 2, RetryOn: dropbox.RetryOnServerError},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dbx := check.New(config)
+
+	for i := 0; i < 2; i++ {
+		if _, e := dbx.User(check.NewEchoArg()); e == nil || errors.Is(e, dropbox.ErrCircuitOpen) {
+			t.Fatalf("Expected server error, got %v", e)
+		}
+	}
+	_, e := dbx.User(check.NewEchoArg())
+	var openErr dropbox.CircuitOpenError
+	if !errors.Is(e, dropbox.ErrCircuitOpen) || !errors.As(e, &openErr) || openErr.Host != "api" {
+		t.Errorf("Expected open circuit, got %v", e)
+	}
+	if n := atomic.LoadInt32(&requests); n != 4 || breaker.State("api") != dropbox.CircuitOpen {
+		t.Errorf("Expected open circuit after 4 requests, got %d, %v", n, breaker.State("api"))
+	}
+
+	// A successful probe closes the circuit.
+	time.Sleep(60 * time.Millisecond)
+	atomic.StoreInt32(&failing, 0)
+	if _, e = dbx.User(check.NewEchoArg()); e != nil {
+		t.Errorf("Unexpected error: %v", e)
+	}
+	expected := []string{"api: closed -> open", "api: open -> half-open", "api: half-open -> closed"}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Unexpected state changes %q", changes)
+	}
+}
@@ -21,6 +21,7 @@
 package check
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -36,14 +37,18 @@ type Client interface {
 	// you receive an HTTP 200 response with the supplied query, it indicates at
 	// least part of the Dropbox API infrastructure is working and that the app
 	// key and secret valid.
-	App(arg *EchoArg) (res *EchoResult, err error)
+	App(arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error)
+	// AppContext is like App but takes a context for cancellation and deadlines.
+	AppContext(ctx context.Context, arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error)
 	// User : This endpoint performs User Authentication, validating the
 	// supplied access token, and returns the supplied string, to allow you to
 	// test your code and connection to the Dropbox API. It has no other effect.
 	// If you receive an HTTP 200 response with the supplied query, it indicates
 	// at least part of the Dropbox API infrastructure is working and that the
 	// access token is valid.
-	User(arg *EchoArg) (res *EchoResult, err error)
+	User(arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error)
+	// UserContext is like User but takes a context for cancellation and deadlines.
+	UserContext(ctx context.Context, arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error)
 }
 
 type apiImpl dropbox.Context
@@ -54,7 +59,16 @@ type AppAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) App(arg *EchoArg) (res *EchoResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *AppAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) App(arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error) {
+	return dbx.AppContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) AppContext(ctx context.Context, arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "check",
@@ -63,11 +77,12 @@ func (dbx *apiImpl) App(arg *EchoArg) (res *EchoResult, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr AppAPIError
 		err = auth.ParseError(err, &appErr)
@@ -92,7 +107,16 @@ type UserAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) User(arg *EchoArg) (res *EchoResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UserAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) User(arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error) {
+	return dbx.UserContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UserContext(ctx context.Context, arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "check",
@@ -101,11 +125,12 @@ func (dbx *apiImpl) User(arg *EchoArg) (res *EchoResult, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UserAPIError
 		err = auth.ParseError(err, &appErr)
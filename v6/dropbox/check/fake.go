@@ -0,0 +1,69 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package check
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	AppFunc  func(ctx context.Context, arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error)
+	UserFunc func(ctx context.Context, arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// App implements Client.
+func (f *Fake) App(arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error) {
+	return f.AppContext(context.Background(), arg, opts...)
+}
+
+// AppContext implements Client.
+func (f *Fake) AppContext(ctx context.Context, arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error) {
+	f.Record("App", opts, arg)
+	if f.AppFunc == nil {
+		err = dropbox.NotImplemented("check", "App")
+		return
+	}
+	return f.AppFunc(ctx, arg, opts...)
+}
+
+// User implements Client.
+func (f *Fake) User(arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error) {
+	return f.UserContext(context.Background(), arg, opts...)
+}
+
+// UserContext implements Client.
+func (f *Fake) UserContext(ctx context.Context, arg *EchoArg, opts ...dropbox.CallOption) (res *EchoResult, err error) {
+	f.Record("User", opts, arg)
+	if f.UserFunc == nil {
+		err = dropbox.NotImplemented("check", "User")
+		return
+	}
+	return f.UserFunc(ctx, arg, opts...)
+}
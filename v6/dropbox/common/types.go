@@ -78,6 +78,23 @@ func (u *PathRoot) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PathRoot instance
+func (u *PathRoot) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "root":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Root string `json:"root"`
+		}{u.Tagged, u.Root})
+	case "namespace_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			NamespaceId string `json:"namespace_id"`
+		}{u.Tagged, u.NamespaceId})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PathRootError : has no documentation (yet)
 type PathRootError struct {
 	dropbox.Tagged
@@ -117,6 +134,18 @@ func (u *PathRootError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PathRootError instance
+func (u *PathRootError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "invalid_root":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidRoot IsRootInfo `json:"invalid_root"`
+		}{u.Tagged, u.InvalidRoot})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RootInfo : Information about current user's root.
 type RootInfo struct {
 	// RootNamespaceId : The namespace ID for user's root namespace. It will be
@@ -218,6 +247,15 @@ func NewTeamRootInfo(RootNamespaceId string, HomeNamespaceId string, HomePath st
 	return s
 }
 
+// MarshalJSON serializes a TeamRootInfo instance, including its `.tag`
+func (u *TeamRootInfo) MarshalJSON() ([]byte, error) {
+	type wrap TeamRootInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "team"}, (*wrap)(u)})
+}
+
 // UserRootInfo : Root info when user is not member of a team or the user is a
 // member of a team and the team does not have a separate root namespace.
 type UserRootInfo struct {
@@ -231,3 +269,12 @@ func NewUserRootInfo(RootNamespaceId string, HomeNamespaceId string) *UserRootIn
 	s.HomeNamespaceId = HomeNamespaceId
 	return s
 }
+
+// MarshalJSON serializes a UserRootInfo instance, including its `.tag`
+func (u *UserRootInfo) MarshalJSON() ([]byte, error) {
+	type wrap UserRootInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "user"}, (*wrap)(u)})
+}
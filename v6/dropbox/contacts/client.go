@@ -21,6 +21,7 @@
 package contacts
 
 import (
+	"context"
 	"io"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
@@ -32,10 +33,14 @@ type Client interface {
 	// DeleteManualContacts : Removes all manually added contacts. You'll still
 	// keep contacts who are on your team or who you imported. New contacts will
 	// be added when you share.
-	DeleteManualContacts() (err error)
+	DeleteManualContacts(opts ...dropbox.CallOption) (err error)
+	// DeleteManualContactsContext is like DeleteManualContacts but takes a context for cancellation and deadlines.
+	DeleteManualContactsContext(ctx context.Context, opts ...dropbox.CallOption) (err error)
 	// DeleteManualContactsBatch : Removes manually added contacts from the
 	// given list.
-	DeleteManualContactsBatch(arg *DeleteManualContactsArg) (err error)
+	DeleteManualContactsBatch(arg *DeleteManualContactsArg, opts ...dropbox.CallOption) (err error)
+	// DeleteManualContactsBatchContext is like DeleteManualContactsBatch but takes a context for cancellation and deadlines.
+	DeleteManualContactsBatchContext(ctx context.Context, arg *DeleteManualContactsArg, opts ...dropbox.CallOption) (err error)
 }
 
 type apiImpl dropbox.Context
@@ -46,7 +51,16 @@ type DeleteManualContactsAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) DeleteManualContacts() (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DeleteManualContactsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) DeleteManualContacts(opts ...dropbox.CallOption) (err error) {
+	return dbx.DeleteManualContactsContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) DeleteManualContactsContext(ctx context.Context, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "contacts",
@@ -55,11 +69,12 @@ func (dbx *apiImpl) DeleteManualContacts() (err error) {
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DeleteManualContactsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -80,7 +95,26 @@ type DeleteManualContactsBatchAPIError struct {
 	EndpointError *DeleteManualContactsError `json:"error"`
 }
 
-func (dbx *apiImpl) DeleteManualContactsBatch(arg *DeleteManualContactsArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DeleteManualContactsBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DeleteManualContactsBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DeleteManualContactsBatch(arg *DeleteManualContactsArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.DeleteManualContactsBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DeleteManualContactsBatchContext(ctx context.Context, arg *DeleteManualContactsArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "contacts",
@@ -89,11 +123,12 @@ func (dbx *apiImpl) DeleteManualContactsBatch(arg *DeleteManualContactsArg) (err
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DeleteManualContactsBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -0,0 +1,69 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package contacts
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	DeleteManualContactsFunc      func(ctx context.Context, opts ...dropbox.CallOption) (err error)
+	DeleteManualContactsBatchFunc func(ctx context.Context, arg *DeleteManualContactsArg, opts ...dropbox.CallOption) (err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// DeleteManualContacts implements Client.
+func (f *Fake) DeleteManualContacts(opts ...dropbox.CallOption) (err error) {
+	return f.DeleteManualContactsContext(context.Background(), opts...)
+}
+
+// DeleteManualContactsContext implements Client.
+func (f *Fake) DeleteManualContactsContext(ctx context.Context, opts ...dropbox.CallOption) (err error) {
+	f.Record("DeleteManualContacts", opts)
+	if f.DeleteManualContactsFunc == nil {
+		err = dropbox.NotImplemented("contacts", "DeleteManualContacts")
+		return
+	}
+	return f.DeleteManualContactsFunc(ctx, opts...)
+}
+
+// DeleteManualContactsBatch implements Client.
+func (f *Fake) DeleteManualContactsBatch(arg *DeleteManualContactsArg, opts ...dropbox.CallOption) (err error) {
+	return f.DeleteManualContactsBatchContext(context.Background(), arg, opts...)
+}
+
+// DeleteManualContactsBatchContext implements Client.
+func (f *Fake) DeleteManualContactsBatchContext(ctx context.Context, arg *DeleteManualContactsArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("DeleteManualContactsBatch", opts, arg)
+	if f.DeleteManualContactsBatchFunc == nil {
+		err = dropbox.NotImplemented("contacts", "DeleteManualContactsBatch")
+		return
+	}
+	return f.DeleteManualContactsBatchFunc(ctx, arg, opts...)
+}
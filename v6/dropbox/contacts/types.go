@@ -76,3 +76,15 @@ func (u *DeleteManualContactsError) UnmarshalJSON(body []byte) error {
 	}
 	return nil
 }
+
+// MarshalJSON serializes a DeleteManualContactsError instance
+func (u *DeleteManualContactsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "contacts_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			ContactsNotFound []string `json:"contacts_not_found"`
+		}{u.Tagged, u.ContactsNotFound})
+	}
+	return json.Marshal(u.Tagged)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+)
+
+const (
+	// ContentHashBlockSize is the size of the blocks hashed individually by
+	// the content hash.
+	ContentHashBlockSize = 4 << 20
+	// ContentHashSize is the size of a content hash in bytes.
+	ContentHashSize = sha256.Size
+)
+
+// contentHash implements the Dropbox content hash: the SHA-256 of the
+// concatenated SHA-256 hashes of every 4 MiB block of the data.
+//
+// See: https://www.dropbox.com/developers/reference/content-hash
+type contentHash struct {
+	overall  hash.Hash
+	block    hash.Hash
+	blockLen int
+}
+
+// NewContentHash returns a new hash.Hash computing the Dropbox content hash,
+// as found in the `ContentHash` fields of file metadata and upload arguments.
+// Use ContentHashString to format the result.
+func NewContentHash() hash.Hash {
+	return &contentHash{overall: sha256.New(), block: sha256.New()}
+}
+
+// ContentHashString returns the content hash of b, hex encoded.
+func ContentHashString(b []byte) string {
+	h := NewContentHash()
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (h *contentHash) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		m := ContentHashBlockSize - h.blockLen
+		if m > len(p) {
+			m = len(p)
+		}
+		h.block.Write(p[:m])
+		h.blockLen += m
+		p = p[m:]
+		if h.blockLen == ContentHashBlockSize {
+			h.flush()
+		}
+	}
+	return n, nil
+}
+
+func (h *contentHash) flush() {
+	h.overall.Write(h.block.Sum(nil))
+	h.block.Reset()
+	h.blockLen = 0
+}
+
+// Sum appends the hash of the data written so far to b, without changing
+// the state of h.
+func (h *contentHash) Sum(b []byte) []byte {
+	if h.blockLen == 0 {
+		return h.overall.Sum(b)
+	}
+
+	// Hash the pending block into a copy of the overall state.
+	state, err := h.overall.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	overall := sha256.New()
+	if err = overall.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+		panic(err)
+	}
+	overall.Write(h.block.Sum(nil))
+	return overall.Sum(b)
+}
+
+func (h *contentHash) Reset() {
+	h.overall.Reset()
+	h.block.Reset()
+	h.blockLen = 0
+}
+
+func (h *contentHash) Size() int {
+	return ContentHashSize
+}
+
+func (h *contentHash) BlockSize() int {
+	return h.overall.BlockSize()
+}
+
+// ContentHashMismatchError is returned by a reader wrapped with
+// VerifyContentHash if the content read does not match the expected hash.
+type ContentHashMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+func (e ContentHashMismatchError) Error() string {
+	return fmt.Sprintf("content hash mismatch: expected %s, got %s", e.Expected, e.Actual)
+}
+
+type verifyingReader struct {
+	r        io.ReadCloser
+	h        hash.Hash
+	expected string
+	err      error
+}
+
+// VerifyContentHash wraps a download body, hashing everything read from it.
+// Once r is exhausted, reads fail with a ContentHashMismatchError instead of
+// io.EOF if the content does not match expected. The hash covers the whole
+// file, so the body of a ranged download, which is answered with 206 Partial
+// Content, can not be verified. Use it for complete downloads, e.g.
+//
+//	res, content, err := dbx.Download(arg)
+//	...
+//	content = dropbox.VerifyContentHash(content, res.ContentHash)
+func VerifyContentHash(r io.ReadCloser, expected string) io.ReadCloser {
+	return &verifyingReader{r: r, h: NewContentHash(), expected: expected}
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+
+	n, err := v.r.Read(p)
+	v.h.Write(p[:n])
+	if err == io.EOF {
+		if actual := hex.EncodeToString(v.h.Sum(nil)); actual != v.expected {
+			err = ContentHashMismatchError{Expected: v.expected, Actual: actual}
+		}
+	}
+	if err != nil {
+		v.err = err
+	}
+	return n, err
+}
+
+func (v *verifyingReader) Close() error {
+	return v.r.Close()
+}
+
+// addContentHash appends a `content_hash` field with the content hash of body
+// to the JSON object serializedArg, unless it is already set. The other fields
+// keep their order. body must be seekable, as it is read once to compute the
+// hash and rewound afterwards; otherwise serializedArg is returned unchanged.
+func addContentHash(serializedArg []byte, body io.Reader) ([]byte, error) {
+	r, ok := body.(io.ReadSeeker)
+	if !ok || serializedArg == nil {
+		return serializedArg, nil
+	}
+
+	var arg map[string]json.RawMessage
+	if err := json.Unmarshal(serializedArg, &arg); err != nil {
+		return nil, err
+	}
+	if _, ok = arg["content_hash"]; ok || arg == nil {
+		return serializedArg, nil
+	}
+
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	h := NewContentHash()
+	if _, err = io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	if _, err = r.Seek(start, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	field := fmt.Sprintf(`"content_hash":"%x"}`, h.Sum(nil))
+	obj := bytes.TrimRight(serializedArg, " \t\r\n")
+	obj = append([]byte{}, obj[:len(obj)-1]...)
+	if len(arg) > 0 {
+		obj = append(obj, ',')
+	}
+	return append(obj, field...), nil
+}
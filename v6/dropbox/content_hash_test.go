@@ -0,0 +1,127 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestContentHash(t *testing.T) {
+	content := make([]byte, 2*dropbox.ContentHashBlockSize+123)
+	for i := range content {
+		content[i] = byte(i * 7)
+	}
+
+	blocks := sha256.New()
+	for b := content; len(b) > 0; {
+		n := len(b)
+		if n > dropbox.ContentHashBlockSize {
+			n = dropbox.ContentHashBlockSize
+		}
+		sum := sha256.Sum256(b[:n])
+		blocks.Write(sum[:])
+		b = b[n:]
+	}
+	want := hex.EncodeToString(blocks.Sum(nil))
+
+	if got := dropbox.ContentHashString(content); got != want {
+		t.Errorf("Want %s got %s", want, got)
+	}
+
+	// Writes of arbitrary sizes, with Sum called in between.
+	h := dropbox.NewContentHash()
+	for b := content; len(b) > 0; {
+		n := 1<<20 + 7
+		if n > len(b) {
+			n = len(b)
+		}
+		h.Write(b[:n])
+		h.Sum(nil)
+		b = b[n:]
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != want {
+		t.Errorf("Want %s got %s", want, got)
+	}
+
+	const empty = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	if got := dropbox.ContentHashString(nil); got != empty {
+		t.Errorf("Want %s got %s", empty, got)
+	}
+}
+
+func TestContentHashUploadDownload(t *testing.T) {
+	content := []byte("Prime numbers: 2, 3, 5, 7, 11, 13, 17, 19, 23, 29")
+	hash := dropbox.ContentHashString(content)
+
+	var gotArg string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/files/upload":
+				gotArg = r.Header.Get("Dropbox-API-Arg")
+				w.Write([]byte(`{"name": "primes.txt"}`))
+			case "/files/download":
+				w.Header().Set("Dropbox-API-Result", `{"name": "primes.txt", "content_hash": "`+hash+`"}`)
+				w.Write(content[:len(content)-1])
+			}
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), ComputeContentHash: true,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := files.New(config)
+
+	arg := files.NewUploadArg("/primes.txt")
+	if _, err := client.Upload(arg, bytes.NewReader(content)); err != nil {
+		t.Fatal(err)
+	}
+	// The hash is appended, keeping the order of the other fields.
+	b, err := json.Marshal(arg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := string(b[:len(b)-1]) + `,"content_hash":"` + hash + `"}`; gotArg != want {
+		t.Errorf("Want arg %s got %s", want, gotArg)
+	}
+
+	res, body, err := client.Download(files.NewDownloadArg("/primes.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body = dropbox.VerifyContentHash(body, res.ContentHash)
+	defer body.Close()
+	_, err = ioutil.ReadAll(body)
+	if e, ok := err.(dropbox.ContentHashMismatchError); !ok || e.Expected != hash {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dropboxmetrics collects metrics of SDK calls and exposes them in
+// the Prometheus text format.
+package dropboxmetrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Upper bounds, in seconds, of the buckets of the histograms of Collectors
+// created by NewCollector
+var (
+	DefaultLatencyBuckets    = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+	DefaultRetryAfterBuckets = []float64{1, 5, 15, 30, 60, 300}
+)
+
+// Collector collects metrics of the calls made through its Middleware. It
+// is an http.Handler serving the metrics in the Prometheus text format:
+//
+//	metrics := dropboxmetrics.NewCollector()
+//	config.Middleware = append(config.Middleware, metrics.Middleware())
+//	http.Handle("/metrics", metrics)
+type Collector struct {
+	mu                sync.Mutex
+	latencyBuckets    []float64
+	retryAfterBuckets []float64
+	routes            map[string]*RouteMetrics
+	breaker           *dropbox.CircuitBreaker
+}
+
+// RouteMetrics are the metrics of the calls of a route.
+type RouteMetrics struct {
+	Namespace string
+	Route     string
+	// Number of calls, including failed ones
+	Requests uint64
+	// Latency of the calls in seconds, until the response of the API
+	// arrived. Retries are included, reading the body of downloads is not.
+	Latency Histogram
+	// Number of failed calls by HTTP status and error tag
+	Errors map[ErrorKey]uint64
+	// Number of attempts rejected with 429 Too Many Requests, whether or not
+	// they were retried
+	RateLimits uint64
+	// Delays in seconds requested by the API with 429 Too Many Requests
+	RetryAfter Histogram
+	// Number of bytes of request bodies sent, including retries
+	BytesUploaded uint64
+	// Number of bytes of results and download bodies received
+	BytesDownloaded uint64
+}
+
+// ErrorKey classifies failed calls.
+type ErrorKey struct {
+	// HTTP status of the response, zero if the call failed without one,
+	// e.g. because of a network error
+	StatusCode int
+	// Tag of the error returned by the API, e.g. "path", if any
+	Tag string
+}
+
+// Histogram counts observations in buckets.
+type Histogram struct {
+	// Upper bounds of the buckets
+	Buckets []float64
+	// Number of observations per bucket, i.e. less than or equal to its upper
+	// bound and greater than the one of the previous bucket. Has an extra
+	// entry for observations greater than all bounds.
+	Counts []uint64
+	// Number of observations
+	Count uint64
+	// Sum of the observations
+	Sum float64
+}
+
+func newHistogram(buckets []float64) Histogram {
+	return Histogram{Buckets: buckets, Counts: make([]uint64, len(buckets)+1)}
+}
+
+func (h *Histogram) observe(v float64) {
+	i := sort.SearchFloat64s(h.Buckets, v)
+	h.Counts[i]++
+	h.Count++
+	h.Sum += v
+}
+
+func (h Histogram) clone() Histogram {
+	h.Counts = append([]uint64(nil), h.Counts...)
+	return h
+}
+
+// NewCollector returns a Collector with the default histogram buckets.
+func NewCollector() *Collector {
+	return &Collector{
+		latencyBuckets:    DefaultLatencyBuckets,
+		retryAfterBuckets: DefaultRetryAfterBuckets,
+		routes:            map[string]*RouteMetrics{},
+	}
+}
+
+// route returns the metrics of the route of req. c.mu must be held.
+func (c *Collector) route(req dropbox.Request) *RouteMetrics {
+	name := req.Namespace + "/" + req.Route
+	m, ok := c.routes[name]
+	if !ok {
+		m = &RouteMetrics{
+			Namespace:  req.Namespace,
+			Route:      req.Route,
+			Latency:    newHistogram(c.latencyBuckets),
+			Errors:     map[ErrorKey]uint64{},
+			RetryAfter: newHistogram(c.retryAfterBuckets),
+		}
+		c.routes[name] = m
+	}
+	return m
+}
+
+// Middleware returns a dropbox.Middleware recording the metrics of calls.
+func (c *Collector) Middleware() dropbox.Middleware {
+	return func(next dropbox.Handler) dropbox.Handler {
+		return func(ctx context.Context, req dropbox.Request, body io.Reader) (*dropbox.Response, error) {
+			start := time.Now()
+			res, err := next(ctx, req, body)
+			latency := time.Since(start)
+
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			m := c.route(req)
+			m.Requests++
+			m.Latency.observe(latency.Seconds())
+			if res != nil {
+				m.BytesUploaded += uint64(res.BytesSent)
+				m.BytesDownloaded += uint64(len(res.Result))
+				for _, d := range res.RateLimits {
+					m.RateLimits++
+					m.RetryAfter.observe(d.Seconds())
+				}
+			}
+			if err != nil {
+				var key ErrorKey
+				if res != nil {
+					key = ErrorKey{StatusCode: res.StatusCode, Tag: res.ErrorTag}
+				}
+				m.Errors[key]++
+				return res, err
+			}
+
+			if res.Body != nil {
+				res.Body = &countingBody{ReadCloser: res.Body, c: c, m: m}
+			}
+			return res, nil
+		}
+	}
+}
+
+// countingBody adds the bytes read from a download body to the metrics.
+type countingBody struct {
+	io.ReadCloser
+	c *Collector
+	m *RouteMetrics
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.c.mu.Lock()
+	b.m.BytesDownloaded += uint64(n)
+	b.c.mu.Unlock()
+	return n, err
+}
+
+// SetCircuitBreaker adds the state of the circuits of b to the metrics
+// served.
+func (c *Collector) SetCircuitBreaker(b *dropbox.CircuitBreaker) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.breaker = b
+}
+
+// Route returns the metrics of the route with the given name, e.g.
+// "files/upload", and whether it has been called.
+func (c *Collector) Route(name string) (RouteMetrics, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m, ok := c.routes[name]
+	if !ok {
+		return RouteMetrics{}, false
+	}
+	return m.clone(), true
+}
+
+// Routes returns the metrics of all routes called so far, sorted by
+// namespace and route.
+func (c *Collector) Routes() []RouteMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	names := make([]string, 0, len(c.routes))
+	for name := range c.routes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	routes := make([]RouteMetrics, len(names))
+	for i, name := range names {
+		routes[i] = c.routes[name].clone()
+	}
+	return routes
+}
+
+func (m *RouteMetrics) clone() RouteMetrics {
+	res := *m
+	res.Latency = m.Latency.clone()
+	res.RetryAfter = m.RetryAfter.clone()
+	res.Errors = make(map[ErrorKey]uint64, len(m.Errors))
+	for k, v := range m.Errors {
+		res.Errors[k] = v
+	}
+	return res
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WritePrometheus(w)
+}
+
+// WritePrometheus writes the metrics to w in the Prometheus text format.
+func (c *Collector) WritePrometheus(w io.Writer) error {
+	routes := c.Routes()
+	bw := bufio.NewWriter(w)
+
+	header(bw, "dropbox_requests_total", "counter", "Number of calls of Dropbox API routes.")
+	for _, m := range routes {
+		sample(bw, "dropbox_requests_total", labels(m), float64(m.Requests))
+	}
+	header(bw, "dropbox_request_duration_seconds", "histogram", "Latency of calls of Dropbox API routes.")
+	for _, m := range routes {
+		histogram(bw, "dropbox_request_duration_seconds", labels(m), m.Latency)
+	}
+	header(bw, "dropbox_errors_total", "counter", "Number of failed calls of Dropbox API routes by HTTP status and error tag.")
+	for _, m := range routes {
+		keys := make([]ErrorKey, 0, len(m.Errors))
+		for k := range m.Errors {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].StatusCode != keys[j].StatusCode {
+				return keys[i].StatusCode < keys[j].StatusCode
+			}
+			return keys[i].Tag < keys[j].Tag
+		})
+		for _, k := range keys {
+			l := labels(m) + `,status="` + strconv.Itoa(k.StatusCode) + `",tag="` + escape(k.Tag) + `"`
+			sample(bw, "dropbox_errors_total", l, float64(m.Errors[k]))
+		}
+	}
+	header(bw, "dropbox_rate_limits_total", "counter", "Number of attempts rejected with 429 Too Many Requests.")
+	for _, m := range routes {
+		sample(bw, "dropbox_rate_limits_total", labels(m), float64(m.RateLimits))
+	}
+	header(bw, "dropbox_rate_limit_retry_after_seconds", "histogram", "Delays requested by the API with 429 Too Many Requests.")
+	for _, m := range routes {
+		histogram(bw, "dropbox_rate_limit_retry_after_seconds", labels(m), m.RetryAfter)
+	}
+	header(bw, "dropbox_uploaded_bytes_total", "counter", "Number of bytes of request bodies sent.")
+	for _, m := range routes {
+		sample(bw, "dropbox_uploaded_bytes_total", labels(m), float64(m.BytesUploaded))
+	}
+	header(bw, "dropbox_downloaded_bytes_total", "counter", "Number of bytes of results and download bodies received.")
+	for _, m := range routes {
+		sample(bw, "dropbox_downloaded_bytes_total", labels(m), float64(m.BytesDownloaded))
+	}
+
+	c.mu.Lock()
+	breaker := c.breaker
+	c.mu.Unlock()
+	if breaker != nil {
+		header(bw, "dropbox_circuit_state", "gauge", "State of the circuit of a host: 0 closed, 1 open, 2 half-open.")
+		for _, host := range hosts {
+			sample(bw, "dropbox_circuit_state", `host="`+host+`"`, float64(breaker.State(host)))
+		}
+	}
+	return bw.Flush()
+}
+
+// hosts are the host types of the API.
+var hosts = []string{"api", "content", "notify"}
+
+func header(w io.Writer, name, typ, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func sample(w io.Writer, name, labels string, v float64) {
+	fmt.Fprintf(w, "%s{%s} %s\n", name, labels, formatFloat(v))
+}
+
+func histogram(w io.Writer, name, labels string, h Histogram) {
+	var cumulative uint64
+	for i, bound := range h.Buckets {
+		cumulative += h.Counts[i]
+		sample(w, name+"_bucket", labels+`,le="`+formatFloat(bound)+`"`, float64(cumulative))
+	}
+	sample(w, name+"_bucket", labels+`,le="+Inf"`, float64(h.Count))
+	sample(w, name+"_sum", labels, h.Sum)
+	sample(w, name+"_count", labels, float64(h.Count))
+}
+
+func labels(m RouteMetrics) string {
+	return `namespace="` + escape(m.Namespace) + `",route="` + escape(m.Route) + `"`
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxmetrics_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxmetrics"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxtest"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/users"
+)
+
+func TestCollector(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	metrics := dropboxmetrics.NewCollector()
+	config := srv.Config()
+	config.Middleware = []dropbox.Middleware{metrics.Middleware()}
+	dbx := files.New(config)
+
+	if _, err := dbx.Upload(files.NewUploadArg("/a.txt"), bytes.NewReader([]byte("hello"))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbx.Upload(files.NewUploadArg("/a.txt"), bytes.NewReader([]byte("world"))); err == nil {
+		t.Fatal("expected conflict")
+	}
+	_, body, err := dbx.Download(files.NewDownloadArg("/a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ioutil.ReadAll(body); err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+
+	upload, ok := metrics.Route("files/upload")
+	if !ok || upload.Requests != 2 || upload.Latency.Count != 2 || upload.BytesUploaded != 10 {
+		t.Errorf("unexpected upload metrics %+v", upload)
+	}
+	if n := upload.Errors[dropboxmetrics.ErrorKey{StatusCode: http.StatusConflict, Tag: "path"}]; n != 1 {
+		t.Errorf("expected 1 path conflict, got %v", upload.Errors)
+	}
+	download, _ := metrics.Route("files/download")
+	if download.Requests != 1 || download.BytesDownloaded <= 5 || len(download.Errors) != 0 {
+		t.Errorf("unexpected download metrics %+v", download)
+	}
+	if _, ok = metrics.Route("files/get_metadata"); ok {
+		t.Error("expected no metrics for uncalled route")
+	}
+}
+
+func TestRateLimits(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error_summary": "too_many_requests/..", "error": {".tag": "too_many_requests", "retry_after": 7}}`))
+	}))
+	defer ts.Close()
+
+	metrics := dropboxmetrics.NewCollector()
+	config := dropbox.Config{Token: "token", Middleware: []dropbox.Middleware{metrics.Middleware()},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return ts.URL + "/" + namespace + "/" + route
+		}}
+	if _, err := users.New(config).GetCurrentAccount(); err == nil {
+		t.Fatal("expected error")
+	}
+
+	m, _ := metrics.Route("users/get_current_account")
+	if m.RateLimits != 1 || m.RetryAfter.Sum != 7 ||
+		m.Errors[dropboxmetrics.ErrorKey{StatusCode: http.StatusTooManyRequests, Tag: "too_many_requests"}] != 1 {
+		t.Errorf("unexpected metrics %+v", m)
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	out := rec.Body.String()
+	for _, line := range []string{
+		"# TYPE dropbox_requests_total counter",
+		`dropbox_requests_total{namespace="users",route="get_current_account"} 1`,
+		`dropbox_errors_total{namespace="users",route="get_current_account",status="429",tag="too_many_requests"} 1`,
+		`dropbox_rate_limit_retry_after_seconds_bucket{namespace="users",route="get_current_account",le="5"} 0`,
+		`dropbox_rate_limit_retry_after_seconds_bucket{namespace="users",route="get_current_account",le="15"} 1`,
+		`dropbox_rate_limit_retry_after_seconds_sum{namespace="users",route="get_current_account"} 7`,
+		`dropbox_request_duration_seconds_count{namespace="users",route="get_current_account"} 1`,
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("expected %q in output:\n%s", line, out)
+		}
+	}
+}
+
+func TestCircuitState(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	metrics := dropboxmetrics.NewCollector()
+	breaker := &dropbox.CircuitBreaker{MinRequests: 1}
+	metrics.SetCircuitBreaker(breaker)
+	config := dropbox.Config{Token: "token", CircuitBreaker: breaker,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return ts.URL + "/" + namespace + "/" + route
+		}}
+	if _, err := users.New(config).GetCurrentAccount(); err == nil {
+		t.Fatal("expected error")
+	}
+
+	var out bytes.Buffer
+	if err := metrics.WritePrometheus(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{`dropbox_circuit_state{host="api"} 1`, `dropbox_circuit_state{host="content"} 0`} {
+		if !strings.Contains(out.String(), line+"\n") {
+			t.Errorf("expected %q in output:\n%s", line, out.String())
+		}
+	}
+}
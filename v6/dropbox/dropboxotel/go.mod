@@ -0,0 +1,30 @@
+module github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxotel
+
+go 1.21
+
+require (
+	github.com/dropbox/dropbox-sdk-go-unofficial/v6 v6.1.0
+	go.opentelemetry.io/otel v1.21.0
+	go.opentelemetry.io/otel/sdk v1.21.0
+	go.opentelemetry.io/otel/trace v1.21.0
+)
+
+require (
+	github.com/go-logr/logr v1.3.0 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
+	go.opentelemetry.io/otel/metric v1.21.0 // indirect
+	golang.org/x/net v0.9.0 // indirect
+	golang.org/x/oauth2 v0.7.0 // indirect
+	golang.org/x/sys v0.14.0 // indirect
+	google.golang.org/appengine v1.6.7 // indirect
+	google.golang.org/protobuf v1.28.0 // indirect
+)
+
+// The SDK is replaced by the working tree for local development only; the
+// replace directive is ignored when this module is a dependency. Consumers
+// get the SDK version required above, v6.1.0 being the first release with
+// dropbox.Middleware. Tag that SDK release (`v6.1.0`) before tagging this
+// module (`v6/dropbox/dropboxotel/vX.Y.Z`), and raise the requirement
+// whenever this module starts using newer SDK APIs.
+replace github.com/dropbox/dropbox-sdk-go-unofficial/v6 => ../..
@@ -0,0 +1,141 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dropboxotel integrates the SDK with OpenTelemetry. It is a separate
+// module, so that programs not using it do not depend on OpenTelemetry.
+package dropboxotel
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxotel"
+
+// Attributes recorded on the spans of calls
+const (
+	StyleKey           = attribute.Key("dropbox.style")
+	AuthKey            = attribute.Key("dropbox.auth")
+	StatusCodeKey      = attribute.Key("http.response.status_code")
+	ErrorTagKey        = attribute.Key("dropbox.error.tag")
+	ErrorSummaryKey    = attribute.Key("dropbox.error.summary")
+	RequestIDKey       = attribute.Key("dropbox.request_id")
+	RetryCountKey      = attribute.Key("dropbox.retry_count")
+	BytesUploadedKey   = attribute.Key("dropbox.bytes_uploaded")
+	BytesDownloadedKey = attribute.Key("dropbox.bytes_downloaded")
+)
+
+// Tracing returns a dropbox.Middleware creating a span named after the route,
+// e.g. "files/upload", for every call. The span is a child of the span in the
+// context of the call, if any, so calls made with the Context variants of the
+// routes join the trace of the caller:
+//
+//	config.Middleware = append(config.Middleware, dropboxotel.Tracing(nil))
+//	res, err := dbx.ListFolderContext(ctx, arg)
+//
+// The span of a download route ends when its body is closed. A nil tp uses
+// the global TracerProvider.
+func Tracing(tp trace.TracerProvider) dropbox.Middleware {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	sdkVersion, _ := dropbox.Version()
+	tracer := tp.Tracer(instrumentationName, trace.WithInstrumentationVersion(sdkVersion))
+
+	return func(next dropbox.Handler) dropbox.Handler {
+		return func(ctx context.Context, req dropbox.Request, body io.Reader) (*dropbox.Response, error) {
+			ctx, span := tracer.Start(ctx, req.Namespace+"/"+req.Route,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(StyleKey.String(req.Style)))
+
+			res, err := next(ctx, req, body)
+			if res != nil {
+				span.SetAttributes(
+					AuthKey.String(res.Auth),
+					StatusCodeKey.Int(res.StatusCode),
+					RetryCountKey.Int(res.Attempts-1),
+					BytesUploadedKey.Int64(res.BytesSent),
+				)
+				if id := res.Header.Get("X-Dropbox-Request-Id"); id != "" {
+					span.SetAttributes(RequestIDKey.String(id))
+				}
+				if res.ErrorTag != "" {
+					span.SetAttributes(ErrorTagKey.String(res.ErrorTag), ErrorSummaryKey.String(res.ErrorSummary))
+				}
+			}
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, errorDescription(res, err))
+				span.End()
+				return res, err
+			}
+
+			if res.Body != nil {
+				res.Body = &spanBody{ReadCloser: res.Body, span: span}
+				return res, nil
+			}
+			span.SetAttributes(BytesDownloadedKey.Int(len(res.Result)))
+			span.End()
+			return res, nil
+		}
+	}
+}
+
+func errorDescription(res *dropbox.Response, err error) string {
+	if res != nil && res.ErrorSummary != "" {
+		return strings.TrimRight(res.ErrorSummary, "./")
+	}
+	return err.Error()
+}
+
+// spanBody ends the span of a download once its body is closed.
+type spanBody struct {
+	io.ReadCloser
+	span trace.Span
+	n    int64
+	once sync.Once
+}
+
+func (b *spanBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	if err != nil && err != io.EOF {
+		b.span.RecordError(err)
+		b.span.SetStatus(codes.Error, err.Error())
+	}
+	return n, err
+}
+
+func (b *spanBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.span.SetAttributes(BytesDownloadedKey.Int64(b.n))
+		b.span.End()
+	})
+	return err
+}
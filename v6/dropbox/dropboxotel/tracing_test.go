@@ -0,0 +1,105 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxotel_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxotel"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxtest"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestTracing(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	config := srv.Config()
+	config.Middleware = []dropbox.Middleware{dropboxotel.Tracing(tp)}
+	dbx := files.New(config)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	if _, err := dbx.UploadContext(ctx, files.NewUploadArg("/a.txt"), bytes.NewReader([]byte("hello"))); err != nil {
+		t.Fatal(err)
+	}
+	parent.End()
+	_, body, err := dbx.Download(files.NewDownloadArg("/a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.Ended()) != 2 {
+		t.Errorf("expected download span to end with its body, got %d spans", len(recorder.Ended()))
+	}
+	if _, err = ioutil.ReadAll(body); err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if _, err = dbx.GetMetadata(files.NewGetMetadataArg("/missing")); err == nil {
+		t.Fatal("expected error")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans, got %d", len(spans))
+	}
+
+	upload := spans[0]
+	attrs := attributes(upload)
+	if upload.Name() != "files/upload" || upload.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("unexpected span %s with parent %v", upload.Name(), upload.Parent())
+	}
+	if attrs[dropboxotel.StyleKey].AsString() != "upload" || attrs[dropboxotel.AuthKey].AsString() != "user" ||
+		attrs[dropboxotel.StatusCodeKey].AsInt64() != 200 || attrs[dropboxotel.BytesUploadedKey].AsInt64() != 5 ||
+		attrs[dropboxotel.RetryCountKey].AsInt64() != 0 || attrs[dropboxotel.RequestIDKey].AsString() == "" {
+		t.Errorf("unexpected upload attributes %v", attrs)
+	}
+
+	download := spans[2]
+	if download.Name() != "files/download" || download.Parent().IsValid() ||
+		attributes(download)[dropboxotel.BytesDownloadedKey].AsInt64() != 5 {
+		t.Errorf("unexpected download span %s: %v", download.Name(), attributes(download))
+	}
+
+	failed := spans[3]
+	attrs = attributes(failed)
+	if failed.Status().Code != codes.Error || attrs[dropboxotel.StatusCodeKey].AsInt64() != 409 ||
+		attrs[dropboxotel.ErrorTagKey].AsString() != "path" || attrs[dropboxotel.ErrorSummaryKey].AsString() != "path/not_found/.." {
+		t.Errorf("unexpected error span %v: %v", failed.Status(), attrs)
+	}
+}
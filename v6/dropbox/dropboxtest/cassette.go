@@ -0,0 +1,453 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Cassette holds the calls recorded by a Recorder, as saved to a file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded call.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the request of a recorded call.
+type RecordedRequest struct {
+	// Namespace and route, e.g. "files/list_folder"
+	Route string `json:"route"`
+	// Normalized JSON argument, from the body of RPC routes or the
+	// Dropbox-API-Arg header of upload and download routes
+	Arg json.RawMessage `json:"arg,omitempty"`
+	// Form-encoded body, e.g. of token refreshes, with secrets scrubbed
+	Form url.Values `json:"form,omitempty"`
+	// Content of upload routes, or other bodies that are not JSON
+	Body []byte `json:"body,omitempty"`
+}
+
+// RecordedResponse is the response of a recorded call.
+type RecordedResponse struct {
+	StatusCode int `json:"status"`
+	// Result of download routes, from the Dropbox-API-Result header
+	Result json.RawMessage `json:"result,omitempty"`
+	// Body of JSON responses
+	JSON json.RawMessage `json:"json,omitempty"`
+	// Body of other responses, e.g. downloads
+	Body []byte `json:"body,omitempty"`
+	// Whether Body is only the start of the response body, which was longer
+	// than Recorder.MaxBodySize or not read to its end
+	Truncated bool `json:"truncated,omitempty"`
+	// Headers relevant to the SDK, e.g. Content-Type and Retry-After
+	Header map[string]string `json:"header,omitempty"`
+}
+
+// recordedHeaders are the response headers kept in cassettes.
+var recordedHeaders = []string{"Content-Type", "Dropbox-API-Result", "Retry-After", "X-Dropbox-Request-Id"}
+
+// oauthFields are the fields of the OAuth token endpoint holding tokens or
+// secrets, which are not part of the API spec.
+var oauthFields = map[string]bool{
+	"access_token":  true,
+	"client_secret": true,
+	"code":          true,
+	"code_verifier": true,
+	"id_token":      true,
+	"refresh_token": true,
+}
+
+// scrubbed replaces the values of secret fields.
+const scrubbed = "SCRUBBED"
+
+// isSecret reports whether the values of the field are replaced in cassettes.
+func isSecret(field string) bool {
+	return oauthFields[field] || dropbox.IsSensitiveField(field)
+}
+
+// DefaultMaxBodySize is the default of Recorder.MaxBodySize.
+const DefaultMaxBodySize = 1 << 20
+
+// Recorder is an http.RoundTripper recording the calls made through it to a
+// Cassette. The Authorization header is never recorded, and neither are
+// tokens, secrets or passwords in arguments, results and the form-encoded
+// requests of the OAuth token endpoint. Response bodies other than JSON, e.g.
+// downloads, are streamed to the caller and recorded up to MaxBodySize bytes.
+// A test can record calls once against the API and replay them with a
+// Replayer from then on:
+//
+//	rec := dropboxtest.NewRecorder(oauth2.NewClient(ctx, tokenSource).Transport)
+//	dbx := files.New(dropbox.Config{Client: &http.Client{Transport: rec}})
+//	// make calls with dbx
+//	err := rec.Save("testdata/list_folder.json")
+type Recorder struct {
+	// Transport making the requests, which has to authenticate them
+	Base http.RoundTripper
+	// Number of bytes recorded of response bodies other than JSON, if not
+	// DefaultMaxBodySize. Replaying a truncated body fails after its
+	// recorded part with io.ErrUnexpectedEOF.
+	MaxBodySize int64
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a Recorder making requests with base.
+func NewRecorder(base http.RoundTripper) *Recorder {
+	return &Recorder{Base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.Base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	interaction := Interaction{Request: recorded, Response: RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     map[string]string{},
+	}}
+	for _, h := range recordedHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			interaction.Response.Header[h] = v
+		}
+	}
+	if result := resp.Header.Get("Dropbox-API-Result"); json.Valid([]byte(result)) {
+		interaction.Response.Result = scrub([]byte(result))
+		delete(interaction.Response.Header, "Dropbox-API-Result")
+	}
+
+	// JSON bodies have to be read as a whole to scrub their secrets.
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if json.Valid(body) {
+			interaction.Response.JSON = scrub(body)
+		} else {
+			interaction.Response.Body = body
+		}
+		r.add(interaction)
+		return resp, nil
+	}
+
+	max := r.MaxBodySize
+	if max <= 0 {
+		max = DefaultMaxBodySize
+	}
+	interaction.Response.Truncated = true
+	resp.Body = &recordingBody{ReadCloser: resp.Body, r: r, i: r.add(interaction), max: max}
+	return resp, nil
+}
+
+// add appends interaction to the cassette, and returns its index.
+func (r *Recorder) add(interaction Interaction) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	return len(r.cassette.Interactions) - 1
+}
+
+// recordingBody records the start of a response body as it is read, once
+// it is read to its end or closed.
+type recordingBody struct {
+	io.ReadCloser
+	r   *Recorder
+	i   int
+	max int64
+
+	buf bytes.Buffer
+	// Whether bytes past max were read
+	dropped bool
+	eof     bool
+}
+
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	keep := n
+	if left := b.max - int64(b.buf.Len()); int64(keep) > left {
+		keep = int(left)
+		b.dropped = true
+	}
+	b.buf.Write(p[:keep])
+	if err == io.EOF && !b.eof {
+		b.eof = true
+		b.record()
+	}
+	return n, err
+}
+
+func (b *recordingBody) Close() error {
+	if !b.eof {
+		b.record()
+	}
+	return b.ReadCloser.Close()
+}
+
+// record updates the recorded response with the body read so far.
+func (b *recordingBody) record() {
+	b.r.mu.Lock()
+	defer b.r.mu.Unlock()
+	res := &b.r.cassette.Interactions[b.i].Response
+	res.Body = append([]byte(nil), b.buf.Bytes()...)
+	res.Truncated = !b.eof || b.dropped
+}
+
+// Cassette returns the calls recorded so far.
+func (r *Recorder) Cassette() Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save writes the calls recorded so far to the file at path.
+func (r *Recorder) Save(path string) error {
+	b, err := json.MarshalIndent(r.Cassette(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(b, '\n'), 0644)
+}
+
+// TB is the part of testing.TB used by a Replayer.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// Replayer is an http.RoundTripper answering calls with the responses of a
+// Cassette, without network access:
+//
+//	dbx := files.New(dropbox.Config{Client: &http.Client{
+//		Transport: dropboxtest.NewReplayer(t, "testdata/list_folder.json"),
+//	}})
+//
+// A call matches a recorded one with the same route and argument, compared
+// as JSON values. Each recorded call answers one call, in the order they were
+// recorded. Calls without match fail the test.
+type Replayer struct {
+	t            TB
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer returns a Replayer of the cassette at path, failing t if it
+// can not be read.
+func NewReplayer(t TB, path string) *Replayer {
+	t.Helper()
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("dropboxtest: %v", err)
+	}
+	var cassette Cassette
+	if err = json.Unmarshal(b, &cassette); err != nil {
+		t.Fatalf("dropboxtest: invalid cassette %s: %v", path, err)
+	}
+	return ReplayCassette(t, cassette)
+}
+
+// ReplayCassette returns a Replayer of cassette.
+func ReplayCassette(t TB, cassette Cassette) *Replayer {
+	return &Replayer{
+		t:            t,
+		interactions: cassette.Interactions,
+		used:         make([]bool, len(cassette.Interactions)),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request.Route != recorded.Route ||
+			!bytes.Equal(normalize(interaction.Request.Arg), recorded.Arg) {
+			continue
+		}
+		r.used[i] = true
+		return interaction.Response.response(req), nil
+	}
+
+	err = fmt.Errorf("dropboxtest: no recorded call of %s with argument %s", recorded.Route, recorded.Arg)
+	r.t.Errorf("%v", err)
+	return nil, err
+}
+
+func (res RecordedResponse) response(req *http.Request) *http.Response {
+	header := http.Header{}
+	for k, v := range res.Header {
+		header.Set(k, v)
+	}
+	if res.Result != nil {
+		header.Set("Dropbox-API-Result", string(res.Result))
+	}
+	body := res.Body
+	if res.JSON != nil {
+		body = res.JSON
+	}
+	var content io.Reader = bytes.NewReader(body)
+	length := int64(len(body))
+	if res.Truncated {
+		content = io.MultiReader(content, errReader{io.ErrUnexpectedEOF})
+		length = -1
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+		StatusCode:    res.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(content),
+		ContentLength: length,
+		Request:       req,
+	}
+}
+
+// errReader fails every read with err.
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+// recordRequest returns the route, argument and content of req, leaving its
+// body to be read again.
+func recordRequest(req *http.Request) (RecordedRequest, error) {
+	var recorded RecordedRequest
+	path := strings.TrimPrefix(req.URL.Path, "/")
+	// Drop the version, e.g. "2/files/upload" or "1/oauth2/token".
+	if i := strings.IndexByte(path, '/'); i > 0 && strings.Trim(path[:i], "0123456789") == "" {
+		path = path[i+1:]
+	}
+	recorded.Route = path
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return recorded, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	switch arg := req.Header.Get("Dropbox-API-Arg"); {
+	case json.Valid([]byte(arg)):
+		recorded.Arg = normalize([]byte(arg))
+		if len(body) > 0 {
+			recorded.Body = body
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return recorded, err
+		}
+		for k := range form {
+			if isSecret(k) {
+				form[k] = []string{scrubbed}
+			}
+		}
+		recorded.Form = form
+	case json.Valid(body):
+		recorded.Arg = normalize(body)
+	default:
+		recorded.Body = body
+	}
+	return recorded, nil
+}
+
+// normalize returns b as compact JSON with sorted keys and scrubbed secrets.
+func normalize(b []byte) json.RawMessage {
+	if len(b) == 0 {
+		return nil
+	}
+	return scrub(b)
+}
+
+// scrub replaces the values of secret fields in the JSON value b, and
+// returns it in canonical form. Invalid JSON is returned as is.
+func scrub(b []byte) json.RawMessage {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if d.Decode(&v) != nil {
+		return b
+	}
+	out, err := json.Marshal(scrubValue(v))
+	if err != nil {
+		return b
+	}
+	return out
+}
+
+func scrubValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if isSecret(k) {
+				v[k] = scrubbed
+			} else {
+				v[k] = scrubValue(field)
+			}
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = scrubValue(elem)
+		}
+	}
+	return v
+}
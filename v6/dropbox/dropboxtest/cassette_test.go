@@ -0,0 +1,200 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxtest"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// failTB records the failures of a Replayer.
+type failTB struct {
+	*testing.T
+	errors []string
+}
+
+func (t *failTB) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+
+	srv := dropboxtest.NewServer()
+	config := srv.Config()
+	rec := dropboxtest.NewRecorder(config.Client.Transport)
+	config.Client = &http.Client{Transport: rec}
+	dbx := files.New(config)
+	if _, err = dbx.Upload(files.NewUploadArg("/a.txt"), bytes.NewReader([]byte("abc"))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dbx.GetMetadata(files.NewGetMetadataArg("/missing")); err == nil {
+		t.Fatal("expected not_found")
+	}
+	if err = rec.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "Bearer") {
+		t.Error("expected Authorization header not to be recorded")
+	}
+
+	// Replay against the default URLs, which are never dialed.
+	tb := &failTB{T: t}
+	dbx = files.New(dropbox.Config{
+		Token:  "replay",
+		Client: &http.Client{Transport: dropboxtest.NewReplayer(tb, path)},
+	})
+	if _, err = dbx.GetMetadata(files.NewGetMetadataArg("/missing")); err == nil || !strings.Contains(err.Error(), "not_found") {
+		t.Errorf("expected replayed not_found, got %v", err)
+	}
+	meta, content, err := dbx.Download(files.NewDownloadArg("/a.txt"))
+	if err == nil {
+		content.Close()
+		t.Errorf("expected unrecorded download to fail, got %v", meta)
+	}
+	if len(tb.errors) != 1 {
+		t.Errorf("expected the unrecorded call to fail the test, got %v", tb.errors)
+	}
+	up, err := dbx.Upload(files.NewUploadArg("/a.txt"), bytes.NewReader([]byte("abc")))
+	if err != nil || up.PathDisplay != "/a.txt" || up.Size != 3 {
+		t.Errorf("unexpected replayed upload %+v, %v", up, err)
+	}
+}
+
+func TestRecordLargeBody(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	if _, err := srv.WriteFile("/a.txt", []byte("hello world")); err != nil {
+		t.Fatal(err)
+	}
+	config := srv.Config()
+	rec := dropboxtest.NewRecorder(config.Client.Transport)
+	rec.MaxBodySize = 5
+	config.Client = &http.Client{Transport: rec}
+	_, content, err := files.New(config).Download(files.NewDownloadArg("/a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(content)
+	content.Close()
+	if err != nil || string(b) != "hello world" {
+		t.Fatalf("expected whole download to be passed through, got %q, %v", b, err)
+	}
+
+	cassette := rec.Cassette()
+	res := cassette.Interactions[len(cassette.Interactions)-1].Response
+	if string(res.Body) != "hello" || !res.Truncated {
+		t.Fatalf("expected download to be recorded truncated, got %q", res.Body)
+	}
+
+	config.Client = &http.Client{Transport: dropboxtest.ReplayCassette(t, cassette)}
+	_, content, err = files.New(config).Download(files.NewDownloadArg("/a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err = ioutil.ReadAll(content)
+	content.Close()
+	if string(b) != "hello" || err != io.ErrUnexpectedEOF {
+		t.Errorf("expected truncated replay, got %q, %v", b, err)
+	}
+}
+
+// roundTripFunc is an http.RoundTripper calling itself.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRecordTokenRefresh(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	rec := dropboxtest.NewRecorder(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/1/oauth2/token" {
+			return srv.Config().Client.Transport.RoundTrip(req)
+		}
+		body := `{"access_token": "fresh-token", "token_type": "bearer", "expires_in": 14400}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}))
+	config := srv.Config()
+	config.Token = ""
+	config.RefreshToken = "refresh-secret"
+	config.AppKey = "key"
+	config.AppSecret = "app-secret"
+	config.Client = &http.Client{Transport: rec}
+	if _, err := files.New(config).GetMetadata(files.NewGetMetadataArg("/missing")); err == nil {
+		t.Fatal("expected not_found")
+	}
+
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+	if err := rec.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"refresh-secret", "app-secret", "fresh-token"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expected %s to be scrubbed from %s", secret, b)
+		}
+	}
+	if !strings.Contains(string(b), `"oauth2/token"`) {
+		t.Errorf("expected token refresh to be recorded in %s", b)
+	}
+
+	config.Client = &http.Client{Transport: dropboxtest.NewReplayer(t, path)}
+	if _, err = files.New(config).GetMetadata(files.NewGetMetadataArg("/missing")); err == nil || !strings.Contains(err.Error(), "not_found") {
+		t.Errorf("expected replayed not_found, got %v", err)
+	}
+}
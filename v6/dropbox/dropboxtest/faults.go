@@ -0,0 +1,341 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FaultKind is a kind of failure injected by a FaultInjector.
+type FaultKind int
+
+const (
+	// FaultRateLimit rejects the call with 429 Too Many Requests.
+	FaultRateLimit FaultKind = iota
+	// FaultServerError fails the call with a 5xx status.
+	FaultServerError
+	// FaultEndpointError fails the call with a 409 endpoint error.
+	FaultEndpointError
+	// FaultTruncate cuts the response body short, as a dropped download.
+	FaultTruncate
+	// FaultSlow delays the call.
+	FaultSlow
+	// FaultReset fails the call with a connection reset, before any
+	// response is received.
+	FaultReset
+)
+
+// String returns the name of k.
+func (k FaultKind) String() string {
+	switch k {
+	case FaultRateLimit:
+		return "rate_limit"
+	case FaultServerError:
+		return "server_error"
+	case FaultEndpointError:
+		return "endpoint_error"
+	case FaultTruncate:
+		return "truncate"
+	case FaultSlow:
+		return "slow"
+	case FaultReset:
+		return "reset"
+	}
+	return "unknown"
+}
+
+// Fault describes a failure injected by a FaultInjector.
+type Fault struct {
+	Kind FaultKind
+	// FaultRateLimit: reason of the RateLimitError, `too_many_requests` by
+	// default, or `too_many_write_operations`
+	Reason string
+	// FaultRateLimit: delay requested in `retry_after`, rounded up to whole
+	// seconds, if any
+	RetryAfter time.Duration
+	// FaultServerError: status of the response, 500 by default
+	StatusCode int
+	// FaultEndpointError: tag of the endpoint error, e.g. "path/not_found"
+	Tag string
+	// FaultTruncate: number of bytes of the response body delivered before
+	// the connection drops
+	Bytes int64
+	// FaultSlow: delay before the call is sent
+	Delay time.Duration
+}
+
+// FaultRule injects a fault into the matching calls of a FaultInjector.
+type FaultRule struct {
+	// Routes the rule applies to, e.g. "files/upload" or "files/*" for a
+	// whole namespace. An empty route matches every call.
+	Route string
+	// Probability of injecting the fault into a matching call, from 0 to 1
+	Probability float64
+	// Maximum number of injections, unlimited if zero
+	Limit int
+	Fault Fault
+
+	injected int
+}
+
+// FaultInjector is an http.RoundTripper injecting failures into the calls
+// made through it, to test how code using the SDK copes with an API that
+// misbehaves. It can wrap the transport of a Server, of a Replayer or of the
+// actual API:
+//
+//	faults := dropboxtest.NewFaultInjector(srv.Config().Client.Transport, 1)
+//	faults.Script("files/upload", &dropboxtest.Fault{Kind: dropboxtest.FaultServerError}, nil)
+//	faults.Add(dropboxtest.FaultRule{
+//		Route:       "files/*",
+//		Probability: 0.1,
+//		Fault:       dropboxtest.Fault{Kind: dropboxtest.FaultRateLimit, RetryAfter: time.Second},
+//	})
+//
+// Scripted faults take precedence over rules. The outcome of a run only
+// depends on the seed and the order of calls.
+type FaultInjector struct {
+	// Transport making the calls that are not failed
+	Base http.RoundTripper
+
+	mu      sync.Mutex
+	rand    *rand.Rand
+	rules   []*FaultRule
+	scripts map[string][]*Fault
+	counts  map[FaultKind]int
+}
+
+// NewFaultInjector returns a FaultInjector making calls with base, drawing
+// the faults of its rules from a source seeded with seed.
+func NewFaultInjector(base http.RoundTripper, seed int64) *FaultInjector {
+	return &FaultInjector{
+		Base:    base,
+		rand:    rand.New(rand.NewSource(seed)),
+		scripts: map[string][]*Fault{},
+		counts:  map[FaultKind]int{},
+	}
+}
+
+// Add injects the fault of rule into calls from now on.
+func (f *FaultInjector) Add(rule FaultRule) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rules = append(f.rules, &rule)
+}
+
+// Script queues faults for the next calls of route, which has the same form
+// as FaultRule.Route: each call takes the next fault, and a nil fault lets a
+// call through. Scripts of the same route are queued one after another, and
+// those of a route are used before those of its namespace.
+func (f *FaultInjector) Script(route string, faults ...*Fault) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.scripts[route] = append(f.scripts[route], faults...)
+}
+
+// Injected returns the number of faults of kind injected so far.
+func (f *FaultInjector) Injected(kind FaultKind) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.counts[kind]
+}
+
+// next returns the fault to inject into a call of route, if any.
+func (f *FaultInjector) next(route string) *Fault {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Scripts of the route take precedence over those of its namespace.
+	namespace := route
+	if i := strings.IndexByte(route, '/'); i >= 0 {
+		namespace = route[:i]
+	}
+	for _, pattern := range []string{route, namespace + "/*", ""} {
+		script := f.scripts[pattern]
+		if len(script) == 0 {
+			continue
+		}
+		fault := script[0]
+		if len(script) == 1 {
+			delete(f.scripts, pattern)
+		} else {
+			f.scripts[pattern] = script[1:]
+		}
+		if fault != nil {
+			f.counts[fault.Kind]++
+		}
+		return fault
+	}
+
+	for _, rule := range f.rules {
+		if !matchRoute(rule.Route, route) || (rule.Limit > 0 && rule.injected >= rule.Limit) {
+			continue
+		}
+		if f.rand.Float64() < rule.Probability {
+			rule.injected++
+			f.counts[rule.Fault.Kind]++
+			fault := rule.Fault
+			return &fault
+		}
+	}
+	return nil
+}
+
+// matchRoute reports whether route, e.g. "files/upload", matches pattern.
+func matchRoute(pattern, route string) bool {
+	if pattern == "" || pattern == route {
+		return true
+	}
+	return strings.HasSuffix(pattern, "/*") && strings.HasPrefix(route, pattern[:len(pattern)-1])
+}
+
+// RoundTrip implements http.RoundTripper.
+func (f *FaultInjector) RoundTrip(req *http.Request) (*http.Response, error) {
+	route := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, "/"), "2/")
+	fault := f.next(route)
+	if fault == nil {
+		return f.Base.RoundTrip(req)
+	}
+
+	switch fault.Kind {
+	case FaultSlow:
+		t := time.NewTimer(fault.Delay)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-req.Context().Done():
+			closeBody(req)
+			return nil, req.Context().Err()
+		}
+		return f.Base.RoundTrip(req)
+
+	case FaultTruncate:
+		resp, err := f.Base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = &truncatedBody{ReadCloser: resp.Body, left: fault.Bytes}
+		return resp, nil
+
+	case FaultReset:
+		closeBody(req)
+		return nil, fmt.Errorf("dropboxtest: injected fault: %w", syscall.ECONNRESET)
+
+	case FaultRateLimit:
+		closeBody(req)
+		reason := fault.Reason
+		if reason == "" {
+			reason = "too_many_requests"
+		}
+		rateLimit := map[string]interface{}{"reason": map[string]string{".tag": reason}}
+		header := http.Header{}
+		if fault.RetryAfter > 0 {
+			secs := int64((fault.RetryAfter + time.Second - 1) / time.Second)
+			rateLimit["retry_after"] = secs
+			header.Set("Retry-After", strconv.FormatInt(secs, 10))
+		}
+		return faultResponse(req, http.StatusTooManyRequests, header, map[string]interface{}{
+			"error_summary": reason + "/..",
+			"error":         rateLimit,
+		}), nil
+
+	case FaultEndpointError:
+		closeBody(req)
+		return faultResponse(req, http.StatusConflict, nil, map[string]interface{}{
+			"error_summary": fault.Tag + "/..",
+			"error":         taggedError(strings.Split(fault.Tag, "/")),
+		}), nil
+
+	default:
+		closeBody(req)
+		status := fault.StatusCode
+		if status == 0 {
+			status = http.StatusInternalServerError
+		}
+		resp := faultResponse(req, status, nil, nil)
+		resp.Header.Set("Content-Type", "text/plain; charset=utf-8")
+		resp.Body = ioutil.NopCloser(strings.NewReader(http.StatusText(status)))
+		return resp, nil
+	}
+}
+
+// taggedError returns the union value with the nested tags, e.g.
+// {".tag": "path", "path": {".tag": "not_found"}} for "path/not_found".
+func taggedError(tags []string) map[string]interface{} {
+	v := map[string]interface{}{".tag": tags[0]}
+	if len(tags) > 1 && tags[1] != "" {
+		v[tags[0]] = taggedError(tags[1:])
+	}
+	return v
+}
+
+func faultResponse(req *http.Request, status int, header http.Header, body interface{}) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Type", "application/json")
+	header.Set("X-Dropbox-Request-Id", "fault")
+	b, _ := json.Marshal(body)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(string(b))),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}
+}
+
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// truncatedBody fails with io.ErrUnexpectedEOF after left bytes.
+type truncatedBody struct {
+	io.ReadCloser
+	left int64
+}
+
+func (b *truncatedBody) Read(p []byte) (int, error) {
+	if b.left <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if int64(len(p)) > b.left {
+		p = p[:b.left]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.left -= int64(n)
+	return n, err
+}
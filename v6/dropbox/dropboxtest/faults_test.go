@@ -0,0 +1,163 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxtest"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func faultyClient(t *testing.T, seed int64) (*dropboxtest.Server, *dropboxtest.FaultInjector, files.Client) {
+	srv := dropboxtest.NewServer()
+	if _, err := srv.WriteFile("/a.txt", []byte("hello world")); err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	config := srv.Config()
+	faults := dropboxtest.NewFaultInjector(config.Client.Transport, seed)
+	config.Client = &http.Client{Transport: faults}
+	return srv, faults, files.New(config)
+}
+
+func TestScriptedFaults(t *testing.T) {
+	srv, faults, dbx := faultyClient(t, 1)
+	defer srv.Close()
+
+	faults.Script("files/get_metadata",
+		&dropboxtest.Fault{Kind: dropboxtest.FaultEndpointError, Tag: "path/not_found"},
+		nil,
+		&dropboxtest.Fault{Kind: dropboxtest.FaultServerError, StatusCode: http.StatusServiceUnavailable},
+		&dropboxtest.Fault{Kind: dropboxtest.FaultRateLimit, Reason: "too_many_write_operations"},
+		&dropboxtest.Fault{Kind: dropboxtest.FaultReset},
+	)
+	arg := files.NewGetMetadataArg("/a.txt")
+	var apiErr dropbox.APIError
+	_, err := dbx.GetMetadata(arg)
+	if !errors.Is(err, files.ErrLookupNotFound) || !errors.As(err, &apiErr) || apiErr.ErrorSummary != "path/not_found/.." {
+		t.Errorf("expected injected not_found, got %v", err)
+	}
+	if _, err := dbx.GetMetadata(arg); err != nil {
+		t.Errorf("expected call to go through, got %v", err)
+	}
+	if _, err := dbx.GetMetadata(arg); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %v", err)
+	}
+	if _, err := dbx.GetMetadata(arg); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests ||
+		apiErr.ErrorSummary != "too_many_write_operations/.." {
+		t.Errorf("expected 429, got %v", err)
+	}
+	if _, err := dbx.GetMetadata(arg); !errors.Is(err, syscall.ECONNRESET) {
+		t.Errorf("expected connection reset, got %v", err)
+	}
+	if _, err := dbx.GetMetadata(arg); err != nil {
+		t.Errorf("expected script to be exhausted, got %v", err)
+	}
+
+	faults.Script("files/*", &dropboxtest.Fault{Kind: dropboxtest.FaultTruncate, Bytes: 5})
+	_, content, err := dbx.Download(files.NewDownloadArg("/a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(content)
+	content.Close()
+	if string(b) != "hello" || err != io.ErrUnexpectedEOF {
+		t.Errorf("expected truncated download, got %q, %v", b, err)
+	}
+
+	faults.Script("", &dropboxtest.Fault{Kind: dropboxtest.FaultSlow, Delay: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = dbx.GetMetadataContext(ctx, arg)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected slow call to time out, got %v", err)
+	}
+}
+
+func TestFaultRetries(t *testing.T) {
+	srv, faults, _ := faultyClient(t, 1)
+	defer srv.Close()
+	config := srv.Config()
+	config.Client = &http.Client{Transport: faults}
+	config.RetryPolicy = &dropbox.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, RetryOn: dropbox.RetryOnAll}
+	dbx := files.New(config)
+
+	faults.Script("files/upload",
+		&dropboxtest.Fault{Kind: dropboxtest.FaultReset},
+		&dropboxtest.Fault{Kind: dropboxtest.FaultServerError},
+	)
+	if _, err := dbx.Upload(files.NewUploadArg("/b.txt"), bytes.NewReader([]byte("b"))); err != nil {
+		t.Fatalf("expected upload to survive the faults, got %v", err)
+	}
+	if faults.Injected(dropboxtest.FaultReset) != 1 || faults.Injected(dropboxtest.FaultServerError) != 1 {
+		t.Error("expected both faults to be injected")
+	}
+}
+
+func TestRandomFaults(t *testing.T) {
+	run := func() []bool {
+		srv, faults, dbx := faultyClient(t, 42)
+		defer srv.Close()
+		faults.Add(dropboxtest.FaultRule{
+			Route:       "files/*",
+			Probability: 0.5,
+			Fault:       dropboxtest.Fault{Kind: dropboxtest.FaultEndpointError, Tag: "path/not_found"},
+		})
+		faults.Add(dropboxtest.FaultRule{
+			Route:       "users/*",
+			Probability: 1,
+			Fault:       dropboxtest.Fault{Kind: dropboxtest.FaultServerError},
+		})
+		var failed []bool
+		for i := 0; i < 20; i++ {
+			_, err := dbx.GetMetadata(files.NewGetMetadataArg("/a.txt"))
+			failed = append(failed, err != nil)
+		}
+		if faults.Injected(dropboxtest.FaultServerError) != 0 {
+			t.Error("expected rule of users namespace not to apply")
+		}
+		return failed
+	}
+
+	first, second := run(), run()
+	var n int
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatal("expected faults to be reproducible with the same seed")
+		}
+		if first[i] {
+			n++
+		}
+	}
+	if n == 0 || n == len(first) {
+		t.Errorf("expected some calls to fail, got %d of %d", n, len(first))
+	}
+}
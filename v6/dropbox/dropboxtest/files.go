@@ -0,0 +1,954 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+const (
+	defaultListLimit       = 2000
+	defaultRevisionsLimit  = 10
+	defaultSearchLimit     = 100
+	defaultLongpollTimeout = 30
+)
+
+// node is a file or folder. Deleted nodes are kept for their revisions.
+type node struct {
+	id      string
+	path    string
+	folder  bool
+	deleted bool
+	revs    []*revision
+}
+
+type revision struct {
+	rev            string
+	content        []byte
+	clientModified time.Time
+	serverModified time.Time
+}
+
+// change records that the node at a lower case path changed.
+type change struct {
+	seq  uint64
+	path string
+}
+
+// listCursor is the state behind a `list_folder` cursor. Cursors are never
+// modified, so that listing can be resumed from any of them.
+type listCursor struct {
+	path           string
+	recursive      bool
+	includeDeleted bool
+	limit          int
+	seq            uint64
+	pending        []string
+}
+
+type searchCursor struct {
+	limit   int
+	pending []*files.SearchMatchV2
+}
+
+type session struct {
+	concurrent bool
+	closed     bool
+	data       []byte
+	// Chunks of a concurrent session by offset. Chunks may arrive in any
+	// order, so a closed concurrent session accepts chunks before end.
+	chunks map[uint64][]byte
+	end    uint64
+}
+
+func (n *node) latest() *revision {
+	return n.revs[len(n.revs)-1]
+}
+
+func (n *node) fileMetadata(r *revision) *files.FileMetadata {
+	m := files.NewFileMetadata(path.Base(n.path), n.id, r.clientModified, r.serverModified, r.rev, uint64(len(r.content)))
+	m.PathLower = strings.ToLower(n.path)
+	m.PathDisplay = n.path
+	m.IsDownloadable = true
+	m.ContentHash = dropbox.ContentHashString(r.content)
+	return m
+}
+
+func (n *node) metadata() files.IsMetadata {
+	switch {
+	case n.deleted:
+		m := files.NewDeletedMetadata(path.Base(n.path))
+		m.PathLower = strings.ToLower(n.path)
+		m.PathDisplay = n.path
+		return m
+	case n.folder:
+		m := files.NewFolderMetadata(path.Base(n.path), n.id)
+		m.PathLower = strings.ToLower(n.path)
+		m.PathDisplay = n.path
+		return m
+	}
+	return n.fileMetadata(n.latest())
+}
+
+// cleanPath validates an absolute path and strips its trailing slash.
+func cleanPath(p string) (string, bool) {
+	p = strings.TrimSuffix(p, "/")
+	if !strings.HasPrefix(p, "/") || strings.Contains(p, "//") || path.Clean(p) != p {
+		return "", false
+	}
+	return p, true
+}
+
+// inFolder reports whether the lower case path p is inside folder, or a
+// direct child of it unless recursive is set.
+func inFolder(p, folder string, recursive bool) bool {
+	if !strings.HasPrefix(p, folder+"/") {
+		return false
+	}
+	return recursive || !strings.Contains(p[len(folder)+1:], "/")
+}
+
+func (s *Server) now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+func (s *Server) nextID() uint64 {
+	s.counter++
+	return s.counter
+}
+
+func (s *Server) newRevision(content []byte, clientModified *time.Time) *revision {
+	r := &revision{
+		rev:            fmt.Sprintf("%09x", s.nextID()),
+		content:        append([]byte{}, content...),
+		serverModified: s.now(),
+	}
+	r.clientModified = r.serverModified
+	if clientModified != nil {
+		r.clientModified = *clientModified
+	}
+	return r
+}
+
+// record notes a change of the node at the lower case path p and wakes up
+// pending long polls.
+func (s *Server) record(p string) {
+	s.seq++
+	s.changes = append(s.changes, change{s.seq, p})
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// lookup returns the live node at a path or ID.
+func (s *Server) lookup(p string) (*node, *files.LookupError) {
+	if strings.HasPrefix(p, "id:") {
+		for _, n := range s.nodes {
+			if n.id == p && !n.deleted {
+				return n, nil
+			}
+		}
+		return nil, lookupError(files.LookupErrorNotFound)
+	}
+
+	p, ok := cleanPath(p)
+	if !ok {
+		return nil, lookupError(files.LookupErrorMalformedPath)
+	}
+	n := s.nodes[strings.ToLower(p)]
+	if n == nil || n.deleted {
+		return nil, lookupError(files.LookupErrorNotFound)
+	}
+	return n, nil
+}
+
+// lookupRevision returns the file and revision at a path, ID or `rev:` path.
+func (s *Server) lookupRevision(p string) (*node, *revision, *files.LookupError) {
+	if strings.HasPrefix(p, "rev:") {
+		for _, n := range s.nodes {
+			for _, r := range n.revs {
+				if "rev:"+r.rev == p {
+					return n, r, nil
+				}
+			}
+		}
+		return nil, nil, lookupError(files.LookupErrorNotFound)
+	}
+
+	n, err := s.lookup(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n.folder {
+		return nil, nil, lookupError(files.LookupErrorNotFile)
+	}
+	return n, n.latest(), nil
+}
+
+// makeParents creates the missing parent folders of p.
+func (s *Server) makeParents(p string) *files.WriteError {
+	parent := path.Dir(p)
+	if parent == "/" {
+		return nil
+	}
+	if n := s.nodes[strings.ToLower(parent)]; n != nil && !n.deleted {
+		if !n.folder {
+			return conflictError(files.WriteConflictErrorFileAncestor)
+		}
+		return nil
+	}
+	if err := s.makeParents(parent); err != nil {
+		return err
+	}
+	s.put(parent, true, nil)
+	return nil
+}
+
+// put creates or revives the node at p. A file gets r as its new revision.
+func (s *Server) put(p string, folder bool, r *revision) *node {
+	key := strings.ToLower(p)
+	n := s.nodes[key]
+	if n == nil || (n.deleted && n.folder != folder) {
+		n = &node{id: fmt.Sprintf("id:dropboxtest%010d", s.nextID())}
+		s.nodes[key] = n
+	}
+	n.path = p
+	n.folder = folder
+	n.deleted = false
+	if r != nil {
+		n.revs = append(n.revs, r)
+	}
+	s.record(key)
+	return n
+}
+
+// available returns p, or if autorename is set and p is taken, the first
+// free path of the form "name (1).ext". ok is false if p is taken.
+func (s *Server) available(p string, autorename bool) (string, bool) {
+	if n := s.nodes[strings.ToLower(p)]; n == nil || n.deleted {
+		return p, true
+	}
+	if !autorename {
+		return p, false
+	}
+	ext := path.Ext(p)
+	base := strings.TrimSuffix(p, ext)
+	for i := 1; ; i++ {
+		q := fmt.Sprintf("%s (%d)%s", base, i, ext)
+		if n := s.nodes[strings.ToLower(q)]; n == nil || n.deleted {
+			return q, true
+		}
+	}
+}
+
+// writeFile commits content as described by commit.
+func (s *Server) writeFile(commit *files.CommitInfo, content []byte) (*files.FileMetadata, *files.WriteError) {
+	p, ok := cleanPath(commit.Path)
+	if !ok {
+		return nil, writeTagError(files.WriteErrorMalformedPath)
+	}
+	if err := s.makeParents(p); err != nil {
+		return nil, err
+	}
+
+	if n := s.nodes[strings.ToLower(p)]; n != nil && !n.deleted {
+		conflict := files.WriteConflictErrorFile
+		switch {
+		case n.folder:
+			conflict = files.WriteConflictErrorFolder
+		case commit.Mode != nil && commit.Mode.Tag == files.WriteModeOverwrite:
+			conflict = ""
+		case commit.Mode != nil && commit.Mode.Tag == files.WriteModeUpdate:
+			if n.latest().rev == commit.Mode.Update {
+				conflict = ""
+			}
+		default:
+			if string(n.latest().content) == string(content) {
+				// Adding identical content is a no-op.
+				return n.fileMetadata(n.latest()), nil
+			}
+		}
+		if conflict != "" {
+			if p, ok = s.available(p, commit.Autorename); !ok {
+				return nil, conflictError(conflict)
+			}
+		}
+	}
+
+	n := s.put(p, false, s.newRevision(content, commit.ClientModified))
+	return n.fileMetadata(n.latest()), nil
+}
+
+// tree returns the lower case paths of n and all live nodes below it.
+func (s *Server) tree(n *node) []string {
+	key := strings.ToLower(n.path)
+	paths := []string{key}
+	if n.folder {
+		for p, c := range s.nodes {
+			if !c.deleted && inFolder(p, key, true) {
+				paths = append(paths, p)
+			}
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// WriteFile stores content at p, replacing any existing file, and creates the
+// parent folders as needed. It is a shortcut for seeding the server in tests.
+func (s *Server) WriteFile(p string, content []byte) (*files.FileMetadata, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	commit := files.NewCommitInfo(p)
+	commit.Mode.Tag = files.WriteModeOverwrite
+	m, err := s.writeFile(commit, content)
+	if err != nil {
+		return nil, routeError{err}
+	}
+	return m, nil
+}
+
+// ReadFile returns the content of the file at p.
+func (s *Server) ReadFile(p string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, r, err := s.lookupRevision(p)
+	if err != nil {
+		return nil, routeError{err}
+	}
+	return append([]byte{}, r.content...), nil
+}
+
+func (s *Server) upload(c *call) (interface{}, error) {
+	var arg files.UploadArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	if arg.ContentHash != "" && arg.ContentHash != dropbox.ContentHashString(c.content) {
+		return nil, routeError{&files.UploadError{Tagged: dropbox.Tagged{Tag: files.UploadErrorContentHashMismatch}}}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.writeFile(&arg.CommitInfo, c.content)
+	if err != nil {
+		return nil, routeError{&files.UploadError{
+			Tagged: dropbox.Tagged{Tag: files.UploadErrorPath},
+			Path:   files.NewUploadWriteFailed(err, ""),
+		}}
+	}
+	return m, nil
+}
+
+func (s *Server) newSession(concurrent bool) string {
+	id := fmt.Sprintf("session:%d", s.nextID())
+	s.sessions[id] = &session{concurrent: concurrent, chunks: map[uint64][]byte{}}
+	return id
+}
+
+func (s *Server) uploadSessionStart(c *call) (interface{}, error) {
+	var arg files.UploadSessionStartArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	startError := func(tag string) error {
+		return routeError{&files.UploadSessionStartError{Tagged: dropbox.Tagged{Tag: tag}}}
+	}
+	if arg.ContentHash != "" && arg.ContentHash != dropbox.ContentHashString(c.content) {
+		return nil, startError(files.UploadSessionStartErrorContentHashMismatch)
+	}
+	concurrent := arg.SessionType != nil && arg.SessionType.Tag == files.UploadSessionTypeConcurrent
+	if concurrent && len(c.content) > 0 {
+		return nil, startError(files.UploadSessionStartErrorConcurrentSessionDataNotAllowed)
+	}
+	if concurrent && arg.Close {
+		return nil, startError(files.UploadSessionStartErrorConcurrentSessionCloseNotAllowed)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.newSession(concurrent)
+	s.sessions[id].data = append([]byte{}, c.content...)
+	s.sessions[id].closed = arg.Close
+	return files.NewUploadSessionStartResult(id), nil
+}
+
+func (s *Server) uploadSessionStartBatch(c *call) (interface{}, error) {
+	var arg files.UploadSessionStartBatchArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	concurrent := arg.SessionType != nil && arg.SessionType.Tag == files.UploadSessionTypeConcurrent
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for i := uint64(0); i < arg.NumSessions; i++ {
+		ids = append(ids, s.newSession(concurrent))
+	}
+	return files.NewUploadSessionStartBatchResult(ids), nil
+}
+
+func (s *Server) uploadSessionAppend(c *call) (interface{}, error) {
+	var arg files.UploadSessionAppendArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	appendError := func(tag string, correct uint64) error {
+		err := &files.UploadSessionAppendError{Tagged: dropbox.Tagged{Tag: tag}}
+		if tag == files.UploadSessionAppendErrorIncorrectOffset {
+			err.IncorrectOffset = files.NewUploadSessionOffsetError(correct)
+		}
+		return routeError{err}
+	}
+	if arg.ContentHash != "" && arg.ContentHash != dropbox.ContentHashString(c.content) {
+		return nil, appendError(files.UploadSessionAppendErrorContentHashMismatch, 0)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess := s.sessions[arg.Cursor.SessionId]
+	switch {
+	case sess == nil:
+		return nil, appendError(files.UploadSessionAppendErrorNotFound, 0)
+	case sess.closed && (!sess.concurrent || arg.Cursor.Offset >= sess.end):
+		return nil, appendError(files.UploadSessionAppendErrorClosed, 0)
+	case sess.concurrent:
+		if len(c.content) > 0 {
+			sess.chunks[arg.Cursor.Offset] = append([]byte{}, c.content...)
+		}
+		if arg.Close {
+			sess.end = arg.Cursor.Offset + uint64(len(c.content))
+		}
+	case arg.Cursor.Offset != uint64(len(sess.data)):
+		return nil, appendError(files.UploadSessionAppendErrorIncorrectOffset, uint64(len(sess.data)))
+	default:
+		sess.data = append(sess.data, c.content...)
+	}
+	sess.closed = sess.closed || arg.Close
+	return nil, nil
+}
+
+func (s *Server) uploadSessionFinish(c *call) (interface{}, error) {
+	var arg files.UploadSessionFinishArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	finishError := func(tag string) *files.UploadSessionFinishError {
+		return &files.UploadSessionFinishError{Tagged: dropbox.Tagged{Tag: tag}}
+	}
+	lookupFailed := func(tag string, correct uint64) error {
+		err := finishError(files.UploadSessionFinishErrorLookupFailed)
+		err.LookupFailed = &files.UploadSessionLookupError{Tagged: dropbox.Tagged{Tag: tag}}
+		if tag == files.UploadSessionLookupErrorIncorrectOffset {
+			err.LookupFailed.IncorrectOffset = files.NewUploadSessionOffsetError(correct)
+		}
+		return routeError{err}
+	}
+	if arg.ContentHash != "" && arg.ContentHash != dropbox.ContentHashString(c.content) {
+		return nil, routeError{finishError(files.UploadSessionFinishErrorContentHashMismatch)}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess := s.sessions[arg.Cursor.SessionId]
+	if sess == nil {
+		return nil, lookupFailed(files.UploadSessionLookupErrorNotFound, 0)
+	}
+
+	data := sess.data
+	if sess.concurrent {
+		if len(c.content) > 0 {
+			return nil, routeError{finishError(files.UploadSessionFinishErrorConcurrentSessionDataNotAllowed)}
+		}
+		if !sess.closed {
+			return nil, routeError{finishError(files.UploadSessionFinishErrorConcurrentSessionNotClosed)}
+		}
+		var offsets []uint64
+		for offset := range sess.chunks {
+			offsets = append(offsets, offset)
+		}
+		sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+		data = nil
+		for _, offset := range offsets {
+			if offset != uint64(len(data)) {
+				return nil, routeError{finishError(files.UploadSessionFinishErrorConcurrentSessionMissingData)}
+			}
+			data = append(data, sess.chunks[offset]...)
+		}
+	}
+	if arg.Cursor.Offset != uint64(len(data)) {
+		return nil, lookupFailed(files.UploadSessionLookupErrorIncorrectOffset, uint64(len(data)))
+	}
+	data = append(data, c.content...)
+
+	m, err := s.writeFile(arg.Commit, data)
+	if err != nil {
+		fErr := finishError(files.UploadSessionFinishErrorPath)
+		fErr.Path = err
+		return nil, routeError{fErr}
+	}
+	delete(s.sessions, arg.Cursor.SessionId)
+	return m, nil
+}
+
+func (s *Server) download(c *call) (interface{}, error) {
+	var arg files.DownloadArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, r, err := s.lookupRevision(arg.Path)
+	if err != nil {
+		return nil, routeError{&files.DownloadError{Tagged: dropbox.Tagged{Tag: files.DownloadErrorPath}, Path: err}}
+	}
+	return &download{res: n.fileMetadata(r), content: r.content}, nil
+}
+
+func (s *Server) getMetadata(c *call) (interface{}, error) {
+	var arg files.GetMetadataArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, err := s.lookup(arg.Path)
+	if err != nil && arg.IncludeDeleted && err.Tag == files.LookupErrorNotFound {
+		if p, ok := cleanPath(arg.Path); ok && s.nodes[strings.ToLower(p)] != nil {
+			n, err = s.nodes[strings.ToLower(p)], nil
+		}
+	}
+	if err != nil {
+		return nil, routeError{&files.GetMetadataError{Tagged: dropbox.Tagged{Tag: files.GetMetadataErrorPath}, Path: err}}
+	}
+	return n.metadata(), nil
+}
+
+func (s *Server) createFolder(c *call) (interface{}, error) {
+	var arg files.CreateFolderArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	folderError := func(err *files.WriteError) error {
+		return routeError{&files.CreateFolderError{Tagged: dropbox.Tagged{Tag: files.CreateFolderErrorPath}, Path: err}}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := cleanPath(arg.Path)
+	if !ok {
+		return nil, folderError(writeTagError(files.WriteErrorMalformedPath))
+	}
+	if err := s.makeParents(p); err != nil {
+		return nil, folderError(err)
+	}
+	if n := s.nodes[strings.ToLower(p)]; n != nil && !n.deleted {
+		conflict := files.WriteConflictErrorFile
+		if n.folder {
+			conflict = files.WriteConflictErrorFolder
+		}
+		if p, ok = s.available(p, arg.Autorename); !ok {
+			return nil, folderError(conflictError(conflict))
+		}
+	}
+
+	n := s.put(p, true, nil)
+	return files.NewCreateFolderResult(n.metadata().(*files.FolderMetadata)), nil
+}
+
+func (s *Server) relocate(c *call, move bool) (interface{}, error) {
+	var arg files.RelocationArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	relocationError := func(tag string) *files.RelocationError {
+		return &files.RelocationError{Tagged: dropbox.Tagged{Tag: tag}}
+	}
+	toError := func(err *files.WriteError) error {
+		rErr := relocationError(files.RelocationErrorTo)
+		rErr.To = err
+		return routeError{rErr}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	src, lErr := s.lookup(arg.FromPath)
+	if lErr != nil {
+		rErr := relocationError(files.RelocationErrorFromLookup)
+		rErr.FromLookup = lErr
+		return nil, routeError{rErr}
+	}
+	dst, ok := cleanPath(arg.ToPath)
+	if !ok {
+		return nil, toError(writeTagError(files.WriteErrorMalformedPath))
+	}
+	srcKey := strings.ToLower(src.path)
+	if src.folder && inFolder(strings.ToLower(dst), srcKey, true) {
+		return nil, routeError{relocationError(files.RelocationErrorCantMoveFolderIntoItself)}
+	}
+	if n := s.nodes[strings.ToLower(dst)]; n != nil && !n.deleted && !(move && n == src) {
+		conflict := files.WriteConflictErrorFile
+		if n.folder {
+			conflict = files.WriteConflictErrorFolder
+		}
+		if dst, ok = s.available(dst, arg.Autorename); !ok {
+			return nil, toError(conflictError(conflict))
+		}
+	}
+	if err := s.makeParents(dst); err != nil {
+		return nil, toError(err)
+	}
+
+	srcPath := src.path
+	var root *node
+	for _, p := range s.tree(src) {
+		old := s.nodes[p]
+		newPath := dst + old.path[len(srcPath):]
+		var n *node
+		if old.folder {
+			n = s.put(newPath, true, nil)
+		} else {
+			r := old.latest()
+			if !move {
+				r = s.newRevision(r.content, &r.clientModified)
+			}
+			n = s.put(newPath, false, r)
+		}
+		if move && n != old {
+			n.id = old.id
+			n.revs = append([]*revision{}, old.revs...)
+			old.deleted = true
+			s.record(p)
+		}
+		if root == nil {
+			root = n
+		}
+	}
+	return files.NewRelocationResult(root.metadata()), nil
+}
+
+func (s *Server) move(c *call) (interface{}, error) {
+	return s.relocate(c, true)
+}
+
+func (s *Server) copy(c *call) (interface{}, error) {
+	return s.relocate(c, false)
+}
+
+func (s *Server) delete(c *call) (interface{}, error) {
+	var arg files.DeleteArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, err := s.lookup(arg.Path)
+	if err != nil {
+		return nil, routeError{&files.DeleteError{Tagged: dropbox.Tagged{Tag: files.DeleteErrorPathLookup}, PathLookup: err}}
+	}
+	if arg.ParentRev != "" && (n.folder || n.latest().rev != arg.ParentRev) {
+		return nil, routeError{&files.DeleteError{
+			Tagged:    dropbox.Tagged{Tag: files.DeleteErrorPathWrite},
+			PathWrite: conflictError(files.WriteConflictErrorFile),
+		}}
+	}
+
+	res := files.NewDeleteResult(n.metadata())
+	paths := s.tree(n)
+	for i := len(paths) - 1; i >= 0; i-- {
+		s.nodes[paths[i]].deleted = true
+		s.record(paths[i])
+	}
+	return res, nil
+}
+
+func (s *Server) listFolder(c *call) (interface{}, error) {
+	var arg files.ListFolderArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var folder string
+	if arg.Path != "" {
+		n, err := s.lookup(arg.Path)
+		if err == nil && !n.folder {
+			err = lookupError(files.LookupErrorNotFolder)
+		}
+		if err != nil {
+			return nil, routeError{&files.ListFolderError{Tagged: dropbox.Tagged{Tag: files.ListFolderErrorPath}, Path: err}}
+		}
+		folder = strings.ToLower(n.path)
+	}
+
+	cur := &listCursor{
+		path:           folder,
+		recursive:      arg.Recursive,
+		includeDeleted: arg.IncludeDeleted,
+		limit:          int(arg.Limit),
+		seq:            s.seq,
+	}
+	for p, n := range s.nodes {
+		if inFolder(p, folder, cur.recursive) && (!n.deleted || cur.includeDeleted) {
+			cur.pending = append(cur.pending, p)
+		}
+	}
+	sort.Strings(cur.pending)
+	return s.listPage(cur), nil
+}
+
+// listPage returns the next page of cur, along with a new cursor for the
+// rest.
+func (s *Server) listPage(cur *listCursor) *files.ListFolderResult {
+	limit := cur.limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > len(cur.pending) {
+		limit = len(cur.pending)
+	}
+
+	entries := []files.IsMetadata{}
+	for _, p := range cur.pending[:limit] {
+		entries = append(entries, s.nodes[p].metadata())
+	}
+
+	next := *cur
+	next.pending = cur.pending[limit:]
+	id := fmt.Sprintf("cursor:%d", s.nextID())
+	s.cursors[id] = &next
+	return files.NewListFolderResult(entries, id, len(next.pending) > 0)
+}
+
+// changedSince returns the lower case paths that changed within the folder
+// of cur after it was issued.
+func (s *Server) changedSince(cur *listCursor) []string {
+	var paths []string
+	seen := map[string]bool{}
+	for _, ch := range s.changes {
+		if ch.seq > cur.seq && !seen[ch.path] && inFolder(ch.path, cur.path, cur.recursive) {
+			seen[ch.path] = true
+			paths = append(paths, ch.path)
+		}
+	}
+	return paths
+}
+
+func (s *Server) listFolderContinue(c *call) (interface{}, error) {
+	var arg files.ListFolderContinueArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur := s.cursors[arg.Cursor]
+	if cur == nil {
+		return nil, routeError{&files.ListFolderContinueError{Tagged: dropbox.Tagged{Tag: files.ListFolderContinueErrorReset}}}
+	}
+	if len(cur.pending) == 0 {
+		next := *cur
+		next.seq = s.seq
+		next.pending = s.changedSince(cur)
+		cur = &next
+	}
+	return s.listPage(cur), nil
+}
+
+func (s *Server) listFolderLongpoll(c *call) (interface{}, error) {
+	var arg files.ListFolderLongpollArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	timeout := arg.Timeout
+	if timeout == 0 {
+		timeout = defaultLongpollTimeout
+	}
+	deadline := time.NewTimer(time.Duration(timeout) * time.Second)
+	defer deadline.Stop()
+
+	for {
+		s.mu.Lock()
+		cur := s.cursors[arg.Cursor]
+		changes := cur != nil && (len(cur.pending) > 0 || len(s.changedSince(cur)) > 0)
+		changed := s.changed
+		s.mu.Unlock()
+
+		if cur == nil {
+			return nil, routeError{&files.ListFolderLongpollError{Tagged: dropbox.Tagged{Tag: files.ListFolderLongpollErrorReset}}}
+		}
+		if changes {
+			return files.NewListFolderLongpollResult(true), nil
+		}
+
+		select {
+		case <-changed:
+		case <-deadline.C:
+			return files.NewListFolderLongpollResult(false), nil
+		case <-s.closed:
+			return files.NewListFolderLongpollResult(false), nil
+		case <-c.r.Context().Done():
+			return nil, c.r.Context().Err()
+		}
+	}
+}
+
+func (s *Server) listRevisions(c *call) (interface{}, error) {
+	var arg files.ListRevisionsArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	revisionsError := func(tag string) error {
+		return routeError{&files.ListRevisionsError{Tagged: dropbox.Tagged{Tag: files.ListRevisionsErrorPath}, Path: lookupError(tag)}}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, err := s.lookup(arg.Path)
+	if err != nil && err.Tag == files.LookupErrorNotFound {
+		// Deleted files still have revisions.
+		if p, ok := cleanPath(arg.Path); ok && s.nodes[strings.ToLower(p)] != nil {
+			n, err = s.nodes[strings.ToLower(p)], nil
+		}
+	}
+	if err != nil {
+		return nil, routeError{&files.ListRevisionsError{Tagged: dropbox.Tagged{Tag: files.ListRevisionsErrorPath}, Path: err}}
+	}
+	if n.folder {
+		return nil, revisionsError(files.LookupErrorNotFile)
+	}
+
+	limit := int(arg.Limit)
+	if limit <= 0 {
+		limit = defaultRevisionsLimit
+	}
+	var entries []*files.FileMetadata
+	for i := len(n.revs) - 1; i >= 0 && len(entries) < limit; i-- {
+		entries = append(entries, n.fileMetadata(n.revs[i]))
+	}
+	return files.NewListRevisionsResult(n.deleted, entries), nil
+}
+
+func (s *Server) search(c *call) (interface{}, error) {
+	var arg files.SearchV2Arg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+	terms := strings.Fields(strings.ToLower(arg.Query))
+	if len(terms) == 0 {
+		return nil, routeError{&files.SearchError{
+			Tagged:          dropbox.Tagged{Tag: files.SearchErrorInvalidArgument},
+			InvalidArgument: "query is empty",
+		}}
+	}
+	opts := arg.Options
+	if opts == nil {
+		opts = files.NewSearchOptions()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var folder string
+	if opts.Path != "" {
+		n, err := s.lookup(opts.Path)
+		if err == nil && !n.folder {
+			err = lookupError(files.LookupErrorNotFolder)
+		}
+		if err != nil {
+			return nil, routeError{&files.SearchError{Tagged: dropbox.Tagged{Tag: files.SearchErrorPath}, Path: err}}
+		}
+		folder = strings.ToLower(n.path)
+	}
+	deleted := opts.FileStatus != nil && opts.FileStatus.Tag == files.FileStatusDeleted
+
+	var paths []string
+	for p, n := range s.nodes {
+		if n.deleted != deleted || !inFolder(p, folder, true) {
+			continue
+		}
+		name := path.Base(p)
+		match := true
+		for _, term := range terms {
+			match = match && strings.Contains(name, term)
+		}
+		if match {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	cur := &searchCursor{limit: int(opts.MaxResults)}
+	for _, p := range paths {
+		cur.pending = append(cur.pending, files.NewSearchMatchV2(&files.MetadataV2{
+			Tagged:   dropbox.Tagged{Tag: files.MetadataV2Metadata},
+			Metadata: s.nodes[p].metadata(),
+		}))
+	}
+	return s.searchPage(cur), nil
+}
+
+func (s *Server) searchPage(cur *searchCursor) *files.SearchV2Result {
+	limit := cur.limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > len(cur.pending) {
+		limit = len(cur.pending)
+	}
+
+	res := files.NewSearchV2Result(cur.pending[:limit], limit < len(cur.pending))
+	if res.Matches == nil {
+		res.Matches = []*files.SearchMatchV2{}
+	}
+	if res.HasMore {
+		res.Cursor = fmt.Sprintf("search:%d", s.nextID())
+		s.searches[res.Cursor] = &searchCursor{limit: cur.limit, pending: cur.pending[limit:]}
+	}
+	return res
+}
+
+func (s *Server) searchContinue(c *call) (interface{}, error) {
+	var arg files.SearchV2ContinueArg
+	if err := c.decode(&arg); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur := s.searches[arg.Cursor]
+	if cur == nil {
+		return nil, badRequest("invalid cursor")
+	}
+	return s.searchPage(cur), nil
+}
@@ -0,0 +1,282 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dropboxtest provides an in-memory fake of the Dropbox API for
+// hermetic tests of code using the SDK.
+//
+// A Server implements the core routes of the `files` namespace: uploads and
+// upload sessions, downloads including byte ranges, `list_folder` with its
+// `continue` and `longpoll` routes, `get_metadata`, `create_folder_v2`,
+// `move_v2`, `copy_v2`, `delete_v2`, `list_revisions` and `search_v2`.
+// Failures are reported with the same status codes and error payloads as the
+// Dropbox API, e.g. `path/not_found` or `path/conflict`, so they surface as the
+// usual typed errors of the SDK:
+//
+//	srv := dropboxtest.NewServer()
+//	defer srv.Close()
+//
+//	dbx := files.New(srv.Config())
+//	_, err := dbx.GetMetadata(files.NewGetMetadataArg("/missing"))
+//	// err is a files.GetMetadataAPIError with a `not_found` LookupError
+//
+// Calls to the actual API can be recorded to a file with a Recorder, and
+// replayed with a Replayer in later runs, without network access. A
+// FaultInjector fails calls made through it with rate limits, server errors,
+// endpoint errors, truncated downloads, delays or connection resets.
+package dropboxtest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// Server is an in-memory fake of the Dropbox API, listening on a local
+// HTTP server. Its state is shared by all clients created from Config.
+type Server struct {
+	// URL of the server, e.g. http://127.0.0.1:1234
+	URL string
+
+	ts        *httptest.Server
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	mu       sync.Mutex
+	nodes    map[string]*node
+	counter  uint64
+	seq      uint64
+	changes  []change
+	changed  chan struct{}
+	cursors  map[string]*listCursor
+	searches map[string]*searchCursor
+	sessions map[string]*session
+}
+
+// NewServer starts and returns a new Server with an empty Dropbox. The caller
+// should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		closed:   make(chan struct{}),
+		nodes:    map[string]*node{},
+		changed:  make(chan struct{}),
+		cursors:  map[string]*listCursor{},
+		searches: map[string]*searchCursor{},
+		sessions: map[string]*session{},
+	}
+	s.ts = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.ts.URL
+	return s
+}
+
+// Close shuts down the server, aborting pending long polls. Closing it again
+// has no effect.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() { close(s.closed) })
+	s.ts.Close()
+}
+
+// Config returns a Config for clients of s. Requests are routed to s through
+// its URLGenerator and Client, regardless of their host.
+func (s *Server) Config() dropbox.Config {
+	return dropbox.Config{
+		Token:  "dropboxtest",
+		Client: s.ts.Client(),
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return fmt.Sprintf("%s/2/%s/%s", s.URL, namespace, route)
+		},
+	}
+}
+
+// call holds the input of a route.
+type call struct {
+	r       *http.Request
+	arg     []byte
+	content []byte
+}
+
+// decode unmarshals the argument of the call into v.
+func (c *call) decode(v interface{}) error {
+	if err := json.Unmarshal(c.arg, v); err != nil {
+		return badRequest(fmt.Sprintf("could not decode input as JSON: %v", err))
+	}
+	return nil
+}
+
+// download is the result of a download style route.
+type download struct {
+	res     interface{}
+	content []byte
+}
+
+// routeError is an endpoint specific error, sent with status 409.
+type routeError struct {
+	err interface{}
+}
+
+func (e routeError) Error() string {
+	b, _ := json.Marshal(e.err)
+	return errorSummary(b)
+}
+
+// badRequest is an invalid request, sent with status 400.
+type badRequest string
+
+func (e badRequest) Error() string {
+	return string(e)
+}
+
+type route struct {
+	style  string
+	handle func(s *Server, c *call) (interface{}, error)
+}
+
+var routes = map[string]route{
+	"files/copy_v2":                    {"rpc", (*Server).copy},
+	"files/create_folder_v2":           {"rpc", (*Server).createFolder},
+	"files/delete_v2":                  {"rpc", (*Server).delete},
+	"files/download":                   {"download", (*Server).download},
+	"files/get_metadata":               {"rpc", (*Server).getMetadata},
+	"files/list_folder":                {"rpc", (*Server).listFolder},
+	"files/list_folder/continue":       {"rpc", (*Server).listFolderContinue},
+	"files/list_folder/longpoll":       {"rpc", (*Server).listFolderLongpoll},
+	"files/list_revisions":             {"rpc", (*Server).listRevisions},
+	"files/move_v2":                    {"rpc", (*Server).move},
+	"files/search_v2":                  {"rpc", (*Server).search},
+	"files/search/continue_v2":         {"rpc", (*Server).searchContinue},
+	"files/upload":                     {"upload", (*Server).upload},
+	"files/upload_session/append_v2":   {"upload", (*Server).uploadSessionAppend},
+	"files/upload_session/finish":      {"upload", (*Server).uploadSessionFinish},
+	"files/upload_session/start":       {"upload", (*Server).uploadSessionStart},
+	"files/upload_session/start_batch": {"rpc", (*Server).uploadSessionStartBatch},
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Dropbox-Request-Id", requestID())
+	name := strings.TrimPrefix(r.URL.Path, "/2/")
+	rt, ok := routes[name]
+	if !ok || r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("Unknown API function: %q", name), http.StatusNotFound)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	c := &call{r: r, arg: body}
+	if rt.style != "rpc" {
+		c.arg = []byte(r.Header.Get("Dropbox-API-Arg"))
+		c.content = body
+	}
+
+	res, err := rt.handle(s, c)
+	if err != nil {
+		writeError(w, name, err)
+		return
+	}
+
+	if d, ok := res.(*download); ok {
+		b, err := json.Marshal(d.res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Dropbox-API-Result", dropbox.HTTPHeaderSafeJSON(b))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(d.content))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+func requestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func writeError(w http.ResponseWriter, name string, err error) {
+	switch e := err.(type) {
+	case routeError:
+		b, mErr := json.Marshal(e.err)
+		if mErr != nil {
+			http.Error(w, mErr.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(struct {
+			ErrorSummary string          `json:"error_summary"`
+			Error        json.RawMessage `json:"error"`
+		}{errorSummary(b), b})
+	case badRequest:
+		http.Error(w, fmt.Sprintf("Error in call to API function %q: %s", name, e), http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// errorSummary joins the tags of a serialized error, like the
+// `error_summary` of the Dropbox API, e.g. `path/not_found/..`.
+func errorSummary(b []byte) string {
+	var tags []string
+	for {
+		var v map[string]json.RawMessage
+		if json.Unmarshal(b, &v) != nil {
+			break
+		}
+		var tag string
+		if json.Unmarshal(v[".tag"], &tag) != nil || tag == "" {
+			break
+		}
+		tags = append(tags, tag)
+		b = v[tag]
+	}
+	return strings.Join(tags, "/") + "/.."
+}
+
+func lookupError(tag string) *files.LookupError {
+	return &files.LookupError{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+func writeTagError(tag string) *files.WriteError {
+	return &files.WriteError{Tagged: dropbox.Tagged{Tag: tag}}
+}
+
+func conflictError(tag string) *files.WriteError {
+	return &files.WriteError{
+		Tagged:   dropbox.Tagged{Tag: files.WriteErrorConflict},
+		Conflict: &files.WriteConflictError{Tagged: dropbox.Tagged{Tag: tag}},
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropboxtest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxtest"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// writerAt is an in-memory io.WriterAt.
+type writerAt []byte
+
+func (w writerAt) WriteAt(p []byte, off int64) (int, error) {
+	return copy(w[off:], p), nil
+}
+
+func TestUploadDownload(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	dbx := files.New(srv.Config())
+	ctx := context.Background()
+
+	content := make([]byte, 9<<20+123)
+	rand.New(rand.NewSource(1)).Read(content)
+
+	u := files.NewUploader(dbx)
+	u.ChunkSize = 4 << 20
+	// Concurrent upload session.
+	if _, err := u.Upload(ctx, bytes.NewReader(content), files.NewCommitInfo("/a.bin")); err != nil {
+		t.Fatal(err)
+	}
+	// Sequential upload session.
+	meta, err := u.Upload(ctx, ioutil.NopCloser(bytes.NewReader(content)), files.NewCommitInfo("/Dir/B.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.PathDisplay != "/Dir/B.bin" || meta.Size != uint64(len(content)) {
+		t.Errorf("unexpected metadata %+v", meta)
+	}
+
+	for _, path := range []string{"/a.bin", "/dir/b.bin", meta.Id} {
+		d := files.NewDownloader(dbx)
+		d.RangeSize = 1 << 20
+		got := make(writerAt, len(content))
+		if _, err := d.Download(ctx, path, got); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, content) {
+			t.Errorf("%s: downloaded content differs", path)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	dbx := files.New(srv.Config())
+
+	_, err := dbx.GetMetadata(files.NewGetMetadataArg("/missing"))
+	if e, ok := err.(files.GetMetadataAPIError); !ok || e.EndpointError.Path.Tag != files.LookupErrorNotFound {
+		t.Errorf("expected not_found, got %v", err)
+	}
+	if !errors.Is(err, files.ErrLookupNotFound) || errors.Is(err, files.ErrLookupNotFolder) {
+		t.Errorf("expected err to match only ErrLookupNotFound: %v", err)
+	}
+	var apiErr dropbox.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected dropbox.APIError, got %T", err)
+	}
+	if apiErr.ErrorSummary != "path/not_found/.." || apiErr.Route != "files/get_metadata" ||
+		apiErr.StatusCode != http.StatusConflict || apiErr.RequestID == "" {
+		t.Errorf("unexpected error %+v", apiErr)
+	}
+
+	if _, err = srv.WriteFile("/a.txt", []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	_, err = dbx.Upload(files.NewUploadArg("/a.txt"), bytes.NewReader([]byte("b")))
+	if e, ok := err.(files.UploadAPIError); !ok || e.EndpointError.Path.Reason.Conflict.Tag != files.WriteConflictErrorFile {
+		t.Errorf("expected path/conflict/file, got %v", err)
+	}
+
+	arg := files.NewUploadArg("/a.txt/b.txt")
+	_, err = dbx.Upload(arg, bytes.NewReader([]byte("b")))
+	if e, ok := err.(files.UploadAPIError); !ok || e.EndpointError.Path.Reason.Conflict.Tag != files.WriteConflictErrorFileAncestor {
+		t.Errorf("expected path/conflict/file_ancestor, got %v", err)
+	}
+	if !errors.Is(fmt.Errorf("upload: %w", err), files.ErrWriteConflict) {
+		t.Errorf("expected wrapped err to match ErrWriteConflict: %v", err)
+	}
+
+	_, _, err = dbx.Download(files.NewDownloadArg("/missing"))
+	if e, ok := err.(files.DownloadAPIError); !ok || e.EndpointError.Path.Tag != files.LookupErrorNotFound {
+		t.Errorf("expected path/not_found, got %v", err)
+	}
+
+	_, err = dbx.MoveV2(files.NewRelocationArg("/missing", "/b.txt"))
+	if e, ok := err.(files.MoveV2APIError); !ok || e.EndpointError.FromLookup.Tag != files.LookupErrorNotFound {
+		t.Errorf("expected from_lookup/not_found, got %v", err)
+	}
+}
+
+func TestRelocate(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	dbx := files.New(srv.Config())
+
+	for _, p := range []string{"/src/a.txt", "/src/sub/b.txt"} {
+		if _, err := srv.WriteFile(p, []byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := dbx.CopyV2(files.NewRelocationArg("/src", "/copy")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbx.MoveV2(files.NewRelocationArg("/src", "/dst")); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"/copy/sub/b.txt", "/dst/sub/b.txt"} {
+		if b, err := srv.ReadFile(p); err != nil || string(b) != "/src/sub/b.txt" {
+			t.Errorf("%s: got %q, %v", p, b, err)
+		}
+	}
+	if _, err := srv.ReadFile("/src/a.txt"); err == nil {
+		t.Error("expected source to be moved")
+	}
+
+	arg := files.NewCommitInfo("/dst/a.txt")
+	arg.Autorename = true
+	meta, err := dbx.Upload(&files.UploadArg{CommitInfo: *arg}, bytes.NewReader([]byte("new")))
+	if err != nil || meta.PathDisplay != "/dst/a (1).txt" {
+		t.Errorf("expected autorename, got %v, %v", meta, err)
+	}
+
+	if _, err = dbx.DeleteV2(files.NewDeleteArg("/dst")); err != nil {
+		t.Fatal(err)
+	}
+	revs, err := dbx.ListRevisions(files.NewListRevisionsArg("/dst/a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !revs.IsDeleted || len(revs.Entries) != 1 {
+		t.Errorf("unexpected revisions %+v", revs)
+	}
+}
+
+func TestListFolder(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	dbx := files.New(srv.Config())
+	ctx := context.Background()
+
+	for _, p := range []string{"/a.txt", "/b.txt", "/dir/c.txt"} {
+		if _, err := srv.WriteFile(p, []byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	arg := files.NewListFolderArg("")
+	arg.Recursive = true
+	arg.Limit = 2
+	it := files.NewListFolderIterator(ctx, dbx, arg)
+	var n int
+	for it.Next() {
+		n++
+	}
+	if it.Err() != nil || n != 4 {
+		t.Fatalf("expected 4 entries, got %d, %v", n, it.Err())
+	}
+	cursor := it.Cursor()
+
+	done := make(chan *files.ListFolderLongpollResult)
+	go func() {
+		res, err := dbx.ListFolderLongpoll(files.NewListFolderLongpollArg(cursor))
+		if err != nil {
+			t.Error(err)
+		}
+		done <- res
+	}()
+	time.Sleep(50 * time.Millisecond)
+	if _, err := dbx.DeleteV2(files.NewDeleteArg("/a.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if res := <-done; res == nil || !res.Changes {
+		t.Error("expected long poll to report changes")
+	}
+
+	res, err := dbx.ListFolderContinue(files.NewListFolderContinueArg(cursor))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Entries) != 1 {
+		t.Fatalf("expected 1 change, got %d", len(res.Entries))
+	}
+	if _, ok := res.Entries[0].(*files.DeletedMetadata); !ok {
+		t.Errorf("expected deleted entry, got %T", res.Entries[0])
+	}
+}
+
+func TestSearch(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	dbx := files.New(srv.Config())
+
+	for _, p := range []string{"/Report 2020.pdf", "/dir/report 2021.pdf", "/notes.txt"} {
+		if _, err := srv.WriteFile(p, []byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	arg := files.NewSearchV2Arg("REPORT pdf")
+	arg.Options = files.NewSearchOptions()
+	arg.Options.MaxResults = 1
+	it := files.NewSearchV2Iterator(context.Background(), dbx, arg)
+	var names []string
+	for it.Next() {
+		names = append(names, it.Entry().Metadata.Metadata.(*files.FileMetadata).Name)
+	}
+	if it.Err() != nil || len(names) != 2 {
+		t.Errorf("expected 2 matches, got %v, %v", names, it.Err())
+	}
+}
+
+func TestCloseTwice(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	srv.Close()
+	srv.Close()
+}
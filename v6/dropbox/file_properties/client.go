@@ -21,6 +21,7 @@
 package file_properties
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -32,26 +33,36 @@ import (
 type Client interface {
 	// PropertiesAdd : Add property groups to a Dropbox file. See
 	// `templatesAddForUser` or `templatesAddForTeam` to create new templates.
-	PropertiesAdd(arg *AddPropertiesArg) (err error)
+	PropertiesAdd(arg *AddPropertiesArg, opts ...dropbox.CallOption) (err error)
+	// PropertiesAddContext is like PropertiesAdd but takes a context for cancellation and deadlines.
+	PropertiesAddContext(ctx context.Context, arg *AddPropertiesArg, opts ...dropbox.CallOption) (err error)
 	// PropertiesOverwrite : Overwrite property groups associated with a file.
 	// This endpoint should be used instead of `propertiesUpdate` when property
 	// groups are being updated via a "snapshot" instead of via a "delta". In
 	// other words, this endpoint will delete all omitted fields from a property
 	// group, whereas `propertiesUpdate` will only delete fields that are
 	// explicitly marked for deletion.
-	PropertiesOverwrite(arg *OverwritePropertyGroupArg) (err error)
+	PropertiesOverwrite(arg *OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error)
+	// PropertiesOverwriteContext is like PropertiesOverwrite but takes a context for cancellation and deadlines.
+	PropertiesOverwriteContext(ctx context.Context, arg *OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error)
 	// PropertiesRemove : Permanently removes the specified property group from
 	// the file. To remove specific property field key value pairs, see
 	// `propertiesUpdate`. To update a template, see `templatesUpdateForUser` or
 	// `templatesUpdateForTeam`. To remove a template, see
 	// `templatesRemoveForUser` or `templatesRemoveForTeam`.
-	PropertiesRemove(arg *RemovePropertiesArg) (err error)
+	PropertiesRemove(arg *RemovePropertiesArg, opts ...dropbox.CallOption) (err error)
+	// PropertiesRemoveContext is like PropertiesRemove but takes a context for cancellation and deadlines.
+	PropertiesRemoveContext(ctx context.Context, arg *RemovePropertiesArg, opts ...dropbox.CallOption) (err error)
 	// PropertiesSearch : Search across property templates for particular
 	// property field values.
-	PropertiesSearch(arg *PropertiesSearchArg) (res *PropertiesSearchResult, err error)
+	PropertiesSearch(arg *PropertiesSearchArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error)
+	// PropertiesSearchContext is like PropertiesSearch but takes a context for cancellation and deadlines.
+	PropertiesSearchContext(ctx context.Context, arg *PropertiesSearchArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error)
 	// PropertiesSearchContinue : Once a cursor has been retrieved from
 	// `propertiesSearch`, use this to paginate through all search results.
-	PropertiesSearchContinue(arg *PropertiesSearchContinueArg) (res *PropertiesSearchResult, err error)
+	PropertiesSearchContinue(arg *PropertiesSearchContinueArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error)
+	// PropertiesSearchContinueContext is like PropertiesSearchContinue but takes a context for cancellation and deadlines.
+	PropertiesSearchContinueContext(ctx context.Context, arg *PropertiesSearchContinueArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error)
 	// PropertiesUpdate : Add, update or remove properties associated with the
 	// supplied file and templates. This endpoint should be used instead of
 	// `propertiesOverwrite` when property groups are being updated via a
@@ -59,44 +70,99 @@ type Client interface {
 	// not delete any omitted fields from a property group, whereas
 	// `propertiesOverwrite` will delete any fields that are omitted from a
 	// property group.
-	PropertiesUpdate(arg *UpdatePropertiesArg) (err error)
+	PropertiesUpdate(arg *UpdatePropertiesArg, opts ...dropbox.CallOption) (err error)
+	// PropertiesUpdateContext is like PropertiesUpdate but takes a context for cancellation and deadlines.
+	PropertiesUpdateContext(ctx context.Context, arg *UpdatePropertiesArg, opts ...dropbox.CallOption) (err error)
 	// TemplatesAddForTeam : Add a template associated with a team. See
 	// `propertiesAdd` to add properties to a file or folder. Note: this
 	// endpoint will create team-owned templates.
-	TemplatesAddForTeam(arg *AddTemplateArg) (res *AddTemplateResult, err error)
+	TemplatesAddForTeam(arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error)
+	// TemplatesAddForTeamContext is like TemplatesAddForTeam but takes a context for cancellation and deadlines.
+	TemplatesAddForTeamContext(ctx context.Context, arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error)
 	// TemplatesAddForUser : Add a template associated with a user. See
 	// `propertiesAdd` to add properties to a file. This endpoint can't be
 	// called on a team member or admin's behalf.
-	TemplatesAddForUser(arg *AddTemplateArg) (res *AddTemplateResult, err error)
+	TemplatesAddForUser(arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error)
+	// TemplatesAddForUserContext is like TemplatesAddForUser but takes a context for cancellation and deadlines.
+	TemplatesAddForUserContext(ctx context.Context, arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error)
 	// TemplatesGetForTeam : Get the schema for a specified template.
-	TemplatesGetForTeam(arg *GetTemplateArg) (res *GetTemplateResult, err error)
+	TemplatesGetForTeam(arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error)
+	// TemplatesGetForTeamContext is like TemplatesGetForTeam but takes a context for cancellation and deadlines.
+	TemplatesGetForTeamContext(ctx context.Context, arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error)
 	// TemplatesGetForUser : Get the schema for a specified template. This
 	// endpoint can't be called on a team member or admin's behalf.
-	TemplatesGetForUser(arg *GetTemplateArg) (res *GetTemplateResult, err error)
+	TemplatesGetForUser(arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error)
+	// TemplatesGetForUserContext is like TemplatesGetForUser but takes a context for cancellation and deadlines.
+	TemplatesGetForUserContext(ctx context.Context, arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error)
 	// TemplatesListForTeam : Get the template identifiers for a team. To get
 	// the schema of each template use `templatesGetForTeam`.
-	TemplatesListForTeam() (res *ListTemplateResult, err error)
+	TemplatesListForTeam(opts ...dropbox.CallOption) (res *ListTemplateResult, err error)
+	// TemplatesListForTeamContext is like TemplatesListForTeam but takes a context for cancellation and deadlines.
+	TemplatesListForTeamContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListTemplateResult, err error)
 	// TemplatesListForUser : Get the template identifiers for a team. To get
 	// the schema of each template use `templatesGetForUser`. This endpoint
 	// can't be called on a team member or admin's behalf.
-	TemplatesListForUser() (res *ListTemplateResult, err error)
+	TemplatesListForUser(opts ...dropbox.CallOption) (res *ListTemplateResult, err error)
+	// TemplatesListForUserContext is like TemplatesListForUser but takes a context for cancellation and deadlines.
+	TemplatesListForUserContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListTemplateResult, err error)
 	// TemplatesRemoveForTeam : Permanently removes the specified template
 	// created from `templatesAddForUser`. All properties associated with the
 	// template will also be removed. This action cannot be undone.
-	TemplatesRemoveForTeam(arg *RemoveTemplateArg) (err error)
+	TemplatesRemoveForTeam(arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error)
+	// TemplatesRemoveForTeamContext is like TemplatesRemoveForTeam but takes a context for cancellation and deadlines.
+	TemplatesRemoveForTeamContext(ctx context.Context, arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error)
 	// TemplatesRemoveForUser : Permanently removes the specified template
 	// created from `templatesAddForUser`. All properties associated with the
 	// template will also be removed. This action cannot be undone.
-	TemplatesRemoveForUser(arg *RemoveTemplateArg) (err error)
+	TemplatesRemoveForUser(arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error)
+	// TemplatesRemoveForUserContext is like TemplatesRemoveForUser but takes a context for cancellation and deadlines.
+	TemplatesRemoveForUserContext(ctx context.Context, arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error)
 	// TemplatesUpdateForTeam : Update a template associated with a team. This
 	// route can update the template name, the template description and add
 	// optional properties to templates.
-	TemplatesUpdateForTeam(arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error)
+	TemplatesUpdateForTeam(arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error)
+	// TemplatesUpdateForTeamContext is like TemplatesUpdateForTeam but takes a context for cancellation and deadlines.
+	TemplatesUpdateForTeamContext(ctx context.Context, arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error)
 	// TemplatesUpdateForUser : Update a template associated with a user. This
 	// route can update the template name, the template description and add
 	// optional properties to templates. This endpoint can't be called on a team
 	// member or admin's behalf.
-	TemplatesUpdateForUser(arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error)
+	TemplatesUpdateForUser(arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error)
+	// TemplatesUpdateForUserContext is like TemplatesUpdateForUser but takes a context for cancellation and deadlines.
+	TemplatesUpdateForUserContext(ctx context.Context, arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error)
+}
+
+// Sentinel errors for the tags of unions that are nested in the errors of
+// many routes. The errors of all routes match them with errors.Is if their
+// endpoint error contains the union with the tag.
+var (
+	ErrLookUpPropertiesPropertyGroupNotFound error = endpointErrorTag{"LookUpPropertiesError", LookUpPropertiesErrorPropertyGroupNotFound}
+	ErrLookupMalformedPath                   error = endpointErrorTag{"LookupError", LookupErrorMalformedPath}
+	ErrLookupNotFound                        error = endpointErrorTag{"LookupError", LookupErrorNotFound}
+	ErrLookupNotFile                         error = endpointErrorTag{"LookupError", LookupErrorNotFile}
+	ErrLookupNotFolder                       error = endpointErrorTag{"LookupError", LookupErrorNotFolder}
+	ErrLookupRestrictedContent               error = endpointErrorTag{"LookupError", LookupErrorRestrictedContent}
+)
+
+// endpointErrorTag matches the unions of a type with a tag.
+type endpointErrorTag struct {
+	union string
+	tag   string
+}
+
+func (e endpointErrorTag) Error() string {
+	return "file_properties: " + e.union + " " + e.tag
+}
+
+// MatchEndpointError implements dropbox.EndpointErrorMatcher.
+func (e endpointErrorTag) MatchEndpointError(endpointError interface{}) bool {
+	switch err := endpointError.(type) {
+	case *LookUpPropertiesError:
+		return e.union == "LookUpPropertiesError" && err.Tag == e.tag
+	case *LookupError:
+		return e.union == "LookupError" && err.Tag == e.tag
+	}
+	return false
 }
 
 type apiImpl dropbox.Context
@@ -107,7 +173,28 @@ type PropertiesAddAPIError struct {
 	EndpointError *AddPropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesAdd(arg *AddPropertiesArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesAddAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) PropertiesAdd(arg *AddPropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PropertiesAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesAddContext(ctx context.Context, arg *AddPropertiesArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -116,11 +203,12 @@ func (dbx *apiImpl) PropertiesAdd(arg *AddPropertiesArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -141,7 +229,28 @@ type PropertiesOverwriteAPIError struct {
 	EndpointError *InvalidPropertyGroupError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesOverwrite(arg *OverwritePropertyGroupArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesOverwriteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesOverwriteAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) PropertiesOverwrite(arg *OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PropertiesOverwriteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesOverwriteContext(ctx context.Context, arg *OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -150,11 +259,12 @@ func (dbx *apiImpl) PropertiesOverwrite(arg *OverwritePropertyGroupArg) (err err
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesOverwriteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -175,7 +285,29 @@ type PropertiesRemoveAPIError struct {
 	EndpointError *RemovePropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesRemove(arg *RemovePropertiesArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesRemoveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesRemoveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.PropertyGroupLookup != nil && m.MatchEndpointError(err.PropertyGroupLookup)
+}
+
+func (dbx *apiImpl) PropertiesRemove(arg *RemovePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PropertiesRemoveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesRemoveContext(ctx context.Context, arg *RemovePropertiesArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -184,11 +316,12 @@ func (dbx *apiImpl) PropertiesRemove(arg *RemovePropertiesArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesRemoveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -209,7 +342,28 @@ type PropertiesSearchAPIError struct {
 	EndpointError *PropertiesSearchError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesSearch(arg *PropertiesSearchArg) (res *PropertiesSearchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesSearchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesSearchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PropertyGroupLookup != nil && m.MatchEndpointError(err.PropertyGroupLookup)
+}
+
+func (dbx *apiImpl) PropertiesSearch(arg *PropertiesSearchArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error) {
+	return dbx.PropertiesSearchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesSearchContext(ctx context.Context, arg *PropertiesSearchArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -218,11 +372,12 @@ func (dbx *apiImpl) PropertiesSearch(arg *PropertiesSearchArg) (res *PropertiesS
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesSearchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -247,7 +402,26 @@ type PropertiesSearchContinueAPIError struct {
 	EndpointError *PropertiesSearchContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesSearchContinue(arg *PropertiesSearchContinueArg) (res *PropertiesSearchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesSearchContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesSearchContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) PropertiesSearchContinue(arg *PropertiesSearchContinueArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error) {
+	return dbx.PropertiesSearchContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesSearchContinueContext(ctx context.Context, arg *PropertiesSearchContinueArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -256,11 +430,12 @@ func (dbx *apiImpl) PropertiesSearchContinue(arg *PropertiesSearchContinueArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesSearchContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -285,7 +460,29 @@ type PropertiesUpdateAPIError struct {
 	EndpointError *UpdatePropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesUpdate(arg *UpdatePropertiesArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesUpdateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesUpdateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.PropertyGroupLookup != nil && m.MatchEndpointError(err.PropertyGroupLookup)
+}
+
+func (dbx *apiImpl) PropertiesUpdate(arg *UpdatePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PropertiesUpdateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesUpdateContext(ctx context.Context, arg *UpdatePropertiesArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -294,11 +491,12 @@ func (dbx *apiImpl) PropertiesUpdate(arg *UpdatePropertiesArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesUpdateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -319,7 +517,26 @@ type TemplatesAddForTeamAPIError struct {
 	EndpointError *ModifyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesAddForTeam(arg *AddTemplateArg) (res *AddTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesAddForTeamAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesAddForTeamAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesAddForTeam(arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error) {
+	return dbx.TemplatesAddForTeamContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TemplatesAddForTeamContext(ctx context.Context, arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -328,11 +545,12 @@ func (dbx *apiImpl) TemplatesAddForTeam(arg *AddTemplateArg) (res *AddTemplateRe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesAddForTeamAPIError
 		err = auth.ParseError(err, &appErr)
@@ -357,7 +575,26 @@ type TemplatesAddForUserAPIError struct {
 	EndpointError *ModifyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesAddForUser(arg *AddTemplateArg) (res *AddTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesAddForUserAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesAddForUserAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesAddForUser(arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error) {
+	return dbx.TemplatesAddForUserContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TemplatesAddForUserContext(ctx context.Context, arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -366,11 +603,12 @@ func (dbx *apiImpl) TemplatesAddForUser(arg *AddTemplateArg) (res *AddTemplateRe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesAddForUserAPIError
 		err = auth.ParseError(err, &appErr)
@@ -395,7 +633,26 @@ type TemplatesGetForTeamAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesGetForTeam(arg *GetTemplateArg) (res *GetTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesGetForTeamAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesGetForTeamAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesGetForTeam(arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error) {
+	return dbx.TemplatesGetForTeamContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TemplatesGetForTeamContext(ctx context.Context, arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -404,11 +661,12 @@ func (dbx *apiImpl) TemplatesGetForTeam(arg *GetTemplateArg) (res *GetTemplateRe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesGetForTeamAPIError
 		err = auth.ParseError(err, &appErr)
@@ -433,7 +691,26 @@ type TemplatesGetForUserAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesGetForUser(arg *GetTemplateArg) (res *GetTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesGetForUserAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesGetForUserAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesGetForUser(arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error) {
+	return dbx.TemplatesGetForUserContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TemplatesGetForUserContext(ctx context.Context, arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -442,11 +719,12 @@ func (dbx *apiImpl) TemplatesGetForUser(arg *GetTemplateArg) (res *GetTemplateRe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesGetForUserAPIError
 		err = auth.ParseError(err, &appErr)
@@ -471,7 +749,26 @@ type TemplatesListForTeamAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesListForTeam() (res *ListTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesListForTeamAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesListForTeamAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesListForTeam(opts ...dropbox.CallOption) (res *ListTemplateResult, err error) {
+	return dbx.TemplatesListForTeamContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) TemplatesListForTeamContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -480,11 +777,12 @@ func (dbx *apiImpl) TemplatesListForTeam() (res *ListTemplateResult, err error)
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesListForTeamAPIError
 		err = auth.ParseError(err, &appErr)
@@ -509,7 +807,26 @@ type TemplatesListForUserAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesListForUser() (res *ListTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesListForUserAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesListForUserAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesListForUser(opts ...dropbox.CallOption) (res *ListTemplateResult, err error) {
+	return dbx.TemplatesListForUserContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) TemplatesListForUserContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -518,11 +835,12 @@ func (dbx *apiImpl) TemplatesListForUser() (res *ListTemplateResult, err error)
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesListForUserAPIError
 		err = auth.ParseError(err, &appErr)
@@ -547,7 +865,26 @@ type TemplatesRemoveForTeamAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesRemoveForTeam(arg *RemoveTemplateArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesRemoveForTeamAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesRemoveForTeamAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesRemoveForTeam(arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.TemplatesRemoveForTeamContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TemplatesRemoveForTeamContext(ctx context.Context, arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -556,11 +893,12 @@ func (dbx *apiImpl) TemplatesRemoveForTeam(arg *RemoveTemplateArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesRemoveForTeamAPIError
 		err = auth.ParseError(err, &appErr)
@@ -581,7 +919,26 @@ type TemplatesRemoveForUserAPIError struct {
 	EndpointError *TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesRemoveForUser(arg *RemoveTemplateArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesRemoveForUserAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesRemoveForUserAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesRemoveForUser(arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.TemplatesRemoveForUserContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TemplatesRemoveForUserContext(ctx context.Context, arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -590,11 +947,12 @@ func (dbx *apiImpl) TemplatesRemoveForUser(arg *RemoveTemplateArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesRemoveForUserAPIError
 		err = auth.ParseError(err, &appErr)
@@ -615,7 +973,26 @@ type TemplatesUpdateForTeamAPIError struct {
 	EndpointError *ModifyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesUpdateForTeam(arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesUpdateForTeamAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesUpdateForTeamAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesUpdateForTeam(arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error) {
+	return dbx.TemplatesUpdateForTeamContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TemplatesUpdateForTeamContext(ctx context.Context, arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -624,11 +1001,12 @@ func (dbx *apiImpl) TemplatesUpdateForTeam(arg *UpdateTemplateArg) (res *UpdateT
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesUpdateForTeamAPIError
 		err = auth.ParseError(err, &appErr)
@@ -653,7 +1031,26 @@ type TemplatesUpdateForUserAPIError struct {
 	EndpointError *ModifyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) TemplatesUpdateForUser(arg *UpdateTemplateArg) (res *UpdateTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TemplatesUpdateForUserAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TemplatesUpdateForUserAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TemplatesUpdateForUser(arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error) {
+	return dbx.TemplatesUpdateForUserContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TemplatesUpdateForUserContext(ctx context.Context, arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_properties",
@@ -662,11 +1059,12 @@ func (dbx *apiImpl) TemplatesUpdateForUser(arg *UpdateTemplateArg) (res *UpdateT
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TemplatesUpdateForUserAPIError
 		err = auth.ParseError(err, &appErr)
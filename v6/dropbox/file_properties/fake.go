@@ -0,0 +1,293 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package file_properties
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	PropertiesAddFunc            func(ctx context.Context, arg *AddPropertiesArg, opts ...dropbox.CallOption) (err error)
+	PropertiesOverwriteFunc      func(ctx context.Context, arg *OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error)
+	PropertiesRemoveFunc         func(ctx context.Context, arg *RemovePropertiesArg, opts ...dropbox.CallOption) (err error)
+	PropertiesSearchFunc         func(ctx context.Context, arg *PropertiesSearchArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error)
+	PropertiesSearchContinueFunc func(ctx context.Context, arg *PropertiesSearchContinueArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error)
+	PropertiesUpdateFunc         func(ctx context.Context, arg *UpdatePropertiesArg, opts ...dropbox.CallOption) (err error)
+	TemplatesAddForTeamFunc      func(ctx context.Context, arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error)
+	TemplatesAddForUserFunc      func(ctx context.Context, arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error)
+	TemplatesGetForTeamFunc      func(ctx context.Context, arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error)
+	TemplatesGetForUserFunc      func(ctx context.Context, arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error)
+	TemplatesListForTeamFunc     func(ctx context.Context, opts ...dropbox.CallOption) (res *ListTemplateResult, err error)
+	TemplatesListForUserFunc     func(ctx context.Context, opts ...dropbox.CallOption) (res *ListTemplateResult, err error)
+	TemplatesRemoveForTeamFunc   func(ctx context.Context, arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error)
+	TemplatesRemoveForUserFunc   func(ctx context.Context, arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error)
+	TemplatesUpdateForTeamFunc   func(ctx context.Context, arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error)
+	TemplatesUpdateForUserFunc   func(ctx context.Context, arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// PropertiesAdd implements Client.
+func (f *Fake) PropertiesAdd(arg *AddPropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return f.PropertiesAddContext(context.Background(), arg, opts...)
+}
+
+// PropertiesAddContext implements Client.
+func (f *Fake) PropertiesAddContext(ctx context.Context, arg *AddPropertiesArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PropertiesAdd", opts, arg)
+	if f.PropertiesAddFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "PropertiesAdd")
+		return
+	}
+	return f.PropertiesAddFunc(ctx, arg, opts...)
+}
+
+// PropertiesOverwrite implements Client.
+func (f *Fake) PropertiesOverwrite(arg *OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error) {
+	return f.PropertiesOverwriteContext(context.Background(), arg, opts...)
+}
+
+// PropertiesOverwriteContext implements Client.
+func (f *Fake) PropertiesOverwriteContext(ctx context.Context, arg *OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PropertiesOverwrite", opts, arg)
+	if f.PropertiesOverwriteFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "PropertiesOverwrite")
+		return
+	}
+	return f.PropertiesOverwriteFunc(ctx, arg, opts...)
+}
+
+// PropertiesRemove implements Client.
+func (f *Fake) PropertiesRemove(arg *RemovePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return f.PropertiesRemoveContext(context.Background(), arg, opts...)
+}
+
+// PropertiesRemoveContext implements Client.
+func (f *Fake) PropertiesRemoveContext(ctx context.Context, arg *RemovePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PropertiesRemove", opts, arg)
+	if f.PropertiesRemoveFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "PropertiesRemove")
+		return
+	}
+	return f.PropertiesRemoveFunc(ctx, arg, opts...)
+}
+
+// PropertiesSearch implements Client.
+func (f *Fake) PropertiesSearch(arg *PropertiesSearchArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error) {
+	return f.PropertiesSearchContext(context.Background(), arg, opts...)
+}
+
+// PropertiesSearchContext implements Client.
+func (f *Fake) PropertiesSearchContext(ctx context.Context, arg *PropertiesSearchArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error) {
+	f.Record("PropertiesSearch", opts, arg)
+	if f.PropertiesSearchFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "PropertiesSearch")
+		return
+	}
+	return f.PropertiesSearchFunc(ctx, arg, opts...)
+}
+
+// PropertiesSearchContinue implements Client.
+func (f *Fake) PropertiesSearchContinue(arg *PropertiesSearchContinueArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error) {
+	return f.PropertiesSearchContinueContext(context.Background(), arg, opts...)
+}
+
+// PropertiesSearchContinueContext implements Client.
+func (f *Fake) PropertiesSearchContinueContext(ctx context.Context, arg *PropertiesSearchContinueArg, opts ...dropbox.CallOption) (res *PropertiesSearchResult, err error) {
+	f.Record("PropertiesSearchContinue", opts, arg)
+	if f.PropertiesSearchContinueFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "PropertiesSearchContinue")
+		return
+	}
+	return f.PropertiesSearchContinueFunc(ctx, arg, opts...)
+}
+
+// PropertiesUpdate implements Client.
+func (f *Fake) PropertiesUpdate(arg *UpdatePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return f.PropertiesUpdateContext(context.Background(), arg, opts...)
+}
+
+// PropertiesUpdateContext implements Client.
+func (f *Fake) PropertiesUpdateContext(ctx context.Context, arg *UpdatePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PropertiesUpdate", opts, arg)
+	if f.PropertiesUpdateFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "PropertiesUpdate")
+		return
+	}
+	return f.PropertiesUpdateFunc(ctx, arg, opts...)
+}
+
+// TemplatesAddForTeam implements Client.
+func (f *Fake) TemplatesAddForTeam(arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error) {
+	return f.TemplatesAddForTeamContext(context.Background(), arg, opts...)
+}
+
+// TemplatesAddForTeamContext implements Client.
+func (f *Fake) TemplatesAddForTeamContext(ctx context.Context, arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error) {
+	f.Record("TemplatesAddForTeam", opts, arg)
+	if f.TemplatesAddForTeamFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesAddForTeam")
+		return
+	}
+	return f.TemplatesAddForTeamFunc(ctx, arg, opts...)
+}
+
+// TemplatesAddForUser implements Client.
+func (f *Fake) TemplatesAddForUser(arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error) {
+	return f.TemplatesAddForUserContext(context.Background(), arg, opts...)
+}
+
+// TemplatesAddForUserContext implements Client.
+func (f *Fake) TemplatesAddForUserContext(ctx context.Context, arg *AddTemplateArg, opts ...dropbox.CallOption) (res *AddTemplateResult, err error) {
+	f.Record("TemplatesAddForUser", opts, arg)
+	if f.TemplatesAddForUserFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesAddForUser")
+		return
+	}
+	return f.TemplatesAddForUserFunc(ctx, arg, opts...)
+}
+
+// TemplatesGetForTeam implements Client.
+func (f *Fake) TemplatesGetForTeam(arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error) {
+	return f.TemplatesGetForTeamContext(context.Background(), arg, opts...)
+}
+
+// TemplatesGetForTeamContext implements Client.
+func (f *Fake) TemplatesGetForTeamContext(ctx context.Context, arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error) {
+	f.Record("TemplatesGetForTeam", opts, arg)
+	if f.TemplatesGetForTeamFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesGetForTeam")
+		return
+	}
+	return f.TemplatesGetForTeamFunc(ctx, arg, opts...)
+}
+
+// TemplatesGetForUser implements Client.
+func (f *Fake) TemplatesGetForUser(arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error) {
+	return f.TemplatesGetForUserContext(context.Background(), arg, opts...)
+}
+
+// TemplatesGetForUserContext implements Client.
+func (f *Fake) TemplatesGetForUserContext(ctx context.Context, arg *GetTemplateArg, opts ...dropbox.CallOption) (res *GetTemplateResult, err error) {
+	f.Record("TemplatesGetForUser", opts, arg)
+	if f.TemplatesGetForUserFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesGetForUser")
+		return
+	}
+	return f.TemplatesGetForUserFunc(ctx, arg, opts...)
+}
+
+// TemplatesListForTeam implements Client.
+func (f *Fake) TemplatesListForTeam(opts ...dropbox.CallOption) (res *ListTemplateResult, err error) {
+	return f.TemplatesListForTeamContext(context.Background(), opts...)
+}
+
+// TemplatesListForTeamContext implements Client.
+func (f *Fake) TemplatesListForTeamContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListTemplateResult, err error) {
+	f.Record("TemplatesListForTeam", opts)
+	if f.TemplatesListForTeamFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesListForTeam")
+		return
+	}
+	return f.TemplatesListForTeamFunc(ctx, opts...)
+}
+
+// TemplatesListForUser implements Client.
+func (f *Fake) TemplatesListForUser(opts ...dropbox.CallOption) (res *ListTemplateResult, err error) {
+	return f.TemplatesListForUserContext(context.Background(), opts...)
+}
+
+// TemplatesListForUserContext implements Client.
+func (f *Fake) TemplatesListForUserContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListTemplateResult, err error) {
+	f.Record("TemplatesListForUser", opts)
+	if f.TemplatesListForUserFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesListForUser")
+		return
+	}
+	return f.TemplatesListForUserFunc(ctx, opts...)
+}
+
+// TemplatesRemoveForTeam implements Client.
+func (f *Fake) TemplatesRemoveForTeam(arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error) {
+	return f.TemplatesRemoveForTeamContext(context.Background(), arg, opts...)
+}
+
+// TemplatesRemoveForTeamContext implements Client.
+func (f *Fake) TemplatesRemoveForTeamContext(ctx context.Context, arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("TemplatesRemoveForTeam", opts, arg)
+	if f.TemplatesRemoveForTeamFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesRemoveForTeam")
+		return
+	}
+	return f.TemplatesRemoveForTeamFunc(ctx, arg, opts...)
+}
+
+// TemplatesRemoveForUser implements Client.
+func (f *Fake) TemplatesRemoveForUser(arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error) {
+	return f.TemplatesRemoveForUserContext(context.Background(), arg, opts...)
+}
+
+// TemplatesRemoveForUserContext implements Client.
+func (f *Fake) TemplatesRemoveForUserContext(ctx context.Context, arg *RemoveTemplateArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("TemplatesRemoveForUser", opts, arg)
+	if f.TemplatesRemoveForUserFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesRemoveForUser")
+		return
+	}
+	return f.TemplatesRemoveForUserFunc(ctx, arg, opts...)
+}
+
+// TemplatesUpdateForTeam implements Client.
+func (f *Fake) TemplatesUpdateForTeam(arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error) {
+	return f.TemplatesUpdateForTeamContext(context.Background(), arg, opts...)
+}
+
+// TemplatesUpdateForTeamContext implements Client.
+func (f *Fake) TemplatesUpdateForTeamContext(ctx context.Context, arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error) {
+	f.Record("TemplatesUpdateForTeam", opts, arg)
+	if f.TemplatesUpdateForTeamFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesUpdateForTeam")
+		return
+	}
+	return f.TemplatesUpdateForTeamFunc(ctx, arg, opts...)
+}
+
+// TemplatesUpdateForUser implements Client.
+func (f *Fake) TemplatesUpdateForUser(arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error) {
+	return f.TemplatesUpdateForUserContext(context.Background(), arg, opts...)
+}
+
+// TemplatesUpdateForUserContext implements Client.
+func (f *Fake) TemplatesUpdateForUserContext(ctx context.Context, arg *UpdateTemplateArg, opts ...dropbox.CallOption) (res *UpdateTemplateResult, err error) {
+	f.Record("TemplatesUpdateForUser", opts, arg)
+	if f.TemplatesUpdateForUserFunc == nil {
+		err = dropbox.NotImplemented("file_properties", "TemplatesUpdateForUser")
+		return
+	}
+	return f.TemplatesUpdateForUserFunc(ctx, arg, opts...)
+}
@@ -103,6 +103,18 @@ func (u *TemplateError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TemplateError instance
+func (u *TemplateError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "template_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateNotFound string `json:"template_not_found"`
+		}{u.Tagged, u.TemplateNotFound})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PropertiesError : has no documentation (yet)
 type PropertiesError struct {
 	dropbox.Tagged
@@ -147,6 +159,23 @@ func (u *PropertiesError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PropertiesError instance
+func (u *PropertiesError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "template_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateNotFound string `json:"template_not_found"`
+		}{u.Tagged, u.TemplateNotFound})
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // InvalidPropertyGroupError : has no documentation (yet)
 type InvalidPropertyGroupError struct {
 	dropbox.Tagged
@@ -194,6 +223,23 @@ func (u *InvalidPropertyGroupError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a InvalidPropertyGroupError instance
+func (u *InvalidPropertyGroupError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "template_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateNotFound string `json:"template_not_found"`
+		}{u.Tagged, u.TemplateNotFound})
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AddPropertiesError : has no documentation (yet)
 type AddPropertiesError struct {
 	dropbox.Tagged
@@ -242,6 +288,23 @@ func (u *AddPropertiesError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AddPropertiesError instance
+func (u *AddPropertiesError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "template_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateNotFound string `json:"template_not_found"`
+		}{u.Tagged, u.TemplateNotFound})
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PropertyGroupTemplate : Defines how a property group may be structured.
 type PropertyGroupTemplate struct {
 	// Name : Display name for the template. Template names can be up to 256
@@ -394,6 +457,18 @@ func (u *LookupError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LookupError instance
+func (u *LookupError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "malformed_path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MalformedPath string `json:"malformed_path"`
+		}{u.Tagged, u.MalformedPath})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ModifyTemplateError : has no documentation (yet)
 type ModifyTemplateError struct {
 	dropbox.Tagged
@@ -433,6 +508,18 @@ func (u *ModifyTemplateError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ModifyTemplateError instance
+func (u *ModifyTemplateError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "template_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateNotFound string `json:"template_not_found"`
+		}{u.Tagged, u.TemplateNotFound})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // OverwritePropertyGroupArg : has no documentation (yet)
 type OverwritePropertyGroupArg struct {
 	// Path : A unique identifier for the file or folder.
@@ -526,6 +613,18 @@ func (u *PropertiesSearchError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PropertiesSearchError instance
+func (u *PropertiesSearchError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "property_group_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertyGroupLookup *LookUpPropertiesError `json:"property_group_lookup"`
+		}{u.Tagged, u.PropertyGroupLookup})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PropertiesSearchMatch : has no documentation (yet)
 type PropertiesSearchMatch struct {
 	// Id : The ID for the matched file or folder.
@@ -582,6 +681,18 @@ func (u *PropertiesSearchMode) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PropertiesSearchMode instance
+func (u *PropertiesSearchMode) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "field_name":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FieldName string `json:"field_name"`
+		}{u.Tagged, u.FieldName})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PropertiesSearchQuery : has no documentation (yet)
 type PropertiesSearchQuery struct {
 	// Query : The property field value for which to search across templates.
@@ -779,6 +890,28 @@ func (u *RemovePropertiesError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RemovePropertiesError instance
+func (u *RemovePropertiesError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "template_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateNotFound string `json:"template_not_found"`
+		}{u.Tagged, u.TemplateNotFound})
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	case "property_group_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertyGroupLookup *LookUpPropertiesError `json:"property_group_lookup"`
+		}{u.Tagged, u.PropertyGroupLookup})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RemoveTemplateArg : has no documentation (yet)
 type RemoveTemplateArg struct {
 	// TemplateId : An identifier for a template created by
@@ -829,6 +962,18 @@ func (u *TemplateFilterBase) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TemplateFilterBase instance
+func (u *TemplateFilterBase) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "filter_some":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FilterSome []string `json:"filter_some"`
+		}{u.Tagged, u.FilterSome})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TemplateFilter : has no documentation (yet)
 type TemplateFilter struct {
 	dropbox.Tagged
@@ -866,6 +1011,18 @@ func (u *TemplateFilter) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TemplateFilter instance
+func (u *TemplateFilter) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "filter_some":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FilterSome []string `json:"filter_some"`
+		}{u.Tagged, u.FilterSome})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TemplateOwnerType : has no documentation (yet)
 type TemplateOwnerType struct {
 	dropbox.Tagged
@@ -949,6 +1106,28 @@ func (u *UpdatePropertiesError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UpdatePropertiesError instance
+func (u *UpdatePropertiesError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "template_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateNotFound string `json:"template_not_found"`
+		}{u.Tagged, u.TemplateNotFound})
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	case "property_group_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertyGroupLookup *LookUpPropertiesError `json:"property_group_lookup"`
+		}{u.Tagged, u.PropertyGroupLookup})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UpdateTemplateArg : has no documentation (yet)
 type UpdateTemplateArg struct {
 	// TemplateId : An identifier for template added by  See
@@ -21,6 +21,7 @@
 package file_requests
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -32,29 +33,47 @@ import (
 type Client interface {
 	// Count : Returns the total number of file requests owned by this user.
 	// Includes both open and closed file requests.
-	Count() (res *CountFileRequestsResult, err error)
+	Count(opts ...dropbox.CallOption) (res *CountFileRequestsResult, err error)
+	// CountContext is like Count but takes a context for cancellation and deadlines.
+	CountContext(ctx context.Context, opts ...dropbox.CallOption) (res *CountFileRequestsResult, err error)
 	// Create : Creates a file request for this user.
-	Create(arg *CreateFileRequestArgs) (res *FileRequest, err error)
+	Create(arg *CreateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
+	// CreateContext is like Create but takes a context for cancellation and deadlines.
+	CreateContext(ctx context.Context, arg *CreateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
 	// Delete : Delete a batch of closed file requests.
-	Delete(arg *DeleteFileRequestArgs) (res *DeleteFileRequestsResult, err error)
+	Delete(arg *DeleteFileRequestArgs, opts ...dropbox.CallOption) (res *DeleteFileRequestsResult, err error)
+	// DeleteContext is like Delete but takes a context for cancellation and deadlines.
+	DeleteContext(ctx context.Context, arg *DeleteFileRequestArgs, opts ...dropbox.CallOption) (res *DeleteFileRequestsResult, err error)
 	// DeleteAllClosed : Delete all closed file requests owned by this user.
-	DeleteAllClosed() (res *DeleteAllClosedFileRequestsResult, err error)
+	DeleteAllClosed(opts ...dropbox.CallOption) (res *DeleteAllClosedFileRequestsResult, err error)
+	// DeleteAllClosedContext is like DeleteAllClosed but takes a context for cancellation and deadlines.
+	DeleteAllClosedContext(ctx context.Context, opts ...dropbox.CallOption) (res *DeleteAllClosedFileRequestsResult, err error)
 	// Get : Returns the specified file request.
-	Get(arg *GetFileRequestArgs) (res *FileRequest, err error)
+	Get(arg *GetFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
+	// GetContext is like Get but takes a context for cancellation and deadlines.
+	GetContext(ctx context.Context, arg *GetFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
 	// List : Returns a list of file requests owned by this user. For apps with
 	// the app folder permission, this will only return file requests with
 	// destinations in the app folder.
-	ListV2(arg *ListFileRequestsArg) (res *ListFileRequestsV2Result, err error)
+	ListV2(arg *ListFileRequestsArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error)
+	// ListV2Context is like ListV2 but takes a context for cancellation and deadlines.
+	ListV2Context(ctx context.Context, arg *ListFileRequestsArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error)
 	// List : Returns a list of file requests owned by this user. For apps with
 	// the app folder permission, this will only return file requests with
 	// destinations in the app folder.
-	List() (res *ListFileRequestsResult, err error)
+	List(opts ...dropbox.CallOption) (res *ListFileRequestsResult, err error)
+	// ListContext is like List but takes a context for cancellation and deadlines.
+	ListContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListFileRequestsResult, err error)
 	// ListContinue : Once a cursor has been retrieved from `list`, use this to
 	// paginate through all file requests. The cursor must come from a previous
 	// call to `list` or `listContinue`.
-	ListContinue(arg *ListFileRequestsContinueArg) (res *ListFileRequestsV2Result, err error)
+	ListContinue(arg *ListFileRequestsContinueArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error)
+	// ListContinueContext is like ListContinue but takes a context for cancellation and deadlines.
+	ListContinueContext(ctx context.Context, arg *ListFileRequestsContinueArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error)
 	// Update : Update a file request.
-	Update(arg *UpdateFileRequestArgs) (res *FileRequest, err error)
+	Update(arg *UpdateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
+	// UpdateContext is like Update but takes a context for cancellation and deadlines.
+	UpdateContext(ctx context.Context, arg *UpdateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
 }
 
 type apiImpl dropbox.Context
@@ -65,7 +84,26 @@ type CountAPIError struct {
 	EndpointError *CountFileRequestsError `json:"error"`
 }
 
-func (dbx *apiImpl) Count() (res *CountFileRequestsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CountAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CountAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) Count(opts ...dropbox.CallOption) (res *CountFileRequestsResult, err error) {
+	return dbx.CountContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) CountContext(ctx context.Context, opts ...dropbox.CallOption) (res *CountFileRequestsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -74,11 +112,12 @@ func (dbx *apiImpl) Count() (res *CountFileRequestsResult, err error) {
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CountAPIError
 		err = auth.ParseError(err, &appErr)
@@ -103,7 +142,26 @@ type CreateAPIError struct {
 	EndpointError *CreateFileRequestError `json:"error"`
 }
 
-func (dbx *apiImpl) Create(arg *CreateFileRequestArgs) (res *FileRequest, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CreateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CreateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) Create(arg *CreateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	return dbx.CreateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CreateContext(ctx context.Context, arg *CreateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -112,11 +170,12 @@ func (dbx *apiImpl) Create(arg *CreateFileRequestArgs) (res *FileRequest, err er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CreateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -141,7 +200,26 @@ type DeleteAPIError struct {
 	EndpointError *DeleteFileRequestError `json:"error"`
 }
 
-func (dbx *apiImpl) Delete(arg *DeleteFileRequestArgs) (res *DeleteFileRequestsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DeleteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DeleteAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) Delete(arg *DeleteFileRequestArgs, opts ...dropbox.CallOption) (res *DeleteFileRequestsResult, err error) {
+	return dbx.DeleteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DeleteContext(ctx context.Context, arg *DeleteFileRequestArgs, opts ...dropbox.CallOption) (res *DeleteFileRequestsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -150,11 +228,12 @@ func (dbx *apiImpl) Delete(arg *DeleteFileRequestArgs) (res *DeleteFileRequestsR
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DeleteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -179,7 +258,26 @@ type DeleteAllClosedAPIError struct {
 	EndpointError *DeleteAllClosedFileRequestsError `json:"error"`
 }
 
-func (dbx *apiImpl) DeleteAllClosed() (res *DeleteAllClosedFileRequestsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DeleteAllClosedAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DeleteAllClosedAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DeleteAllClosed(opts ...dropbox.CallOption) (res *DeleteAllClosedFileRequestsResult, err error) {
+	return dbx.DeleteAllClosedContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) DeleteAllClosedContext(ctx context.Context, opts ...dropbox.CallOption) (res *DeleteAllClosedFileRequestsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -188,11 +286,12 @@ func (dbx *apiImpl) DeleteAllClosed() (res *DeleteAllClosedFileRequestsResult, e
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DeleteAllClosedAPIError
 		err = auth.ParseError(err, &appErr)
@@ -217,7 +316,26 @@ type GetAPIError struct {
 	EndpointError *GetFileRequestError `json:"error"`
 }
 
-func (dbx *apiImpl) Get(arg *GetFileRequestArgs) (res *FileRequest, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) Get(arg *GetFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	return dbx.GetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetContext(ctx context.Context, arg *GetFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -226,11 +344,12 @@ func (dbx *apiImpl) Get(arg *GetFileRequestArgs) (res *FileRequest, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -255,7 +374,26 @@ type ListV2APIError struct {
 	EndpointError *ListFileRequestsError `json:"error"`
 }
 
-func (dbx *apiImpl) ListV2(arg *ListFileRequestsArg) (res *ListFileRequestsV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ListV2(arg *ListFileRequestsArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error) {
+	return dbx.ListV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListV2Context(ctx context.Context, arg *ListFileRequestsArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -264,11 +402,12 @@ func (dbx *apiImpl) ListV2(arg *ListFileRequestsArg) (res *ListFileRequestsV2Res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -293,7 +432,26 @@ type ListAPIError struct {
 	EndpointError *ListFileRequestsError `json:"error"`
 }
 
-func (dbx *apiImpl) List() (res *ListFileRequestsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) List(opts ...dropbox.CallOption) (res *ListFileRequestsResult, err error) {
+	return dbx.ListContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) ListContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListFileRequestsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -302,11 +460,12 @@ func (dbx *apiImpl) List() (res *ListFileRequestsResult, err error) {
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -331,7 +490,26 @@ type ListContinueAPIError struct {
 	EndpointError *ListFileRequestsContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListContinue(arg *ListFileRequestsContinueArg) (res *ListFileRequestsV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ListContinue(arg *ListFileRequestsContinueArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error) {
+	return dbx.ListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListContinueContext(ctx context.Context, arg *ListFileRequestsContinueArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -340,11 +518,12 @@ func (dbx *apiImpl) ListContinue(arg *ListFileRequestsContinueArg) (res *ListFil
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -369,7 +548,26 @@ type UpdateAPIError struct {
 	EndpointError *UpdateFileRequestError `json:"error"`
 }
 
-func (dbx *apiImpl) Update(arg *UpdateFileRequestArgs) (res *FileRequest, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UpdateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UpdateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) Update(arg *UpdateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	return dbx.UpdateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UpdateContext(ctx context.Context, arg *UpdateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "file_requests",
@@ -378,11 +576,12 @@ func (dbx *apiImpl) Update(arg *UpdateFileRequestArgs) (res *FileRequest, err er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UpdateAPIError
 		err = auth.ParseError(err, &appErr)
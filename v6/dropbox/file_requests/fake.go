@@ -0,0 +1,181 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package file_requests
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	CountFunc           func(ctx context.Context, opts ...dropbox.CallOption) (res *CountFileRequestsResult, err error)
+	CreateFunc          func(ctx context.Context, arg *CreateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
+	DeleteFunc          func(ctx context.Context, arg *DeleteFileRequestArgs, opts ...dropbox.CallOption) (res *DeleteFileRequestsResult, err error)
+	DeleteAllClosedFunc func(ctx context.Context, opts ...dropbox.CallOption) (res *DeleteAllClosedFileRequestsResult, err error)
+	GetFunc             func(ctx context.Context, arg *GetFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
+	ListV2Func          func(ctx context.Context, arg *ListFileRequestsArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error)
+	ListFunc            func(ctx context.Context, opts ...dropbox.CallOption) (res *ListFileRequestsResult, err error)
+	ListContinueFunc    func(ctx context.Context, arg *ListFileRequestsContinueArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error)
+	UpdateFunc          func(ctx context.Context, arg *UpdateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// Count implements Client.
+func (f *Fake) Count(opts ...dropbox.CallOption) (res *CountFileRequestsResult, err error) {
+	return f.CountContext(context.Background(), opts...)
+}
+
+// CountContext implements Client.
+func (f *Fake) CountContext(ctx context.Context, opts ...dropbox.CallOption) (res *CountFileRequestsResult, err error) {
+	f.Record("Count", opts)
+	if f.CountFunc == nil {
+		err = dropbox.NotImplemented("file_requests", "Count")
+		return
+	}
+	return f.CountFunc(ctx, opts...)
+}
+
+// Create implements Client.
+func (f *Fake) Create(arg *CreateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	return f.CreateContext(context.Background(), arg, opts...)
+}
+
+// CreateContext implements Client.
+func (f *Fake) CreateContext(ctx context.Context, arg *CreateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	f.Record("Create", opts, arg)
+	if f.CreateFunc == nil {
+		err = dropbox.NotImplemented("file_requests", "Create")
+		return
+	}
+	return f.CreateFunc(ctx, arg, opts...)
+}
+
+// Delete implements Client.
+func (f *Fake) Delete(arg *DeleteFileRequestArgs, opts ...dropbox.CallOption) (res *DeleteFileRequestsResult, err error) {
+	return f.DeleteContext(context.Background(), arg, opts...)
+}
+
+// DeleteContext implements Client.
+func (f *Fake) DeleteContext(ctx context.Context, arg *DeleteFileRequestArgs, opts ...dropbox.CallOption) (res *DeleteFileRequestsResult, err error) {
+	f.Record("Delete", opts, arg)
+	if f.DeleteFunc == nil {
+		err = dropbox.NotImplemented("file_requests", "Delete")
+		return
+	}
+	return f.DeleteFunc(ctx, arg, opts...)
+}
+
+// DeleteAllClosed implements Client.
+func (f *Fake) DeleteAllClosed(opts ...dropbox.CallOption) (res *DeleteAllClosedFileRequestsResult, err error) {
+	return f.DeleteAllClosedContext(context.Background(), opts...)
+}
+
+// DeleteAllClosedContext implements Client.
+func (f *Fake) DeleteAllClosedContext(ctx context.Context, opts ...dropbox.CallOption) (res *DeleteAllClosedFileRequestsResult, err error) {
+	f.Record("DeleteAllClosed", opts)
+	if f.DeleteAllClosedFunc == nil {
+		err = dropbox.NotImplemented("file_requests", "DeleteAllClosed")
+		return
+	}
+	return f.DeleteAllClosedFunc(ctx, opts...)
+}
+
+// Get implements Client.
+func (f *Fake) Get(arg *GetFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	return f.GetContext(context.Background(), arg, opts...)
+}
+
+// GetContext implements Client.
+func (f *Fake) GetContext(ctx context.Context, arg *GetFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	f.Record("Get", opts, arg)
+	if f.GetFunc == nil {
+		err = dropbox.NotImplemented("file_requests", "Get")
+		return
+	}
+	return f.GetFunc(ctx, arg, opts...)
+}
+
+// ListV2 implements Client.
+func (f *Fake) ListV2(arg *ListFileRequestsArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error) {
+	return f.ListV2Context(context.Background(), arg, opts...)
+}
+
+// ListV2Context implements Client.
+func (f *Fake) ListV2Context(ctx context.Context, arg *ListFileRequestsArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error) {
+	f.Record("ListV2", opts, arg)
+	if f.ListV2Func == nil {
+		err = dropbox.NotImplemented("file_requests", "ListV2")
+		return
+	}
+	return f.ListV2Func(ctx, arg, opts...)
+}
+
+// List implements Client.
+func (f *Fake) List(opts ...dropbox.CallOption) (res *ListFileRequestsResult, err error) {
+	return f.ListContext(context.Background(), opts...)
+}
+
+// ListContext implements Client.
+func (f *Fake) ListContext(ctx context.Context, opts ...dropbox.CallOption) (res *ListFileRequestsResult, err error) {
+	f.Record("List", opts)
+	if f.ListFunc == nil {
+		err = dropbox.NotImplemented("file_requests", "List")
+		return
+	}
+	return f.ListFunc(ctx, opts...)
+}
+
+// ListContinue implements Client.
+func (f *Fake) ListContinue(arg *ListFileRequestsContinueArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error) {
+	return f.ListContinueContext(context.Background(), arg, opts...)
+}
+
+// ListContinueContext implements Client.
+func (f *Fake) ListContinueContext(ctx context.Context, arg *ListFileRequestsContinueArg, opts ...dropbox.CallOption) (res *ListFileRequestsV2Result, err error) {
+	f.Record("ListContinue", opts, arg)
+	if f.ListContinueFunc == nil {
+		err = dropbox.NotImplemented("file_requests", "ListContinue")
+		return
+	}
+	return f.ListContinueFunc(ctx, arg, opts...)
+}
+
+// Update implements Client.
+func (f *Fake) Update(arg *UpdateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	return f.UpdateContext(context.Background(), arg, opts...)
+}
+
+// UpdateContext implements Client.
+func (f *Fake) UpdateContext(ctx context.Context, arg *UpdateFileRequestArgs, opts ...dropbox.CallOption) (res *FileRequest, err error) {
+	f.Record("Update", opts, arg)
+	if f.UpdateFunc == nil {
+		err = dropbox.NotImplemented("file_requests", "Update")
+		return
+	}
+	return f.UpdateFunc(ctx, arg, opts...)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package file_requests
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// ListV2Iterator iterates over the entries returned by `ListV2`, fetching
+// further pages with `ListContinue`.
+type ListV2Iterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListFileRequestsArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*FileRequest
+	index      int
+	entry      *FileRequest
+	err        error
+}
+
+// NewListV2Iterator returns an iterator over the entries of `ListV2` called
+// with arg. Every page is fetched with opts.
+func NewListV2Iterator(ctx context.Context, dbx Client, arg *ListFileRequestsArg, opts ...dropbox.CallOption) *ListV2Iterator {
+	return &ListV2Iterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeListV2Iterator returns an iterator continuing at cursor, as returned by
+// `ListV2Iterator.Cursor`.
+func ResumeListV2Iterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *ListV2Iterator {
+	return &ListV2Iterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *ListV2Iterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *ListV2Iterator) Entry() *FileRequest {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ListV2Iterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *ListV2Iterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *ListV2Iterator) fetch() {
+	var res *ListFileRequestsV2Result
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.ListV2Context(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.ListContinueContext(it.ctx, NewListFileRequestsContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.FileRequests
+	it.index = 0
+}
@@ -456,6 +456,18 @@ func (u *UpdateFileRequestDeadline) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UpdateFileRequestDeadline instance
+func (u *UpdateFileRequestDeadline) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "update":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Update *FileRequestDeadline `json:"update"`
+		}{u.Tagged, u.Update})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UpdateFileRequestError : There is an error updating the file request.
 type UpdateFileRequestError struct {
 	dropbox.Tagged
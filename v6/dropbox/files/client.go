@@ -21,6 +21,7 @@
 package files
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log"
@@ -37,52 +38,76 @@ type Client interface {
 	// alpha endpoint compatible with the properties API. Note: Metadata for the
 	// root folder is unsupported.
 	// Deprecated: Use `GetMetadata` instead
-	AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata, err error)
+	AlphaGetMetadata(arg *AlphaGetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	// AlphaGetMetadataContext is like AlphaGetMetadata but takes a context for cancellation and deadlines.
+	AlphaGetMetadataContext(ctx context.Context, arg *AlphaGetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
 	// AlphaUpload : Create a new file with the contents provided in the
 	// request. Note that the behavior of this alpha endpoint is unstable and
 	// subject to change. Do not use this to upload a file larger than 150 MB.
 	// Instead, create an upload session with `uploadSessionStart`.
 	// Deprecated: Use `Upload` instead
-	AlphaUpload(arg *UploadArg, content io.Reader) (res *FileMetadata, err error)
+	AlphaUpload(arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
+	// AlphaUploadContext is like AlphaUpload but takes a context for cancellation and deadlines.
+	AlphaUploadContext(ctx context.Context, arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
 	// Copy : Copy a file or folder to a different location in the user's
 	// Dropbox. If the source path is a folder all its contents will be copied.
-	CopyV2(arg *RelocationArg) (res *RelocationResult, err error)
+	CopyV2(arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error)
+	// CopyV2Context is like CopyV2 but takes a context for cancellation and deadlines.
+	CopyV2Context(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error)
 	// Copy : Copy a file or folder to a different location in the user's
 	// Dropbox. If the source path is a folder all its contents will be copied.
 	// Deprecated: Use `CopyV2` instead
-	Copy(arg *RelocationArg) (res IsMetadata, err error)
+	Copy(arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	// CopyContext is like Copy but takes a context for cancellation and deadlines.
+	CopyContext(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
 	// CopyBatch : Copy multiple files or folders to different locations at once
 	// in the user's Dropbox. This route will replace `copyBatch`. The main
 	// difference is this route will return status for each entry, while
 	// `copyBatch` raises failure if any entry fails. This route will either
 	// finish synchronously, or return a job ID and do the async copy job in
 	// background. Please use `copyBatchCheck` to check the job status.
-	CopyBatchV2(arg *RelocationBatchArgBase) (res *RelocationBatchV2Launch, err error)
+	CopyBatchV2(arg *RelocationBatchArgBase, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error)
+	// CopyBatchV2Context is like CopyBatchV2 but takes a context for cancellation and deadlines.
+	CopyBatchV2Context(ctx context.Context, arg *RelocationBatchArgBase, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error)
 	// CopyBatch : Copy multiple files or folders to different locations at once
 	// in the user's Dropbox. This route will return job ID immediately and do
 	// the async copy job in background. Please use `copyBatchCheck` to check
 	// the job status.
 	// Deprecated: Use `CopyBatchV2` instead
-	CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error)
+	CopyBatch(arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error)
+	// CopyBatchContext is like CopyBatch but takes a context for cancellation and deadlines.
+	CopyBatchContext(ctx context.Context, arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error)
 	// CopyBatchCheck : Returns the status of an asynchronous job for
 	// `copyBatch`. It returns list of results for each entry.
-	CopyBatchCheckV2(arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error)
+	CopyBatchCheckV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error)
+	// CopyBatchCheckV2Context is like CopyBatchCheckV2 but takes a context for cancellation and deadlines.
+	CopyBatchCheckV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error)
 	// CopyBatchCheck : Returns the status of an asynchronous job for
 	// `copyBatch`. If success, it returns list of results for each entry.
 	// Deprecated: Use `CopyBatchCheckV2` instead
-	CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error)
+	CopyBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error)
+	// CopyBatchCheckContext is like CopyBatchCheck but takes a context for cancellation and deadlines.
+	CopyBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error)
 	// CopyReferenceGet : Get a copy reference to a file or folder. This
 	// reference string can be used to save that file or folder to another
 	// user's Dropbox by passing it to `copyReferenceSave`.
-	CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error)
+	CopyReferenceGet(arg *GetCopyReferenceArg, opts ...dropbox.CallOption) (res *GetCopyReferenceResult, err error)
+	// CopyReferenceGetContext is like CopyReferenceGet but takes a context for cancellation and deadlines.
+	CopyReferenceGetContext(ctx context.Context, arg *GetCopyReferenceArg, opts ...dropbox.CallOption) (res *GetCopyReferenceResult, err error)
 	// CopyReferenceSave : Save a copy reference returned by `copyReferenceGet`
 	// to the user's Dropbox.
-	CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error)
+	CopyReferenceSave(arg *SaveCopyReferenceArg, opts ...dropbox.CallOption) (res *SaveCopyReferenceResult, err error)
+	// CopyReferenceSaveContext is like CopyReferenceSave but takes a context for cancellation and deadlines.
+	CopyReferenceSaveContext(ctx context.Context, arg *SaveCopyReferenceArg, opts ...dropbox.CallOption) (res *SaveCopyReferenceResult, err error)
 	// CreateFolder : Create a folder at a given path.
-	CreateFolderV2(arg *CreateFolderArg) (res *CreateFolderResult, err error)
+	CreateFolderV2(arg *CreateFolderArg, opts ...dropbox.CallOption) (res *CreateFolderResult, err error)
+	// CreateFolderV2Context is like CreateFolderV2 but takes a context for cancellation and deadlines.
+	CreateFolderV2Context(ctx context.Context, arg *CreateFolderArg, opts ...dropbox.CallOption) (res *CreateFolderResult, err error)
 	// CreateFolder : Create a folder at a given path.
 	// Deprecated: Use `CreateFolderV2` instead
-	CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err error)
+	CreateFolder(arg *CreateFolderArg, opts ...dropbox.CallOption) (res *FolderMetadata, err error)
+	// CreateFolderContext is like CreateFolder but takes a context for cancellation and deadlines.
+	CreateFolderContext(ctx context.Context, arg *CreateFolderArg, opts ...dropbox.CallOption) (res *FolderMetadata, err error)
 	// CreateFolderBatch : Create multiple folders at once. This route is
 	// asynchronous for large batches, which returns a job ID immediately and
 	// runs the create folder batch asynchronously. Otherwise, creates the
@@ -90,62 +115,88 @@ type Client interface {
 	// force asynchronous behaviour by using the
 	// `CreateFolderBatchArg.force_async` flag.  Use `createFolderBatchCheck` to
 	// check the job status.
-	CreateFolderBatch(arg *CreateFolderBatchArg) (res *CreateFolderBatchLaunch, err error)
+	CreateFolderBatch(arg *CreateFolderBatchArg, opts ...dropbox.CallOption) (res *CreateFolderBatchLaunch, err error)
+	// CreateFolderBatchContext is like CreateFolderBatch but takes a context for cancellation and deadlines.
+	CreateFolderBatchContext(ctx context.Context, arg *CreateFolderBatchArg, opts ...dropbox.CallOption) (res *CreateFolderBatchLaunch, err error)
 	// CreateFolderBatchCheck : Returns the status of an asynchronous job for
 	// `createFolderBatch`. If success, it returns list of result for each
 	// entry.
-	CreateFolderBatchCheck(arg *async.PollArg) (res *CreateFolderBatchJobStatus, err error)
+	CreateFolderBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *CreateFolderBatchJobStatus, err error)
+	// CreateFolderBatchCheckContext is like CreateFolderBatchCheck but takes a context for cancellation and deadlines.
+	CreateFolderBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *CreateFolderBatchJobStatus, err error)
 	// Delete : Delete the file or folder at a given path. If the path is a
 	// folder, all its contents will be deleted too. A successful response
 	// indicates that the file or folder was deleted. The returned metadata will
 	// be the corresponding `FileMetadata` or `FolderMetadata` for the item at
 	// time of deletion, and not a `DeletedMetadata` object.
-	DeleteV2(arg *DeleteArg) (res *DeleteResult, err error)
+	DeleteV2(arg *DeleteArg, opts ...dropbox.CallOption) (res *DeleteResult, err error)
+	// DeleteV2Context is like DeleteV2 but takes a context for cancellation and deadlines.
+	DeleteV2Context(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (res *DeleteResult, err error)
 	// Delete : Delete the file or folder at a given path. If the path is a
 	// folder, all its contents will be deleted too. A successful response
 	// indicates that the file or folder was deleted. The returned metadata will
 	// be the corresponding `FileMetadata` or `FolderMetadata` for the item at
 	// time of deletion, and not a `DeletedMetadata` object.
 	// Deprecated: Use `DeleteV2` instead
-	Delete(arg *DeleteArg) (res IsMetadata, err error)
+	Delete(arg *DeleteArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	// DeleteContext is like Delete but takes a context for cancellation and deadlines.
+	DeleteContext(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
 	// DeleteBatch : Delete multiple files/folders at once. This route is
 	// asynchronous, which returns a job ID immediately and runs the delete
 	// batch asynchronously. Use `deleteBatchCheck` to check the job status.
-	DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error)
+	DeleteBatch(arg *DeleteBatchArg, opts ...dropbox.CallOption) (res *DeleteBatchLaunch, err error)
+	// DeleteBatchContext is like DeleteBatch but takes a context for cancellation and deadlines.
+	DeleteBatchContext(ctx context.Context, arg *DeleteBatchArg, opts ...dropbox.CallOption) (res *DeleteBatchLaunch, err error)
 	// DeleteBatchCheck : Returns the status of an asynchronous job for
 	// `deleteBatch`. If success, it returns list of result for each entry.
-	DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobStatus, err error)
+	DeleteBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *DeleteBatchJobStatus, err error)
+	// DeleteBatchCheckContext is like DeleteBatchCheck but takes a context for cancellation and deadlines.
+	DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *DeleteBatchJobStatus, err error)
 	// Download : Download a file from a user's Dropbox.
-	Download(arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error)
+	Download(arg *DownloadArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
+	// DownloadContext is like Download but takes a context for cancellation and deadlines.
+	DownloadContext(ctx context.Context, arg *DownloadArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
 	// DownloadZip : Download a folder from the user's Dropbox, as a zip file.
 	// The folder must be less than 20 GB in size and any single file within
 	// must be less than 4 GB in size. The resulting zip must have fewer than
 	// 10,000 total file and folder entries, including the top level folder. The
 	// input cannot be a single file. Note: this endpoint does not support HTTP
 	// range requests.
-	DownloadZip(arg *DownloadZipArg) (res *DownloadZipResult, content io.ReadCloser, err error)
+	DownloadZip(arg *DownloadZipArg, opts ...dropbox.CallOption) (res *DownloadZipResult, content io.ReadCloser, err error)
+	// DownloadZipContext is like DownloadZip but takes a context for cancellation and deadlines.
+	DownloadZipContext(ctx context.Context, arg *DownloadZipArg, opts ...dropbox.CallOption) (res *DownloadZipResult, content io.ReadCloser, err error)
 	// Export : Export a file from a user's Dropbox. This route only supports
 	// exporting files that cannot be downloaded directly  and whose
 	// `ExportResult.file_metadata` has `ExportInfo.export_as` populated.
-	Export(arg *ExportArg) (res *ExportResult, content io.ReadCloser, err error)
+	Export(arg *ExportArg, opts ...dropbox.CallOption) (res *ExportResult, content io.ReadCloser, err error)
+	// ExportContext is like Export but takes a context for cancellation and deadlines.
+	ExportContext(ctx context.Context, arg *ExportArg, opts ...dropbox.CallOption) (res *ExportResult, content io.ReadCloser, err error)
 	// GetFileLockBatch : Return the lock metadata for the given list of paths.
-	GetFileLockBatch(arg *LockFileBatchArg) (res *LockFileBatchResult, err error)
+	GetFileLockBatch(arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
+	// GetFileLockBatchContext is like GetFileLockBatch but takes a context for cancellation and deadlines.
+	GetFileLockBatchContext(ctx context.Context, arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
 	// GetMetadata : Returns the metadata for a file or folder. Note: Metadata
 	// for the root folder is unsupported.
-	GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error)
+	GetMetadata(arg *GetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	// GetMetadataContext is like GetMetadata but takes a context for cancellation and deadlines.
+	GetMetadataContext(ctx context.Context, arg *GetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
 	// GetPreview : Get a preview for a file. Currently, PDF previews are
 	// generated for files with the following extensions: .ai, .doc, .docm,
 	// .docx, .eps, .gdoc, .gslides, .odp, .odt, .pps, .ppsm, .ppsx, .ppt,
 	// .pptm, .pptx, .rtf. HTML previews are generated for files with the
 	// following extensions: .csv, .ods, .xls, .xlsm, .gsheet, .xlsx. Other
 	// formats will return an unsupported extension error.
-	GetPreview(arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error)
+	GetPreview(arg *PreviewArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
+	// GetPreviewContext is like GetPreview but takes a context for cancellation and deadlines.
+	GetPreviewContext(ctx context.Context, arg *PreviewArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
 	// GetTemporaryLink : Get a temporary link to stream content of a file. This
 	// link will expire in four hours and afterwards you will get 410 Gone. This
 	// URL should not be used to display content directly in the browser. The
 	// Content-Type of the link is determined automatically by the file's mime
 	// type.
-	GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error)
+	GetTemporaryLink(arg *GetTemporaryLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryLinkResult, err error)
+	// GetTemporaryLinkContext is like GetTemporaryLink but takes a context for cancellation and deadlines.
+	GetTemporaryLinkContext(ctx context.Context, arg *GetTemporaryLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryLinkResult, err error)
 	// GetTemporaryUploadLink : Get a one-time use temporary upload link to
 	// upload a file to a Dropbox location.  This endpoint acts as a delayed
 	// `upload`. The returned temporary upload link may be used to make a POST
@@ -175,23 +226,31 @@ type Client interface {
 	// The temporary upload link is expired or consumed.  Example unsuccessful
 	// temporary upload link consumption response: Temporary upload link has
 	// been recently consumed.
-	GetTemporaryUploadLink(arg *GetTemporaryUploadLinkArg) (res *GetTemporaryUploadLinkResult, err error)
+	GetTemporaryUploadLink(arg *GetTemporaryUploadLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryUploadLinkResult, err error)
+	// GetTemporaryUploadLinkContext is like GetTemporaryUploadLink but takes a context for cancellation and deadlines.
+	GetTemporaryUploadLinkContext(ctx context.Context, arg *GetTemporaryUploadLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryUploadLinkResult, err error)
 	// GetThumbnail : Get a thumbnail for an image. This method currently
 	// supports files with the following file extensions: jpg, jpeg, png, tiff,
 	// tif, gif, webp, ppm and bmp. Photos that are larger than 20MB in size
 	// won't be converted to a thumbnail.
-	GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error)
+	GetThumbnail(arg *ThumbnailArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
+	// GetThumbnailContext is like GetThumbnail but takes a context for cancellation and deadlines.
+	GetThumbnailContext(ctx context.Context, arg *ThumbnailArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
 	// GetThumbnail : Get a thumbnail for an image. This method currently
 	// supports files with the following file extensions: jpg, jpeg, png, tiff,
 	// tif, gif, webp, ppm and bmp. Photos that are larger than 20MB in size
 	// won't be converted to a thumbnail.
-	GetThumbnailV2(arg *ThumbnailV2Arg) (res *PreviewResult, content io.ReadCloser, err error)
+	GetThumbnailV2(arg *ThumbnailV2Arg, opts ...dropbox.CallOption) (res *PreviewResult, content io.ReadCloser, err error)
+	// GetThumbnailV2Context is like GetThumbnailV2 but takes a context for cancellation and deadlines.
+	GetThumbnailV2Context(ctx context.Context, arg *ThumbnailV2Arg, opts ...dropbox.CallOption) (res *PreviewResult, content io.ReadCloser, err error)
 	// GetThumbnailBatch : Get thumbnails for a list of images. We allow up to
 	// 25 thumbnails in a single batch. This method currently supports files
 	// with the following file extensions: jpg, jpeg, png, tiff, tif, gif, webp,
 	// ppm and bmp. Photos that are larger than 20MB in size won't be converted
 	// to a thumbnail.
-	GetThumbnailBatch(arg *GetThumbnailBatchArg) (res *GetThumbnailBatchResult, err error)
+	GetThumbnailBatch(arg *GetThumbnailBatchArg, opts ...dropbox.CallOption) (res *GetThumbnailBatchResult, err error)
+	// GetThumbnailBatchContext is like GetThumbnailBatch but takes a context for cancellation and deadlines.
+	GetThumbnailBatchContext(ctx context.Context, arg *GetThumbnailBatchArg, opts ...dropbox.CallOption) (res *GetThumbnailBatchResult, err error)
 	// ListFolder : Starts returning the contents of a folder. If the result's
 	// `ListFolderResult.has_more` field is true, call `listFolderContinue` with
 	// the returned `ListFolderResult.cursor` to retrieve more entries. If
@@ -213,17 +272,23 @@ type Client interface {
 	// `listFolderContinue` calls with same parameters are made simultaneously
 	// by same API app for same user. If your app implements retry logic, please
 	// hold off the retry until the previous request finishes.
-	ListFolder(arg *ListFolderArg) (res *ListFolderResult, err error)
+	ListFolder(arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error)
+	// ListFolderContext is like ListFolder but takes a context for cancellation and deadlines.
+	ListFolderContext(ctx context.Context, arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error)
 	// ListFolderContinue : Once a cursor has been retrieved from `listFolder`,
 	// use this to paginate through all files and retrieve updates to the
 	// folder, following the same rules as documented for `listFolder`.
-	ListFolderContinue(arg *ListFolderContinueArg) (res *ListFolderResult, err error)
+	ListFolderContinue(arg *ListFolderContinueArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error)
+	// ListFolderContinueContext is like ListFolderContinue but takes a context for cancellation and deadlines.
+	ListFolderContinueContext(ctx context.Context, arg *ListFolderContinueArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error)
 	// ListFolderGetLatestCursor : A way to quickly get a cursor for the
 	// folder's state. Unlike `listFolder`, `listFolderGetLatestCursor` doesn't
 	// return any entries. This endpoint is for app which only needs to know
 	// about new files and modifications and doesn't need to know about files
 	// that already exist in Dropbox.
-	ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error)
+	ListFolderGetLatestCursor(arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderGetLatestCursorResult, err error)
+	// ListFolderGetLatestCursorContext is like ListFolderGetLatestCursor but takes a context for cancellation and deadlines.
+	ListFolderGetLatestCursorContext(ctx context.Context, arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderGetLatestCursorResult, err error)
 	// ListFolderLongpoll : A longpoll endpoint to wait for changes on an
 	// account. In conjunction with `listFolderContinue`, this call gives you a
 	// low-latency way to monitor an account for file changes. The connection
@@ -231,7 +296,9 @@ type Client interface {
 	// endpoint is useful mostly for client-side apps. If you're looking for
 	// server-side notifications, check out our `webhooks documentation`
 	// <https://www.dropbox.com/developers/reference/webhooks>.
-	ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error)
+	ListFolderLongpoll(arg *ListFolderLongpollArg, opts ...dropbox.CallOption) (res *ListFolderLongpollResult, err error)
+	// ListFolderLongpollContext is like ListFolderLongpoll but takes a context for cancellation and deadlines.
+	ListFolderLongpollContext(ctx context.Context, arg *ListFolderLongpollArg, opts ...dropbox.CallOption) (res *ListFolderLongpollResult, err error)
 	// ListRevisions : Returns revisions for files based on a file path or a
 	// file id. The file path or file id is identified from the latest file
 	// entry at the given file path or id. This end point allows your app to
@@ -241,20 +308,28 @@ type Client interface {
 	// revisions with the same file id are desired, then mode must be set to
 	// `ListRevisionsMode.id`. The `ListRevisionsMode.id` mode is useful to
 	// retrieve revisions for a given file across moves or renames.
-	ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResult, err error)
+	ListRevisions(arg *ListRevisionsArg, opts ...dropbox.CallOption) (res *ListRevisionsResult, err error)
+	// ListRevisionsContext is like ListRevisions but takes a context for cancellation and deadlines.
+	ListRevisionsContext(ctx context.Context, arg *ListRevisionsArg, opts ...dropbox.CallOption) (res *ListRevisionsResult, err error)
 	// LockFileBatch : Lock the files at the given paths. A locked file will be
 	// writable only by the lock holder. A successful response indicates that
 	// the file has been locked. Returns a list of the locked file paths and
 	// their metadata after this operation.
-	LockFileBatch(arg *LockFileBatchArg) (res *LockFileBatchResult, err error)
+	LockFileBatch(arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
+	// LockFileBatchContext is like LockFileBatch but takes a context for cancellation and deadlines.
+	LockFileBatchContext(ctx context.Context, arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
 	// Move : Move a file or folder to a different location in the user's
 	// Dropbox. If the source path is a folder all its contents will be moved.
 	// Note that we do not currently support case-only renaming.
-	MoveV2(arg *RelocationArg) (res *RelocationResult, err error)
+	MoveV2(arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error)
+	// MoveV2Context is like MoveV2 but takes a context for cancellation and deadlines.
+	MoveV2Context(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error)
 	// Move : Move a file or folder to a different location in the user's
 	// Dropbox. If the source path is a folder all its contents will be moved.
 	// Deprecated: Use `MoveV2` instead
-	Move(arg *RelocationArg) (res IsMetadata, err error)
+	Move(arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	// MoveContext is like Move but takes a context for cancellation and deadlines.
+	MoveContext(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
 	// MoveBatch : Move multiple files or folders to different locations at once
 	// in the user's Dropbox. Note that we do not currently support case-only
 	// renaming. This route will replace `moveBatch`. The main difference is
@@ -262,90 +337,136 @@ type Client interface {
 	// failure if any entry fails. This route will either finish synchronously,
 	// or return a job ID and do the async move job in background. Please use
 	// `moveBatchCheck` to check the job status.
-	MoveBatchV2(arg *MoveBatchArg) (res *RelocationBatchV2Launch, err error)
+	MoveBatchV2(arg *MoveBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error)
+	// MoveBatchV2Context is like MoveBatchV2 but takes a context for cancellation and deadlines.
+	MoveBatchV2Context(ctx context.Context, arg *MoveBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error)
 	// MoveBatch : Move multiple files or folders to different locations at once
 	// in the user's Dropbox. This route will return job ID immediately and do
 	// the async moving job in background. Please use `moveBatchCheck` to check
 	// the job status.
 	// Deprecated: Use `MoveBatchV2` instead
-	MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error)
+	MoveBatch(arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error)
+	// MoveBatchContext is like MoveBatch but takes a context for cancellation and deadlines.
+	MoveBatchContext(ctx context.Context, arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error)
 	// MoveBatchCheck : Returns the status of an asynchronous job for
 	// `moveBatch`. It returns list of results for each entry.
-	MoveBatchCheckV2(arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error)
+	MoveBatchCheckV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error)
+	// MoveBatchCheckV2Context is like MoveBatchCheckV2 but takes a context for cancellation and deadlines.
+	MoveBatchCheckV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error)
 	// MoveBatchCheck : Returns the status of an asynchronous job for
 	// `moveBatch`. If success, it returns list of results for each entry.
 	// Deprecated: Use `MoveBatchCheckV2` instead
-	MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error)
+	MoveBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error)
+	// MoveBatchCheckContext is like MoveBatchCheck but takes a context for cancellation and deadlines.
+	MoveBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error)
 	// PaperCreate : Creates a new Paper doc with the provided content.
-	PaperCreate(arg *PaperCreateArg, content io.Reader) (res *PaperCreateResult, err error)
+	PaperCreate(arg *PaperCreateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperCreateResult, err error)
+	// PaperCreateContext is like PaperCreate but takes a context for cancellation and deadlines.
+	PaperCreateContext(ctx context.Context, arg *PaperCreateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperCreateResult, err error)
 	// PaperUpdate : Updates an existing Paper doc with the provided content.
-	PaperUpdate(arg *PaperUpdateArg, content io.Reader) (res *PaperUpdateResult, err error)
+	PaperUpdate(arg *PaperUpdateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperUpdateResult, err error)
+	// PaperUpdateContext is like PaperUpdate but takes a context for cancellation and deadlines.
+	PaperUpdateContext(ctx context.Context, arg *PaperUpdateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperUpdateResult, err error)
 	// PermanentlyDelete : Permanently delete the file or folder at a given path
 	// (see https://www.dropbox.com/en/help/40). If the given file or folder is
 	// not yet deleted, this route will first delete it. It is possible for this
 	// route to successfully delete, then fail to permanently delete. Note: This
 	// endpoint is only available for Dropbox Business apps.
-	PermanentlyDelete(arg *DeleteArg) (err error)
+	PermanentlyDelete(arg *DeleteArg, opts ...dropbox.CallOption) (err error)
+	// PermanentlyDeleteContext is like PermanentlyDelete but takes a context for cancellation and deadlines.
+	PermanentlyDeleteContext(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (err error)
 	// PropertiesAdd : has no documentation (yet)
 	// Deprecated:
-	PropertiesAdd(arg *file_properties.AddPropertiesArg) (err error)
+	PropertiesAdd(arg *file_properties.AddPropertiesArg, opts ...dropbox.CallOption) (err error)
+	// PropertiesAddContext is like PropertiesAdd but takes a context for cancellation and deadlines.
+	PropertiesAddContext(ctx context.Context, arg *file_properties.AddPropertiesArg, opts ...dropbox.CallOption) (err error)
 	// PropertiesOverwrite : has no documentation (yet)
 	// Deprecated:
-	PropertiesOverwrite(arg *file_properties.OverwritePropertyGroupArg) (err error)
+	PropertiesOverwrite(arg *file_properties.OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error)
+	// PropertiesOverwriteContext is like PropertiesOverwrite but takes a context for cancellation and deadlines.
+	PropertiesOverwriteContext(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error)
 	// PropertiesRemove : has no documentation (yet)
 	// Deprecated:
-	PropertiesRemove(arg *file_properties.RemovePropertiesArg) (err error)
+	PropertiesRemove(arg *file_properties.RemovePropertiesArg, opts ...dropbox.CallOption) (err error)
+	// PropertiesRemoveContext is like PropertiesRemove but takes a context for cancellation and deadlines.
+	PropertiesRemoveContext(ctx context.Context, arg *file_properties.RemovePropertiesArg, opts ...dropbox.CallOption) (err error)
 	// PropertiesTemplateGet : has no documentation (yet)
 	// Deprecated:
-	PropertiesTemplateGet(arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error)
+	PropertiesTemplateGet(arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error)
+	// PropertiesTemplateGetContext is like PropertiesTemplateGet but takes a context for cancellation and deadlines.
+	PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error)
 	// PropertiesTemplateList : has no documentation (yet)
 	// Deprecated:
-	PropertiesTemplateList() (res *file_properties.ListTemplateResult, err error)
+	PropertiesTemplateList(opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error)
+	// PropertiesTemplateListContext is like PropertiesTemplateList but takes a context for cancellation and deadlines.
+	PropertiesTemplateListContext(ctx context.Context, opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error)
 	// PropertiesUpdate : has no documentation (yet)
 	// Deprecated:
-	PropertiesUpdate(arg *file_properties.UpdatePropertiesArg) (err error)
+	PropertiesUpdate(arg *file_properties.UpdatePropertiesArg, opts ...dropbox.CallOption) (err error)
+	// PropertiesUpdateContext is like PropertiesUpdate but takes a context for cancellation and deadlines.
+	PropertiesUpdateContext(ctx context.Context, arg *file_properties.UpdatePropertiesArg, opts ...dropbox.CallOption) (err error)
 	// Restore : Restore a specific revision of a file to the given path.
-	Restore(arg *RestoreArg) (res *FileMetadata, err error)
+	Restore(arg *RestoreArg, opts ...dropbox.CallOption) (res *FileMetadata, err error)
+	// RestoreContext is like Restore but takes a context for cancellation and deadlines.
+	RestoreContext(ctx context.Context, arg *RestoreArg, opts ...dropbox.CallOption) (res *FileMetadata, err error)
 	// SaveUrl : Save the data from a specified URL into a file in user's
 	// Dropbox. Note that the transfer from the URL must complete within 5
 	// minutes, or the operation will time out and the job will fail. If the
 	// given path already exists, the file will be renamed to avoid the conflict
 	// (e.g. myfile (1).txt).
-	SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error)
+	SaveUrl(arg *SaveUrlArg, opts ...dropbox.CallOption) (res *SaveUrlResult, err error)
+	// SaveUrlContext is like SaveUrl but takes a context for cancellation and deadlines.
+	SaveUrlContext(ctx context.Context, arg *SaveUrlArg, opts ...dropbox.CallOption) (res *SaveUrlResult, err error)
 	// SaveUrlCheckJobStatus : Check the status of a `saveUrl` job.
-	SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobStatus, err error)
+	SaveUrlCheckJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *SaveUrlJobStatus, err error)
+	// SaveUrlCheckJobStatusContext is like SaveUrlCheckJobStatus but takes a context for cancellation and deadlines.
+	SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *SaveUrlJobStatus, err error)
 	// Search : Searches for files and folders. Note: Recent changes will be
 	// reflected in search results within a few seconds and older revisions of
 	// existing files may still match your query for up to a few days.
 	// Deprecated: Use `SearchV2` instead
-	Search(arg *SearchArg) (res *SearchResult, err error)
+	Search(arg *SearchArg, opts ...dropbox.CallOption) (res *SearchResult, err error)
+	// SearchContext is like Search but takes a context for cancellation and deadlines.
+	SearchContext(ctx context.Context, arg *SearchArg, opts ...dropbox.CallOption) (res *SearchResult, err error)
 	// Search : Searches for files and folders. Note: `search` along with
 	// `searchContinue` can only be used to retrieve a maximum of 10,000
 	// matches. Recent changes may not immediately be reflected in search
 	// results due to a short delay in indexing. Duplicate results may be
 	// returned across pages. Some results may not be returned.
-	SearchV2(arg *SearchV2Arg) (res *SearchV2Result, err error)
+	SearchV2(arg *SearchV2Arg, opts ...dropbox.CallOption) (res *SearchV2Result, err error)
+	// SearchV2Context is like SearchV2 but takes a context for cancellation and deadlines.
+	SearchV2Context(ctx context.Context, arg *SearchV2Arg, opts ...dropbox.CallOption) (res *SearchV2Result, err error)
 	// SearchContinue : Fetches the next page of search results returned from
 	// `search`. Note: `search` along with `searchContinue` can only be used to
 	// retrieve a maximum of 10,000 matches. Recent changes may not immediately
 	// be reflected in search results due to a short delay in indexing.
 	// Duplicate results may be returned across pages. Some results may not be
 	// returned.
-	SearchContinueV2(arg *SearchV2ContinueArg) (res *SearchV2Result, err error)
+	SearchContinueV2(arg *SearchV2ContinueArg, opts ...dropbox.CallOption) (res *SearchV2Result, err error)
+	// SearchContinueV2Context is like SearchContinueV2 but takes a context for cancellation and deadlines.
+	SearchContinueV2Context(ctx context.Context, arg *SearchV2ContinueArg, opts ...dropbox.CallOption) (res *SearchV2Result, err error)
 	// TagsAdd : Add a tag to an item. A tag is a string. The strings are
 	// automatically converted to lowercase letters. No more than 20 tags can be
 	// added to a given item.
-	TagsAdd(arg *AddTagArg) (err error)
+	TagsAdd(arg *AddTagArg, opts ...dropbox.CallOption) (err error)
+	// TagsAddContext is like TagsAdd but takes a context for cancellation and deadlines.
+	TagsAddContext(ctx context.Context, arg *AddTagArg, opts ...dropbox.CallOption) (err error)
 	// TagsGet : Get list of tags assigned to items.
-	TagsGet(arg *GetTagsArg) (res *GetTagsResult, err error)
+	TagsGet(arg *GetTagsArg, opts ...dropbox.CallOption) (res *GetTagsResult, err error)
+	// TagsGetContext is like TagsGet but takes a context for cancellation and deadlines.
+	TagsGetContext(ctx context.Context, arg *GetTagsArg, opts ...dropbox.CallOption) (res *GetTagsResult, err error)
 	// TagsRemove : Remove a tag from an item.
-	TagsRemove(arg *RemoveTagArg) (err error)
+	TagsRemove(arg *RemoveTagArg, opts ...dropbox.CallOption) (err error)
+	// TagsRemoveContext is like TagsRemove but takes a context for cancellation and deadlines.
+	TagsRemoveContext(ctx context.Context, arg *RemoveTagArg, opts ...dropbox.CallOption) (err error)
 	// UnlockFileBatch : Unlock the files at the given paths. A locked file can
 	// only be unlocked by the lock holder or, if a business account, a team
 	// admin. A successful response indicates that the file has been unlocked.
 	// Returns a list of the unlocked file paths and their metadata after this
 	// operation.
-	UnlockFileBatch(arg *UnlockFileBatchArg) (res *LockFileBatchResult, err error)
+	UnlockFileBatch(arg *UnlockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
+	// UnlockFileBatchContext is like UnlockFileBatch but takes a context for cancellation and deadlines.
+	UnlockFileBatchContext(ctx context.Context, arg *UnlockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
 	// Upload : Create a new file with the contents provided in the request. Do
 	// not use this to upload a file larger than 150 MB. Instead, create an
 	// upload session with `uploadSessionStart`. Calls to this endpoint will
@@ -353,7 +474,9 @@ type Client interface {
 	// on the number of data transport calls allowed per month. For more
 	// information, see the `Data transport limit page`
 	// <https://www.dropbox.com/developers/reference/data-transport-limit>.
-	Upload(arg *UploadArg, content io.Reader) (res *FileMetadata, err error)
+	Upload(arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
+	// UploadContext is like Upload but takes a context for cancellation and deadlines.
+	UploadContext(ctx context.Context, arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
 	// UploadSessionAppend : Append more data to an upload session. When the
 	// parameter close is set, this call will close the session. A single
 	// request should not upload more than 150 MB. The maximum size of a file
@@ -362,7 +485,9 @@ type Client interface {
 	// limit on the number of data transport calls allowed per month. For more
 	// information, see the `Data transport limit page`
 	// <https://www.dropbox.com/developers/reference/data-transport-limit>.
-	UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader) (err error)
+	UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) (err error)
+	// UploadSessionAppendV2Context is like UploadSessionAppendV2 but takes a context for cancellation and deadlines.
+	UploadSessionAppendV2Context(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) (err error)
 	// UploadSessionAppend : Append more data to an upload session. A single
 	// request should not upload more than 150 MB. The maximum size of a file
 	// one can upload to an upload session is 350 GB. Calls to this endpoint
@@ -371,7 +496,9 @@ type Client interface {
 	// information, see the `Data transport limit page`
 	// <https://www.dropbox.com/developers/reference/data-transport-limit>.
 	// Deprecated: Use `UploadSessionAppendV2` instead
-	UploadSessionAppend(arg *UploadSessionCursor, content io.Reader) (err error)
+	UploadSessionAppend(arg *UploadSessionCursor, content io.Reader, opts ...dropbox.CallOption) (err error)
+	// UploadSessionAppendContext is like UploadSessionAppend but takes a context for cancellation and deadlines.
+	UploadSessionAppendContext(ctx context.Context, arg *UploadSessionCursor, content io.Reader, opts ...dropbox.CallOption) (err error)
 	// UploadSessionFinish : Finish an upload session and save the uploaded data
 	// to the given file path. A single request should not upload more than 150
 	// MB. The maximum size of a file one can upload to an upload session is 350
@@ -380,7 +507,9 @@ type Client interface {
 	// allowed per month. For more information, see the `Data transport limit
 	// page`
 	// <https://www.dropbox.com/developers/reference/data-transport-limit>.
-	UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error)
+	UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
+	// UploadSessionFinishContext is like UploadSessionFinish but takes a context for cancellation and deadlines.
+	UploadSessionFinishContext(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
 	// UploadSessionFinishBatch : This route helps you commit many files at once
 	// into a user's Dropbox. Use `uploadSessionStart` and `uploadSessionAppend`
 	// to upload file contents. We recommend uploading many files in parallel to
@@ -400,7 +529,9 @@ type Client interface {
 	// `Data transport limit page`
 	// <https://www.dropbox.com/developers/reference/data-transport-limit>.
 	// Deprecated: Use `UploadSessionFinishBatchV2` instead
-	UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error)
+	UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchLaunch, err error)
+	// UploadSessionFinishBatchContext is like UploadSessionFinishBatch but takes a context for cancellation and deadlines.
+	UploadSessionFinishBatchContext(ctx context.Context, arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchLaunch, err error)
 	// UploadSessionFinishBatch : This route helps you commit many files at once
 	// into a user's Dropbox. Use `uploadSessionStart` and `uploadSessionAppend`
 	// to upload file contents. We recommend uploading many files in parallel to
@@ -415,11 +546,15 @@ type Client interface {
 	// teams with a limit on the number of data transport calls allowed per
 	// month. For more information, see the `Data transport limit page`
 	// <https://www.dropbox.com/developers/reference/data-transport-limit>.
-	UploadSessionFinishBatchV2(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchResult, err error)
+	UploadSessionFinishBatchV2(arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchResult, err error)
+	// UploadSessionFinishBatchV2Context is like UploadSessionFinishBatchV2 but takes a context for cancellation and deadlines.
+	UploadSessionFinishBatchV2Context(ctx context.Context, arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchResult, err error)
 	// UploadSessionFinishBatchCheck : Returns the status of an asynchronous job
 	// for `uploadSessionFinishBatch`. If success, it returns list of result for
 	// each entry.
-	UploadSessionFinishBatchCheck(arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error)
+	UploadSessionFinishBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchJobStatus, err error)
+	// UploadSessionFinishBatchCheckContext is like UploadSessionFinishBatchCheck but takes a context for cancellation and deadlines.
+	UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchJobStatus, err error)
 	// UploadSessionStart : Upload sessions allow you to upload a single file in
 	// one or more requests, for example where the size of the file is greater
 	// than 150 MB.  This call starts a new upload session with the given data.
@@ -450,14 +585,65 @@ type Client interface {
 	// call must be multiple of 4194304 bytes (except for last
 	// `uploadSessionAppend` with `UploadSessionStartArg.close` to true, that
 	// may contain any remaining data).
-	UploadSessionStart(arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error)
+	UploadSessionStart(arg *UploadSessionStartArg, content io.Reader, opts ...dropbox.CallOption) (res *UploadSessionStartResult, err error)
+	// UploadSessionStartContext is like UploadSessionStart but takes a context for cancellation and deadlines.
+	UploadSessionStartContext(ctx context.Context, arg *UploadSessionStartArg, content io.Reader, opts ...dropbox.CallOption) (res *UploadSessionStartResult, err error)
 	// UploadSessionStartBatch : This route starts batch of upload_sessions.
 	// Please refer to `upload_session/start` usage. Calls to this endpoint will
 	// count as data transport calls for any Dropbox Business teams with a limit
 	// on the number of data transport calls allowed per month. For more
 	// information, see the `Data transport limit page`
 	// <https://www.dropbox.com/developers/reference/data-transport-limit>.
-	UploadSessionStartBatch(arg *UploadSessionStartBatchArg) (res *UploadSessionStartBatchResult, err error)
+	UploadSessionStartBatch(arg *UploadSessionStartBatchArg, opts ...dropbox.CallOption) (res *UploadSessionStartBatchResult, err error)
+	// UploadSessionStartBatchContext is like UploadSessionStartBatch but takes a context for cancellation and deadlines.
+	UploadSessionStartBatchContext(ctx context.Context, arg *UploadSessionStartBatchArg, opts ...dropbox.CallOption) (res *UploadSessionStartBatchResult, err error)
+}
+
+// Sentinel errors for the tags of unions that are nested in the errors of
+// many routes. The errors of all routes match them with errors.Is if their
+// endpoint error contains the union with the tag.
+var (
+	ErrLookupMalformedPath          error = endpointErrorTag{"LookupError", LookupErrorMalformedPath}
+	ErrLookupNotFound               error = endpointErrorTag{"LookupError", LookupErrorNotFound}
+	ErrLookupNotFile                error = endpointErrorTag{"LookupError", LookupErrorNotFile}
+	ErrLookupNotFolder              error = endpointErrorTag{"LookupError", LookupErrorNotFolder}
+	ErrLookupRestrictedContent      error = endpointErrorTag{"LookupError", LookupErrorRestrictedContent}
+	ErrLookupUnsupportedContentType error = endpointErrorTag{"LookupError", LookupErrorUnsupportedContentType}
+	ErrLookupLocked                 error = endpointErrorTag{"LookupError", LookupErrorLocked}
+	ErrWriteConflictFile            error = endpointErrorTag{"WriteConflictError", WriteConflictErrorFile}
+	ErrWriteConflictFolder          error = endpointErrorTag{"WriteConflictError", WriteConflictErrorFolder}
+	ErrWriteConflictFileAncestor    error = endpointErrorTag{"WriteConflictError", WriteConflictErrorFileAncestor}
+	ErrWriteMalformedPath           error = endpointErrorTag{"WriteError", WriteErrorMalformedPath}
+	ErrWriteConflict                error = endpointErrorTag{"WriteError", WriteErrorConflict}
+	ErrWriteNoWritePermission       error = endpointErrorTag{"WriteError", WriteErrorNoWritePermission}
+	ErrWriteInsufficientSpace       error = endpointErrorTag{"WriteError", WriteErrorInsufficientSpace}
+	ErrWriteDisallowedName          error = endpointErrorTag{"WriteError", WriteErrorDisallowedName}
+	ErrWriteTeamFolder              error = endpointErrorTag{"WriteError", WriteErrorTeamFolder}
+	ErrWriteOperationSuppressed     error = endpointErrorTag{"WriteError", WriteErrorOperationSuppressed}
+	ErrWriteTooManyWriteOperations  error = endpointErrorTag{"WriteError", WriteErrorTooManyWriteOperations}
+)
+
+// endpointErrorTag matches the unions of a type with a tag.
+type endpointErrorTag struct {
+	union string
+	tag   string
+}
+
+func (e endpointErrorTag) Error() string {
+	return "files: " + e.union + " " + e.tag
+}
+
+// MatchEndpointError implements dropbox.EndpointErrorMatcher.
+func (e endpointErrorTag) MatchEndpointError(endpointError interface{}) bool {
+	switch err := endpointError.(type) {
+	case *LookupError:
+		return e.union == "LookupError" && err.Tag == e.tag
+	case *WriteConflictError:
+		return e.union == "WriteConflictError" && err.Tag == e.tag
+	case *WriteError:
+		return e.union == "WriteError" && err.Tag == e.tag
+	}
+	return false
 }
 
 type apiImpl dropbox.Context
@@ -468,7 +654,29 @@ type AlphaGetMetadataAPIError struct {
 	EndpointError *AlphaGetMetadataError `json:"error"`
 }
 
-func (dbx *apiImpl) AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *AlphaGetMetadataAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e AlphaGetMetadataAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.PropertiesError != nil && m.MatchEndpointError(err.PropertiesError)
+}
+
+func (dbx *apiImpl) AlphaGetMetadata(arg *AlphaGetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return dbx.AlphaGetMetadataContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) AlphaGetMetadataContext(ctx context.Context, arg *AlphaGetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
 	log.Printf("WARNING: API `AlphaGetMetadata` is deprecated")
 	log.Printf("Use API `GetMetadata` instead")
 
@@ -480,11 +688,12 @@ func (dbx *apiImpl) AlphaGetMetadata(arg *AlphaGetMetadataArg) (res IsMetadata,
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr AlphaGetMetadataAPIError
 		err = auth.ParseError(err, &appErr)
@@ -520,23 +729,49 @@ type AlphaUploadAPIError struct {
 	EndpointError *UploadError `json:"error"`
 }
 
-func (dbx *apiImpl) AlphaUpload(arg *UploadArg, content io.Reader) (res *FileMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *AlphaUploadAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e AlphaUploadAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && err.Path.Reason != nil && m.MatchEndpointError(err.Path.Reason) ||
+		err.Path != nil && err.Path.Reason != nil && err.Path.Reason.Conflict != nil && m.MatchEndpointError(err.Path.Reason.Conflict) ||
+		err.PropertiesError != nil && m.MatchEndpointError(err.PropertiesError) ||
+		err.PropertiesError != nil && err.PropertiesError.Path != nil && m.MatchEndpointError(err.PropertiesError.Path)
+}
+
+func (dbx *apiImpl) AlphaUpload(arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	return dbx.AlphaUploadContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) AlphaUploadContext(ctx context.Context, arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
 	log.Printf("WARNING: API `AlphaUpload` is deprecated")
 	log.Printf("Use API `Upload` instead")
 
 	req := dropbox.Request{
-		Host:         "content",
-		Namespace:    "files",
-		Route:        "alpha/upload",
-		Auth:         "user",
-		Style:        "upload",
-		Arg:          arg,
-		ExtraHeaders: nil,
+		Host:               "content",
+		Namespace:          "files",
+		Route:              "alpha/upload",
+		Auth:               "user",
+		Style:              "upload",
+		Arg:                arg,
+		ExtraHeaders:       nil,
+		AcceptsContentHash: true,
+		Options:            opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr AlphaUploadAPIError
 		err = auth.ParseError(err, &appErr)
@@ -561,7 +796,34 @@ type CopyV2APIError struct {
 	EndpointError *RelocationError `json:"error"`
 }
 
-func (dbx *apiImpl) CopyV2(arg *RelocationArg) (res *RelocationResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CopyV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CopyV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.FromLookup != nil && m.MatchEndpointError(err.FromLookup) ||
+		err.FromWrite != nil && m.MatchEndpointError(err.FromWrite) ||
+		err.FromWrite != nil && err.FromWrite.Conflict != nil && m.MatchEndpointError(err.FromWrite.Conflict) ||
+		err.To != nil && m.MatchEndpointError(err.To) ||
+		err.To != nil && err.To.Conflict != nil && m.MatchEndpointError(err.To.Conflict) ||
+		err.CantMoveIntoVault != nil && m.MatchEndpointError(err.CantMoveIntoVault) ||
+		err.CantMoveIntoFamily != nil && m.MatchEndpointError(err.CantMoveIntoFamily)
+}
+
+func (dbx *apiImpl) CopyV2(arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error) {
+	return dbx.CopyV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CopyV2Context(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -570,11 +832,12 @@ func (dbx *apiImpl) CopyV2(arg *RelocationArg) (res *RelocationResult, err error
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CopyV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -599,7 +862,34 @@ type CopyAPIError struct {
 	EndpointError *RelocationError `json:"error"`
 }
 
-func (dbx *apiImpl) Copy(arg *RelocationArg) (res IsMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CopyAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CopyAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.FromLookup != nil && m.MatchEndpointError(err.FromLookup) ||
+		err.FromWrite != nil && m.MatchEndpointError(err.FromWrite) ||
+		err.FromWrite != nil && err.FromWrite.Conflict != nil && m.MatchEndpointError(err.FromWrite.Conflict) ||
+		err.To != nil && m.MatchEndpointError(err.To) ||
+		err.To != nil && err.To.Conflict != nil && m.MatchEndpointError(err.To.Conflict) ||
+		err.CantMoveIntoVault != nil && m.MatchEndpointError(err.CantMoveIntoVault) ||
+		err.CantMoveIntoFamily != nil && m.MatchEndpointError(err.CantMoveIntoFamily)
+}
+
+func (dbx *apiImpl) Copy(arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return dbx.CopyContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CopyContext(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
 	log.Printf("WARNING: API `Copy` is deprecated")
 	log.Printf("Use API `CopyV2` instead")
 
@@ -611,11 +901,12 @@ func (dbx *apiImpl) Copy(arg *RelocationArg) (res IsMetadata, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CopyAPIError
 		err = auth.ParseError(err, &appErr)
@@ -651,7 +942,16 @@ type CopyBatchV2APIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) CopyBatchV2(arg *RelocationBatchArgBase) (res *RelocationBatchV2Launch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CopyBatchV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) CopyBatchV2(arg *RelocationBatchArgBase, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error) {
+	return dbx.CopyBatchV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CopyBatchV2Context(ctx context.Context, arg *RelocationBatchArgBase, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -660,11 +960,12 @@ func (dbx *apiImpl) CopyBatchV2(arg *RelocationBatchArgBase) (res *RelocationBat
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CopyBatchV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -689,7 +990,16 @@ type CopyBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CopyBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) CopyBatch(arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error) {
+	return dbx.CopyBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CopyBatchContext(ctx context.Context, arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error) {
 	log.Printf("WARNING: API `CopyBatch` is deprecated")
 	log.Printf("Use API `CopyBatchV2` instead")
 
@@ -701,11 +1011,12 @@ func (dbx *apiImpl) CopyBatch(arg *RelocationBatchArg) (res *RelocationBatchLaun
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CopyBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -730,7 +1041,26 @@ type CopyBatchCheckV2APIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CopyBatchCheckV2(arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CopyBatchCheckV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CopyBatchCheckV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) CopyBatchCheckV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
+	return dbx.CopyBatchCheckV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CopyBatchCheckV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -739,11 +1069,12 @@ func (dbx *apiImpl) CopyBatchCheckV2(arg *async.PollArg) (res *RelocationBatchV2
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CopyBatchCheckV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -768,7 +1099,26 @@ type CopyBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CopyBatchCheckAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CopyBatchCheckAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) CopyBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
+	return dbx.CopyBatchCheckContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CopyBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
 	log.Printf("WARNING: API `CopyBatchCheck` is deprecated")
 	log.Printf("Use API `CopyBatchCheckV2` instead")
 
@@ -780,11 +1130,12 @@ func (dbx *apiImpl) CopyBatchCheck(arg *async.PollArg) (res *RelocationBatchJobS
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CopyBatchCheckAPIError
 		err = auth.ParseError(err, &appErr)
@@ -809,7 +1160,28 @@ type CopyReferenceGetAPIError struct {
 	EndpointError *GetCopyReferenceError `json:"error"`
 }
 
-func (dbx *apiImpl) CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyReferenceResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CopyReferenceGetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CopyReferenceGetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) CopyReferenceGet(arg *GetCopyReferenceArg, opts ...dropbox.CallOption) (res *GetCopyReferenceResult, err error) {
+	return dbx.CopyReferenceGetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CopyReferenceGetContext(ctx context.Context, arg *GetCopyReferenceArg, opts ...dropbox.CallOption) (res *GetCopyReferenceResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -818,11 +1190,12 @@ func (dbx *apiImpl) CopyReferenceGet(arg *GetCopyReferenceArg) (res *GetCopyRefe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CopyReferenceGetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -847,7 +1220,29 @@ type CopyReferenceSaveAPIError struct {
 	EndpointError *SaveCopyReferenceError `json:"error"`
 }
 
-func (dbx *apiImpl) CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyReferenceResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CopyReferenceSaveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CopyReferenceSaveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.Path != nil && err.Path.Conflict != nil && m.MatchEndpointError(err.Path.Conflict)
+}
+
+func (dbx *apiImpl) CopyReferenceSave(arg *SaveCopyReferenceArg, opts ...dropbox.CallOption) (res *SaveCopyReferenceResult, err error) {
+	return dbx.CopyReferenceSaveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CopyReferenceSaveContext(ctx context.Context, arg *SaveCopyReferenceArg, opts ...dropbox.CallOption) (res *SaveCopyReferenceResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -856,11 +1251,12 @@ func (dbx *apiImpl) CopyReferenceSave(arg *SaveCopyReferenceArg) (res *SaveCopyR
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CopyReferenceSaveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -885,7 +1281,29 @@ type CreateFolderV2APIError struct {
 	EndpointError *CreateFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) CreateFolderV2(arg *CreateFolderArg) (res *CreateFolderResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CreateFolderV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CreateFolderV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.Path != nil && err.Path.Conflict != nil && m.MatchEndpointError(err.Path.Conflict)
+}
+
+func (dbx *apiImpl) CreateFolderV2(arg *CreateFolderArg, opts ...dropbox.CallOption) (res *CreateFolderResult, err error) {
+	return dbx.CreateFolderV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CreateFolderV2Context(ctx context.Context, arg *CreateFolderArg, opts ...dropbox.CallOption) (res *CreateFolderResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -894,11 +1312,12 @@ func (dbx *apiImpl) CreateFolderV2(arg *CreateFolderArg) (res *CreateFolderResul
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CreateFolderV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -923,7 +1342,29 @@ type CreateFolderAPIError struct {
 	EndpointError *CreateFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CreateFolderAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CreateFolderAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.Path != nil && err.Path.Conflict != nil && m.MatchEndpointError(err.Path.Conflict)
+}
+
+func (dbx *apiImpl) CreateFolder(arg *CreateFolderArg, opts ...dropbox.CallOption) (res *FolderMetadata, err error) {
+	return dbx.CreateFolderContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CreateFolderContext(ctx context.Context, arg *CreateFolderArg, opts ...dropbox.CallOption) (res *FolderMetadata, err error) {
 	log.Printf("WARNING: API `CreateFolder` is deprecated")
 	log.Printf("Use API `CreateFolderV2` instead")
 
@@ -935,11 +1376,12 @@ func (dbx *apiImpl) CreateFolder(arg *CreateFolderArg) (res *FolderMetadata, err
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CreateFolderAPIError
 		err = auth.ParseError(err, &appErr)
@@ -964,7 +1406,16 @@ type CreateFolderBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) CreateFolderBatch(arg *CreateFolderBatchArg) (res *CreateFolderBatchLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CreateFolderBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) CreateFolderBatch(arg *CreateFolderBatchArg, opts ...dropbox.CallOption) (res *CreateFolderBatchLaunch, err error) {
+	return dbx.CreateFolderBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CreateFolderBatchContext(ctx context.Context, arg *CreateFolderBatchArg, opts ...dropbox.CallOption) (res *CreateFolderBatchLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -973,11 +1424,12 @@ func (dbx *apiImpl) CreateFolderBatch(arg *CreateFolderBatchArg) (res *CreateFol
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CreateFolderBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1002,7 +1454,26 @@ type CreateFolderBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CreateFolderBatchCheck(arg *async.PollArg) (res *CreateFolderBatchJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CreateFolderBatchCheckAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CreateFolderBatchCheckAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) CreateFolderBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *CreateFolderBatchJobStatus, err error) {
+	return dbx.CreateFolderBatchCheckContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CreateFolderBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *CreateFolderBatchJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1011,11 +1482,12 @@ func (dbx *apiImpl) CreateFolderBatchCheck(arg *async.PollArg) (res *CreateFolde
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CreateFolderBatchCheckAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1040,7 +1512,30 @@ type DeleteV2APIError struct {
 	EndpointError *DeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) DeleteV2(arg *DeleteArg) (res *DeleteResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DeleteV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DeleteV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PathLookup != nil && m.MatchEndpointError(err.PathLookup) ||
+		err.PathWrite != nil && m.MatchEndpointError(err.PathWrite) ||
+		err.PathWrite != nil && err.PathWrite.Conflict != nil && m.MatchEndpointError(err.PathWrite.Conflict)
+}
+
+func (dbx *apiImpl) DeleteV2(arg *DeleteArg, opts ...dropbox.CallOption) (res *DeleteResult, err error) {
+	return dbx.DeleteV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DeleteV2Context(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (res *DeleteResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1049,11 +1544,12 @@ func (dbx *apiImpl) DeleteV2(arg *DeleteArg) (res *DeleteResult, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DeleteV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -1078,7 +1574,30 @@ type DeleteAPIError struct {
 	EndpointError *DeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) Delete(arg *DeleteArg) (res IsMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DeleteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DeleteAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PathLookup != nil && m.MatchEndpointError(err.PathLookup) ||
+		err.PathWrite != nil && m.MatchEndpointError(err.PathWrite) ||
+		err.PathWrite != nil && err.PathWrite.Conflict != nil && m.MatchEndpointError(err.PathWrite.Conflict)
+}
+
+func (dbx *apiImpl) Delete(arg *DeleteArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return dbx.DeleteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DeleteContext(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
 	log.Printf("WARNING: API `Delete` is deprecated")
 	log.Printf("Use API `DeleteV2` instead")
 
@@ -1090,11 +1609,12 @@ func (dbx *apiImpl) Delete(arg *DeleteArg) (res IsMetadata, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DeleteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1130,7 +1650,16 @@ type DeleteBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DeleteBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) DeleteBatch(arg *DeleteBatchArg, opts ...dropbox.CallOption) (res *DeleteBatchLaunch, err error) {
+	return dbx.DeleteBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DeleteBatchContext(ctx context.Context, arg *DeleteBatchArg, opts ...dropbox.CallOption) (res *DeleteBatchLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1139,11 +1668,12 @@ func (dbx *apiImpl) DeleteBatch(arg *DeleteBatchArg) (res *DeleteBatchLaunch, er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DeleteBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1168,7 +1698,26 @@ type DeleteBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DeleteBatchCheckAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DeleteBatchCheckAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DeleteBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *DeleteBatchJobStatus, err error) {
+	return dbx.DeleteBatchCheckContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *DeleteBatchJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1177,11 +1726,12 @@ func (dbx *apiImpl) DeleteBatchCheck(arg *async.PollArg) (res *DeleteBatchJobSta
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DeleteBatchCheckAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1206,7 +1756,28 @@ type DownloadAPIError struct {
 	EndpointError *DownloadError `json:"error"`
 }
 
-func (dbx *apiImpl) Download(arg *DownloadArg) (res *FileMetadata, content io.ReadCloser, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DownloadAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DownloadAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) Download(arg *DownloadArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	return dbx.DownloadContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DownloadContext(ctx context.Context, arg *DownloadArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
 		Namespace:    "files",
@@ -1215,11 +1786,12 @@ func (dbx *apiImpl) Download(arg *DownloadArg) (res *FileMetadata, content io.Re
 		Style:        "download",
 		Arg:          arg,
 		ExtraHeaders: arg.ExtraHeaders,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DownloadAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1244,7 +1816,28 @@ type DownloadZipAPIError struct {
 	EndpointError *DownloadZipError `json:"error"`
 }
 
-func (dbx *apiImpl) DownloadZip(arg *DownloadZipArg) (res *DownloadZipResult, content io.ReadCloser, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DownloadZipAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DownloadZipAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) DownloadZip(arg *DownloadZipArg, opts ...dropbox.CallOption) (res *DownloadZipResult, content io.ReadCloser, err error) {
+	return dbx.DownloadZipContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DownloadZipContext(ctx context.Context, arg *DownloadZipArg, opts ...dropbox.CallOption) (res *DownloadZipResult, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
 		Namespace:    "files",
@@ -1253,11 +1846,12 @@ func (dbx *apiImpl) DownloadZip(arg *DownloadZipArg) (res *DownloadZipResult, co
 		Style:        "download",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DownloadZipAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1282,7 +1876,28 @@ type ExportAPIError struct {
 	EndpointError *ExportError `json:"error"`
 }
 
-func (dbx *apiImpl) Export(arg *ExportArg) (res *ExportResult, content io.ReadCloser, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ExportAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ExportAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) Export(arg *ExportArg, opts ...dropbox.CallOption) (res *ExportResult, content io.ReadCloser, err error) {
+	return dbx.ExportContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ExportContext(ctx context.Context, arg *ExportArg, opts ...dropbox.CallOption) (res *ExportResult, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
 		Namespace:    "files",
@@ -1291,11 +1906,12 @@ func (dbx *apiImpl) Export(arg *ExportArg) (res *ExportResult, content io.ReadCl
 		Style:        "download",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ExportAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1320,7 +1936,28 @@ type GetFileLockBatchAPIError struct {
 	EndpointError *LockFileError `json:"error"`
 }
 
-func (dbx *apiImpl) GetFileLockBatch(arg *LockFileBatchArg) (res *LockFileBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetFileLockBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetFileLockBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PathLookup != nil && m.MatchEndpointError(err.PathLookup)
+}
+
+func (dbx *apiImpl) GetFileLockBatch(arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	return dbx.GetFileLockBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetFileLockBatchContext(ctx context.Context, arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1329,11 +1966,12 @@ func (dbx *apiImpl) GetFileLockBatch(arg *LockFileBatchArg) (res *LockFileBatchR
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetFileLockBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1358,7 +1996,28 @@ type GetMetadataAPIError struct {
 	EndpointError *GetMetadataError `json:"error"`
 }
 
-func (dbx *apiImpl) GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetMetadataAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetMetadataAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) GetMetadata(arg *GetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return dbx.GetMetadataContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetMetadataContext(ctx context.Context, arg *GetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1367,11 +2026,12 @@ func (dbx *apiImpl) GetMetadata(arg *GetMetadataArg) (res IsMetadata, err error)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetMetadataAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1407,7 +2067,28 @@ type GetPreviewAPIError struct {
 	EndpointError *PreviewError `json:"error"`
 }
 
-func (dbx *apiImpl) GetPreview(arg *PreviewArg) (res *FileMetadata, content io.ReadCloser, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetPreviewAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetPreviewAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) GetPreview(arg *PreviewArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	return dbx.GetPreviewContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetPreviewContext(ctx context.Context, arg *PreviewArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
 		Namespace:    "files",
@@ -1416,11 +2097,12 @@ func (dbx *apiImpl) GetPreview(arg *PreviewArg) (res *FileMetadata, content io.R
 		Style:        "download",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetPreviewAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1445,7 +2127,28 @@ type GetTemporaryLinkAPIError struct {
 	EndpointError *GetTemporaryLinkError `json:"error"`
 }
 
-func (dbx *apiImpl) GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporaryLinkResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetTemporaryLinkAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetTemporaryLinkAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) GetTemporaryLink(arg *GetTemporaryLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryLinkResult, err error) {
+	return dbx.GetTemporaryLinkContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetTemporaryLinkContext(ctx context.Context, arg *GetTemporaryLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryLinkResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1454,11 +2157,12 @@ func (dbx *apiImpl) GetTemporaryLink(arg *GetTemporaryLinkArg) (res *GetTemporar
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetTemporaryLinkAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1483,7 +2187,16 @@ type GetTemporaryUploadLinkAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GetTemporaryUploadLink(arg *GetTemporaryUploadLinkArg) (res *GetTemporaryUploadLinkResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetTemporaryUploadLinkAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) GetTemporaryUploadLink(arg *GetTemporaryUploadLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryUploadLinkResult, err error) {
+	return dbx.GetTemporaryUploadLinkContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetTemporaryUploadLinkContext(ctx context.Context, arg *GetTemporaryUploadLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryUploadLinkResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1492,11 +2205,12 @@ func (dbx *apiImpl) GetTemporaryUploadLink(arg *GetTemporaryUploadLinkArg) (res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetTemporaryUploadLinkAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1521,7 +2235,28 @@ type GetThumbnailAPIError struct {
 	EndpointError *ThumbnailError `json:"error"`
 }
 
-func (dbx *apiImpl) GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content io.ReadCloser, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetThumbnailAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetThumbnailAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) GetThumbnail(arg *ThumbnailArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	return dbx.GetThumbnailContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetThumbnailContext(ctx context.Context, arg *ThumbnailArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
 		Namespace:    "files",
@@ -1530,11 +2265,12 @@ func (dbx *apiImpl) GetThumbnail(arg *ThumbnailArg) (res *FileMetadata, content
 		Style:        "download",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetThumbnailAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1559,7 +2295,28 @@ type GetThumbnailV2APIError struct {
 	EndpointError *ThumbnailV2Error `json:"error"`
 }
 
-func (dbx *apiImpl) GetThumbnailV2(arg *ThumbnailV2Arg) (res *PreviewResult, content io.ReadCloser, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetThumbnailV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetThumbnailV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) GetThumbnailV2(arg *ThumbnailV2Arg, opts ...dropbox.CallOption) (res *PreviewResult, content io.ReadCloser, err error) {
+	return dbx.GetThumbnailV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetThumbnailV2Context(ctx context.Context, arg *ThumbnailV2Arg, opts ...dropbox.CallOption) (res *PreviewResult, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
 		Namespace:    "files",
@@ -1568,11 +2325,12 @@ func (dbx *apiImpl) GetThumbnailV2(arg *ThumbnailV2Arg) (res *PreviewResult, con
 		Style:        "download",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetThumbnailV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -1597,7 +2355,26 @@ type GetThumbnailBatchAPIError struct {
 	EndpointError *GetThumbnailBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) GetThumbnailBatch(arg *GetThumbnailBatchArg) (res *GetThumbnailBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetThumbnailBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetThumbnailBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetThumbnailBatch(arg *GetThumbnailBatchArg, opts ...dropbox.CallOption) (res *GetThumbnailBatchResult, err error) {
+	return dbx.GetThumbnailBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetThumbnailBatchContext(ctx context.Context, arg *GetThumbnailBatchArg, opts ...dropbox.CallOption) (res *GetThumbnailBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "content",
 		Namespace:    "files",
@@ -1606,11 +2383,12 @@ func (dbx *apiImpl) GetThumbnailBatch(arg *GetThumbnailBatchArg) (res *GetThumbn
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetThumbnailBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1635,7 +2413,29 @@ type ListFolderAPIError struct {
 	EndpointError *ListFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolder(arg *ListFolderArg) (res *ListFolderResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFolderAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFolderAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.TemplateError != nil && m.MatchEndpointError(err.TemplateError)
+}
+
+func (dbx *apiImpl) ListFolder(arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error) {
+	return dbx.ListFolderContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFolderContext(ctx context.Context, arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1644,11 +2444,12 @@ func (dbx *apiImpl) ListFolder(arg *ListFolderArg) (res *ListFolderResult, err e
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFolderAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1673,7 +2474,28 @@ type ListFolderContinueAPIError struct {
 	EndpointError *ListFolderContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderContinue(arg *ListFolderContinueArg) (res *ListFolderResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFolderContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFolderContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) ListFolderContinue(arg *ListFolderContinueArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error) {
+	return dbx.ListFolderContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFolderContinueContext(ctx context.Context, arg *ListFolderContinueArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1682,11 +2504,12 @@ func (dbx *apiImpl) ListFolderContinue(arg *ListFolderContinueArg) (res *ListFol
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFolderContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1711,7 +2534,29 @@ type ListFolderGetLatestCursorAPIError struct {
 	EndpointError *ListFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFolderGetLatestCursorResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFolderGetLatestCursorAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFolderGetLatestCursorAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.TemplateError != nil && m.MatchEndpointError(err.TemplateError)
+}
+
+func (dbx *apiImpl) ListFolderGetLatestCursor(arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderGetLatestCursorResult, err error) {
+	return dbx.ListFolderGetLatestCursorContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFolderGetLatestCursorContext(ctx context.Context, arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderGetLatestCursorResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1720,11 +2565,12 @@ func (dbx *apiImpl) ListFolderGetLatestCursor(arg *ListFolderArg) (res *ListFold
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFolderGetLatestCursorAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1749,7 +2595,26 @@ type ListFolderLongpollAPIError struct {
 	EndpointError *ListFolderLongpollError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFolderLongpollResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFolderLongpollAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFolderLongpollAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ListFolderLongpoll(arg *ListFolderLongpollArg, opts ...dropbox.CallOption) (res *ListFolderLongpollResult, err error) {
+	return dbx.ListFolderLongpollContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFolderLongpollContext(ctx context.Context, arg *ListFolderLongpollArg, opts ...dropbox.CallOption) (res *ListFolderLongpollResult, err error) {
 	req := dropbox.Request{
 		Host:         "notify",
 		Namespace:    "files",
@@ -1758,11 +2623,12 @@ func (dbx *apiImpl) ListFolderLongpoll(arg *ListFolderLongpollArg) (res *ListFol
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFolderLongpollAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1787,7 +2653,28 @@ type ListRevisionsAPIError struct {
 	EndpointError *ListRevisionsError `json:"error"`
 }
 
-func (dbx *apiImpl) ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListRevisionsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListRevisionsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) ListRevisions(arg *ListRevisionsArg, opts ...dropbox.CallOption) (res *ListRevisionsResult, err error) {
+	return dbx.ListRevisionsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListRevisionsContext(ctx context.Context, arg *ListRevisionsArg, opts ...dropbox.CallOption) (res *ListRevisionsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1796,11 +2683,12 @@ func (dbx *apiImpl) ListRevisions(arg *ListRevisionsArg) (res *ListRevisionsResu
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListRevisionsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1825,7 +2713,28 @@ type LockFileBatchAPIError struct {
 	EndpointError *LockFileError `json:"error"`
 }
 
-func (dbx *apiImpl) LockFileBatch(arg *LockFileBatchArg) (res *LockFileBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LockFileBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LockFileBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PathLookup != nil && m.MatchEndpointError(err.PathLookup)
+}
+
+func (dbx *apiImpl) LockFileBatch(arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	return dbx.LockFileBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LockFileBatchContext(ctx context.Context, arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1834,11 +2743,12 @@ func (dbx *apiImpl) LockFileBatch(arg *LockFileBatchArg) (res *LockFileBatchResu
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LockFileBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1863,7 +2773,34 @@ type MoveV2APIError struct {
 	EndpointError *RelocationError `json:"error"`
 }
 
-func (dbx *apiImpl) MoveV2(arg *RelocationArg) (res *RelocationResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MoveV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MoveV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.FromLookup != nil && m.MatchEndpointError(err.FromLookup) ||
+		err.FromWrite != nil && m.MatchEndpointError(err.FromWrite) ||
+		err.FromWrite != nil && err.FromWrite.Conflict != nil && m.MatchEndpointError(err.FromWrite.Conflict) ||
+		err.To != nil && m.MatchEndpointError(err.To) ||
+		err.To != nil && err.To.Conflict != nil && m.MatchEndpointError(err.To.Conflict) ||
+		err.CantMoveIntoVault != nil && m.MatchEndpointError(err.CantMoveIntoVault) ||
+		err.CantMoveIntoFamily != nil && m.MatchEndpointError(err.CantMoveIntoFamily)
+}
+
+func (dbx *apiImpl) MoveV2(arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error) {
+	return dbx.MoveV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MoveV2Context(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1872,11 +2809,12 @@ func (dbx *apiImpl) MoveV2(arg *RelocationArg) (res *RelocationResult, err error
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MoveV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -1901,7 +2839,34 @@ type MoveAPIError struct {
 	EndpointError *RelocationError `json:"error"`
 }
 
-func (dbx *apiImpl) Move(arg *RelocationArg) (res IsMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MoveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MoveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.FromLookup != nil && m.MatchEndpointError(err.FromLookup) ||
+		err.FromWrite != nil && m.MatchEndpointError(err.FromWrite) ||
+		err.FromWrite != nil && err.FromWrite.Conflict != nil && m.MatchEndpointError(err.FromWrite.Conflict) ||
+		err.To != nil && m.MatchEndpointError(err.To) ||
+		err.To != nil && err.To.Conflict != nil && m.MatchEndpointError(err.To.Conflict) ||
+		err.CantMoveIntoVault != nil && m.MatchEndpointError(err.CantMoveIntoVault) ||
+		err.CantMoveIntoFamily != nil && m.MatchEndpointError(err.CantMoveIntoFamily)
+}
+
+func (dbx *apiImpl) Move(arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return dbx.MoveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MoveContext(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
 	log.Printf("WARNING: API `Move` is deprecated")
 	log.Printf("Use API `MoveV2` instead")
 
@@ -1913,11 +2878,12 @@ func (dbx *apiImpl) Move(arg *RelocationArg) (res IsMetadata, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MoveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1953,7 +2919,16 @@ type MoveBatchV2APIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MoveBatchV2(arg *MoveBatchArg) (res *RelocationBatchV2Launch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MoveBatchV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) MoveBatchV2(arg *MoveBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error) {
+	return dbx.MoveBatchV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MoveBatchV2Context(ctx context.Context, arg *MoveBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -1962,11 +2937,12 @@ func (dbx *apiImpl) MoveBatchV2(arg *MoveBatchArg) (res *RelocationBatchV2Launch
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MoveBatchV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -1991,7 +2967,16 @@ type MoveBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MoveBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) MoveBatch(arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error) {
+	return dbx.MoveBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MoveBatchContext(ctx context.Context, arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error) {
 	log.Printf("WARNING: API `MoveBatch` is deprecated")
 	log.Printf("Use API `MoveBatchV2` instead")
 
@@ -2003,11 +2988,12 @@ func (dbx *apiImpl) MoveBatch(arg *RelocationBatchArg) (res *RelocationBatchLaun
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MoveBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2032,7 +3018,26 @@ type MoveBatchCheckV2APIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MoveBatchCheckV2(arg *async.PollArg) (res *RelocationBatchV2JobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MoveBatchCheckV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MoveBatchCheckV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MoveBatchCheckV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
+	return dbx.MoveBatchCheckV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MoveBatchCheckV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2041,11 +3046,12 @@ func (dbx *apiImpl) MoveBatchCheckV2(arg *async.PollArg) (res *RelocationBatchV2
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MoveBatchCheckV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2070,7 +3076,26 @@ type MoveBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MoveBatchCheckAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MoveBatchCheckAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MoveBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
+	return dbx.MoveBatchCheckContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MoveBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
 	log.Printf("WARNING: API `MoveBatchCheck` is deprecated")
 	log.Printf("Use API `MoveBatchCheckV2` instead")
 
@@ -2082,11 +3107,12 @@ func (dbx *apiImpl) MoveBatchCheck(arg *async.PollArg) (res *RelocationBatchJobS
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MoveBatchCheckAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2111,7 +3137,26 @@ type PaperCreateAPIError struct {
 	EndpointError *PaperCreateError `json:"error"`
 }
 
-func (dbx *apiImpl) PaperCreate(arg *PaperCreateArg, content io.Reader) (res *PaperCreateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PaperCreateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PaperCreateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) PaperCreate(arg *PaperCreateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperCreateResult, err error) {
+	return dbx.PaperCreateContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) PaperCreateContext(ctx context.Context, arg *PaperCreateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperCreateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2120,11 +3165,12 @@ func (dbx *apiImpl) PaperCreate(arg *PaperCreateArg, content io.Reader) (res *Pa
 		Style:        "upload",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr PaperCreateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2149,7 +3195,28 @@ type PaperUpdateAPIError struct {
 	EndpointError *PaperUpdateError `json:"error"`
 }
 
-func (dbx *apiImpl) PaperUpdate(arg *PaperUpdateArg, content io.Reader) (res *PaperUpdateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PaperUpdateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PaperUpdateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) PaperUpdate(arg *PaperUpdateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperUpdateResult, err error) {
+	return dbx.PaperUpdateContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) PaperUpdateContext(ctx context.Context, arg *PaperUpdateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperUpdateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2158,11 +3225,12 @@ func (dbx *apiImpl) PaperUpdate(arg *PaperUpdateArg, content io.Reader) (res *Pa
 		Style:        "upload",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr PaperUpdateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2187,7 +3255,30 @@ type PermanentlyDeleteAPIError struct {
 	EndpointError *DeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) PermanentlyDelete(arg *DeleteArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PermanentlyDeleteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PermanentlyDeleteAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PathLookup != nil && m.MatchEndpointError(err.PathLookup) ||
+		err.PathWrite != nil && m.MatchEndpointError(err.PathWrite) ||
+		err.PathWrite != nil && err.PathWrite.Conflict != nil && m.MatchEndpointError(err.PathWrite.Conflict)
+}
+
+func (dbx *apiImpl) PermanentlyDelete(arg *DeleteArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PermanentlyDeleteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PermanentlyDeleteContext(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2196,11 +3287,12 @@ func (dbx *apiImpl) PermanentlyDelete(arg *DeleteArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PermanentlyDeleteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2221,7 +3313,28 @@ type PropertiesAddAPIError struct {
 	EndpointError *file_properties.AddPropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesAdd(arg *file_properties.AddPropertiesArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesAddAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) PropertiesAdd(arg *file_properties.AddPropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PropertiesAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesAddContext(ctx context.Context, arg *file_properties.AddPropertiesArg, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `PropertiesAdd` is deprecated")
 
 	req := dropbox.Request{
@@ -2232,11 +3345,12 @@ func (dbx *apiImpl) PropertiesAdd(arg *file_properties.AddPropertiesArg) (err er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2257,7 +3371,28 @@ type PropertiesOverwriteAPIError struct {
 	EndpointError *file_properties.InvalidPropertyGroupError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesOverwrite(arg *file_properties.OverwritePropertyGroupArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesOverwriteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesOverwriteAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) PropertiesOverwrite(arg *file_properties.OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PropertiesOverwriteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesOverwriteContext(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `PropertiesOverwrite` is deprecated")
 
 	req := dropbox.Request{
@@ -2268,11 +3403,12 @@ func (dbx *apiImpl) PropertiesOverwrite(arg *file_properties.OverwritePropertyGr
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesOverwriteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2293,7 +3429,29 @@ type PropertiesRemoveAPIError struct {
 	EndpointError *file_properties.RemovePropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesRemove(arg *file_properties.RemovePropertiesArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesRemoveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesRemoveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.PropertyGroupLookup != nil && m.MatchEndpointError(err.PropertyGroupLookup)
+}
+
+func (dbx *apiImpl) PropertiesRemove(arg *file_properties.RemovePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PropertiesRemoveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesRemoveContext(ctx context.Context, arg *file_properties.RemovePropertiesArg, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `PropertiesRemove` is deprecated")
 
 	req := dropbox.Request{
@@ -2304,11 +3462,12 @@ func (dbx *apiImpl) PropertiesRemove(arg *file_properties.RemovePropertiesArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesRemoveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2329,7 +3488,26 @@ type PropertiesTemplateGetAPIError struct {
 	EndpointError *file_properties.TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateGet(arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesTemplateGetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesTemplateGetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) PropertiesTemplateGet(arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error) {
+	return dbx.PropertiesTemplateGetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error) {
 	log.Printf("WARNING: API `PropertiesTemplateGet` is deprecated")
 
 	req := dropbox.Request{
@@ -2340,11 +3518,12 @@ func (dbx *apiImpl) PropertiesTemplateGet(arg *file_properties.GetTemplateArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesTemplateGetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2369,7 +3548,26 @@ type PropertiesTemplateListAPIError struct {
 	EndpointError *file_properties.TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateList() (res *file_properties.ListTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesTemplateListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesTemplateListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) PropertiesTemplateList(opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error) {
+	return dbx.PropertiesTemplateListContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) PropertiesTemplateListContext(ctx context.Context, opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error) {
 	log.Printf("WARNING: API `PropertiesTemplateList` is deprecated")
 
 	req := dropbox.Request{
@@ -2380,11 +3578,12 @@ func (dbx *apiImpl) PropertiesTemplateList() (res *file_properties.ListTemplateR
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesTemplateListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2409,7 +3608,29 @@ type PropertiesUpdateAPIError struct {
 	EndpointError *file_properties.UpdatePropertiesError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesUpdate(arg *file_properties.UpdatePropertiesArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesUpdateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesUpdateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.PropertyGroupLookup != nil && m.MatchEndpointError(err.PropertyGroupLookup)
+}
+
+func (dbx *apiImpl) PropertiesUpdate(arg *file_properties.UpdatePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.PropertiesUpdateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesUpdateContext(ctx context.Context, arg *file_properties.UpdatePropertiesArg, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `PropertiesUpdate` is deprecated")
 
 	req := dropbox.Request{
@@ -2420,11 +3641,12 @@ func (dbx *apiImpl) PropertiesUpdate(arg *file_properties.UpdatePropertiesArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesUpdateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2445,7 +3667,30 @@ type RestoreAPIError struct {
 	EndpointError *RestoreError `json:"error"`
 }
 
-func (dbx *apiImpl) Restore(arg *RestoreArg) (res *FileMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *RestoreAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e RestoreAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PathLookup != nil && m.MatchEndpointError(err.PathLookup) ||
+		err.PathWrite != nil && m.MatchEndpointError(err.PathWrite) ||
+		err.PathWrite != nil && err.PathWrite.Conflict != nil && m.MatchEndpointError(err.PathWrite.Conflict)
+}
+
+func (dbx *apiImpl) Restore(arg *RestoreArg, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	return dbx.RestoreContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) RestoreContext(ctx context.Context, arg *RestoreArg, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2454,11 +3699,12 @@ func (dbx *apiImpl) Restore(arg *RestoreArg) (res *FileMetadata, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr RestoreAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2483,7 +3729,29 @@ type SaveUrlAPIError struct {
 	EndpointError *SaveUrlError `json:"error"`
 }
 
-func (dbx *apiImpl) SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *SaveUrlAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e SaveUrlAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.Path != nil && err.Path.Conflict != nil && m.MatchEndpointError(err.Path.Conflict)
+}
+
+func (dbx *apiImpl) SaveUrl(arg *SaveUrlArg, opts ...dropbox.CallOption) (res *SaveUrlResult, err error) {
+	return dbx.SaveUrlContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) SaveUrlContext(ctx context.Context, arg *SaveUrlArg, opts ...dropbox.CallOption) (res *SaveUrlResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2492,11 +3760,12 @@ func (dbx *apiImpl) SaveUrl(arg *SaveUrlArg) (res *SaveUrlResult, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr SaveUrlAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2521,7 +3790,26 @@ type SaveUrlCheckJobStatusAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *SaveUrlCheckJobStatusAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e SaveUrlCheckJobStatusAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) SaveUrlCheckJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *SaveUrlJobStatus, err error) {
+	return dbx.SaveUrlCheckJobStatusContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *SaveUrlJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2530,11 +3818,12 @@ func (dbx *apiImpl) SaveUrlCheckJobStatus(arg *async.PollArg) (res *SaveUrlJobSt
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr SaveUrlCheckJobStatusAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2559,7 +3848,28 @@ type SearchAPIError struct {
 	EndpointError *SearchError `json:"error"`
 }
 
-func (dbx *apiImpl) Search(arg *SearchArg) (res *SearchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *SearchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e SearchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) Search(arg *SearchArg, opts ...dropbox.CallOption) (res *SearchResult, err error) {
+	return dbx.SearchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) SearchContext(ctx context.Context, arg *SearchArg, opts ...dropbox.CallOption) (res *SearchResult, err error) {
 	log.Printf("WARNING: API `Search` is deprecated")
 	log.Printf("Use API `SearchV2` instead")
 
@@ -2571,11 +3881,12 @@ func (dbx *apiImpl) Search(arg *SearchArg) (res *SearchResult, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr SearchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2600,7 +3911,28 @@ type SearchV2APIError struct {
 	EndpointError *SearchError `json:"error"`
 }
 
-func (dbx *apiImpl) SearchV2(arg *SearchV2Arg) (res *SearchV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *SearchV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e SearchV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) SearchV2(arg *SearchV2Arg, opts ...dropbox.CallOption) (res *SearchV2Result, err error) {
+	return dbx.SearchV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) SearchV2Context(ctx context.Context, arg *SearchV2Arg, opts ...dropbox.CallOption) (res *SearchV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2609,11 +3941,12 @@ func (dbx *apiImpl) SearchV2(arg *SearchV2Arg) (res *SearchV2Result, err error)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr SearchV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2638,7 +3971,28 @@ type SearchContinueV2APIError struct {
 	EndpointError *SearchError `json:"error"`
 }
 
-func (dbx *apiImpl) SearchContinueV2(arg *SearchV2ContinueArg) (res *SearchV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *SearchContinueV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e SearchContinueV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) SearchContinueV2(arg *SearchV2ContinueArg, opts ...dropbox.CallOption) (res *SearchV2Result, err error) {
+	return dbx.SearchContinueV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) SearchContinueV2Context(ctx context.Context, arg *SearchV2ContinueArg, opts ...dropbox.CallOption) (res *SearchV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2647,11 +4001,12 @@ func (dbx *apiImpl) SearchContinueV2(arg *SearchV2ContinueArg) (res *SearchV2Res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr SearchContinueV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2676,7 +4031,28 @@ type TagsAddAPIError struct {
 	EndpointError *AddTagError `json:"error"`
 }
 
-func (dbx *apiImpl) TagsAdd(arg *AddTagArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TagsAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TagsAddAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) TagsAdd(arg *AddTagArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.TagsAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TagsAddContext(ctx context.Context, arg *AddTagArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2685,11 +4061,12 @@ func (dbx *apiImpl) TagsAdd(arg *AddTagArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TagsAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2710,7 +4087,28 @@ type TagsGetAPIError struct {
 	EndpointError *BaseTagError `json:"error"`
 }
 
-func (dbx *apiImpl) TagsGet(arg *GetTagsArg) (res *GetTagsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TagsGetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TagsGetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) TagsGet(arg *GetTagsArg, opts ...dropbox.CallOption) (res *GetTagsResult, err error) {
+	return dbx.TagsGetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TagsGetContext(ctx context.Context, arg *GetTagsArg, opts ...dropbox.CallOption) (res *GetTagsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2719,11 +4117,12 @@ func (dbx *apiImpl) TagsGet(arg *GetTagsArg) (res *GetTagsResult, err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TagsGetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2748,7 +4147,28 @@ type TagsRemoveAPIError struct {
 	EndpointError *RemoveTagError `json:"error"`
 }
 
-func (dbx *apiImpl) TagsRemove(arg *RemoveTagArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TagsRemoveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TagsRemoveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) TagsRemove(arg *RemoveTagArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.TagsRemoveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TagsRemoveContext(ctx context.Context, arg *RemoveTagArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2757,11 +4177,12 @@ func (dbx *apiImpl) TagsRemove(arg *RemoveTagArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TagsRemoveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2782,7 +4203,28 @@ type UnlockFileBatchAPIError struct {
 	EndpointError *LockFileError `json:"error"`
 }
 
-func (dbx *apiImpl) UnlockFileBatch(arg *UnlockFileBatchArg) (res *LockFileBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UnlockFileBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UnlockFileBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PathLookup != nil && m.MatchEndpointError(err.PathLookup)
+}
+
+func (dbx *apiImpl) UnlockFileBatch(arg *UnlockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	return dbx.UnlockFileBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UnlockFileBatchContext(ctx context.Context, arg *UnlockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -2791,11 +4233,12 @@ func (dbx *apiImpl) UnlockFileBatch(arg *UnlockFileBatchArg) (res *LockFileBatch
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UnlockFileBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2820,20 +4263,46 @@ type UploadAPIError struct {
 	EndpointError *UploadError `json:"error"`
 }
 
-func (dbx *apiImpl) Upload(arg *UploadArg, content io.Reader) (res *FileMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UploadAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && err.Path.Reason != nil && m.MatchEndpointError(err.Path.Reason) ||
+		err.Path != nil && err.Path.Reason != nil && err.Path.Reason.Conflict != nil && m.MatchEndpointError(err.Path.Reason.Conflict) ||
+		err.PropertiesError != nil && m.MatchEndpointError(err.PropertiesError) ||
+		err.PropertiesError != nil && err.PropertiesError.Path != nil && m.MatchEndpointError(err.PropertiesError.Path)
+}
+
+func (dbx *apiImpl) Upload(arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	return dbx.UploadContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) UploadContext(ctx context.Context, arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
 	req := dropbox.Request{
-		Host:         "content",
-		Namespace:    "files",
-		Route:        "upload",
-		Auth:         "user",
-		Style:        "upload",
-		Arg:          arg,
-		ExtraHeaders: nil,
+		Host:               "content",
+		Namespace:          "files",
+		Route:              "upload",
+		Auth:               "user",
+		Style:              "upload",
+		Arg:                arg,
+		ExtraHeaders:       nil,
+		AcceptsContentHash: true,
+		Options:            opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr UploadAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2858,20 +4327,41 @@ type UploadSessionAppendV2APIError struct {
 	EndpointError *UploadSessionAppendError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadSessionAppendV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UploadSessionAppendV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) (err error) {
+	return dbx.UploadSessionAppendV2Context(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) UploadSessionAppendV2Context(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
-		Host:         "content",
-		Namespace:    "files",
-		Route:        "upload_session/append_v2",
-		Auth:         "user",
-		Style:        "upload",
-		Arg:          arg,
-		ExtraHeaders: nil,
+		Host:               "content",
+		Namespace:          "files",
+		Route:              "upload_session/append_v2",
+		Auth:               "user",
+		Style:              "upload",
+		Arg:                arg,
+		ExtraHeaders:       nil,
+		AcceptsContentHash: true,
+		Options:            opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr UploadSessionAppendV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2892,7 +4382,26 @@ type UploadSessionAppendAPIError struct {
 	EndpointError *UploadSessionAppendError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionAppend(arg *UploadSessionCursor, content io.Reader) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadSessionAppendAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UploadSessionAppendAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) UploadSessionAppend(arg *UploadSessionCursor, content io.Reader, opts ...dropbox.CallOption) (err error) {
+	return dbx.UploadSessionAppendContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) UploadSessionAppendContext(ctx context.Context, arg *UploadSessionCursor, content io.Reader, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `UploadSessionAppend` is deprecated")
 	log.Printf("Use API `UploadSessionAppendV2` instead")
 
@@ -2904,11 +4413,12 @@ func (dbx *apiImpl) UploadSessionAppend(arg *UploadSessionCursor, content io.Rea
 		Style:        "upload",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr UploadSessionAppendAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2929,20 +4439,47 @@ type UploadSessionFinishAPIError struct {
 	EndpointError *UploadSessionFinishError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader) (res *FileMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadSessionFinishAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UploadSessionFinishAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.LookupFailed != nil && m.MatchEndpointError(err.LookupFailed) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.Path != nil && err.Path.Conflict != nil && m.MatchEndpointError(err.Path.Conflict) ||
+		err.PropertiesError != nil && m.MatchEndpointError(err.PropertiesError) ||
+		err.PropertiesError != nil && err.PropertiesError.Path != nil && m.MatchEndpointError(err.PropertiesError.Path)
+}
+
+func (dbx *apiImpl) UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	return dbx.UploadSessionFinishContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) UploadSessionFinishContext(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
 	req := dropbox.Request{
-		Host:         "content",
-		Namespace:    "files",
-		Route:        "upload_session/finish",
-		Auth:         "user",
-		Style:        "upload",
-		Arg:          arg,
-		ExtraHeaders: nil,
+		Host:               "content",
+		Namespace:          "files",
+		Route:              "upload_session/finish",
+		Auth:               "user",
+		Style:              "upload",
+		Arg:                arg,
+		ExtraHeaders:       nil,
+		AcceptsContentHash: true,
+		Options:            opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr UploadSessionFinishAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2967,7 +4504,16 @@ type UploadSessionFinishBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadSessionFinishBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchLaunch, err error) {
+	return dbx.UploadSessionFinishBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UploadSessionFinishBatchContext(ctx context.Context, arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchLaunch, err error) {
 	log.Printf("WARNING: API `UploadSessionFinishBatch` is deprecated")
 	log.Printf("Use API `UploadSessionFinishBatchV2` instead")
 
@@ -2979,11 +4525,12 @@ func (dbx *apiImpl) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UploadSessionFinishBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3008,7 +4555,16 @@ type UploadSessionFinishBatchV2APIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionFinishBatchV2(arg *UploadSessionFinishBatchArg) (res *UploadSessionFinishBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadSessionFinishBatchV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) UploadSessionFinishBatchV2(arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchResult, err error) {
+	return dbx.UploadSessionFinishBatchV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UploadSessionFinishBatchV2Context(ctx context.Context, arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -3017,11 +4573,12 @@ func (dbx *apiImpl) UploadSessionFinishBatchV2(arg *UploadSessionFinishBatchArg)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UploadSessionFinishBatchV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -3046,7 +4603,26 @@ type UploadSessionFinishBatchCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *UploadSessionFinishBatchJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadSessionFinishBatchCheckAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UploadSessionFinishBatchCheckAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) UploadSessionFinishBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchJobStatus, err error) {
+	return dbx.UploadSessionFinishBatchCheckContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -3055,11 +4631,12 @@ func (dbx *apiImpl) UploadSessionFinishBatchCheck(arg *async.PollArg) (res *Uplo
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UploadSessionFinishBatchCheckAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3084,20 +4661,41 @@ type UploadSessionStartAPIError struct {
 	EndpointError *UploadSessionStartError `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionStart(arg *UploadSessionStartArg, content io.Reader) (res *UploadSessionStartResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadSessionStartAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UploadSessionStartAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) UploadSessionStart(arg *UploadSessionStartArg, content io.Reader, opts ...dropbox.CallOption) (res *UploadSessionStartResult, err error) {
+	return dbx.UploadSessionStartContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) UploadSessionStartContext(ctx context.Context, arg *UploadSessionStartArg, content io.Reader, opts ...dropbox.CallOption) (res *UploadSessionStartResult, err error) {
 	req := dropbox.Request{
-		Host:         "content",
-		Namespace:    "files",
-		Route:        "upload_session/start",
-		Auth:         "user",
-		Style:        "upload",
-		Arg:          arg,
-		ExtraHeaders: nil,
+		Host:               "content",
+		Namespace:          "files",
+		Route:              "upload_session/start",
+		Auth:               "user",
+		Style:              "upload",
+		Arg:                arg,
+		ExtraHeaders:       nil,
+		AcceptsContentHash: true,
+		Options:            opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr UploadSessionStartAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3122,7 +4720,16 @@ type UploadSessionStartBatchAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) UploadSessionStartBatch(arg *UploadSessionStartBatchArg) (res *UploadSessionStartBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UploadSessionStartBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) UploadSessionStartBatch(arg *UploadSessionStartBatchArg, opts ...dropbox.CallOption) (res *UploadSessionStartBatchResult, err error) {
+	return dbx.UploadSessionStartBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UploadSessionStartBatchContext(ctx context.Context, arg *UploadSessionStartBatchArg, opts ...dropbox.CallOption) (res *UploadSessionStartBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "files",
@@ -3131,11 +4738,12 @@ func (dbx *apiImpl) UploadSessionStartBatch(arg *UploadSessionStartBatchArg) (re
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UploadSessionStartBatchAPIError
 		err = auth.ParseError(err, &appErr)
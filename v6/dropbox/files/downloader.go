@@ -0,0 +1,221 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// DefaultRangeSize is the size of the byte ranges fetched by a Downloader
+// unless configured otherwise.
+const DefaultRangeSize = 16 << 20
+
+// ErrNotFile is returned by Downloader.Download if the path does not refer
+// to a file.
+var ErrNotFile = errors.New("files: path is not a file")
+
+// Downloader downloads files of arbitrary size into an io.WriterAt, like
+// *os.File, fetching several byte ranges in parallel.
+//
+// Every range is downloaded from the revision of the file found when the
+// download starts, so concurrent modifications of the file can not mix two
+// versions. If reading a range fails, e.g. because the connection dropped,
+// the range is requested again starting at the last byte written. Failed
+// requests are retried according to the Config's RetryPolicy, but errors
+// writing to the io.WriterAt are returned straight away.
+//
+// Each range must be answered with 206 Partial Content and a Content-Range
+// header matching the requested bytes, otherwise the download fails rather
+// than writing e.g. the whole file at the offset of the range. Clients not
+// backed by the API, like fakes, report no response and are trusted.
+type Downloader struct {
+	// Size of each byte range. Defaults to DefaultRangeSize.
+	RangeSize int64
+	// Maximum number of ranges downloaded in parallel. Defaults to 4.
+	Concurrency int
+	// Maximum number of times a range is resumed after a failed read.
+	// Defaults to 3.
+	MaxRetries int
+
+	client Client
+}
+
+// NewDownloader returns a new Downloader sending requests through client.
+func NewDownloader(client Client) *Downloader {
+	return &Downloader{client: client}
+}
+
+func (d *Downloader) rangeSize() int64 {
+	if d.RangeSize <= 0 {
+		return DefaultRangeSize
+	}
+	return d.RangeSize
+}
+
+func (d *Downloader) concurrency() int {
+	if d.Concurrency <= 0 {
+		return defaultConcurrency
+	}
+	return d.Concurrency
+}
+
+func (d *Downloader) maxRetries() int {
+	if d.MaxRetries <= 0 {
+		return defaultMaxRetries
+	}
+	return d.MaxRetries
+}
+
+// Download downloads the file at path, which may also be an ID or a
+// `rev:` path, and writes its content to w. It returns the metadata of the
+// downloaded revision.
+func (d *Downloader) Download(ctx context.Context, path string, w io.WriterAt) (*FileMetadata, error) {
+	meta, err := d.client.GetMetadataContext(ctx, NewGetMetadataArg(path))
+	if err != nil {
+		return nil, err
+	}
+	file, ok := meta.(*FileMetadata)
+	if !ok {
+		return nil, ErrNotFile
+	}
+	rev := "rev:" + file.Rev
+	size := int64(file.Size)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	offsets := make(chan int64)
+	for i := 0; i < d.concurrency(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for offset := range offsets {
+				end := offset + min64(d.rangeSize(), size-offset)
+				if err := d.downloadRange(ctx, rev, w, offset, end); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+loop:
+	for offset := int64(0); offset < size; offset += d.rangeSize() {
+		select {
+		case offsets <- offset:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(offsets)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// downloadRange writes the bytes in [offset, end) of the file at rev to w,
+// resuming from the last byte written if reading the content fails.
+func (d *Downloader) downloadRange(ctx context.Context, rev string, w io.WriterAt, offset, end int64) error {
+	for retries := 0; ; retries++ {
+		arg := NewDownloadArg(rev)
+		arg.ExtraHeaders = map[string]string{"Range": fmt.Sprintf("bytes=%d-%d", offset, end-1)}
+		var resp *dropbox.Response
+		_, content, err := d.client.DownloadContext(ctx, arg, dropbox.OnResponse(func(r *dropbox.Response) {
+			resp = r
+		}))
+		if err != nil {
+			return err
+		}
+		if err = checkRange(resp, offset, end); err != nil {
+			content.Close()
+			return err
+		}
+
+		ow := &offsetWriter{w: w, offset: offset}
+		n, err := io.CopyN(ow, content, end-offset)
+		content.Close()
+		offset += n
+		if err == nil {
+			return nil
+		}
+		if ow.err != nil {
+			return ow.err
+		}
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		if ctx.Err() != nil || retries >= d.maxRetries() {
+			return err
+		}
+	}
+}
+
+// checkRange returns an error unless resp is the partial content of the
+// bytes in [offset, end). A nil resp is not checked.
+func checkRange(resp *dropbox.Response, offset, end int64) error {
+	if resp == nil {
+		return nil
+	}
+	contentRange := resp.Header.Get("Content-Range")
+	var first, last int64
+	if resp.StatusCode == http.StatusPartialContent {
+		if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/", &first, &last); err == nil && first == offset && last == end-1 {
+			return nil
+		}
+	}
+	return fmt.Errorf("files: expected bytes %d-%d, got status %d with Content-Range %q",
+		offset, end-1, resp.StatusCode, contentRange)
+}
+
+// offsetWriter writes sequentially to an io.WriterAt, starting at offset. It
+// keeps the error of the io.WriterAt, to tell it apart from read errors.
+type offsetWriter struct {
+	w      io.WriterAt
+	offset int64
+	err    error
+}
+
+func (o *offsetWriter) Write(p []byte) (int, error) {
+	n, err := o.w.WriteAt(p, o.offset)
+	o.offset += int64(n)
+	o.err = err
+	return n, err
+}
@@ -0,0 +1,187 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxtest"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// rangeClient serves ranges of a single file revision.
+type rangeClient struct {
+	files.Client
+
+	content []byte
+	rev     string
+
+	mu        sync.Mutex
+	downloads int
+	// Number of downloads whose content breaks off after 1000 bytes.
+	broken int
+}
+
+func (c *rangeClient) GetMetadataContext(ctx context.Context, arg *files.GetMetadataArg, opts ...dropbox.CallOption) (files.IsMetadata, error) {
+	return files.NewFileMetadata("a.bin", "id:a", time.Time{}, time.Time{}, c.rev, uint64(len(c.content))), nil
+}
+
+func (c *rangeClient) DownloadContext(ctx context.Context, arg *files.DownloadArg, opts ...dropbox.CallOption) (*files.FileMetadata, io.ReadCloser, error) {
+	if arg.Path != "rev:"+c.rev {
+		return nil, nil, fmt.Errorf("unexpected path %s", arg.Path)
+	}
+	var start, end int
+	if _, err := fmt.Sscanf(arg.ExtraHeaders["Range"], "bytes=%d-%d", &start, &end); err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.downloads++
+	var r io.Reader = bytes.NewReader(c.content[start : end+1])
+	if c.broken > 0 && end+1-start > 1000 {
+		c.broken--
+		r = io.MultiReader(io.LimitReader(r, 1000), errReader{})
+	}
+	return nil, ioutil.NopCloser(r), nil
+}
+
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+// writerAt is an in-memory io.WriterAt.
+type writerAt struct {
+	mu  sync.Mutex
+	buf []byte
+}
+
+func (w *writerAt) WriteAt(p []byte, off int64) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	copy(w.buf[off:], p)
+	return len(p), nil
+}
+
+func TestDownloader(t *testing.T) {
+	content := make([]byte, 10<<20+123)
+	for i := range content {
+		content[i] = byte(i * 7)
+	}
+
+	client := &rangeClient{content: content, rev: "a1c10ce0dd78", broken: 2}
+	downloader := files.NewDownloader(client)
+	downloader.RangeSize = 1 << 20
+
+	w := &writerAt{buf: make([]byte, len(content))}
+	res, err := downloader.Download(context.Background(), "/a.bin", w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Rev != client.rev {
+		t.Errorf("Unexpected rev %s", res.Rev)
+	}
+	if !bytes.Equal(w.buf, content) {
+		t.Error("Downloaded content differs")
+	}
+	if client.downloads != 11+2 {
+		t.Errorf("Unexpected number of downloads: %d", client.downloads)
+	}
+
+	// Give up after too many broken connections.
+	client.broken = 100
+	downloader.MaxRetries = 1
+	if _, err = downloader.Download(context.Background(), "/a.bin", w); err == nil {
+		t.Error("Expected error")
+	}
+}
+
+// failingWriterAt fails every write.
+type failingWriterAt struct{}
+
+func (failingWriterAt) WriteAt(p []byte, off int64) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestDownloaderWriteError(t *testing.T) {
+	client := &rangeClient{content: make([]byte, 3000), rev: "a1c10ce0dd78"}
+	downloader := files.NewDownloader(client)
+	downloader.Concurrency = 1
+	_, err := downloader.Download(context.Background(), "/a.bin", failingWriterAt{})
+	if err == nil || err.Error() != "disk full" {
+		t.Errorf("Expected write error, got %v", err)
+	}
+	if client.downloads != 1 {
+		t.Errorf("Expected write error not to be retried, got %d downloads", client.downloads)
+	}
+}
+
+// ignoreRange drops the Range header of requests, like a proxy that does
+// not support ranges.
+type ignoreRange struct {
+	http.RoundTripper
+}
+
+func (t ignoreRange) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Del("Range")
+	return t.RoundTripper.RoundTrip(req)
+}
+
+func TestDownloaderIgnoredRange(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	content := make([]byte, 3000)
+	for i := range content {
+		content[i] = byte(i * 7)
+	}
+	if _, err := srv.WriteFile("/a.bin", content); err != nil {
+		t.Fatal(err)
+	}
+
+	config := srv.Config()
+	downloader := files.NewDownloader(files.New(config))
+	downloader.RangeSize = 1000
+	w := &writerAt{buf: make([]byte, len(content))}
+	if _, err := downloader.Download(context.Background(), "/a.bin", w); err != nil || !bytes.Equal(w.buf, content) {
+		t.Fatalf("Expected ranged download to succeed, got %v", err)
+	}
+
+	config.Client = &http.Client{Transport: ignoreRange{config.Client.Transport}}
+	downloader = files.NewDownloader(files.New(config))
+	downloader.RangeSize = 1000
+	_, err := downloader.Download(context.Background(), "/a.bin", w)
+	if err == nil || !strings.Contains(err.Error(), "status 200") {
+		t.Errorf("Expected ignored range to fail, got %v", err)
+	}
+}
@@ -0,0 +1,1144 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"context"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	AlphaGetMetadataFunc              func(ctx context.Context, arg *AlphaGetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	AlphaUploadFunc                   func(ctx context.Context, arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
+	CopyV2Func                        func(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error)
+	CopyFunc                          func(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	CopyBatchV2Func                   func(ctx context.Context, arg *RelocationBatchArgBase, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error)
+	CopyBatchFunc                     func(ctx context.Context, arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error)
+	CopyBatchCheckV2Func              func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error)
+	CopyBatchCheckFunc                func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error)
+	CopyReferenceGetFunc              func(ctx context.Context, arg *GetCopyReferenceArg, opts ...dropbox.CallOption) (res *GetCopyReferenceResult, err error)
+	CopyReferenceSaveFunc             func(ctx context.Context, arg *SaveCopyReferenceArg, opts ...dropbox.CallOption) (res *SaveCopyReferenceResult, err error)
+	CreateFolderV2Func                func(ctx context.Context, arg *CreateFolderArg, opts ...dropbox.CallOption) (res *CreateFolderResult, err error)
+	CreateFolderFunc                  func(ctx context.Context, arg *CreateFolderArg, opts ...dropbox.CallOption) (res *FolderMetadata, err error)
+	CreateFolderBatchFunc             func(ctx context.Context, arg *CreateFolderBatchArg, opts ...dropbox.CallOption) (res *CreateFolderBatchLaunch, err error)
+	CreateFolderBatchCheckFunc        func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *CreateFolderBatchJobStatus, err error)
+	DeleteV2Func                      func(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (res *DeleteResult, err error)
+	DeleteFunc                        func(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	DeleteBatchFunc                   func(ctx context.Context, arg *DeleteBatchArg, opts ...dropbox.CallOption) (res *DeleteBatchLaunch, err error)
+	DeleteBatchCheckFunc              func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *DeleteBatchJobStatus, err error)
+	DownloadFunc                      func(ctx context.Context, arg *DownloadArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
+	DownloadZipFunc                   func(ctx context.Context, arg *DownloadZipArg, opts ...dropbox.CallOption) (res *DownloadZipResult, content io.ReadCloser, err error)
+	ExportFunc                        func(ctx context.Context, arg *ExportArg, opts ...dropbox.CallOption) (res *ExportResult, content io.ReadCloser, err error)
+	GetFileLockBatchFunc              func(ctx context.Context, arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
+	GetMetadataFunc                   func(ctx context.Context, arg *GetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	GetPreviewFunc                    func(ctx context.Context, arg *PreviewArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
+	GetTemporaryLinkFunc              func(ctx context.Context, arg *GetTemporaryLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryLinkResult, err error)
+	GetTemporaryUploadLinkFunc        func(ctx context.Context, arg *GetTemporaryUploadLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryUploadLinkResult, err error)
+	GetThumbnailFunc                  func(ctx context.Context, arg *ThumbnailArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error)
+	GetThumbnailV2Func                func(ctx context.Context, arg *ThumbnailV2Arg, opts ...dropbox.CallOption) (res *PreviewResult, content io.ReadCloser, err error)
+	GetThumbnailBatchFunc             func(ctx context.Context, arg *GetThumbnailBatchArg, opts ...dropbox.CallOption) (res *GetThumbnailBatchResult, err error)
+	ListFolderFunc                    func(ctx context.Context, arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error)
+	ListFolderContinueFunc            func(ctx context.Context, arg *ListFolderContinueArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error)
+	ListFolderGetLatestCursorFunc     func(ctx context.Context, arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderGetLatestCursorResult, err error)
+	ListFolderLongpollFunc            func(ctx context.Context, arg *ListFolderLongpollArg, opts ...dropbox.CallOption) (res *ListFolderLongpollResult, err error)
+	ListRevisionsFunc                 func(ctx context.Context, arg *ListRevisionsArg, opts ...dropbox.CallOption) (res *ListRevisionsResult, err error)
+	LockFileBatchFunc                 func(ctx context.Context, arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
+	MoveV2Func                        func(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error)
+	MoveFunc                          func(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error)
+	MoveBatchV2Func                   func(ctx context.Context, arg *MoveBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error)
+	MoveBatchFunc                     func(ctx context.Context, arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error)
+	MoveBatchCheckV2Func              func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error)
+	MoveBatchCheckFunc                func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error)
+	PaperCreateFunc                   func(ctx context.Context, arg *PaperCreateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperCreateResult, err error)
+	PaperUpdateFunc                   func(ctx context.Context, arg *PaperUpdateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperUpdateResult, err error)
+	PermanentlyDeleteFunc             func(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (err error)
+	PropertiesAddFunc                 func(ctx context.Context, arg *file_properties.AddPropertiesArg, opts ...dropbox.CallOption) (err error)
+	PropertiesOverwriteFunc           func(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error)
+	PropertiesRemoveFunc              func(ctx context.Context, arg *file_properties.RemovePropertiesArg, opts ...dropbox.CallOption) (err error)
+	PropertiesTemplateGetFunc         func(ctx context.Context, arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error)
+	PropertiesTemplateListFunc        func(ctx context.Context, opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error)
+	PropertiesUpdateFunc              func(ctx context.Context, arg *file_properties.UpdatePropertiesArg, opts ...dropbox.CallOption) (err error)
+	RestoreFunc                       func(ctx context.Context, arg *RestoreArg, opts ...dropbox.CallOption) (res *FileMetadata, err error)
+	SaveUrlFunc                       func(ctx context.Context, arg *SaveUrlArg, opts ...dropbox.CallOption) (res *SaveUrlResult, err error)
+	SaveUrlCheckJobStatusFunc         func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *SaveUrlJobStatus, err error)
+	SearchFunc                        func(ctx context.Context, arg *SearchArg, opts ...dropbox.CallOption) (res *SearchResult, err error)
+	SearchV2Func                      func(ctx context.Context, arg *SearchV2Arg, opts ...dropbox.CallOption) (res *SearchV2Result, err error)
+	SearchContinueV2Func              func(ctx context.Context, arg *SearchV2ContinueArg, opts ...dropbox.CallOption) (res *SearchV2Result, err error)
+	TagsAddFunc                       func(ctx context.Context, arg *AddTagArg, opts ...dropbox.CallOption) (err error)
+	TagsGetFunc                       func(ctx context.Context, arg *GetTagsArg, opts ...dropbox.CallOption) (res *GetTagsResult, err error)
+	TagsRemoveFunc                    func(ctx context.Context, arg *RemoveTagArg, opts ...dropbox.CallOption) (err error)
+	UnlockFileBatchFunc               func(ctx context.Context, arg *UnlockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error)
+	UploadFunc                        func(ctx context.Context, arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
+	UploadSessionAppendV2Func         func(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) (err error)
+	UploadSessionAppendFunc           func(ctx context.Context, arg *UploadSessionCursor, content io.Reader, opts ...dropbox.CallOption) (err error)
+	UploadSessionFinishFunc           func(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error)
+	UploadSessionFinishBatchFunc      func(ctx context.Context, arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchLaunch, err error)
+	UploadSessionFinishBatchV2Func    func(ctx context.Context, arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchResult, err error)
+	UploadSessionFinishBatchCheckFunc func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchJobStatus, err error)
+	UploadSessionStartFunc            func(ctx context.Context, arg *UploadSessionStartArg, content io.Reader, opts ...dropbox.CallOption) (res *UploadSessionStartResult, err error)
+	UploadSessionStartBatchFunc       func(ctx context.Context, arg *UploadSessionStartBatchArg, opts ...dropbox.CallOption) (res *UploadSessionStartBatchResult, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// AlphaGetMetadata implements Client.
+func (f *Fake) AlphaGetMetadata(arg *AlphaGetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return f.AlphaGetMetadataContext(context.Background(), arg, opts...)
+}
+
+// AlphaGetMetadataContext implements Client.
+func (f *Fake) AlphaGetMetadataContext(ctx context.Context, arg *AlphaGetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	f.Record("AlphaGetMetadata", opts, arg)
+	if f.AlphaGetMetadataFunc == nil {
+		err = dropbox.NotImplemented("files", "AlphaGetMetadata")
+		return
+	}
+	return f.AlphaGetMetadataFunc(ctx, arg, opts...)
+}
+
+// AlphaUpload implements Client.
+func (f *Fake) AlphaUpload(arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	return f.AlphaUploadContext(context.Background(), arg, content, opts...)
+}
+
+// AlphaUploadContext implements Client.
+func (f *Fake) AlphaUploadContext(ctx context.Context, arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	f.Record("AlphaUpload", opts, arg, content)
+	if f.AlphaUploadFunc == nil {
+		err = dropbox.NotImplemented("files", "AlphaUpload")
+		return
+	}
+	return f.AlphaUploadFunc(ctx, arg, content, opts...)
+}
+
+// CopyV2 implements Client.
+func (f *Fake) CopyV2(arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error) {
+	return f.CopyV2Context(context.Background(), arg, opts...)
+}
+
+// CopyV2Context implements Client.
+func (f *Fake) CopyV2Context(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error) {
+	f.Record("CopyV2", opts, arg)
+	if f.CopyV2Func == nil {
+		err = dropbox.NotImplemented("files", "CopyV2")
+		return
+	}
+	return f.CopyV2Func(ctx, arg, opts...)
+}
+
+// Copy implements Client.
+func (f *Fake) Copy(arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return f.CopyContext(context.Background(), arg, opts...)
+}
+
+// CopyContext implements Client.
+func (f *Fake) CopyContext(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	f.Record("Copy", opts, arg)
+	if f.CopyFunc == nil {
+		err = dropbox.NotImplemented("files", "Copy")
+		return
+	}
+	return f.CopyFunc(ctx, arg, opts...)
+}
+
+// CopyBatchV2 implements Client.
+func (f *Fake) CopyBatchV2(arg *RelocationBatchArgBase, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error) {
+	return f.CopyBatchV2Context(context.Background(), arg, opts...)
+}
+
+// CopyBatchV2Context implements Client.
+func (f *Fake) CopyBatchV2Context(ctx context.Context, arg *RelocationBatchArgBase, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error) {
+	f.Record("CopyBatchV2", opts, arg)
+	if f.CopyBatchV2Func == nil {
+		err = dropbox.NotImplemented("files", "CopyBatchV2")
+		return
+	}
+	return f.CopyBatchV2Func(ctx, arg, opts...)
+}
+
+// CopyBatch implements Client.
+func (f *Fake) CopyBatch(arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error) {
+	return f.CopyBatchContext(context.Background(), arg, opts...)
+}
+
+// CopyBatchContext implements Client.
+func (f *Fake) CopyBatchContext(ctx context.Context, arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error) {
+	f.Record("CopyBatch", opts, arg)
+	if f.CopyBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "CopyBatch")
+		return
+	}
+	return f.CopyBatchFunc(ctx, arg, opts...)
+}
+
+// CopyBatchCheckV2 implements Client.
+func (f *Fake) CopyBatchCheckV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
+	return f.CopyBatchCheckV2Context(context.Background(), arg, opts...)
+}
+
+// CopyBatchCheckV2Context implements Client.
+func (f *Fake) CopyBatchCheckV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
+	f.Record("CopyBatchCheckV2", opts, arg)
+	if f.CopyBatchCheckV2Func == nil {
+		err = dropbox.NotImplemented("files", "CopyBatchCheckV2")
+		return
+	}
+	return f.CopyBatchCheckV2Func(ctx, arg, opts...)
+}
+
+// CopyBatchCheck implements Client.
+func (f *Fake) CopyBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
+	return f.CopyBatchCheckContext(context.Background(), arg, opts...)
+}
+
+// CopyBatchCheckContext implements Client.
+func (f *Fake) CopyBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
+	f.Record("CopyBatchCheck", opts, arg)
+	if f.CopyBatchCheckFunc == nil {
+		err = dropbox.NotImplemented("files", "CopyBatchCheck")
+		return
+	}
+	return f.CopyBatchCheckFunc(ctx, arg, opts...)
+}
+
+// CopyReferenceGet implements Client.
+func (f *Fake) CopyReferenceGet(arg *GetCopyReferenceArg, opts ...dropbox.CallOption) (res *GetCopyReferenceResult, err error) {
+	return f.CopyReferenceGetContext(context.Background(), arg, opts...)
+}
+
+// CopyReferenceGetContext implements Client.
+func (f *Fake) CopyReferenceGetContext(ctx context.Context, arg *GetCopyReferenceArg, opts ...dropbox.CallOption) (res *GetCopyReferenceResult, err error) {
+	f.Record("CopyReferenceGet", opts, arg)
+	if f.CopyReferenceGetFunc == nil {
+		err = dropbox.NotImplemented("files", "CopyReferenceGet")
+		return
+	}
+	return f.CopyReferenceGetFunc(ctx, arg, opts...)
+}
+
+// CopyReferenceSave implements Client.
+func (f *Fake) CopyReferenceSave(arg *SaveCopyReferenceArg, opts ...dropbox.CallOption) (res *SaveCopyReferenceResult, err error) {
+	return f.CopyReferenceSaveContext(context.Background(), arg, opts...)
+}
+
+// CopyReferenceSaveContext implements Client.
+func (f *Fake) CopyReferenceSaveContext(ctx context.Context, arg *SaveCopyReferenceArg, opts ...dropbox.CallOption) (res *SaveCopyReferenceResult, err error) {
+	f.Record("CopyReferenceSave", opts, arg)
+	if f.CopyReferenceSaveFunc == nil {
+		err = dropbox.NotImplemented("files", "CopyReferenceSave")
+		return
+	}
+	return f.CopyReferenceSaveFunc(ctx, arg, opts...)
+}
+
+// CreateFolderV2 implements Client.
+func (f *Fake) CreateFolderV2(arg *CreateFolderArg, opts ...dropbox.CallOption) (res *CreateFolderResult, err error) {
+	return f.CreateFolderV2Context(context.Background(), arg, opts...)
+}
+
+// CreateFolderV2Context implements Client.
+func (f *Fake) CreateFolderV2Context(ctx context.Context, arg *CreateFolderArg, opts ...dropbox.CallOption) (res *CreateFolderResult, err error) {
+	f.Record("CreateFolderV2", opts, arg)
+	if f.CreateFolderV2Func == nil {
+		err = dropbox.NotImplemented("files", "CreateFolderV2")
+		return
+	}
+	return f.CreateFolderV2Func(ctx, arg, opts...)
+}
+
+// CreateFolder implements Client.
+func (f *Fake) CreateFolder(arg *CreateFolderArg, opts ...dropbox.CallOption) (res *FolderMetadata, err error) {
+	return f.CreateFolderContext(context.Background(), arg, opts...)
+}
+
+// CreateFolderContext implements Client.
+func (f *Fake) CreateFolderContext(ctx context.Context, arg *CreateFolderArg, opts ...dropbox.CallOption) (res *FolderMetadata, err error) {
+	f.Record("CreateFolder", opts, arg)
+	if f.CreateFolderFunc == nil {
+		err = dropbox.NotImplemented("files", "CreateFolder")
+		return
+	}
+	return f.CreateFolderFunc(ctx, arg, opts...)
+}
+
+// CreateFolderBatch implements Client.
+func (f *Fake) CreateFolderBatch(arg *CreateFolderBatchArg, opts ...dropbox.CallOption) (res *CreateFolderBatchLaunch, err error) {
+	return f.CreateFolderBatchContext(context.Background(), arg, opts...)
+}
+
+// CreateFolderBatchContext implements Client.
+func (f *Fake) CreateFolderBatchContext(ctx context.Context, arg *CreateFolderBatchArg, opts ...dropbox.CallOption) (res *CreateFolderBatchLaunch, err error) {
+	f.Record("CreateFolderBatch", opts, arg)
+	if f.CreateFolderBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "CreateFolderBatch")
+		return
+	}
+	return f.CreateFolderBatchFunc(ctx, arg, opts...)
+}
+
+// CreateFolderBatchCheck implements Client.
+func (f *Fake) CreateFolderBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *CreateFolderBatchJobStatus, err error) {
+	return f.CreateFolderBatchCheckContext(context.Background(), arg, opts...)
+}
+
+// CreateFolderBatchCheckContext implements Client.
+func (f *Fake) CreateFolderBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *CreateFolderBatchJobStatus, err error) {
+	f.Record("CreateFolderBatchCheck", opts, arg)
+	if f.CreateFolderBatchCheckFunc == nil {
+		err = dropbox.NotImplemented("files", "CreateFolderBatchCheck")
+		return
+	}
+	return f.CreateFolderBatchCheckFunc(ctx, arg, opts...)
+}
+
+// DeleteV2 implements Client.
+func (f *Fake) DeleteV2(arg *DeleteArg, opts ...dropbox.CallOption) (res *DeleteResult, err error) {
+	return f.DeleteV2Context(context.Background(), arg, opts...)
+}
+
+// DeleteV2Context implements Client.
+func (f *Fake) DeleteV2Context(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (res *DeleteResult, err error) {
+	f.Record("DeleteV2", opts, arg)
+	if f.DeleteV2Func == nil {
+		err = dropbox.NotImplemented("files", "DeleteV2")
+		return
+	}
+	return f.DeleteV2Func(ctx, arg, opts...)
+}
+
+// Delete implements Client.
+func (f *Fake) Delete(arg *DeleteArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return f.DeleteContext(context.Background(), arg, opts...)
+}
+
+// DeleteContext implements Client.
+func (f *Fake) DeleteContext(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	f.Record("Delete", opts, arg)
+	if f.DeleteFunc == nil {
+		err = dropbox.NotImplemented("files", "Delete")
+		return
+	}
+	return f.DeleteFunc(ctx, arg, opts...)
+}
+
+// DeleteBatch implements Client.
+func (f *Fake) DeleteBatch(arg *DeleteBatchArg, opts ...dropbox.CallOption) (res *DeleteBatchLaunch, err error) {
+	return f.DeleteBatchContext(context.Background(), arg, opts...)
+}
+
+// DeleteBatchContext implements Client.
+func (f *Fake) DeleteBatchContext(ctx context.Context, arg *DeleteBatchArg, opts ...dropbox.CallOption) (res *DeleteBatchLaunch, err error) {
+	f.Record("DeleteBatch", opts, arg)
+	if f.DeleteBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "DeleteBatch")
+		return
+	}
+	return f.DeleteBatchFunc(ctx, arg, opts...)
+}
+
+// DeleteBatchCheck implements Client.
+func (f *Fake) DeleteBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *DeleteBatchJobStatus, err error) {
+	return f.DeleteBatchCheckContext(context.Background(), arg, opts...)
+}
+
+// DeleteBatchCheckContext implements Client.
+func (f *Fake) DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *DeleteBatchJobStatus, err error) {
+	f.Record("DeleteBatchCheck", opts, arg)
+	if f.DeleteBatchCheckFunc == nil {
+		err = dropbox.NotImplemented("files", "DeleteBatchCheck")
+		return
+	}
+	return f.DeleteBatchCheckFunc(ctx, arg, opts...)
+}
+
+// Download implements Client.
+func (f *Fake) Download(arg *DownloadArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	return f.DownloadContext(context.Background(), arg, opts...)
+}
+
+// DownloadContext implements Client.
+func (f *Fake) DownloadContext(ctx context.Context, arg *DownloadArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	f.Record("Download", opts, arg)
+	if f.DownloadFunc == nil {
+		err = dropbox.NotImplemented("files", "Download")
+		return
+	}
+	return f.DownloadFunc(ctx, arg, opts...)
+}
+
+// DownloadZip implements Client.
+func (f *Fake) DownloadZip(arg *DownloadZipArg, opts ...dropbox.CallOption) (res *DownloadZipResult, content io.ReadCloser, err error) {
+	return f.DownloadZipContext(context.Background(), arg, opts...)
+}
+
+// DownloadZipContext implements Client.
+func (f *Fake) DownloadZipContext(ctx context.Context, arg *DownloadZipArg, opts ...dropbox.CallOption) (res *DownloadZipResult, content io.ReadCloser, err error) {
+	f.Record("DownloadZip", opts, arg)
+	if f.DownloadZipFunc == nil {
+		err = dropbox.NotImplemented("files", "DownloadZip")
+		return
+	}
+	return f.DownloadZipFunc(ctx, arg, opts...)
+}
+
+// Export implements Client.
+func (f *Fake) Export(arg *ExportArg, opts ...dropbox.CallOption) (res *ExportResult, content io.ReadCloser, err error) {
+	return f.ExportContext(context.Background(), arg, opts...)
+}
+
+// ExportContext implements Client.
+func (f *Fake) ExportContext(ctx context.Context, arg *ExportArg, opts ...dropbox.CallOption) (res *ExportResult, content io.ReadCloser, err error) {
+	f.Record("Export", opts, arg)
+	if f.ExportFunc == nil {
+		err = dropbox.NotImplemented("files", "Export")
+		return
+	}
+	return f.ExportFunc(ctx, arg, opts...)
+}
+
+// GetFileLockBatch implements Client.
+func (f *Fake) GetFileLockBatch(arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	return f.GetFileLockBatchContext(context.Background(), arg, opts...)
+}
+
+// GetFileLockBatchContext implements Client.
+func (f *Fake) GetFileLockBatchContext(ctx context.Context, arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	f.Record("GetFileLockBatch", opts, arg)
+	if f.GetFileLockBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "GetFileLockBatch")
+		return
+	}
+	return f.GetFileLockBatchFunc(ctx, arg, opts...)
+}
+
+// GetMetadata implements Client.
+func (f *Fake) GetMetadata(arg *GetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return f.GetMetadataContext(context.Background(), arg, opts...)
+}
+
+// GetMetadataContext implements Client.
+func (f *Fake) GetMetadataContext(ctx context.Context, arg *GetMetadataArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	f.Record("GetMetadata", opts, arg)
+	if f.GetMetadataFunc == nil {
+		err = dropbox.NotImplemented("files", "GetMetadata")
+		return
+	}
+	return f.GetMetadataFunc(ctx, arg, opts...)
+}
+
+// GetPreview implements Client.
+func (f *Fake) GetPreview(arg *PreviewArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	return f.GetPreviewContext(context.Background(), arg, opts...)
+}
+
+// GetPreviewContext implements Client.
+func (f *Fake) GetPreviewContext(ctx context.Context, arg *PreviewArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	f.Record("GetPreview", opts, arg)
+	if f.GetPreviewFunc == nil {
+		err = dropbox.NotImplemented("files", "GetPreview")
+		return
+	}
+	return f.GetPreviewFunc(ctx, arg, opts...)
+}
+
+// GetTemporaryLink implements Client.
+func (f *Fake) GetTemporaryLink(arg *GetTemporaryLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryLinkResult, err error) {
+	return f.GetTemporaryLinkContext(context.Background(), arg, opts...)
+}
+
+// GetTemporaryLinkContext implements Client.
+func (f *Fake) GetTemporaryLinkContext(ctx context.Context, arg *GetTemporaryLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryLinkResult, err error) {
+	f.Record("GetTemporaryLink", opts, arg)
+	if f.GetTemporaryLinkFunc == nil {
+		err = dropbox.NotImplemented("files", "GetTemporaryLink")
+		return
+	}
+	return f.GetTemporaryLinkFunc(ctx, arg, opts...)
+}
+
+// GetTemporaryUploadLink implements Client.
+func (f *Fake) GetTemporaryUploadLink(arg *GetTemporaryUploadLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryUploadLinkResult, err error) {
+	return f.GetTemporaryUploadLinkContext(context.Background(), arg, opts...)
+}
+
+// GetTemporaryUploadLinkContext implements Client.
+func (f *Fake) GetTemporaryUploadLinkContext(ctx context.Context, arg *GetTemporaryUploadLinkArg, opts ...dropbox.CallOption) (res *GetTemporaryUploadLinkResult, err error) {
+	f.Record("GetTemporaryUploadLink", opts, arg)
+	if f.GetTemporaryUploadLinkFunc == nil {
+		err = dropbox.NotImplemented("files", "GetTemporaryUploadLink")
+		return
+	}
+	return f.GetTemporaryUploadLinkFunc(ctx, arg, opts...)
+}
+
+// GetThumbnail implements Client.
+func (f *Fake) GetThumbnail(arg *ThumbnailArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	return f.GetThumbnailContext(context.Background(), arg, opts...)
+}
+
+// GetThumbnailContext implements Client.
+func (f *Fake) GetThumbnailContext(ctx context.Context, arg *ThumbnailArg, opts ...dropbox.CallOption) (res *FileMetadata, content io.ReadCloser, err error) {
+	f.Record("GetThumbnail", opts, arg)
+	if f.GetThumbnailFunc == nil {
+		err = dropbox.NotImplemented("files", "GetThumbnail")
+		return
+	}
+	return f.GetThumbnailFunc(ctx, arg, opts...)
+}
+
+// GetThumbnailV2 implements Client.
+func (f *Fake) GetThumbnailV2(arg *ThumbnailV2Arg, opts ...dropbox.CallOption) (res *PreviewResult, content io.ReadCloser, err error) {
+	return f.GetThumbnailV2Context(context.Background(), arg, opts...)
+}
+
+// GetThumbnailV2Context implements Client.
+func (f *Fake) GetThumbnailV2Context(ctx context.Context, arg *ThumbnailV2Arg, opts ...dropbox.CallOption) (res *PreviewResult, content io.ReadCloser, err error) {
+	f.Record("GetThumbnailV2", opts, arg)
+	if f.GetThumbnailV2Func == nil {
+		err = dropbox.NotImplemented("files", "GetThumbnailV2")
+		return
+	}
+	return f.GetThumbnailV2Func(ctx, arg, opts...)
+}
+
+// GetThumbnailBatch implements Client.
+func (f *Fake) GetThumbnailBatch(arg *GetThumbnailBatchArg, opts ...dropbox.CallOption) (res *GetThumbnailBatchResult, err error) {
+	return f.GetThumbnailBatchContext(context.Background(), arg, opts...)
+}
+
+// GetThumbnailBatchContext implements Client.
+func (f *Fake) GetThumbnailBatchContext(ctx context.Context, arg *GetThumbnailBatchArg, opts ...dropbox.CallOption) (res *GetThumbnailBatchResult, err error) {
+	f.Record("GetThumbnailBatch", opts, arg)
+	if f.GetThumbnailBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "GetThumbnailBatch")
+		return
+	}
+	return f.GetThumbnailBatchFunc(ctx, arg, opts...)
+}
+
+// ListFolder implements Client.
+func (f *Fake) ListFolder(arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error) {
+	return f.ListFolderContext(context.Background(), arg, opts...)
+}
+
+// ListFolderContext implements Client.
+func (f *Fake) ListFolderContext(ctx context.Context, arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error) {
+	f.Record("ListFolder", opts, arg)
+	if f.ListFolderFunc == nil {
+		err = dropbox.NotImplemented("files", "ListFolder")
+		return
+	}
+	return f.ListFolderFunc(ctx, arg, opts...)
+}
+
+// ListFolderContinue implements Client.
+func (f *Fake) ListFolderContinue(arg *ListFolderContinueArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error) {
+	return f.ListFolderContinueContext(context.Background(), arg, opts...)
+}
+
+// ListFolderContinueContext implements Client.
+func (f *Fake) ListFolderContinueContext(ctx context.Context, arg *ListFolderContinueArg, opts ...dropbox.CallOption) (res *ListFolderResult, err error) {
+	f.Record("ListFolderContinue", opts, arg)
+	if f.ListFolderContinueFunc == nil {
+		err = dropbox.NotImplemented("files", "ListFolderContinue")
+		return
+	}
+	return f.ListFolderContinueFunc(ctx, arg, opts...)
+}
+
+// ListFolderGetLatestCursor implements Client.
+func (f *Fake) ListFolderGetLatestCursor(arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderGetLatestCursorResult, err error) {
+	return f.ListFolderGetLatestCursorContext(context.Background(), arg, opts...)
+}
+
+// ListFolderGetLatestCursorContext implements Client.
+func (f *Fake) ListFolderGetLatestCursorContext(ctx context.Context, arg *ListFolderArg, opts ...dropbox.CallOption) (res *ListFolderGetLatestCursorResult, err error) {
+	f.Record("ListFolderGetLatestCursor", opts, arg)
+	if f.ListFolderGetLatestCursorFunc == nil {
+		err = dropbox.NotImplemented("files", "ListFolderGetLatestCursor")
+		return
+	}
+	return f.ListFolderGetLatestCursorFunc(ctx, arg, opts...)
+}
+
+// ListFolderLongpoll implements Client.
+func (f *Fake) ListFolderLongpoll(arg *ListFolderLongpollArg, opts ...dropbox.CallOption) (res *ListFolderLongpollResult, err error) {
+	return f.ListFolderLongpollContext(context.Background(), arg, opts...)
+}
+
+// ListFolderLongpollContext implements Client.
+func (f *Fake) ListFolderLongpollContext(ctx context.Context, arg *ListFolderLongpollArg, opts ...dropbox.CallOption) (res *ListFolderLongpollResult, err error) {
+	f.Record("ListFolderLongpoll", opts, arg)
+	if f.ListFolderLongpollFunc == nil {
+		err = dropbox.NotImplemented("files", "ListFolderLongpoll")
+		return
+	}
+	return f.ListFolderLongpollFunc(ctx, arg, opts...)
+}
+
+// ListRevisions implements Client.
+func (f *Fake) ListRevisions(arg *ListRevisionsArg, opts ...dropbox.CallOption) (res *ListRevisionsResult, err error) {
+	return f.ListRevisionsContext(context.Background(), arg, opts...)
+}
+
+// ListRevisionsContext implements Client.
+func (f *Fake) ListRevisionsContext(ctx context.Context, arg *ListRevisionsArg, opts ...dropbox.CallOption) (res *ListRevisionsResult, err error) {
+	f.Record("ListRevisions", opts, arg)
+	if f.ListRevisionsFunc == nil {
+		err = dropbox.NotImplemented("files", "ListRevisions")
+		return
+	}
+	return f.ListRevisionsFunc(ctx, arg, opts...)
+}
+
+// LockFileBatch implements Client.
+func (f *Fake) LockFileBatch(arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	return f.LockFileBatchContext(context.Background(), arg, opts...)
+}
+
+// LockFileBatchContext implements Client.
+func (f *Fake) LockFileBatchContext(ctx context.Context, arg *LockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	f.Record("LockFileBatch", opts, arg)
+	if f.LockFileBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "LockFileBatch")
+		return
+	}
+	return f.LockFileBatchFunc(ctx, arg, opts...)
+}
+
+// MoveV2 implements Client.
+func (f *Fake) MoveV2(arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error) {
+	return f.MoveV2Context(context.Background(), arg, opts...)
+}
+
+// MoveV2Context implements Client.
+func (f *Fake) MoveV2Context(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res *RelocationResult, err error) {
+	f.Record("MoveV2", opts, arg)
+	if f.MoveV2Func == nil {
+		err = dropbox.NotImplemented("files", "MoveV2")
+		return
+	}
+	return f.MoveV2Func(ctx, arg, opts...)
+}
+
+// Move implements Client.
+func (f *Fake) Move(arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	return f.MoveContext(context.Background(), arg, opts...)
+}
+
+// MoveContext implements Client.
+func (f *Fake) MoveContext(ctx context.Context, arg *RelocationArg, opts ...dropbox.CallOption) (res IsMetadata, err error) {
+	f.Record("Move", opts, arg)
+	if f.MoveFunc == nil {
+		err = dropbox.NotImplemented("files", "Move")
+		return
+	}
+	return f.MoveFunc(ctx, arg, opts...)
+}
+
+// MoveBatchV2 implements Client.
+func (f *Fake) MoveBatchV2(arg *MoveBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error) {
+	return f.MoveBatchV2Context(context.Background(), arg, opts...)
+}
+
+// MoveBatchV2Context implements Client.
+func (f *Fake) MoveBatchV2Context(ctx context.Context, arg *MoveBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchV2Launch, err error) {
+	f.Record("MoveBatchV2", opts, arg)
+	if f.MoveBatchV2Func == nil {
+		err = dropbox.NotImplemented("files", "MoveBatchV2")
+		return
+	}
+	return f.MoveBatchV2Func(ctx, arg, opts...)
+}
+
+// MoveBatch implements Client.
+func (f *Fake) MoveBatch(arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error) {
+	return f.MoveBatchContext(context.Background(), arg, opts...)
+}
+
+// MoveBatchContext implements Client.
+func (f *Fake) MoveBatchContext(ctx context.Context, arg *RelocationBatchArg, opts ...dropbox.CallOption) (res *RelocationBatchLaunch, err error) {
+	f.Record("MoveBatch", opts, arg)
+	if f.MoveBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "MoveBatch")
+		return
+	}
+	return f.MoveBatchFunc(ctx, arg, opts...)
+}
+
+// MoveBatchCheckV2 implements Client.
+func (f *Fake) MoveBatchCheckV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
+	return f.MoveBatchCheckV2Context(context.Background(), arg, opts...)
+}
+
+// MoveBatchCheckV2Context implements Client.
+func (f *Fake) MoveBatchCheckV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
+	f.Record("MoveBatchCheckV2", opts, arg)
+	if f.MoveBatchCheckV2Func == nil {
+		err = dropbox.NotImplemented("files", "MoveBatchCheckV2")
+		return
+	}
+	return f.MoveBatchCheckV2Func(ctx, arg, opts...)
+}
+
+// MoveBatchCheck implements Client.
+func (f *Fake) MoveBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
+	return f.MoveBatchCheckContext(context.Background(), arg, opts...)
+}
+
+// MoveBatchCheckContext implements Client.
+func (f *Fake) MoveBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
+	f.Record("MoveBatchCheck", opts, arg)
+	if f.MoveBatchCheckFunc == nil {
+		err = dropbox.NotImplemented("files", "MoveBatchCheck")
+		return
+	}
+	return f.MoveBatchCheckFunc(ctx, arg, opts...)
+}
+
+// PaperCreate implements Client.
+func (f *Fake) PaperCreate(arg *PaperCreateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperCreateResult, err error) {
+	return f.PaperCreateContext(context.Background(), arg, content, opts...)
+}
+
+// PaperCreateContext implements Client.
+func (f *Fake) PaperCreateContext(ctx context.Context, arg *PaperCreateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperCreateResult, err error) {
+	f.Record("PaperCreate", opts, arg, content)
+	if f.PaperCreateFunc == nil {
+		err = dropbox.NotImplemented("files", "PaperCreate")
+		return
+	}
+	return f.PaperCreateFunc(ctx, arg, content, opts...)
+}
+
+// PaperUpdate implements Client.
+func (f *Fake) PaperUpdate(arg *PaperUpdateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperUpdateResult, err error) {
+	return f.PaperUpdateContext(context.Background(), arg, content, opts...)
+}
+
+// PaperUpdateContext implements Client.
+func (f *Fake) PaperUpdateContext(ctx context.Context, arg *PaperUpdateArg, content io.Reader, opts ...dropbox.CallOption) (res *PaperUpdateResult, err error) {
+	f.Record("PaperUpdate", opts, arg, content)
+	if f.PaperUpdateFunc == nil {
+		err = dropbox.NotImplemented("files", "PaperUpdate")
+		return
+	}
+	return f.PaperUpdateFunc(ctx, arg, content, opts...)
+}
+
+// PermanentlyDelete implements Client.
+func (f *Fake) PermanentlyDelete(arg *DeleteArg, opts ...dropbox.CallOption) (err error) {
+	return f.PermanentlyDeleteContext(context.Background(), arg, opts...)
+}
+
+// PermanentlyDeleteContext implements Client.
+func (f *Fake) PermanentlyDeleteContext(ctx context.Context, arg *DeleteArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PermanentlyDelete", opts, arg)
+	if f.PermanentlyDeleteFunc == nil {
+		err = dropbox.NotImplemented("files", "PermanentlyDelete")
+		return
+	}
+	return f.PermanentlyDeleteFunc(ctx, arg, opts...)
+}
+
+// PropertiesAdd implements Client.
+func (f *Fake) PropertiesAdd(arg *file_properties.AddPropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return f.PropertiesAddContext(context.Background(), arg, opts...)
+}
+
+// PropertiesAddContext implements Client.
+func (f *Fake) PropertiesAddContext(ctx context.Context, arg *file_properties.AddPropertiesArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PropertiesAdd", opts, arg)
+	if f.PropertiesAddFunc == nil {
+		err = dropbox.NotImplemented("files", "PropertiesAdd")
+		return
+	}
+	return f.PropertiesAddFunc(ctx, arg, opts...)
+}
+
+// PropertiesOverwrite implements Client.
+func (f *Fake) PropertiesOverwrite(arg *file_properties.OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error) {
+	return f.PropertiesOverwriteContext(context.Background(), arg, opts...)
+}
+
+// PropertiesOverwriteContext implements Client.
+func (f *Fake) PropertiesOverwriteContext(ctx context.Context, arg *file_properties.OverwritePropertyGroupArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PropertiesOverwrite", opts, arg)
+	if f.PropertiesOverwriteFunc == nil {
+		err = dropbox.NotImplemented("files", "PropertiesOverwrite")
+		return
+	}
+	return f.PropertiesOverwriteFunc(ctx, arg, opts...)
+}
+
+// PropertiesRemove implements Client.
+func (f *Fake) PropertiesRemove(arg *file_properties.RemovePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return f.PropertiesRemoveContext(context.Background(), arg, opts...)
+}
+
+// PropertiesRemoveContext implements Client.
+func (f *Fake) PropertiesRemoveContext(ctx context.Context, arg *file_properties.RemovePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PropertiesRemove", opts, arg)
+	if f.PropertiesRemoveFunc == nil {
+		err = dropbox.NotImplemented("files", "PropertiesRemove")
+		return
+	}
+	return f.PropertiesRemoveFunc(ctx, arg, opts...)
+}
+
+// PropertiesTemplateGet implements Client.
+func (f *Fake) PropertiesTemplateGet(arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error) {
+	return f.PropertiesTemplateGetContext(context.Background(), arg, opts...)
+}
+
+// PropertiesTemplateGetContext implements Client.
+func (f *Fake) PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error) {
+	f.Record("PropertiesTemplateGet", opts, arg)
+	if f.PropertiesTemplateGetFunc == nil {
+		err = dropbox.NotImplemented("files", "PropertiesTemplateGet")
+		return
+	}
+	return f.PropertiesTemplateGetFunc(ctx, arg, opts...)
+}
+
+// PropertiesTemplateList implements Client.
+func (f *Fake) PropertiesTemplateList(opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error) {
+	return f.PropertiesTemplateListContext(context.Background(), opts...)
+}
+
+// PropertiesTemplateListContext implements Client.
+func (f *Fake) PropertiesTemplateListContext(ctx context.Context, opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error) {
+	f.Record("PropertiesTemplateList", opts)
+	if f.PropertiesTemplateListFunc == nil {
+		err = dropbox.NotImplemented("files", "PropertiesTemplateList")
+		return
+	}
+	return f.PropertiesTemplateListFunc(ctx, opts...)
+}
+
+// PropertiesUpdate implements Client.
+func (f *Fake) PropertiesUpdate(arg *file_properties.UpdatePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	return f.PropertiesUpdateContext(context.Background(), arg, opts...)
+}
+
+// PropertiesUpdateContext implements Client.
+func (f *Fake) PropertiesUpdateContext(ctx context.Context, arg *file_properties.UpdatePropertiesArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("PropertiesUpdate", opts, arg)
+	if f.PropertiesUpdateFunc == nil {
+		err = dropbox.NotImplemented("files", "PropertiesUpdate")
+		return
+	}
+	return f.PropertiesUpdateFunc(ctx, arg, opts...)
+}
+
+// Restore implements Client.
+func (f *Fake) Restore(arg *RestoreArg, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	return f.RestoreContext(context.Background(), arg, opts...)
+}
+
+// RestoreContext implements Client.
+func (f *Fake) RestoreContext(ctx context.Context, arg *RestoreArg, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	f.Record("Restore", opts, arg)
+	if f.RestoreFunc == nil {
+		err = dropbox.NotImplemented("files", "Restore")
+		return
+	}
+	return f.RestoreFunc(ctx, arg, opts...)
+}
+
+// SaveUrl implements Client.
+func (f *Fake) SaveUrl(arg *SaveUrlArg, opts ...dropbox.CallOption) (res *SaveUrlResult, err error) {
+	return f.SaveUrlContext(context.Background(), arg, opts...)
+}
+
+// SaveUrlContext implements Client.
+func (f *Fake) SaveUrlContext(ctx context.Context, arg *SaveUrlArg, opts ...dropbox.CallOption) (res *SaveUrlResult, err error) {
+	f.Record("SaveUrl", opts, arg)
+	if f.SaveUrlFunc == nil {
+		err = dropbox.NotImplemented("files", "SaveUrl")
+		return
+	}
+	return f.SaveUrlFunc(ctx, arg, opts...)
+}
+
+// SaveUrlCheckJobStatus implements Client.
+func (f *Fake) SaveUrlCheckJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *SaveUrlJobStatus, err error) {
+	return f.SaveUrlCheckJobStatusContext(context.Background(), arg, opts...)
+}
+
+// SaveUrlCheckJobStatusContext implements Client.
+func (f *Fake) SaveUrlCheckJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *SaveUrlJobStatus, err error) {
+	f.Record("SaveUrlCheckJobStatus", opts, arg)
+	if f.SaveUrlCheckJobStatusFunc == nil {
+		err = dropbox.NotImplemented("files", "SaveUrlCheckJobStatus")
+		return
+	}
+	return f.SaveUrlCheckJobStatusFunc(ctx, arg, opts...)
+}
+
+// Search implements Client.
+func (f *Fake) Search(arg *SearchArg, opts ...dropbox.CallOption) (res *SearchResult, err error) {
+	return f.SearchContext(context.Background(), arg, opts...)
+}
+
+// SearchContext implements Client.
+func (f *Fake) SearchContext(ctx context.Context, arg *SearchArg, opts ...dropbox.CallOption) (res *SearchResult, err error) {
+	f.Record("Search", opts, arg)
+	if f.SearchFunc == nil {
+		err = dropbox.NotImplemented("files", "Search")
+		return
+	}
+	return f.SearchFunc(ctx, arg, opts...)
+}
+
+// SearchV2 implements Client.
+func (f *Fake) SearchV2(arg *SearchV2Arg, opts ...dropbox.CallOption) (res *SearchV2Result, err error) {
+	return f.SearchV2Context(context.Background(), arg, opts...)
+}
+
+// SearchV2Context implements Client.
+func (f *Fake) SearchV2Context(ctx context.Context, arg *SearchV2Arg, opts ...dropbox.CallOption) (res *SearchV2Result, err error) {
+	f.Record("SearchV2", opts, arg)
+	if f.SearchV2Func == nil {
+		err = dropbox.NotImplemented("files", "SearchV2")
+		return
+	}
+	return f.SearchV2Func(ctx, arg, opts...)
+}
+
+// SearchContinueV2 implements Client.
+func (f *Fake) SearchContinueV2(arg *SearchV2ContinueArg, opts ...dropbox.CallOption) (res *SearchV2Result, err error) {
+	return f.SearchContinueV2Context(context.Background(), arg, opts...)
+}
+
+// SearchContinueV2Context implements Client.
+func (f *Fake) SearchContinueV2Context(ctx context.Context, arg *SearchV2ContinueArg, opts ...dropbox.CallOption) (res *SearchV2Result, err error) {
+	f.Record("SearchContinueV2", opts, arg)
+	if f.SearchContinueV2Func == nil {
+		err = dropbox.NotImplemented("files", "SearchContinueV2")
+		return
+	}
+	return f.SearchContinueV2Func(ctx, arg, opts...)
+}
+
+// TagsAdd implements Client.
+func (f *Fake) TagsAdd(arg *AddTagArg, opts ...dropbox.CallOption) (err error) {
+	return f.TagsAddContext(context.Background(), arg, opts...)
+}
+
+// TagsAddContext implements Client.
+func (f *Fake) TagsAddContext(ctx context.Context, arg *AddTagArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("TagsAdd", opts, arg)
+	if f.TagsAddFunc == nil {
+		err = dropbox.NotImplemented("files", "TagsAdd")
+		return
+	}
+	return f.TagsAddFunc(ctx, arg, opts...)
+}
+
+// TagsGet implements Client.
+func (f *Fake) TagsGet(arg *GetTagsArg, opts ...dropbox.CallOption) (res *GetTagsResult, err error) {
+	return f.TagsGetContext(context.Background(), arg, opts...)
+}
+
+// TagsGetContext implements Client.
+func (f *Fake) TagsGetContext(ctx context.Context, arg *GetTagsArg, opts ...dropbox.CallOption) (res *GetTagsResult, err error) {
+	f.Record("TagsGet", opts, arg)
+	if f.TagsGetFunc == nil {
+		err = dropbox.NotImplemented("files", "TagsGet")
+		return
+	}
+	return f.TagsGetFunc(ctx, arg, opts...)
+}
+
+// TagsRemove implements Client.
+func (f *Fake) TagsRemove(arg *RemoveTagArg, opts ...dropbox.CallOption) (err error) {
+	return f.TagsRemoveContext(context.Background(), arg, opts...)
+}
+
+// TagsRemoveContext implements Client.
+func (f *Fake) TagsRemoveContext(ctx context.Context, arg *RemoveTagArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("TagsRemove", opts, arg)
+	if f.TagsRemoveFunc == nil {
+		err = dropbox.NotImplemented("files", "TagsRemove")
+		return
+	}
+	return f.TagsRemoveFunc(ctx, arg, opts...)
+}
+
+// UnlockFileBatch implements Client.
+func (f *Fake) UnlockFileBatch(arg *UnlockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	return f.UnlockFileBatchContext(context.Background(), arg, opts...)
+}
+
+// UnlockFileBatchContext implements Client.
+func (f *Fake) UnlockFileBatchContext(ctx context.Context, arg *UnlockFileBatchArg, opts ...dropbox.CallOption) (res *LockFileBatchResult, err error) {
+	f.Record("UnlockFileBatch", opts, arg)
+	if f.UnlockFileBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "UnlockFileBatch")
+		return
+	}
+	return f.UnlockFileBatchFunc(ctx, arg, opts...)
+}
+
+// Upload implements Client.
+func (f *Fake) Upload(arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	return f.UploadContext(context.Background(), arg, content, opts...)
+}
+
+// UploadContext implements Client.
+func (f *Fake) UploadContext(ctx context.Context, arg *UploadArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	f.Record("Upload", opts, arg, content)
+	if f.UploadFunc == nil {
+		err = dropbox.NotImplemented("files", "Upload")
+		return
+	}
+	return f.UploadFunc(ctx, arg, content, opts...)
+}
+
+// UploadSessionAppendV2 implements Client.
+func (f *Fake) UploadSessionAppendV2(arg *UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) (err error) {
+	return f.UploadSessionAppendV2Context(context.Background(), arg, content, opts...)
+}
+
+// UploadSessionAppendV2Context implements Client.
+func (f *Fake) UploadSessionAppendV2Context(ctx context.Context, arg *UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) (err error) {
+	f.Record("UploadSessionAppendV2", opts, arg, content)
+	if f.UploadSessionAppendV2Func == nil {
+		err = dropbox.NotImplemented("files", "UploadSessionAppendV2")
+		return
+	}
+	return f.UploadSessionAppendV2Func(ctx, arg, content, opts...)
+}
+
+// UploadSessionAppend implements Client.
+func (f *Fake) UploadSessionAppend(arg *UploadSessionCursor, content io.Reader, opts ...dropbox.CallOption) (err error) {
+	return f.UploadSessionAppendContext(context.Background(), arg, content, opts...)
+}
+
+// UploadSessionAppendContext implements Client.
+func (f *Fake) UploadSessionAppendContext(ctx context.Context, arg *UploadSessionCursor, content io.Reader, opts ...dropbox.CallOption) (err error) {
+	f.Record("UploadSessionAppend", opts, arg, content)
+	if f.UploadSessionAppendFunc == nil {
+		err = dropbox.NotImplemented("files", "UploadSessionAppend")
+		return
+	}
+	return f.UploadSessionAppendFunc(ctx, arg, content, opts...)
+}
+
+// UploadSessionFinish implements Client.
+func (f *Fake) UploadSessionFinish(arg *UploadSessionFinishArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	return f.UploadSessionFinishContext(context.Background(), arg, content, opts...)
+}
+
+// UploadSessionFinishContext implements Client.
+func (f *Fake) UploadSessionFinishContext(ctx context.Context, arg *UploadSessionFinishArg, content io.Reader, opts ...dropbox.CallOption) (res *FileMetadata, err error) {
+	f.Record("UploadSessionFinish", opts, arg, content)
+	if f.UploadSessionFinishFunc == nil {
+		err = dropbox.NotImplemented("files", "UploadSessionFinish")
+		return
+	}
+	return f.UploadSessionFinishFunc(ctx, arg, content, opts...)
+}
+
+// UploadSessionFinishBatch implements Client.
+func (f *Fake) UploadSessionFinishBatch(arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchLaunch, err error) {
+	return f.UploadSessionFinishBatchContext(context.Background(), arg, opts...)
+}
+
+// UploadSessionFinishBatchContext implements Client.
+func (f *Fake) UploadSessionFinishBatchContext(ctx context.Context, arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchLaunch, err error) {
+	f.Record("UploadSessionFinishBatch", opts, arg)
+	if f.UploadSessionFinishBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "UploadSessionFinishBatch")
+		return
+	}
+	return f.UploadSessionFinishBatchFunc(ctx, arg, opts...)
+}
+
+// UploadSessionFinishBatchV2 implements Client.
+func (f *Fake) UploadSessionFinishBatchV2(arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchResult, err error) {
+	return f.UploadSessionFinishBatchV2Context(context.Background(), arg, opts...)
+}
+
+// UploadSessionFinishBatchV2Context implements Client.
+func (f *Fake) UploadSessionFinishBatchV2Context(ctx context.Context, arg *UploadSessionFinishBatchArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchResult, err error) {
+	f.Record("UploadSessionFinishBatchV2", opts, arg)
+	if f.UploadSessionFinishBatchV2Func == nil {
+		err = dropbox.NotImplemented("files", "UploadSessionFinishBatchV2")
+		return
+	}
+	return f.UploadSessionFinishBatchV2Func(ctx, arg, opts...)
+}
+
+// UploadSessionFinishBatchCheck implements Client.
+func (f *Fake) UploadSessionFinishBatchCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchJobStatus, err error) {
+	return f.UploadSessionFinishBatchCheckContext(context.Background(), arg, opts...)
+}
+
+// UploadSessionFinishBatchCheckContext implements Client.
+func (f *Fake) UploadSessionFinishBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchJobStatus, err error) {
+	f.Record("UploadSessionFinishBatchCheck", opts, arg)
+	if f.UploadSessionFinishBatchCheckFunc == nil {
+		err = dropbox.NotImplemented("files", "UploadSessionFinishBatchCheck")
+		return
+	}
+	return f.UploadSessionFinishBatchCheckFunc(ctx, arg, opts...)
+}
+
+// UploadSessionStart implements Client.
+func (f *Fake) UploadSessionStart(arg *UploadSessionStartArg, content io.Reader, opts ...dropbox.CallOption) (res *UploadSessionStartResult, err error) {
+	return f.UploadSessionStartContext(context.Background(), arg, content, opts...)
+}
+
+// UploadSessionStartContext implements Client.
+func (f *Fake) UploadSessionStartContext(ctx context.Context, arg *UploadSessionStartArg, content io.Reader, opts ...dropbox.CallOption) (res *UploadSessionStartResult, err error) {
+	f.Record("UploadSessionStart", opts, arg, content)
+	if f.UploadSessionStartFunc == nil {
+		err = dropbox.NotImplemented("files", "UploadSessionStart")
+		return
+	}
+	return f.UploadSessionStartFunc(ctx, arg, content, opts...)
+}
+
+// UploadSessionStartBatch implements Client.
+func (f *Fake) UploadSessionStartBatch(arg *UploadSessionStartBatchArg, opts ...dropbox.CallOption) (res *UploadSessionStartBatchResult, err error) {
+	return f.UploadSessionStartBatchContext(context.Background(), arg, opts...)
+}
+
+// UploadSessionStartBatchContext implements Client.
+func (f *Fake) UploadSessionStartBatchContext(ctx context.Context, arg *UploadSessionStartBatchArg, opts ...dropbox.CallOption) (res *UploadSessionStartBatchResult, err error) {
+	f.Record("UploadSessionStartBatch", opts, arg)
+	if f.UploadSessionStartBatchFunc == nil {
+		err = dropbox.NotImplemented("files", "UploadSessionStartBatch")
+		return
+	}
+	return f.UploadSessionStartBatchFunc(ctx, arg, opts...)
+}
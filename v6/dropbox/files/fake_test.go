@@ -0,0 +1,73 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestFake(t *testing.T) {
+	fake := &files.Fake{
+		ListFolderFunc: func(ctx context.Context, arg *files.ListFolderArg, opts ...dropbox.CallOption) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{&files.FileMetadata{}}, Cursor: "c1", HasMore: true}, nil
+		},
+		ListFolderContinueFunc: func(ctx context.Context, arg *files.ListFolderContinueArg, opts ...dropbox.CallOption) (*files.ListFolderResult, error) {
+			return &files.ListFolderResult{Entries: []files.IsMetadata{&files.FolderMetadata{}}, Cursor: "c2"}, nil
+		},
+	}
+
+	it := files.NewListFolderIterator(context.Background(), fake, files.NewListFolderArg("/dir"), dropbox.AsMember("dbmid:1"))
+	var n int
+	for it.Next() {
+		n++
+	}
+	if it.Err() != nil || n != 2 {
+		t.Fatalf("expected 2 entries, got %d, %v", n, it.Err())
+	}
+	calls := fake.CallsTo("ListFolder")
+	if len(calls) != 1 || calls[0].Args[0].(*files.ListFolderArg).Path != "/dir" || len(calls[0].Options) != 1 {
+		t.Errorf("unexpected calls %+v", calls)
+	}
+	if fake.CallCount("ListFolderContinue") != 1 {
+		t.Errorf("expected 1 call of ListFolderContinue, got %d", fake.CallCount("ListFolderContinue"))
+	}
+
+	content := bytes.NewReader([]byte("abc"))
+	_, err := fake.Upload(files.NewUploadArg("/a.txt"), content)
+	if !errors.Is(err, dropbox.ErrNotImplemented) {
+		t.Errorf("expected ErrNotImplemented, got %v", err)
+	}
+	calls = fake.Calls()
+	if len(calls) != 3 || calls[2].Method != "Upload" || calls[2].Args[1] != content {
+		t.Errorf("expected upload to be recorded, got %+v", calls)
+	}
+
+	fake.ResetCalls()
+	if len(fake.Calls()) != 0 {
+		t.Error("expected no calls after ResetCalls")
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// ListFolderIterator iterates over the entries returned by `ListFolder`,
+// fetching further pages with `ListFolderContinue`.
+type ListFolderIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListFolderArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []IsMetadata
+	index      int
+	entry      IsMetadata
+	err        error
+}
+
+// NewListFolderIterator returns an iterator over the entries of `ListFolder`
+// called with arg. Every page is fetched with opts.
+func NewListFolderIterator(ctx context.Context, dbx Client, arg *ListFolderArg, opts ...dropbox.CallOption) *ListFolderIterator {
+	return &ListFolderIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeListFolderIterator returns an iterator continuing at cursor, as
+// returned by `ListFolderIterator.Cursor`.
+func ResumeListFolderIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *ListFolderIterator {
+	return &ListFolderIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *ListFolderIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *ListFolderIterator) Entry() IsMetadata {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ListFolderIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *ListFolderIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *ListFolderIterator) fetch() {
+	var res *ListFolderResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.ListFolderContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.ListFolderContinueContext(it.ctx, NewListFolderContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Entries
+	it.index = 0
+}
+
+// SearchV2Iterator iterates over the entries returned by `SearchV2`, fetching
+// further pages with `SearchContinueV2`.
+type SearchV2Iterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *SearchV2Arg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*SearchMatchV2
+	index      int
+	entry      *SearchMatchV2
+	err        error
+}
+
+// NewSearchV2Iterator returns an iterator over the entries of `SearchV2` called
+// with arg. Every page is fetched with opts.
+func NewSearchV2Iterator(ctx context.Context, dbx Client, arg *SearchV2Arg, opts ...dropbox.CallOption) *SearchV2Iterator {
+	return &SearchV2Iterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeSearchV2Iterator returns an iterator continuing at cursor, as returned
+// by `SearchV2Iterator.Cursor`.
+func ResumeSearchV2Iterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *SearchV2Iterator {
+	return &SearchV2Iterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *SearchV2Iterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *SearchV2Iterator) Entry() *SearchMatchV2 {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *SearchV2Iterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *SearchV2Iterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *SearchV2Iterator) fetch() {
+	var res *SearchV2Result
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.SearchV2Context(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.SearchContinueV2Context(it.ctx, NewSearchV2ContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Matches
+	it.index = 0
+}
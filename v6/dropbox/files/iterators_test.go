@@ -0,0 +1,108 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// pageClient serves the pages of a folder listing, with cursors "c1", "c2"
+// and so on.
+type pageClient struct {
+	files.Client
+
+	pages [][]string
+	calls int
+	fail  bool
+}
+
+func (c *pageClient) page(i int) (*files.ListFolderResult, error) {
+	c.calls++
+	if c.fail {
+		return nil, errors.New("failed")
+	}
+	res := files.NewListFolderResult(nil, fmt.Sprintf("c%d", i+1), i+1 < len(c.pages))
+	for _, name := range c.pages[i] {
+		res.Entries = append(res.Entries, files.NewFolderMetadata(name, "id:"+name))
+	}
+	return res, nil
+}
+
+func (c *pageClient) ListFolderContext(ctx context.Context, arg *files.ListFolderArg, opts ...dropbox.CallOption) (*files.ListFolderResult, error) {
+	return c.page(0)
+}
+
+func (c *pageClient) ListFolderContinueContext(ctx context.Context, arg *files.ListFolderContinueArg, opts ...dropbox.CallOption) (*files.ListFolderResult, error) {
+	var i int
+	if _, err := fmt.Sscanf(arg.Cursor, "c%d", &i); err != nil {
+		return nil, err
+	}
+	return c.page(i)
+}
+
+func names(t *testing.T, it *files.ListFolderIterator, n int) []string {
+	var names []string
+	for len(names) < n && it.Next() {
+		names = append(names, it.Entry().(*files.FolderMetadata).Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+func TestListFolderIterator(t *testing.T) {
+	client := &pageClient{pages: [][]string{{"a", "b"}, {}, {"c", "d"}, {"e"}}}
+
+	it := files.NewListFolderIterator(context.Background(), client, files.NewListFolderArg(""))
+	if got := fmt.Sprint(names(t, it, 100)); got != "[a b c d e]" {
+		t.Errorf("Unexpected entries %s", got)
+	}
+	if client.calls != 4 || it.Cursor() != "c4" {
+		t.Errorf("Unexpected state: %d calls, cursor %q", client.calls, it.Cursor())
+	}
+
+	// Resume in the middle of a page, and at the end of one.
+	it = files.NewListFolderIterator(context.Background(), client, files.NewListFolderArg(""))
+	names(t, it, 3)
+	if it.Cursor() != "c2" {
+		t.Errorf("Unexpected cursor %q", it.Cursor())
+	}
+	it = files.ResumeListFolderIterator(context.Background(), client, it.Cursor())
+	if got := fmt.Sprint(names(t, it, 2)); got != "[c d]" {
+		t.Errorf("Unexpected entries %s", got)
+	}
+	if it.Cursor() != "c3" {
+		t.Errorf("Unexpected cursor %q", it.Cursor())
+	}
+
+	client.fail = true
+	it = files.ResumeListFolderIterator(context.Background(), client, it.Cursor())
+	if it.Next() || it.Err() == nil {
+		t.Error("Expected error")
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// CopyBatchV2AndWait calls `CopyBatchV2`, then polls `CopyBatchCheckV2` with
+// poller until the job is done. A nil poller polls with the default intervals.
+// It returns the final status of the job, and an `async.JobFailedError` if the
+// job did not complete. All calls are made with opts.
+func CopyBatchV2AndWait(ctx context.Context, dbx Client, arg *RelocationBatchArgBase, poller *async.Poller, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
+	launch, err := dbx.CopyBatchV2Context(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == RelocationBatchV2LaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.CopyBatchCheckV2Context(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != RelocationBatchV2JobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &RelocationBatchV2JobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != RelocationBatchV2JobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// CopyBatchAndWait calls `CopyBatch`, then polls `CopyBatchCheck` with poller
+// until the job is done. A nil poller polls with the default intervals. It
+// returns the final status of the job, and an `async.JobFailedError` if the job
+// did not complete. All calls are made with opts.
+func CopyBatchAndWait(ctx context.Context, dbx Client, arg *RelocationBatchArg, poller *async.Poller, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
+	launch, err := dbx.CopyBatchContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == RelocationBatchLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.CopyBatchCheckContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != RelocationBatchJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &RelocationBatchJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != RelocationBatchJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// CreateFolderBatchAndWait calls `CreateFolderBatch`, then polls
+// `CreateFolderBatchCheck` with poller until the job is done. A nil poller
+// polls with the default intervals. It returns the final status of the job, and
+// an `async.JobFailedError` if the job did not complete. All calls are made
+// with opts.
+func CreateFolderBatchAndWait(ctx context.Context, dbx Client, arg *CreateFolderBatchArg, poller *async.Poller, opts ...dropbox.CallOption) (res *CreateFolderBatchJobStatus, err error) {
+	launch, err := dbx.CreateFolderBatchContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == CreateFolderBatchLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.CreateFolderBatchCheckContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != CreateFolderBatchJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &CreateFolderBatchJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != CreateFolderBatchJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// DeleteBatchAndWait calls `DeleteBatch`, then polls `DeleteBatchCheck` with
+// poller until the job is done. A nil poller polls with the default intervals.
+// It returns the final status of the job, and an `async.JobFailedError` if the
+// job did not complete. All calls are made with opts.
+func DeleteBatchAndWait(ctx context.Context, dbx Client, arg *DeleteBatchArg, poller *async.Poller, opts ...dropbox.CallOption) (res *DeleteBatchJobStatus, err error) {
+	launch, err := dbx.DeleteBatchContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == DeleteBatchLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.DeleteBatchCheckContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != DeleteBatchJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &DeleteBatchJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != DeleteBatchJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// MoveBatchV2AndWait calls `MoveBatchV2`, then polls `MoveBatchCheckV2` with
+// poller until the job is done. A nil poller polls with the default intervals.
+// It returns the final status of the job, and an `async.JobFailedError` if the
+// job did not complete. All calls are made with opts.
+func MoveBatchV2AndWait(ctx context.Context, dbx Client, arg *MoveBatchArg, poller *async.Poller, opts ...dropbox.CallOption) (res *RelocationBatchV2JobStatus, err error) {
+	launch, err := dbx.MoveBatchV2Context(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == RelocationBatchV2LaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.MoveBatchCheckV2Context(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != RelocationBatchV2JobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &RelocationBatchV2JobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != RelocationBatchV2JobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// MoveBatchAndWait calls `MoveBatch`, then polls `MoveBatchCheck` with poller
+// until the job is done. A nil poller polls with the default intervals. It
+// returns the final status of the job, and an `async.JobFailedError` if the job
+// did not complete. All calls are made with opts.
+func MoveBatchAndWait(ctx context.Context, dbx Client, arg *RelocationBatchArg, poller *async.Poller, opts ...dropbox.CallOption) (res *RelocationBatchJobStatus, err error) {
+	launch, err := dbx.MoveBatchContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == RelocationBatchLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.MoveBatchCheckContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != RelocationBatchJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &RelocationBatchJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != RelocationBatchJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// SaveUrlAndWait calls `SaveUrl`, then polls `SaveUrlCheckJobStatus` with
+// poller until the job is done. A nil poller polls with the default intervals.
+// It returns the final status of the job, and an `async.JobFailedError` if the
+// job did not complete. All calls are made with opts.
+func SaveUrlAndWait(ctx context.Context, dbx Client, arg *SaveUrlArg, poller *async.Poller, opts ...dropbox.CallOption) (res *SaveUrlJobStatus, err error) {
+	launch, err := dbx.SaveUrlContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == SaveUrlResultAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.SaveUrlCheckJobStatusContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != SaveUrlJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &SaveUrlJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != SaveUrlJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// UploadSessionFinishBatchAndWait calls `UploadSessionFinishBatch`, then polls
+// `UploadSessionFinishBatchCheck` with poller until the job is done. A nil
+// poller polls with the default intervals. It returns the final status of the
+// job, and an `async.JobFailedError` if the job did not complete. All calls are
+// made with opts.
+func UploadSessionFinishBatchAndWait(ctx context.Context, dbx Client, arg *UploadSessionFinishBatchArg, poller *async.Poller, opts ...dropbox.CallOption) (res *UploadSessionFinishBatchJobStatus, err error) {
+	launch, err := dbx.UploadSessionFinishBatchContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == UploadSessionFinishBatchLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.UploadSessionFinishBatchCheckContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != UploadSessionFinishBatchJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &UploadSessionFinishBatchJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != UploadSessionFinishBatchJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// jobClient runs a batch delete that is in progress for a number of checks.
+type jobClient struct {
+	files.Client
+
+	launch  *files.DeleteBatchLaunch
+	pending int
+	final   *files.DeleteBatchJobStatus
+	checks  int
+}
+
+func (c *jobClient) DeleteBatchContext(ctx context.Context, arg *files.DeleteBatchArg, opts ...dropbox.CallOption) (*files.DeleteBatchLaunch, error) {
+	return c.launch, nil
+}
+
+func (c *jobClient) DeleteBatchCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (*files.DeleteBatchJobStatus, error) {
+	if arg.AsyncJobId != "job" {
+		return nil, errors.New("unknown job")
+	}
+	c.checks++
+	if c.checks <= c.pending {
+		return &files.DeleteBatchJobStatus{Tagged: dropbox.Tagged{Tag: files.DeleteBatchJobStatusInProgress}}, nil
+	}
+	return c.final, nil
+}
+
+func TestDeleteBatchAndWait(t *testing.T) {
+	ctx := context.Background()
+	poller := &async.Poller{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond, Multiplier: 2}
+	arg := files.NewDeleteBatchArg([]*files.DeleteArg{files.NewDeleteArg("/a")})
+	launched := &files.DeleteBatchLaunch{Tagged: dropbox.Tagged{Tag: files.DeleteBatchLaunchAsyncJobId}, AsyncJobId: "job"}
+	result := files.NewDeleteBatchResult(nil)
+
+	client := &jobClient{
+		launch:  launched,
+		pending: 3,
+		final:   &files.DeleteBatchJobStatus{Tagged: dropbox.Tagged{Tag: files.DeleteBatchJobStatusComplete}, Complete: result},
+	}
+	res, err := files.DeleteBatchAndWait(ctx, client, arg, poller)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Complete != result || client.checks != 4 {
+		t.Errorf("Unexpected result %+v after %d checks", res, client.checks)
+	}
+
+	// Synchronous completion.
+	client = &jobClient{launch: &files.DeleteBatchLaunch{Tagged: dropbox.Tagged{Tag: files.DeleteBatchLaunchComplete}, Complete: result}}
+	res, err = files.DeleteBatchAndWait(ctx, client, arg, nil)
+	if err != nil || res.Tag != files.DeleteBatchJobStatusComplete || res.Complete != result || client.checks != 0 {
+		t.Errorf("Unexpected result %+v, %v", res, err)
+	}
+
+	// Failure.
+	client = &jobClient{
+		launch: launched,
+		final: &files.DeleteBatchJobStatus{
+			Tagged: dropbox.Tagged{Tag: files.DeleteBatchJobStatusFailed},
+			Failed: &files.DeleteBatchError{Tagged: dropbox.Tagged{Tag: files.DeleteBatchErrorTooManyWriteOperations}},
+		},
+	}
+	res, err = files.DeleteBatchAndWait(ctx, client, arg, poller)
+	var failed async.JobFailedError
+	if !errors.As(err, &failed) || failed.Status != res || res.Failed.Tag != files.DeleteBatchErrorTooManyWriteOperations {
+		t.Errorf("Unexpected result %+v, %v", res, err)
+	}
+
+	// Cancellation.
+	client = &jobClient{launch: launched, pending: 1 << 30}
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err = files.DeleteBatchAndWait(ctx, client, arg, poller); err != context.DeadlineExceeded {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -81,6 +81,18 @@ func (u *BaseTagError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a BaseTagError instance
+func (u *BaseTagError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AddTagError : has no documentation (yet)
 type AddTagError struct {
 	dropbox.Tagged
@@ -116,6 +128,18 @@ func (u *AddTagError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AddTagError instance
+func (u *AddTagError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GetMetadataArg : has no documentation (yet)
 type GetMetadataArg struct {
 	// Path : The path of a file or folder on Dropbox.
@@ -197,6 +221,18 @@ func (u *GetMetadataError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetMetadataError instance
+func (u *GetMetadataError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AlphaGetMetadataError : has no documentation (yet)
 type AlphaGetMetadataError struct {
 	dropbox.Tagged
@@ -238,6 +274,23 @@ func (u *AlphaGetMetadataError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AlphaGetMetadataError instance
+func (u *AlphaGetMetadataError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	case "properties_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertiesError *file_properties.LookUpPropertiesError `json:"properties_error"`
+		}{u.Tagged, u.PropertiesError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CommitInfo : has no documentation (yet)
 type CommitInfo struct {
 	// Path : Path in the user's Dropbox to save the file.
@@ -404,6 +457,24 @@ func (u *CreateFolderBatchJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a CreateFolderBatchJobStatus instance
+func (u *CreateFolderBatchJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap CreateFolderBatchResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *CreateFolderBatchError `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CreateFolderBatchLaunch : Result returned by `createFolderBatch` that may
 // either launch an asynchronous job or complete synchronously.
 type CreateFolderBatchLaunch struct {
@@ -451,6 +522,24 @@ func (u *CreateFolderBatchLaunch) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a CreateFolderBatchLaunch instance
+func (u *CreateFolderBatchLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		type wrap CreateFolderBatchResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FileOpsResult : has no documentation (yet)
 type FileOpsResult struct {
 }
@@ -517,6 +606,24 @@ func (u *CreateFolderBatchResultEntry) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a CreateFolderBatchResultEntry instance
+func (u *CreateFolderBatchResultEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap CreateFolderEntryResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "failure":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failure *CreateFolderEntryError `json:"failure"`
+		}{u.Tagged, u.Failure})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CreateFolderEntryError : has no documentation (yet)
 type CreateFolderEntryError struct {
 	dropbox.Tagged
@@ -551,6 +658,18 @@ func (u *CreateFolderEntryError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a CreateFolderEntryError instance
+func (u *CreateFolderEntryError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CreateFolderEntryResult : has no documentation (yet)
 type CreateFolderEntryResult struct {
 	// Metadata : Metadata of the created folder.
@@ -597,6 +716,18 @@ func (u *CreateFolderError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a CreateFolderError instance
+func (u *CreateFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CreateFolderResult : has no documentation (yet)
 type CreateFolderResult struct {
 	FileOpsResult
@@ -694,6 +825,24 @@ func (u *DeleteBatchJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a DeleteBatchJobStatus instance
+func (u *DeleteBatchJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap DeleteBatchResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *DeleteBatchError `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // DeleteBatchLaunch : Result returned by `deleteBatch` that may either launch
 // an asynchronous job or complete synchronously.
 type DeleteBatchLaunch struct {
@@ -741,6 +890,24 @@ func (u *DeleteBatchLaunch) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a DeleteBatchLaunch instance
+func (u *DeleteBatchLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		type wrap DeleteBatchResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // DeleteBatchResult : has no documentation (yet)
 type DeleteBatchResult struct {
 	FileOpsResult
@@ -828,6 +995,24 @@ func (u *DeleteBatchResultEntry) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a DeleteBatchResultEntry instance
+func (u *DeleteBatchResultEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap DeleteBatchResultData
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "failure":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failure *DeleteError `json:"failure"`
+		}{u.Tagged, u.Failure})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // DeleteError : has no documentation (yet)
 type DeleteError struct {
 	dropbox.Tagged
@@ -872,6 +1057,23 @@ func (u *DeleteError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a DeleteError instance
+func (u *DeleteError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathLookup *LookupError `json:"path_lookup"`
+		}{u.Tagged, u.PathLookup})
+	case "path_write":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathWrite *WriteError `json:"path_write"`
+		}{u.Tagged, u.PathWrite})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // DeleteResult : has no documentation (yet)
 type DeleteResult struct {
 	FileOpsResult
@@ -1024,6 +1226,15 @@ func NewDeletedMetadata(Name string) *DeletedMetadata {
 	return s
 }
 
+// MarshalJSON serializes a DeletedMetadata instance, including its `.tag`
+func (u *DeletedMetadata) MarshalJSON() ([]byte, error) {
+	type wrap DeletedMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "deleted"}, (*wrap)(u)})
+}
+
 // Dimensions : Dimensions for a photo or video.
 type Dimensions struct {
 	// Height : Height of the photo/video.
@@ -1092,6 +1303,18 @@ func (u *DownloadError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a DownloadError instance
+func (u *DownloadError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // DownloadZipArg : has no documentation (yet)
 type DownloadZipArg struct {
 	// Path : The path of the folder to download.
@@ -1141,6 +1364,18 @@ func (u *DownloadZipError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a DownloadZipError instance
+func (u *DownloadZipError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // DownloadZipResult : has no documentation (yet)
 type DownloadZipResult struct {
 	// Metadata : has no documentation (yet)
@@ -1209,6 +1444,18 @@ func (u *ExportError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ExportError instance
+func (u *ExportError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ExportInfo : Export information for a file.
 type ExportInfo struct {
 	// ExportAs : Format to which the file can be exported to.
@@ -1333,6 +1580,19 @@ func (u *FileLockContent) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a FileLockContent instance
+func (u *FileLockContent) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "single_user":
+		type wrap SingleUserLock
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SingleUser)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FileLockMetadata : has no documentation (yet)
 type FileLockMetadata struct {
 	// IsLockholder : True if caller holds the file lock.
@@ -1416,6 +1676,15 @@ func NewFileMetadata(Name string, Id string, ClientModified time.Time, ServerMod
 	return s
 }
 
+// MarshalJSON serializes a FileMetadata instance, including its `.tag`
+func (u *FileMetadata) MarshalJSON() ([]byte, error) {
+	type wrap FileMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "file"}, (*wrap)(u)})
+}
+
 // SharingInfo : Sharing info for a file or folder.
 type SharingInfo struct {
 	// ReadOnly : True if the file or folder is inside a read-only shared
@@ -1486,6 +1755,15 @@ func NewFolderMetadata(Name string, Id string) *FolderMetadata {
 	return s
 }
 
+// MarshalJSON serializes a FolderMetadata instance, including its `.tag`
+func (u *FolderMetadata) MarshalJSON() ([]byte, error) {
+	type wrap FolderMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "folder"}, (*wrap)(u)})
+}
+
 // FolderSharingInfo : Sharing info for a folder which is contained in a shared
 // folder or is a shared folder mount point.
 type FolderSharingInfo struct {
@@ -1561,6 +1839,18 @@ func (u *GetCopyReferenceError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetCopyReferenceError instance
+func (u *GetCopyReferenceError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GetCopyReferenceResult : has no documentation (yet)
 type GetCopyReferenceResult struct {
 	// Metadata : Metadata of the file or folder.
@@ -1684,6 +1974,18 @@ func (u *GetTemporaryLinkError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetTemporaryLinkError instance
+func (u *GetTemporaryLinkError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GetTemporaryLinkResult : has no documentation (yet)
 type GetTemporaryLinkResult struct {
 	// Metadata : Metadata of the file.
@@ -1829,6 +2131,24 @@ func (u *GetThumbnailBatchResultEntry) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetThumbnailBatchResultEntry instance
+func (u *GetThumbnailBatchResultEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap GetThumbnailBatchResultData
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "failure":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failure *ThumbnailError `json:"failure"`
+		}{u.Tagged, u.Failure})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GpsCoordinates : GPS coordinates for a photo or video.
 type GpsCoordinates struct {
 	// Latitude : Latitude of the GPS coordinates.
@@ -1977,6 +2297,18 @@ func (u *ListFolderContinueError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListFolderContinueError instance
+func (u *ListFolderContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListFolderError : has no documentation (yet)
 type ListFolderError struct {
 	dropbox.Tagged
@@ -2019,6 +2351,23 @@ func (u *ListFolderError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListFolderError instance
+func (u *ListFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	case "template_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TemplateError *file_properties.TemplateError `json:"template_error"`
+		}{u.Tagged, u.TemplateError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListFolderGetLatestCursorResult : has no documentation (yet)
 type ListFolderGetLatestCursorResult struct {
 	// Cursor : Pass the cursor into `listFolderContinue` to see what's changed
@@ -2186,6 +2535,18 @@ func (u *ListRevisionsError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListRevisionsError instance
+func (u *ListRevisionsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListRevisionsMode : has no documentation (yet)
 type ListRevisionsMode struct {
 	dropbox.Tagged
@@ -2324,6 +2685,24 @@ func (u *LockFileError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LockFileError instance
+func (u *LockFileError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathLookup *LookupError `json:"path_lookup"`
+		}{u.Tagged, u.PathLookup})
+	case "lock_conflict":
+		type wrap LockConflictError
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LockConflict)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // LockFileResult : has no documentation (yet)
 type LockFileResult struct {
 	// Metadata : Metadata of the file.
@@ -2402,6 +2781,24 @@ func (u *LockFileResultEntry) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LockFileResultEntry instance
+func (u *LockFileResultEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap LockFileResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "failure":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failure *LockFileError `json:"failure"`
+		}{u.Tagged, u.Failure})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // LookupError : has no documentation (yet)
 type LookupError struct {
 	dropbox.Tagged
@@ -2448,6 +2845,18 @@ func (u *LookupError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LookupError instance
+func (u *LookupError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "malformed_path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MalformedPath string `json:"malformed_path"`
+		}{u.Tagged, u.MalformedPath})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MediaInfo : has no documentation (yet)
 type MediaInfo struct {
 	dropbox.Tagged
@@ -2484,6 +2893,18 @@ func (u *MediaInfo) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MediaInfo instance
+func (u *MediaInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "metadata":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Metadata IsMediaMetadata `json:"metadata"`
+		}{u.Tagged, u.Metadata})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MediaMetadata : Metadata for a photo or video.
 type MediaMetadata struct {
 	// Dimensions : Dimension of the photo/video.
@@ -2601,6 +3022,18 @@ func (u *MetadataV2) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MetadataV2 instance
+func (u *MetadataV2) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "metadata":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Metadata IsMetadata `json:"metadata"`
+		}{u.Tagged, u.Metadata})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MinimalFileLinkMetadata : has no documentation (yet)
 type MinimalFileLinkMetadata struct {
 	// Url : URL of the shared link.
@@ -2835,6 +3268,18 @@ func (u *PaperUpdateError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PaperUpdateError instance
+func (u *PaperUpdateError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PaperUpdateResult : has no documentation (yet)
 type PaperUpdateResult struct {
 	// PaperRevision : The current doc revision.
@@ -2890,6 +3335,24 @@ func (u *PathOrLink) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PathOrLink instance
+func (u *PathOrLink) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path string `json:"path"`
+		}{u.Tagged, u.Path})
+	case "link":
+		type wrap SharedLinkFileInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Link)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PathToTags : has no documentation (yet)
 type PathToTags struct {
 	// Path : Path of the item.
@@ -2917,6 +3380,15 @@ func NewPhotoMetadata() *PhotoMetadata {
 	return s
 }
 
+// MarshalJSON serializes a PhotoMetadata instance, including its `.tag`
+func (u *PhotoMetadata) MarshalJSON() ([]byte, error) {
+	type wrap PhotoMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "photo"}, (*wrap)(u)})
+}
+
 // PreviewArg : has no documentation (yet)
 type PreviewArg struct {
 	// Path : The path of the file to preview.
@@ -2968,6 +3440,18 @@ func (u *PreviewError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PreviewError instance
+func (u *PreviewError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PreviewResult : has no documentation (yet)
 type PreviewResult struct {
 	// FileMetadata : Metadata corresponding to the file received as an
@@ -3127,6 +3611,38 @@ func (u *RelocationError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelocationError instance
+func (u *RelocationError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "from_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FromLookup *LookupError `json:"from_lookup"`
+		}{u.Tagged, u.FromLookup})
+	case "from_write":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FromWrite *WriteError `json:"from_write"`
+		}{u.Tagged, u.FromWrite})
+	case "to":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			To *WriteError `json:"to"`
+		}{u.Tagged, u.To})
+	case "cant_move_into_vault":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CantMoveIntoVault *MoveIntoVaultError `json:"cant_move_into_vault"`
+		}{u.Tagged, u.CantMoveIntoVault})
+	case "cant_move_into_family":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CantMoveIntoFamily *MoveIntoFamilyError `json:"cant_move_into_family"`
+		}{u.Tagged, u.CantMoveIntoFamily})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelocationBatchError : has no documentation (yet)
 type RelocationBatchError struct {
 	dropbox.Tagged
@@ -3207,6 +3723,38 @@ func (u *RelocationBatchError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchError instance
+func (u *RelocationBatchError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "from_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FromLookup *LookupError `json:"from_lookup"`
+		}{u.Tagged, u.FromLookup})
+	case "from_write":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FromWrite *WriteError `json:"from_write"`
+		}{u.Tagged, u.FromWrite})
+	case "to":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			To *WriteError `json:"to"`
+		}{u.Tagged, u.To})
+	case "cant_move_into_vault":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CantMoveIntoVault *MoveIntoVaultError `json:"cant_move_into_vault"`
+		}{u.Tagged, u.CantMoveIntoVault})
+	case "cant_move_into_family":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CantMoveIntoFamily *MoveIntoFamilyError `json:"cant_move_into_family"`
+		}{u.Tagged, u.CantMoveIntoFamily})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelocationBatchErrorEntry : has no documentation (yet)
 type RelocationBatchErrorEntry struct {
 	dropbox.Tagged
@@ -3243,6 +3791,18 @@ func (u *RelocationBatchErrorEntry) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchErrorEntry instance
+func (u *RelocationBatchErrorEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "relocation_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			RelocationError *RelocationError `json:"relocation_error"`
+		}{u.Tagged, u.RelocationError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelocationBatchJobStatus : has no documentation (yet)
 type RelocationBatchJobStatus struct {
 	dropbox.Tagged
@@ -3285,6 +3845,24 @@ func (u *RelocationBatchJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchJobStatus instance
+func (u *RelocationBatchJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap RelocationBatchResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *RelocationBatchError `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelocationBatchLaunch : Result returned by `copyBatch` or `moveBatch` that
 // may either launch an asynchronous job or complete synchronously.
 type RelocationBatchLaunch struct {
@@ -3332,6 +3910,24 @@ func (u *RelocationBatchLaunch) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchLaunch instance
+func (u *RelocationBatchLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		type wrap RelocationBatchResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelocationBatchResult : has no documentation (yet)
 type RelocationBatchResult struct {
 	FileOpsResult
@@ -3421,6 +4017,23 @@ func (u *RelocationBatchResultEntry) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchResultEntry instance
+func (u *RelocationBatchResultEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Success IsMetadata `json:"success"`
+		}{u.Tagged, u.Success})
+	case "failure":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failure *RelocationBatchErrorEntry `json:"failure"`
+		}{u.Tagged, u.Failure})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelocationBatchV2JobStatus : Result returned by `copyBatchCheck` or
 // `moveBatchCheck` that may either be in progress or completed with result for
 // each entry.
@@ -3457,6 +4070,19 @@ func (u *RelocationBatchV2JobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchV2JobStatus instance
+func (u *RelocationBatchV2JobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap RelocationBatchV2Result
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelocationBatchV2Launch : Result returned by `copyBatch` or `moveBatch` that
 // may either launch an asynchronous job or complete synchronously.
 type RelocationBatchV2Launch struct {
@@ -3503,6 +4129,24 @@ func (u *RelocationBatchV2Launch) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelocationBatchV2Launch instance
+func (u *RelocationBatchV2Launch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		type wrap RelocationBatchV2Result
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelocationBatchV2Result : has no documentation (yet)
 type RelocationBatchV2Result struct {
 	FileOpsResult
@@ -3603,6 +4247,18 @@ func (u *RemoveTagError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RemoveTagError instance
+func (u *RemoveTagError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RestoreArg : has no documentation (yet)
 type RestoreArg struct {
 	// Path : The path to save the restored file.
@@ -3664,6 +4320,23 @@ func (u *RestoreError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RestoreError instance
+func (u *RestoreError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path_lookup":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathLookup *LookupError `json:"path_lookup"`
+		}{u.Tagged, u.PathLookup})
+	case "path_write":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PathWrite *WriteError `json:"path_write"`
+		}{u.Tagged, u.PathWrite})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SaveCopyReferenceArg : has no documentation (yet)
 type SaveCopyReferenceArg struct {
 	// CopyReference : A copy reference returned by `copyReferenceGet`.
@@ -3718,6 +4391,18 @@ func (u *SaveCopyReferenceError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SaveCopyReferenceError instance
+func (u *SaveCopyReferenceError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SaveCopyReferenceResult : has no documentation (yet)
 type SaveCopyReferenceResult struct {
 	// Metadata : The metadata of the saved file or folder in the user's
@@ -3804,6 +4489,18 @@ func (u *SaveUrlError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SaveUrlError instance
+func (u *SaveUrlError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SaveUrlJobStatus : has no documentation (yet)
 type SaveUrlJobStatus struct {
 	dropbox.Tagged
@@ -3846,6 +4543,24 @@ func (u *SaveUrlJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SaveUrlJobStatus instance
+func (u *SaveUrlJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap FileMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *SaveUrlError `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SaveUrlResult : has no documentation (yet)
 type SaveUrlResult struct {
 	dropbox.Tagged
@@ -3891,6 +4606,24 @@ func (u *SaveUrlResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SaveUrlResult instance
+func (u *SaveUrlResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		type wrap FileMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SearchArg : has no documentation (yet)
 type SearchArg struct {
 	// Path : The path in the user's Dropbox to search. Should probably be a
@@ -3965,6 +4698,23 @@ func (u *SearchError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SearchError instance
+func (u *SearchError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	case "invalid_argument":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidArgument string `json:"invalid_argument"`
+		}{u.Tagged, u.InvalidArgument})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SearchMatch : has no documentation (yet)
 type SearchMatch struct {
 	// MatchType : The type of the match.
@@ -4321,6 +5071,18 @@ func (u *SyncSettingsError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SyncSettingsError instance
+func (u *SyncSettingsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // Tag : Tag that can be added in multiple ways.
 type Tag struct {
 	dropbox.Tagged
@@ -4355,6 +5117,19 @@ func (u *Tag) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a Tag instance
+func (u *Tag) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_generated_tag":
+		type wrap UserGeneratedTag
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UserGeneratedTag)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ThumbnailArg : has no documentation (yet)
 type ThumbnailArg struct {
 	// Path : The path to the image file you want to thumbnail.
@@ -4415,6 +5190,18 @@ func (u *ThumbnailError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ThumbnailError instance
+func (u *ThumbnailError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ThumbnailFormat : has no documentation (yet)
 type ThumbnailFormat struct {
 	dropbox.Tagged
@@ -4521,6 +5308,18 @@ func (u *ThumbnailV2Error) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ThumbnailV2Error instance
+func (u *ThumbnailV2Error) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UnlockFileArg : has no documentation (yet)
 type UnlockFileArg struct {
 	// Path : Path in the user's Dropbox to a file.
@@ -4616,6 +5415,24 @@ func (u *UploadError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UploadError instance
+func (u *UploadError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		type wrap UploadWriteFailed
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Path)})
+	case "properties_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertiesError *file_properties.InvalidPropertyGroupError `json:"properties_error"`
+		}{u.Tagged, u.PropertiesError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UploadSessionAppendArg : has no documentation (yet)
 type UploadSessionAppendArg struct {
 	// Cursor : Contains the upload session ID and the offset.
@@ -4683,6 +5500,19 @@ func (u *UploadSessionLookupError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionLookupError instance
+func (u *UploadSessionLookupError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "incorrect_offset":
+		type wrap UploadSessionOffsetError
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.IncorrectOffset)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UploadSessionAppendError : has no documentation (yet)
 type UploadSessionAppendError struct {
 	dropbox.Tagged
@@ -4728,6 +5558,19 @@ func (u *UploadSessionAppendError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionAppendError instance
+func (u *UploadSessionAppendError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "incorrect_offset":
+		type wrap UploadSessionOffsetError
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.IncorrectOffset)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UploadSessionCursor : has no documentation (yet)
 type UploadSessionCursor struct {
 	// SessionId : The upload session ID (returned by `uploadSessionStart`).
@@ -4814,6 +5657,19 @@ func (u *UploadSessionFinishBatchJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionFinishBatchJobStatus instance
+func (u *UploadSessionFinishBatchJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap UploadSessionFinishBatchResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UploadSessionFinishBatchLaunch : Result returned by
 // `uploadSessionFinishBatch` that may either launch an asynchronous job or
 // complete synchronously.
@@ -4862,6 +5718,24 @@ func (u *UploadSessionFinishBatchLaunch) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionFinishBatchLaunch instance
+func (u *UploadSessionFinishBatchLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		type wrap UploadSessionFinishBatchResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UploadSessionFinishBatchResult : has no documentation (yet)
 type UploadSessionFinishBatchResult struct {
 	// Entries : Each entry in `UploadSessionFinishBatchArg.entries` will appear
@@ -4917,6 +5791,24 @@ func (u *UploadSessionFinishBatchResultEntry) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionFinishBatchResultEntry instance
+func (u *UploadSessionFinishBatchResultEntry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap FileMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "failure":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failure *UploadSessionFinishError `json:"failure"`
+		}{u.Tagged, u.Failure})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UploadSessionFinishError : has no documentation (yet)
 type UploadSessionFinishError struct {
 	dropbox.Tagged
@@ -4982,6 +5874,28 @@ func (u *UploadSessionFinishError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UploadSessionFinishError instance
+func (u *UploadSessionFinishError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "lookup_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			LookupFailed *UploadSessionLookupError `json:"lookup_failed"`
+		}{u.Tagged, u.LookupFailed})
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *WriteError `json:"path"`
+		}{u.Tagged, u.Path})
+	case "properties_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PropertiesError *file_properties.InvalidPropertyGroupError `json:"properties_error"`
+		}{u.Tagged, u.PropertiesError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UploadSessionOffsetError : has no documentation (yet)
 type UploadSessionOffsetError struct {
 	// CorrectOffset : The offset up to which data has been collected.
@@ -5132,6 +6046,15 @@ func NewVideoMetadata() *VideoMetadata {
 	return s
 }
 
+// MarshalJSON serializes a VideoMetadata instance, including its `.tag`
+func (u *VideoMetadata) MarshalJSON() ([]byte, error) {
+	type wrap VideoMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "video"}, (*wrap)(u)})
+}
+
 // WriteConflictError : has no documentation (yet)
 type WriteConflictError struct {
 	dropbox.Tagged
@@ -5201,6 +6124,23 @@ func (u *WriteError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a WriteError instance
+func (u *WriteError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "malformed_path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MalformedPath string `json:"malformed_path"`
+		}{u.Tagged, u.MalformedPath})
+	case "conflict":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Conflict *WriteConflictError `json:"conflict"`
+		}{u.Tagged, u.Conflict})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // WriteMode : Your intent when writing a file to some path. This is used to
 // determine what constitutes a conflict and what the autorename strategy is. In
 // some situations, the conflict behavior is identical: (a) If the target path
@@ -5259,3 +6199,15 @@ func (u *WriteMode) UnmarshalJSON(body []byte) error {
 	}
 	return nil
 }
+
+// MarshalJSON serializes a WriteMode instance
+func (u *WriteMode) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "update":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Update string `json:"update"`
+		}{u.Tagged, u.Update})
+	}
+	return json.Marshal(u.Tagged)
+}
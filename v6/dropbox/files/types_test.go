@@ -0,0 +1,110 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestRoundTripJSON(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		value  func() interface{}
+		golden string
+	}{
+		{
+			name:   "WriteMode update",
+			value:  func() interface{} { return new(files.WriteMode) },
+			golden: `{".tag": "update", "update": "a1c10ce0dd78"}`,
+		},
+		{
+			name:   "WriteMode overwrite",
+			value:  func() interface{} { return new(files.WriteMode) },
+			golden: `{".tag": "overwrite"}`,
+		},
+		{
+			name:  "ListFolderResult",
+			value: func() interface{} { return new(files.ListFolderResult) },
+			golden: `{
+				"entries": [
+					{
+						".tag": "file",
+						"name": "Prime_Numbers.txt",
+						"id": "id:a4ayc_80_OEAAAAAAAAAXw",
+						"client_modified": "2015-05-12T15:50:38Z",
+						"server_modified": "2015-05-12T15:50:38Z",
+						"rev": "a1c10ce0dd78",
+						"size": 7212,
+						"path_lower": "/homework/math/prime_numbers.txt",
+						"path_display": "/Homework/math/Prime_Numbers.txt",
+						"is_downloadable": true,
+						"content_hash": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+					},
+					{
+						".tag": "folder",
+						"name": "math",
+						"id": "id:a4ayc_80_OEAAAAAAAAAXz",
+						"path_lower": "/homework/math",
+						"path_display": "/Homework/math"
+					},
+					{
+						".tag": "deleted",
+						"name": "old.txt",
+						"path_lower": "/homework/old.txt"
+					}
+				],
+				"cursor": "ZtkX9_EHj3x7PMkVuFIhwKYXEpwpLwyxp9vMKomUhllil9q7eWiAu",
+				"has_more": false
+			}`,
+		},
+		{
+			name:   "LookupError",
+			value:  func() interface{} { return new(files.GetMetadataError) },
+			golden: `{".tag": "path", "path": {".tag": "malformed_path", "malformed_path": "/a\\b"}}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			v := test.value()
+			if err := json.Unmarshal([]byte(test.golden), v); err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var want, got interface{}
+			if err = json.Unmarshal([]byte(test.golden), &want); err != nil {
+				t.Fatal(err)
+			}
+			if err = json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("Want %s got %s", test.golden, b)
+			}
+		})
+	}
+}
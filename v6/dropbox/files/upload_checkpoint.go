@@ -0,0 +1,138 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrCheckpointMismatch is returned by Uploader.Resume if the content does
+// not match the chunk hashes recorded in the checkpoint.
+var ErrCheckpointMismatch = errors.New("files: upload content does not match checkpoint")
+
+// UploadCheckpoint records the progress of a sequential upload session. It
+// can be serialized, e.g. as JSON, and passed to Uploader.Resume to continue
+// the upload from another process.
+type UploadCheckpoint struct {
+	// SessionId : The upload session ID.
+	SessionId string `json:"session_id"`
+	// Offset : Number of bytes appended to the session so far.
+	Offset uint64 `json:"offset"`
+	// ChunkSize : Size of every chunk but the last one.
+	ChunkSize int64 `json:"chunk_size"`
+	// ChunkHashes : Content hashes of the chunks appended so far, in order.
+	ChunkHashes []string `json:"chunk_hashes"`
+	// Commit : Where and how the file is saved once the session is finished.
+	Commit *CommitInfo `json:"commit"`
+}
+
+// rewind moves cp back to the start of the chunk containing offset.
+func (cp *UploadCheckpoint) rewind(offset uint64) {
+	if offset == cp.Offset {
+		return
+	}
+	n := int(offset / uint64(cp.ChunkSize))
+	if n < len(cp.ChunkHashes) {
+		cp.ChunkHashes = cp.ChunkHashes[:n]
+	}
+	cp.Offset = uint64(len(cp.ChunkHashes)) * uint64(cp.ChunkSize)
+}
+
+// Resume continues the upload recorded in cp and finishes it. r must provide
+// the same content that was passed to Upload, positioned at its start. cp must
+// have a Commit, so the session can be finished.
+//
+// The chunks already uploaded are read again and compared against the
+// checkpoint's chunk hashes, failing with ErrCheckpointMismatch if they
+// differ. Then the offset of the session is reconciled with the offset
+// reported by the server, and the remaining content is appended. cp is
+// updated, and passed to the Uploader's Checkpoint hook, as the upload
+// progresses.
+func (u *Uploader) Resume(ctx context.Context, cp *UploadCheckpoint, r io.ReadSeeker) (*FileMetadata, error) {
+	if cp.ChunkSize <= 0 {
+		return nil, errors.New("files: invalid upload checkpoint")
+	}
+	if cp.Commit == nil {
+		return nil, errors.New("files: upload checkpoint has no Commit")
+	}
+
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifyChunks(cp, r); err != nil {
+		return nil, err
+	}
+
+	for retries := 0; ; retries++ {
+		offset, err := u.sessionOffset(ctx, cp)
+		if err != nil {
+			return nil, err
+		}
+		cp.rewind(offset)
+		if _, err = r.Seek(start+int64(cp.Offset), io.SeekStart); err != nil {
+			return nil, err
+		}
+
+		res, err := u.appendAll(ctx, cp, r)
+		if _, ok := incorrectOffset(err); ok && retries < u.maxRetries() {
+			continue
+		}
+		return res, err
+	}
+}
+
+// sessionOffset returns the number of bytes the server has received for the
+// session of cp, by appending no data at the checkpoint's offset.
+func (u *Uploader) sessionOffset(ctx context.Context, cp *UploadCheckpoint) (uint64, error) {
+	arg := NewUploadSessionAppendArg(NewUploadSessionCursor(cp.SessionId, cp.Offset))
+	arg.ContentHash = contentHash(nil)
+	err := u.client.UploadSessionAppendV2Context(ctx, arg, bytes.NewReader(nil))
+	if err == nil {
+		return cp.Offset, nil
+	}
+	if offset, ok := incorrectOffset(err); ok {
+		return offset, nil
+	}
+	return 0, err
+}
+
+// verifyChunks reads the chunks recorded in cp from r and compares their
+// content hashes.
+func verifyChunks(cp *UploadCheckpoint, r io.Reader) error {
+	buf := make([]byte, cp.ChunkSize)
+	for _, hash := range cp.ChunkHashes {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			if err == io.EOF {
+				return ErrCheckpointMismatch
+			}
+			return err
+		}
+		if contentHash(buf[:n]) != hash {
+			return ErrCheckpointMismatch
+		}
+	}
+	return nil
+}
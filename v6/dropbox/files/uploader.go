@@ -0,0 +1,370 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+const (
+	// DefaultChunkSize is the amount of data sent per request by an Uploader
+	// unless configured otherwise.
+	DefaultChunkSize = 16 << 20
+	// MaxChunkSize is the largest chunk accepted by the upload session routes,
+	// rounded down to a multiple of 4 MiB.
+	MaxChunkSize = 148 << 20
+
+	// Concurrent upload sessions require every chunk but the last one to be a
+	// multiple of this size.
+	chunkAlignment = 4 << 20
+	// Default number of chunks, or byte ranges, transferred in parallel.
+	defaultConcurrency = 4
+	// Default number of times a chunk, or byte range, is retried.
+	defaultMaxRetries = 3
+)
+
+// Uploader uploads files of arbitrary size. Files that fit into a single
+// chunk are sent with `Upload`, larger files through an upload session.
+//
+// Sources implementing io.ReaderAt and io.Seeker, like *os.File, are uploaded
+// using a concurrent upload session with several chunks in flight, unless
+// Checkpoint is set. Any other io.Reader is streamed sequentially.
+//
+// Each request carries the content hash of the data it sends. Transient
+// failures are retried according to the Config's RetryPolicy. If the server
+// reports that a chunk of a sequential session was appended at the wrong
+// offset, the chunk is re-sent starting from the offset reported by the
+// server. Concurrent sessions only accept chunks of a multiple of 4 MiB, so
+// their chunks are re-sent whole, at their own offset.
+type Uploader struct {
+	// Size of each chunk. It is rounded up to a multiple of 4 MiB and capped at
+	// MaxChunkSize. Defaults to DefaultChunkSize.
+	ChunkSize int64
+	// Maximum number of chunks appended in parallel. Defaults to 4.
+	Concurrency int
+	// Maximum number of times a chunk is re-sent after an offset mismatch.
+	// Defaults to 3.
+	MaxRetries int
+	// If set, uploads always use a sequential upload session, and Checkpoint
+	// is called with the progress of the session after each appended chunk.
+	// Persisting the checkpoint allows the upload to be continued with Resume,
+	// e.g. after a restart. Returning an error aborts the upload.
+	Checkpoint func(cp *UploadCheckpoint) error
+
+	client Client
+}
+
+// NewUploader returns a new Uploader sending requests through client.
+func NewUploader(client Client) *Uploader {
+	return &Uploader{client: client}
+}
+
+func (u *Uploader) chunkSize() int64 {
+	size := u.ChunkSize
+	if size <= 0 {
+		return DefaultChunkSize
+	}
+	if size > MaxChunkSize {
+		return MaxChunkSize
+	}
+	return (size + chunkAlignment - 1) / chunkAlignment * chunkAlignment
+}
+
+func (u *Uploader) concurrency() int {
+	if u.Concurrency <= 0 {
+		return defaultConcurrency
+	}
+	return u.Concurrency
+}
+
+func (u *Uploader) maxRetries() int {
+	if u.MaxRetries <= 0 {
+		return defaultMaxRetries
+	}
+	return u.MaxRetries
+}
+
+// Upload uploads everything read from r and saves it as described by commit.
+// Sources implementing io.ReaderAt and io.Seeker are read from their current
+// position on with ReadAt, and are left positioned at their end, as if they
+// had been read sequentially.
+func (u *Uploader) Upload(ctx context.Context, r io.Reader, commit *CommitInfo) (*FileMetadata, error) {
+	if ra, ok := r.(io.ReaderAt); ok && u.Checkpoint == nil {
+		if s, ok := r.(io.Seeker); ok {
+			start, err := s.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return nil, err
+			}
+			end, err := s.Seek(0, io.SeekEnd)
+			if err != nil {
+				return nil, err
+			}
+			return u.UploadAt(ctx, io.NewSectionReader(ra, start, end-start), end-start, commit)
+		}
+	}
+
+	buf := make([]byte, u.chunkSize())
+	n, err := io.ReadFull(r, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return u.uploadSingle(ctx, buf[:n], commit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u.uploadSequential(ctx, r, buf, commit)
+}
+
+// UploadAt uploads the first size bytes of r and saves them as described by
+// commit. Chunks are appended concurrently.
+func (u *Uploader) UploadAt(ctx context.Context, r io.ReaderAt, size int64, commit *CommitInfo) (*FileMetadata, error) {
+	chunkSize := u.chunkSize()
+	if size <= chunkSize {
+		buf := make([]byte, size)
+		if _, err := readChunkAt(r, buf, 0); err != nil {
+			return nil, err
+		}
+		return u.uploadSingle(ctx, buf, commit)
+	}
+
+	arg := NewUploadSessionStartBatchArg(1)
+	arg.SessionType = &UploadSessionType{Tagged: dropbox.Tagged{Tag: UploadSessionTypeConcurrent}}
+	res, err := u.client.UploadSessionStartBatchContext(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.SessionIds) != 1 {
+		return nil, errors.New("files: expected exactly one upload session")
+	}
+	sessionID := res.SessionIds[0]
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	offsets := make(chan int64)
+	for i := 0; i < u.concurrency(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := make([]byte, chunkSize)
+			for offset := range offsets {
+				chunk := buf[:min64(chunkSize, size-offset)]
+				_, err := readChunkAt(r, chunk, offset)
+				if err == nil {
+					last := offset+int64(len(chunk)) == size
+					err = u.appendChunk(ctx, sessionID, uint64(offset), chunk, contentHash(chunk), last, true)
+				}
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+loop:
+	for offset := int64(0); offset < size; offset += chunkSize {
+		select {
+		case offsets <- offset:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(offsets)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return u.finish(ctx, sessionID, uint64(size), commit)
+}
+
+// uploadSingle uploads content with a single request.
+func (u *Uploader) uploadSingle(ctx context.Context, content []byte, commit *CommitInfo) (*FileMetadata, error) {
+	arg := &UploadArg{CommitInfo: *commit, ContentHash: contentHash(content)}
+	return u.client.UploadContext(ctx, arg, bytes.NewReader(content))
+}
+
+// uploadSequential streams first followed by the rest of r through a
+// sequential upload session.
+func (u *Uploader) uploadSequential(ctx context.Context, r io.Reader, first []byte, commit *CommitInfo) (*FileMetadata, error) {
+	hash := contentHash(first)
+	arg := NewUploadSessionStartArg()
+	arg.ContentHash = hash
+	res, err := u.client.UploadSessionStartContext(ctx, arg, bytes.NewReader(first))
+	if err != nil {
+		return nil, err
+	}
+
+	cp := &UploadCheckpoint{
+		SessionId:   res.SessionId,
+		Offset:      uint64(len(first)),
+		ChunkSize:   int64(len(first)),
+		ChunkHashes: []string{hash},
+		Commit:      commit,
+	}
+	if err = u.checkpoint(cp); err != nil {
+		return nil, err
+	}
+
+	return u.appendAll(ctx, cp, r)
+}
+
+// appendAll appends the rest of r to the session of cp, one chunk at a time,
+// and finishes the session.
+func (u *Uploader) appendAll(ctx context.Context, cp *UploadCheckpoint, r io.Reader) (*FileMetadata, error) {
+	buf := make([]byte, cp.ChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			hash := contentHash(buf[:n])
+			if aErr := u.appendChunk(ctx, cp.SessionId, cp.Offset, buf[:n], hash, false, false); aErr != nil {
+				return nil, aErr
+			}
+			cp.Offset += uint64(n)
+			cp.ChunkHashes = append(cp.ChunkHashes, hash)
+			if cErr := u.checkpoint(cp); cErr != nil {
+				return nil, cErr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return u.finish(ctx, cp.SessionId, cp.Offset, cp.Commit)
+}
+
+func (u *Uploader) checkpoint(cp *UploadCheckpoint) error {
+	if u.Checkpoint == nil {
+		return nil
+	}
+	return u.Checkpoint(cp)
+}
+
+// appendChunk appends chunk at offset, re-sending the part of it the server
+// reports as missing after an offset mismatch. hash is the content hash of
+// chunk. Chunks of concurrent sessions are never re-sent in part, as
+// all chunks but the last must be a multiple of 4 MiB; appending a whole
+// chunk at its offset again is safe instead. A chunk is stored once the
+// server reports an offset at or past its end.
+func (u *Uploader) appendChunk(ctx context.Context, sessionID string, offset uint64, chunk []byte, hash string, close, concurrent bool) error {
+	for retries := 0; ; retries++ {
+		arg := NewUploadSessionAppendArg(NewUploadSessionCursor(sessionID, offset))
+		arg.Close = close
+		arg.ContentHash = hash
+		err := u.client.UploadSessionAppendV2Context(ctx, arg, bytes.NewReader(chunk))
+		if err == nil {
+			return nil
+		}
+
+		correct, ok := incorrectOffset(err)
+		if !ok || retries >= u.maxRetries() {
+			return err
+		}
+		end := offset + uint64(len(chunk))
+		if correct >= end {
+			// The server already has the whole chunk.
+			return nil
+		}
+		if concurrent {
+			continue
+		}
+		if correct < offset {
+			return err
+		}
+		chunk = chunk[correct-offset:]
+		hash = contentHash(chunk)
+		offset = correct
+	}
+}
+
+func (u *Uploader) finish(ctx context.Context, sessionID string, size uint64, commit *CommitInfo) (*FileMetadata, error) {
+	arg := NewUploadSessionFinishArg(NewUploadSessionCursor(sessionID, size), commit)
+	return u.client.UploadSessionFinishContext(ctx, arg, bytes.NewReader(nil))
+}
+
+// incorrectOffset returns the offset reported by the server if err is an
+// `incorrect_offset` error from `UploadSessionAppendV2` or
+// `UploadSessionFinish`.
+func incorrectOffset(err error) (uint64, bool) {
+	switch e := err.(type) {
+	case UploadSessionAppendV2APIError:
+		if e.EndpointError != nil && e.EndpointError.Tag == UploadSessionAppendErrorIncorrectOffset &&
+			e.EndpointError.IncorrectOffset != nil {
+			return e.EndpointError.IncorrectOffset.CorrectOffset, true
+		}
+	case UploadSessionFinishAPIError:
+		if e.EndpointError == nil || e.EndpointError.Tag != UploadSessionFinishErrorLookupFailed {
+			break
+		}
+		lookupErr := e.EndpointError.LookupFailed
+		if lookupErr != nil && lookupErr.Tag == UploadSessionLookupErrorIncorrectOffset &&
+			lookupErr.IncorrectOffset != nil {
+			return lookupErr.IncorrectOffset.CorrectOffset, true
+		}
+	}
+	return 0, false
+}
+
+// readChunkAt fills buf from r starting at offset.
+func readChunkAt(r io.ReaderAt, buf []byte, offset int64) (int, error) {
+	n, err := r.ReadAt(buf, offset)
+	if n == len(buf) {
+		return n, nil
+	}
+	if err == nil || err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// contentHash returns the hex encoded content hash of b.
+func contentHash(b []byte) string {
+	return dropbox.ContentHashString(b)
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
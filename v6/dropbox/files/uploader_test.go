@@ -0,0 +1,310 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// sessionClient keeps upload sessions in memory.
+type sessionClient struct {
+	files.Client
+
+	mu       sync.Mutex
+	sessions map[string][]byte
+	uploads  int
+	appends  int
+	// Number of bytes of the next sequential append that are stored before
+	// the request fails.
+	drop int
+}
+
+func newSessionClient() *sessionClient {
+	return &sessionClient{sessions: map[string][]byte{}}
+}
+
+func (c *sessionClient) UploadContext(ctx context.Context, arg *files.UploadArg, content io.Reader, opts ...dropbox.CallOption) (*files.FileMetadata, error) {
+	b, _ := ioutil.ReadAll(content)
+	c.mu.Lock()
+	c.uploads++
+	c.sessions["single"] = b
+	c.mu.Unlock()
+	return &files.FileMetadata{Size: uint64(len(b)), ContentHash: dropbox.ContentHashString(b)}, nil
+}
+
+func (c *sessionClient) UploadSessionStartContext(ctx context.Context, arg *files.UploadSessionStartArg, content io.Reader, opts ...dropbox.CallOption) (*files.UploadSessionStartResult, error) {
+	b, _ := ioutil.ReadAll(content)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions["sequential"] = b
+	return files.NewUploadSessionStartResult("sequential"), nil
+}
+
+func (c *sessionClient) UploadSessionStartBatchContext(ctx context.Context, arg *files.UploadSessionStartBatchArg, opts ...dropbox.CallOption) (*files.UploadSessionStartBatchResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions["concurrent"] = nil
+	return files.NewUploadSessionStartBatchResult([]string{"concurrent"}), nil
+}
+
+func (c *sessionClient) UploadSessionAppendV2Context(ctx context.Context, arg *files.UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) error {
+	b, _ := ioutil.ReadAll(content)
+	if arg.ContentHash != dropbox.ContentHashString(b) {
+		return files.UploadSessionAppendV2APIError{
+			EndpointError: &files.UploadSessionAppendError{
+				Tagged: dropbox.Tagged{Tag: files.UploadSessionAppendErrorContentHashMismatch},
+			},
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.appends++
+	data := c.sessions[arg.Cursor.SessionId]
+	if arg.Cursor.SessionId == "sequential" {
+		if arg.Cursor.Offset != uint64(len(data)) {
+			return files.UploadSessionAppendV2APIError{
+				EndpointError: &files.UploadSessionAppendError{
+					Tagged:          dropbox.Tagged{Tag: files.UploadSessionAppendErrorIncorrectOffset},
+					IncorrectOffset: files.NewUploadSessionOffsetError(uint64(len(data))),
+				},
+			}
+		}
+		if c.drop > 0 {
+			// The connection broke after part of the chunk was stored, and the
+			// retried request was rejected.
+			stored := len(data) + c.drop
+			c.sessions["sequential"] = append(data, b[:c.drop]...)
+			c.drop = 0
+			return files.UploadSessionAppendV2APIError{
+				EndpointError: &files.UploadSessionAppendError{
+					Tagged:          dropbox.Tagged{Tag: files.UploadSessionAppendErrorIncorrectOffset},
+					IncorrectOffset: files.NewUploadSessionOffsetError(uint64(stored)),
+				},
+			}
+		}
+	}
+	if end := int(arg.Cursor.Offset) + len(b); end > len(data) {
+		data = append(data, make([]byte, end-len(data))...)
+	}
+	copy(data[arg.Cursor.Offset:], b)
+	c.sessions[arg.Cursor.SessionId] = data
+	return nil
+}
+
+func (c *sessionClient) UploadSessionFinishContext(ctx context.Context, arg *files.UploadSessionFinishArg, content io.Reader, opts ...dropbox.CallOption) (*files.FileMetadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b := c.sessions[arg.Cursor.SessionId]
+	return &files.FileMetadata{Size: uint64(len(b)), ContentHash: dropbox.ContentHashString(b)}, nil
+}
+
+func TestUploader(t *testing.T) {
+	content := make([]byte, 18<<20+123)
+	for i := range content {
+		content[i] = byte(i * 7)
+	}
+
+	for _, test := range []struct {
+		name    string
+		source  func() io.Reader
+		drop    int
+		uploads int
+		appends int
+	}{
+		{
+			name:    "concurrent",
+			source:  func() io.Reader { return bytes.NewReader(content) },
+			appends: 5,
+		},
+		{
+			name:    "sequential",
+			source:  func() io.Reader { return io.MultiReader(bytes.NewReader(content)) },
+			appends: 4,
+		},
+		{
+			name:    "sequential with partial append",
+			source:  func() io.Reader { return io.MultiReader(bytes.NewReader(content)) },
+			drop:    1000,
+			appends: 5,
+		},
+		{
+			name:    "small",
+			source:  func() io.Reader { return bytes.NewReader(content[:1<<20]) },
+			uploads: 1,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			client := newSessionClient()
+			client.drop = test.drop
+			uploader := files.NewUploader(client)
+			uploader.ChunkSize = 4 << 20
+
+			src := test.source()
+			res, err := uploader.Upload(context.Background(), src, files.NewCommitInfo("/a.bin"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := content
+			if test.uploads > 0 {
+				want = content[:1<<20]
+			}
+			if res.Size != uint64(len(want)) || res.ContentHash != dropbox.ContentHashString(want) {
+				t.Errorf("Unexpected upload result: %d bytes, hash %s", res.Size, res.ContentHash)
+			}
+			if client.uploads != test.uploads || client.appends != test.appends {
+				t.Errorf("Unexpected requests: %d uploads, %d appends", client.uploads, client.appends)
+			}
+		})
+	}
+}
+
+// misalignedClient reports an offset mismatch for the first append of a
+// concurrent session.
+type misalignedClient struct {
+	*sessionClient
+	failed  bool
+	offsets []uint64
+}
+
+func (c *misalignedClient) UploadSessionAppendV2Context(ctx context.Context, arg *files.UploadSessionAppendArg, content io.Reader, opts ...dropbox.CallOption) error {
+	c.mu.Lock()
+	failed := c.failed
+	c.failed = true
+	c.offsets = append(c.offsets, arg.Cursor.Offset)
+	c.mu.Unlock()
+	if !failed {
+		return files.UploadSessionAppendV2APIError{
+			EndpointError: &files.UploadSessionAppendError{
+				Tagged:          dropbox.Tagged{Tag: files.UploadSessionAppendErrorIncorrectOffset},
+				IncorrectOffset: files.NewUploadSessionOffsetError(arg.Cursor.Offset + 1000),
+			},
+		}
+	}
+	return c.sessionClient.UploadSessionAppendV2Context(ctx, arg, content, opts...)
+}
+
+func TestUploaderConcurrentOffsetMismatch(t *testing.T) {
+	content := make([]byte, 9<<20)
+	client := &misalignedClient{sessionClient: newSessionClient()}
+	uploader := files.NewUploader(client)
+	uploader.ChunkSize = 4 << 20
+	uploader.Concurrency = 1
+
+	src := bytes.NewReader(content)
+	res, err := uploader.Upload(context.Background(), src, files.NewCommitInfo("/a.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Size != uint64(len(content)) || res.ContentHash != dropbox.ContentHashString(content) {
+		t.Errorf("Unexpected upload result: %d bytes, hash %s", res.Size, res.ContentHash)
+	}
+	// The rejected chunk is re-sent whole, at its own offset.
+	if len(client.offsets) != 4 || client.offsets[0] != client.offsets[1] {
+		t.Errorf("Expected the first chunk to be re-sent once, got appends at %v", client.offsets)
+	}
+	for _, offset := range client.offsets {
+		if offset%(4<<20) != 0 {
+			t.Errorf("Expected no part of the chunk to be re-sent, got append at %d", offset)
+		}
+	}
+	if pos, _ := src.Seek(0, io.SeekCurrent); pos != int64(len(content)) {
+		t.Errorf("Expected source to be left at its end, got %d", pos)
+	}
+}
+
+func TestUploaderResume(t *testing.T) {
+	content := make([]byte, 18<<20+123)
+	for i := range content {
+		content[i] = byte(i * 7)
+	}
+
+	client := newSessionClient()
+	uploader := files.NewUploader(client)
+	uploader.ChunkSize = 4 << 20
+
+	// Simulate a crash after the second chunk.
+	var saved []byte
+	killed := errors.New("killed")
+	uploader.Checkpoint = func(cp *files.UploadCheckpoint) error {
+		var err error
+		if saved, err = json.Marshal(cp); err != nil {
+			return err
+		}
+		if len(cp.ChunkHashes) == 2 {
+			return killed
+		}
+		return nil
+	}
+	_, err := uploader.Upload(context.Background(), bytes.NewReader(content), files.NewCommitInfo("/a.bin"))
+	if err != killed {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var cp files.UploadCheckpoint
+	if err = json.Unmarshal(saved, &cp); err != nil {
+		t.Fatal(err)
+	}
+	if cp.Offset != 8<<20 || cp.Commit.Path != "/a.bin" || cp.Commit.Mode.Tag != files.WriteModeAdd {
+		t.Fatalf("Unexpected checkpoint: %s", saved)
+	}
+
+	// Resuming with different content must fail.
+	changed := append([]byte{}, content...)
+	changed[42]++
+	_, err = files.NewUploader(client).Resume(context.Background(), &cp, bytes.NewReader(changed))
+	if err != files.ErrCheckpointMismatch {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// Lose part of the last recorded chunk on the server.
+	client.sessions[cp.SessionId] = client.sessions[cp.SessionId][:6<<20]
+	res, err := files.NewUploader(client).Resume(context.Background(), &cp, bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Size != uint64(len(content)) || res.ContentHash != dropbox.ContentHashString(content) {
+		t.Errorf("Unexpected upload result: %d bytes, hash %s", res.Size, res.ContentHash)
+	}
+	if cp.Offset != uint64(len(content)) || len(cp.ChunkHashes) != 5 {
+		t.Errorf("Unexpected checkpoint: %+v", cp)
+	}
+}
+
+func TestUploaderResumeWithoutCommit(t *testing.T) {
+	fake := &files.Fake{}
+	cp := &files.UploadCheckpoint{SessionId: "session", ChunkSize: 4 << 20}
+	_, err := files.NewUploader(fake).Resume(context.Background(), cp, bytes.NewReader(nil))
+	if err == nil || len(fake.Calls()) != 0 {
+		t.Errorf("Expected error before probing the session, got %v after %d calls", err, len(fake.Calls()))
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Governor limits the number of concurrent requests globally, per team
+// member and per namespace, and adapts the limits to the rate limits the API
+// reports, so that large jobs throttle themselves instead of being rejected:
+//
+//   - a 429 with reason `too_many_write_operations`, caused by contention on
+//     a namespace, halves the limit of the namespace
+//   - any other 429 halves the limit of the member, i.e. of the user of the
+//     access token if no member is selected
+//
+// Limits recover by one for as many successful requests as the current limit.
+// The namespace or member is also paused for the `retry_after` delay of a
+// 429, whether or not it has a limit.
+//
+// Set a Governor as Config.Governor, possibly sharing it between several
+// Configs. Every attempt of a call, including retries, is subject to the
+// limits. Downloads count until their body is closed. Calls without a member
+// count for the user of their credentials, so that Configs of different users
+// sharing a Governor do not throttle each other.
+type Governor struct {
+	// Maximum number of concurrent requests. Zero means no limit.
+	MaxConcurrent int
+	// Maximum number of concurrent requests per team member selected with
+	// AsMemberID or AsMember, or else per access token. Zero means no limit.
+	MaxPerMember int
+	// Maximum number of concurrent requests per namespace, i.e. per path
+	// root, or per home namespace of the member for calls without path root.
+	// Zero means no limit.
+	MaxPerNamespace int
+
+	mu         sync.Mutex
+	changed    chan struct{}
+	global     *limiter
+	members    map[string]*limiter
+	namespaces map[string]*limiter
+}
+
+// limiter is an adaptive limit on the number of concurrent requests.
+type limiter struct {
+	max         int
+	limit       float64
+	active      int
+	pausedUntil time.Time
+}
+
+func newLimiter(max int) *limiter {
+	return &limiter{max: max, limit: float64(max)}
+}
+
+// admits reports whether another request may start at now, and otherwise
+// for how long the limiter is paused.
+func (l *limiter) admits(now time.Time) (bool, time.Duration) {
+	if now.Before(l.pausedUntil) {
+		return false, l.pausedUntil.Sub(now)
+	}
+	return l.max == 0 || l.active < int(l.limit), 0
+}
+
+func (l *limiter) backoff(until time.Time) {
+	if l.max > 0 {
+		l.limit /= 2
+		if l.limit < 1 {
+			l.limit = 1
+		}
+	}
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+func (l *limiter) recover() {
+	if l.max > 0 && l.limit < float64(l.max) {
+		l.limit += 1 / l.limit
+		if l.limit > float64(l.max) {
+			l.limit = float64(l.max)
+		}
+	}
+}
+
+func (l *limiter) idle(now time.Time) bool {
+	return l.active == 0 && l.limit >= float64(l.max) && !now.Before(l.pausedUntil)
+}
+
+// limiters returns the limiters of a request. g.mu must be held.
+func (g *Governor) limiters(member, namespace string) (global, m, ns *limiter) {
+	if g.global == nil {
+		g.changed = make(chan struct{})
+		g.global = newLimiter(g.MaxConcurrent)
+		g.members = map[string]*limiter{}
+		g.namespaces = map[string]*limiter{}
+	}
+	if m = g.members[member]; m == nil {
+		m = newLimiter(g.MaxPerMember)
+		g.members[member] = m
+	}
+	if ns = g.namespaces[namespace]; ns == nil {
+		ns = newLimiter(g.MaxPerNamespace)
+		g.namespaces[namespace] = ns
+	}
+	return g.global, m, ns
+}
+
+// acquire waits until a request on behalf of member in namespace may start,
+// or ctx is done. The returned function has to be called with the response
+// of the request, or nil if it failed without one, and the response body if
+// the request was rejected.
+func (g *Governor) acquire(ctx context.Context, member, namespace string) (func(resp *http.Response, body []byte), error) {
+	if g == nil {
+		return func(*http.Response, []byte) {}, nil
+	}
+
+	for {
+		g.mu.Lock()
+		global, m, ns := g.limiters(member, namespace)
+		now := time.Now()
+		admitted := true
+		var wait time.Duration
+		for _, l := range []*limiter{global, m, ns} {
+			if ok, d := l.admits(now); !ok {
+				admitted = false
+				if d > wait {
+					wait = d
+				}
+			}
+		}
+		if admitted {
+			global.active++
+			m.active++
+			ns.active++
+			g.mu.Unlock()
+			return func(resp *http.Response, body []byte) {
+				g.release(member, namespace, resp, body)
+			}, nil
+		}
+		changed := g.changed
+		g.mu.Unlock()
+
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			expired = timer.C
+		}
+		select {
+		case <-changed:
+		case <-expired:
+		case <-ctx.Done():
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (g *Governor) release(member, namespace string, resp *http.Response, body []byte) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	global, m, ns := g.limiters(member, namespace)
+	global.active--
+	m.active--
+	ns.active--
+
+	now := time.Now()
+	switch {
+	case resp == nil || resp.StatusCode >= 500:
+	case resp.StatusCode == http.StatusTooManyRequests:
+		until := now.Add(retryAfter(resp.Header, body))
+		if rateLimitReason(body) == "too_many_write_operations" {
+			ns.backoff(until)
+		} else {
+			m.backoff(until)
+		}
+	default:
+		global.recover()
+		m.recover()
+		ns.recover()
+	}
+
+	if m.idle(now) {
+		delete(g.members, member)
+	}
+	if ns.idle(now) {
+		delete(g.namespaces, namespace)
+	}
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+// rateLimitReason returns the tag of the reason of a RateLimitError body.
+func rateLimitReason(body []byte) string {
+	var rateLimit struct {
+		Error struct {
+			Reason struct {
+				Tag string `json:".tag"`
+			} `json:"reason"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &rateLimit) != nil {
+		return ""
+	}
+	return rateLimit.Error.Reason.Tag
+}
+
+// releasingBody releases the place of a download in the Governor once it is
+// closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,194 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/check"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/dropboxtest"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+// concurrencyServer responds after a delay, or with a rate limit error while
+// reject holds a reason, and records the maximum number of concurrent
+// requests per Dropbox-API-Select-User, and overall as "*".
+type concurrencyServer struct {
+	mu     sync.Mutex
+	active map[string]int
+	max    map[string]int
+	reject atomic.Value
+}
+
+func (s *concurrencyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if reason, _ := s.reject.Load().(string); reason != "" {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error_summary": "` + reason + `/..", "error": {"reason": {".tag": "` + reason + `"}}}`))
+		return
+	}
+
+	member := r.Header.Get("Dropbox-API-Select-User")
+	s.mu.Lock()
+	s.active[member]++
+	s.active["*"]++
+	for _, k := range []string{member, "*"} {
+		if s.active[k] > s.max[k] {
+			s.max[k] = s.active[k]
+		}
+	}
+	s.mu.Unlock()
+
+	time.Sleep(50 * time.Millisecond)
+
+	s.mu.Lock()
+	s.active[member]--
+	s.active["*"]--
+	s.mu.Unlock()
+	_, _ = w.Write([]byte(`{"result": ""}`))
+}
+
+func runConcurrently(t *testing.T, config dropbox.Config, members ...string) {
+	var wg sync.WaitGroup
+	for _, member := range members {
+		wg.Add(1)
+		go func(member string) {
+			defer wg.Done()
+			if _, err := check.New(config).User(check.NewEchoArg(), dropbox.AsMember(member)); err != nil {
+				t.Error(err)
+			}
+		}(member)
+	}
+	wg.Wait()
+}
+
+func TestGovernor(t *testing.T) {
+	srv := &concurrencyServer{active: map[string]int{}, max: map[string]int{}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	governor := &dropbox.Governor{MaxConcurrent: 3, MaxPerMember: 2}
+	config := dropbox.Config{Token: "token", Governor: governor,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+
+	runConcurrently(t, config, "a", "a", "a", "a", "b", "b", "b", "b")
+	if srv.max["a"] != 2 || srv.max["b"] != 2 || srv.max["*"] != 3 {
+		t.Errorf("Unexpected concurrency %v", srv.max)
+	}
+}
+
+func TestGovernorAdapts(t *testing.T) {
+	srv := &concurrencyServer{active: map[string]int{}, max: map[string]int{}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	governor := &dropbox.Governor{MaxPerNamespace: 4}
+	config := dropbox.Config{Token: "token", Governor: governor,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+
+	// Contention on the namespace halves its limit.
+	srv.reject.Store("too_many_write_operations")
+	if _, err := check.New(config).User(check.NewEchoArg()); err == nil {
+		t.Fatal("Expected rate limit error")
+	}
+	srv.reject.Store("")
+	runConcurrently(t, config, "", "", "", "")
+	if srv.max["*"] != 2 {
+		t.Errorf("Expected concurrency 2 after rate limit, got %d", srv.max["*"])
+	}
+
+	// Other namespaces are not affected.
+	srv.max = map[string]int{}
+	runConcurrently(t, config.WithNamespaceID("123"), "", "", "", "")
+	if srv.max["*"] != 4 {
+		t.Errorf("Expected concurrency 4 in other namespace, got %d", srv.max["*"])
+	}
+}
+
+func TestGovernorUsers(t *testing.T) {
+	srv := &concurrencyServer{active: map[string]int{}, max: map[string]int{}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	governor := &dropbox.Governor{MaxPerMember: 4}
+	config := func(token string) dropbox.Config {
+		return dropbox.Config{Token: token, Governor: governor,
+			URLGenerator: func(hostType string, namespace string, route string) string {
+				return generateURL(ts.URL, namespace, route)
+			}}
+	}
+
+	// A rate limit of one user does not throttle another sharing the Governor.
+	srv.reject.Store("too_many_requests")
+	if _, err := check.New(config("alice")).User(check.NewEchoArg()); err == nil {
+		t.Fatal("Expected rate limit error")
+	}
+	srv.reject.Store("")
+	runConcurrently(t, config("bob"), "", "", "", "")
+	if srv.max["*"] != 4 {
+		t.Errorf("Expected concurrency 4 for other user, got %d", srv.max["*"])
+	}
+	srv.max = map[string]int{}
+	runConcurrently(t, config("alice"), "", "", "", "")
+	if srv.max["*"] != 2 {
+		t.Errorf("Expected concurrency 2 after rate limit, got %d", srv.max["*"])
+	}
+}
+
+func TestGovernorDownload(t *testing.T) {
+	srv := dropboxtest.NewServer()
+	defer srv.Close()
+	if _, err := srv.WriteFile("/a.txt", []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	config := srv.Config()
+	config.Governor = &dropbox.Governor{MaxConcurrent: 1}
+	dbx := files.New(config)
+
+	_, content, err := dbx.Download(files.NewDownloadArg("/a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		_, err := dbx.GetMetadata(files.NewGetMetadataArg("/a.txt"))
+		done <- err
+	}()
+	select {
+	case <-done:
+		t.Fatal("Expected call to wait for the download body to be closed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	content.Close()
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+}
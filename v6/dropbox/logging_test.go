@@ -0,0 +1,92 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package dropbox_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
+)
+
+func TestStructuredLogging(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Dropbox-Request-Id", "abc123")
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_summary": "path/not_found/..", "error": {".tag": "path", "path": {".tag": "not_found"}}}`))
+		}))
+	defer ts.Close()
+
+	var out bytes.Buffer
+	config := dropbox.Config{Token: "token", AsMemberID: "dbmid:1", RedactFields: []string{"path"},
+		StructuredLogger: slog.New(slog.NewJSONHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	arg := sharing.NewCreateSharedLinkWithSettingsArg("/secret.txt")
+	arg.Settings = sharing.NewSharedLinkSettings()
+	arg.Settings.LinkPassword = "hunter2"
+	if _, e := sharing.New(config).CreateSharedLinkWithSettings(arg); e == nil {
+		t.Fatal("Expected error")
+	}
+
+	if strings.Contains(out.String(), "hunter2") || strings.Contains(out.String(), "secret.txt") {
+		t.Errorf("Sensitive fields logged: %s", out.String())
+	}
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	if records[0]["level"] != "DEBUG" || records[0]["route"] != "sharing/create_shared_link_with_settings" ||
+		!strings.Contains(records[0]["arg"].(string), `"link_password":"[REDACTED]"`) {
+		t.Errorf("Unexpected debug record %v", records[0])
+	}
+	if records[1]["level"] != "WARN" || records[1]["member_id"] != "dbmid:1" || records[1]["status"] != 409.0 ||
+		records[1]["request_id"] != "abc123" || records[1]["error_summary"] != "path/not_found/.." || records[1]["duration"] == nil {
+		t.Errorf("Unexpected record %v", records[1])
+	}
+
+	// Arguments of unsampled calls are not logged.
+	out.Reset()
+	config.DebugLogSampleRate = 1e-12
+	_, _ = sharing.New(config).CreateSharedLinkWithSettings(arg)
+	if n := strings.Count(out.String(), "\n"); n != 1 || strings.Contains(out.String(), "DEBUG") {
+		t.Errorf("Expected only the outcome to be logged, got %s", out.String())
+	}
+}
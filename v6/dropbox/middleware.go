@@ -0,0 +1,119 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// Response is the outcome of a request, as seen by middleware.
+type Response struct {
+	// HTTP status code of the response
+	StatusCode int
+	// HTTP headers of the response
+	Header http.Header
+	// Auth type the request was authenticated with, among those of the route:
+	// "user" or "team" for an access token, "app" or "noauth"
+	Auth string
+	// JSON encoded result of the route. Nil for failed requests.
+	Result []byte
+	// Body of download routes, to be closed by the caller
+	Body io.ReadCloser
+	// Number of attempts made, including retries
+	Attempts int
+	// Number of bytes of the request body sent, over all attempts
+	BytesSent int64
+	// Delays requested by the API for the attempts rejected with 429 Too Many
+	// Requests, whether or not they were retried. Zero if no delay was
+	// requested.
+	RateLimits []time.Duration
+	// Summary of the error returned by the API for failed requests, e.g.
+	// "path/not_found/.."
+	ErrorSummary string
+	// Tag of the error returned by the API for failed requests, e.g. "path"
+	ErrorTag string
+}
+
+// Handler executes a request. A failed API call results in both a Response,
+// carrying the status of the call, and a SDKInternalError, which the
+// namespace clients convert into the errors of their routes. Other errors,
+// e.g. network errors, come without a Response.
+type Handler func(ctx context.Context, req Request, body io.Reader) (*Response, error)
+
+// Middleware wraps the Handler executing requests, e.g. to log, audit or
+// cache calls, or to fail them without contacting the API:
+//
+//	func audit(next dropbox.Handler) dropbox.Handler {
+//		return func(ctx context.Context, req dropbox.Request, body io.Reader) (*dropbox.Response, error) {
+//			res, err := next(ctx, req, body)
+//			log.Printf("%s/%s: %v", req.Namespace, req.Route, err)
+//			return res, err
+//		}
+//	}
+//
+// Middleware sees each call once, however often it is retried.
+type Middleware func(next Handler) Handler
+
+// handler returns the Handler executing requests through the middleware of
+// the Config.
+func (c *Context) handler() Handler {
+	h := Handler(c.execute)
+	if c.Config.StructuredLogger != nil {
+		h = c.logCalls(h)
+	}
+	for i := len(c.Config.Middleware) - 1; i >= 0; i-- {
+		h = c.Config.Middleware[i](h)
+	}
+	return h
+}
+
+// parseError returns the `error_summary` and the tag of the error of the error
+// response b, if any.
+func parseError(b []byte) (summary string, tag string) {
+	var e struct {
+		ErrorSummary string `json:"error_summary"`
+		Error        struct {
+			Tag string `json:".tag"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(b, &e) != nil {
+		return "", ""
+	}
+	return e.ErrorSummary, e.Error.Tag
+}
+
+// countingBody counts the bytes read from a request body. The transport may
+// still be sending it when the response arrives.
+type countingBody struct {
+	io.ReadCloser
+	n *int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	atomic.AddInt64(b.n, int64(n))
+	return n, err
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/check"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+)
+
+func TestMiddleware(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			if r.URL.Path == "/files/get_metadata" {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error_summary": "path/not_found/..", "error": {".tag": "path", "path": {".tag": "not_found"}}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"result": "echo"}`))
+		}))
+	defer ts.Close()
+
+	var trace []string
+	record := func(name string) dropbox.Middleware {
+		return func(next dropbox.Handler) dropbox.Handler {
+			return func(ctx context.Context, req dropbox.Request, body io.Reader) (*dropbox.Response, error) {
+				trace = append(trace, name+" "+req.Namespace+"/"+req.Route)
+				res, err := next(ctx, req, body)
+				trace = append(trace, fmt.Sprintf("%s %d %s", name, res.StatusCode, res.ErrorSummary))
+				return res, err
+			}
+		}
+	}
+	config := dropbox.Config{Token: "token",
+		Middleware: []dropbox.Middleware{record("outer"), record("inner")},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+
+	if _, e := check.New(config).User(check.NewEchoArg()); e != nil {
+		t.Fatal(e)
+	}
+	_, e := files.New(config).GetMetadata(files.NewGetMetadataArg("/missing"))
+	if !errors.Is(e, files.ErrLookupNotFound) {
+		t.Errorf("Unexpected error: %v\n", e)
+	}
+	expected := []string{
+		"outer check/user", "inner check/user", "inner 200 ", "outer 200 ",
+		"outer files/get_metadata", "inner files/get_metadata",
+		"inner 409 path/not_found/..", "outer 409 path/not_found/..",
+	}
+	if !reflect.DeepEqual(trace, expected) {
+		t.Errorf("Unexpected trace %q\n", trace)
+	}
+
+	// Middleware may answer calls by itself.
+	config.Middleware = []dropbox.Middleware{func(next dropbox.Handler) dropbox.Handler {
+		return func(ctx context.Context, req dropbox.Request, body io.Reader) (*dropbox.Response, error) {
+			return &dropbox.Response{StatusCode: http.StatusOK, Result: []byte(`{"result": "cached"}`)}, nil
+		}
+	}}
+	res, e := check.New(config).User(check.NewEchoArg())
+	if e != nil || res.Result != "cached" || calls != 2 {
+		t.Errorf("Unexpected result: %v, %v, %d calls\n", res, e, calls)
+	}
+}
@@ -21,6 +21,7 @@
 package openid
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -33,7 +34,9 @@ type Client interface {
 	// Userinfo : This route is used for refreshing the info that is found in
 	// the id_token during the OIDC flow. This route doesn't require any
 	// arguments and will use the scopes approved for the given access token.
-	Userinfo(arg *UserInfoArgs) (res *UserInfoResult, err error)
+	Userinfo(arg *UserInfoArgs, opts ...dropbox.CallOption) (res *UserInfoResult, err error)
+	// UserinfoContext is like Userinfo but takes a context for cancellation and deadlines.
+	UserinfoContext(ctx context.Context, arg *UserInfoArgs, opts ...dropbox.CallOption) (res *UserInfoResult, err error)
 }
 
 type apiImpl dropbox.Context
@@ -44,7 +47,29 @@ type UserinfoAPIError struct {
 	EndpointError *UserInfoError `json:"error"`
 }
 
-func (dbx *apiImpl) Userinfo(arg *UserInfoArgs) (res *UserInfoResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UserinfoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UserinfoAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Err != nil && m.MatchEndpointError(err.Err) ||
+		err.Err != nil && err.Err.AuthError != nil && m.MatchEndpointError(err.Err.AuthError)
+}
+
+func (dbx *apiImpl) Userinfo(arg *UserInfoArgs, opts ...dropbox.CallOption) (res *UserInfoResult, err error) {
+	return dbx.UserinfoContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UserinfoContext(ctx context.Context, arg *UserInfoArgs, opts ...dropbox.CallOption) (res *UserInfoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "openid",
@@ -53,11 +78,12 @@ func (dbx *apiImpl) Userinfo(arg *UserInfoArgs) (res *UserInfoResult, err error)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UserinfoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -0,0 +1,127 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import "fmt"
+
+// CallOption overrides the configuration of a client for a single call. All
+// methods of the namespace clients accept options, so that one client, and
+// its connection pool, can serve calls made e.g. on behalf of different team
+// members:
+//
+//	res, err := dbx.ListFolder(arg, dropbox.AsMember(memberID))
+type CallOption func(*callOptions)
+
+// callOptions are the settings of a call that options can override.
+type callOptions struct {
+	asMemberID string
+	asAdminID  string
+	pathRoot   string
+	headers    map[string]string
+	onResponse []func(*Response)
+	appAuth    bool
+}
+
+// AsMember makes the call on behalf of the team member with the given ID,
+// overriding Config.AsMemberID. An empty ID selects no member.
+func AsMember(memberID string) CallOption {
+	return func(o *callOptions) {
+		o.asMemberID = memberID
+	}
+}
+
+// AsAdmin makes the call as the team admin with the given ID, overriding
+// Config.AsAdminID. An empty ID selects no admin.
+func AsAdmin(adminID string) CallOption {
+	return func(o *callOptions) {
+		o.asAdminID = adminID
+	}
+}
+
+// WithPathRoot sets the path root of the call, overriding Config.PathRoot.
+// An empty path root resolves paths relative to the user's home namespace.
+func WithPathRoot(pathRoot string) CallOption {
+	return func(o *callOptions) {
+		o.pathRoot = pathRoot
+	}
+}
+
+// WithNamespaceID resolves the paths of the call relative to the namespace
+// with the given ID, like Config.WithNamespaceID.
+func WithNamespaceID(nsID string) CallOption {
+	return WithPathRoot(namespacePathRoot(nsID))
+}
+
+// WithRoot resolves the paths of the call relative to the root namespace
+// with the given ID, like Config.WithRoot.
+func WithRoot(nsID string) CallOption {
+	return WithPathRoot(rootPathRoot(nsID))
+}
+
+// WithHeader sets an additional HTTP header on the request of the call.
+func WithHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		if o.headers == nil {
+			o.headers = map[string]string{}
+		}
+		o.headers[key] = value
+	}
+}
+
+// WithAppAuth authenticates the call with the app key and secret of the
+// Config instead of the access token, for routes accepting both, e.g.
+// files.ListFolder on a shared link. Routes not accepting app auth fail with
+// a MissingCredentialError.
+func WithAppAuth() CallOption {
+	return func(o *callOptions) {
+		o.appAuth = true
+	}
+}
+
+// OnResponse calls fn with the response of the call once it returns, if a
+// response was received, e.g. to check the status and headers of a download.
+func OnResponse(fn func(res *Response)) CallOption {
+	return func(o *callOptions) {
+		o.onResponse = append(o.onResponse, fn)
+	}
+}
+
+// callOptions returns the settings of req, i.e. the Config's settings
+// overridden by the options of req.
+func (c *Context) callOptions(req Request) callOptions {
+	o := callOptions{
+		asMemberID: c.Config.AsMemberID,
+		asAdminID:  c.Config.AsAdminID,
+		pathRoot:   c.Config.PathRoot,
+	}
+	for _, opt := range req.Options {
+		opt(&o)
+	}
+	return o
+}
+
+func namespacePathRoot(nsID string) string {
+	return fmt.Sprintf(`{".tag": "namespace_id", "namespace_id": "%s"}`, nsID)
+}
+
+func rootPathRoot(nsID string) string {
+	return fmt.Sprintf(`{".tag": "root", "root": "%s"}`, nsID)
+}
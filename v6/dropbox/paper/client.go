@@ -21,6 +21,7 @@
 package paper
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log"
@@ -41,7 +42,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for more information.
 	// Deprecated:
-	DocsArchive(arg *RefPaperDoc) (err error)
+	DocsArchive(arg *RefPaperDoc, opts ...dropbox.CallOption) (err error)
+	// DocsArchiveContext is like DocsArchive but takes a context for cancellation and deadlines.
+	DocsArchiveContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (err error)
 	// DocsCreate : Creates a new Paper doc with the provided content. Note that
 	// this endpoint will continue to work for content created by users on the
 	// older version of Paper. To check which version of Paper a user is on, use
@@ -51,7 +54,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for more information.
 	// Deprecated:
-	DocsCreate(arg *PaperDocCreateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error)
+	DocsCreate(arg *PaperDocCreateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error)
+	// DocsCreateContext is like DocsCreate but takes a context for cancellation and deadlines.
+	DocsCreateContext(ctx context.Context, arg *PaperDocCreateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error)
 	// DocsDownload : Exports and downloads Paper doc either as HTML or
 	// markdown. Note that this endpoint will continue to work for content
 	// created by users on the older version of Paper. To check which version of
@@ -61,7 +66,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error)
+	DocsDownload(arg *PaperDocExport, opts ...dropbox.CallOption) (res *PaperDocExportResult, content io.ReadCloser, err error)
+	// DocsDownloadContext is like DocsDownload but takes a context for cancellation and deadlines.
+	DocsDownloadContext(ctx context.Context, arg *PaperDocExport, opts ...dropbox.CallOption) (res *PaperDocExportResult, content io.ReadCloser, err error)
 	// DocsFolderUsersList : Lists the users who are explicitly invited to the
 	// Paper folder in which the Paper doc is contained. For private folders all
 	// users (including owner) shared on the folder are listed and for team
@@ -74,7 +81,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error)
+	DocsFolderUsersList(arg *ListUsersOnFolderArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error)
+	// DocsFolderUsersListContext is like DocsFolderUsersList but takes a context for cancellation and deadlines.
+	DocsFolderUsersListContext(ctx context.Context, arg *ListUsersOnFolderArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error)
 	// DocsFolderUsersListContinue : Once a cursor has been retrieved from
 	// `docsFolderUsersList`, use this to paginate through all users on the
 	// Paper folder. Note that this endpoint will continue to work for content
@@ -85,7 +94,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error)
+	DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error)
+	// DocsFolderUsersListContinueContext is like DocsFolderUsersListContinue but takes a context for cancellation and deadlines.
+	DocsFolderUsersListContinueContext(ctx context.Context, arg *ListUsersOnFolderContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error)
 	// DocsGetFolderInfo : Retrieves folder information for the given Paper doc.
 	// This includes:   - folder sharing policy; permissions for subfolders are
 	// set by the top-level folder.   - full 'filepath', i.e. the list of
@@ -100,7 +111,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error)
+	DocsGetFolderInfo(arg *RefPaperDoc, opts ...dropbox.CallOption) (res *FoldersContainingPaperDoc, err error)
+	// DocsGetFolderInfoContext is like DocsGetFolderInfo but takes a context for cancellation and deadlines.
+	DocsGetFolderInfoContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (res *FoldersContainingPaperDoc, err error)
 	// DocsList : Return the list of all Paper docs according to the argument
 	// specifications. To iterate over through the full pagination, pass the
 	// cursor to `docsListContinue`. Note that this endpoint will continue to
@@ -111,7 +124,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error)
+	DocsList(arg *ListPaperDocsArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error)
+	// DocsListContext is like DocsList but takes a context for cancellation and deadlines.
+	DocsListContext(ctx context.Context, arg *ListPaperDocsArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error)
 	// DocsListContinue : Once a cursor has been retrieved from `docsList`, use
 	// this to paginate through all Paper doc. Note that this endpoint will
 	// continue to work for content created by users on the older version of
@@ -122,7 +137,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error)
+	DocsListContinue(arg *ListPaperDocsContinueArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error)
+	// DocsListContinueContext is like DocsListContinue but takes a context for cancellation and deadlines.
+	DocsListContinueContext(ctx context.Context, arg *ListPaperDocsContinueArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error)
 	// DocsPermanentlyDelete : Permanently deletes the given Paper doc. This
 	// operation is final as the doc cannot be recovered. This action can be
 	// performed only by the doc owner. Note that this endpoint will continue to
@@ -133,7 +150,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsPermanentlyDelete(arg *RefPaperDoc) (err error)
+	DocsPermanentlyDelete(arg *RefPaperDoc, opts ...dropbox.CallOption) (err error)
+	// DocsPermanentlyDeleteContext is like DocsPermanentlyDelete but takes a context for cancellation and deadlines.
+	DocsPermanentlyDeleteContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (err error)
 	// DocsSharingPolicyGet : Gets the default sharing policy for the given
 	// Paper doc. Note that this endpoint will continue to work for content
 	// created by users on the older version of Paper. To check which version of
@@ -143,7 +162,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy, err error)
+	DocsSharingPolicyGet(arg *RefPaperDoc, opts ...dropbox.CallOption) (res *SharingPolicy, err error)
+	// DocsSharingPolicyGetContext is like DocsSharingPolicyGet but takes a context for cancellation and deadlines.
+	DocsSharingPolicyGetContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (res *SharingPolicy, err error)
 	// DocsSharingPolicySet : Sets the default sharing policy for the given
 	// Paper doc. The default 'team_sharing_policy' can be changed only by
 	// teams, omit this field for personal accounts. The 'public_sharing_policy'
@@ -157,7 +178,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error)
+	DocsSharingPolicySet(arg *PaperDocSharingPolicy, opts ...dropbox.CallOption) (err error)
+	// DocsSharingPolicySetContext is like DocsSharingPolicySet but takes a context for cancellation and deadlines.
+	DocsSharingPolicySetContext(ctx context.Context, arg *PaperDocSharingPolicy, opts ...dropbox.CallOption) (err error)
 	// DocsUpdate : Updates an existing Paper doc with the provided content.
 	// Note that this endpoint will continue to work for content created by
 	// users on the older version of Paper. To check which version of Paper a
@@ -168,7 +191,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for more information.
 	// Deprecated:
-	DocsUpdate(arg *PaperDocUpdateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error)
+	DocsUpdate(arg *PaperDocUpdateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error)
+	// DocsUpdateContext is like DocsUpdate but takes a context for cancellation and deadlines.
+	DocsUpdateContext(ctx context.Context, arg *PaperDocUpdateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error)
 	// DocsUsersAdd : Allows an owner or editor to add users to a Paper doc or
 	// change their permissions using their email address or Dropbox account ID.
 	// The doc owner's permissions cannot be changed. Note that this endpoint
@@ -180,7 +205,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error)
+	DocsUsersAdd(arg *AddPaperDocUser, opts ...dropbox.CallOption) (res []*AddPaperDocUserMemberResult, err error)
+	// DocsUsersAddContext is like DocsUsersAdd but takes a context for cancellation and deadlines.
+	DocsUsersAddContext(ctx context.Context, arg *AddPaperDocUser, opts ...dropbox.CallOption) (res []*AddPaperDocUserMemberResult, err error)
 	// DocsUsersList : Lists all users who visited the Paper doc or users with
 	// explicit access. This call excludes users who have been removed. The list
 	// is sorted by the date of the visit or the share date. The list will
@@ -193,7 +220,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error)
+	DocsUsersList(arg *ListUsersOnPaperDocArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error)
+	// DocsUsersListContext is like DocsUsersList but takes a context for cancellation and deadlines.
+	DocsUsersListContext(ctx context.Context, arg *ListUsersOnPaperDocArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error)
 	// DocsUsersListContinue : Once a cursor has been retrieved from
 	// `docsUsersList`, use this to paginate through all users on the Paper doc.
 	// Note that this endpoint will continue to work for content created by
@@ -204,7 +233,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error)
+	DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error)
+	// DocsUsersListContinueContext is like DocsUsersListContinue but takes a context for cancellation and deadlines.
+	DocsUsersListContinueContext(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error)
 	// DocsUsersRemove : Allows an owner or editor to remove users from a Paper
 	// doc using their email address or Dropbox account ID. The doc owner cannot
 	// be removed. Note that this endpoint will continue to work for content
@@ -215,7 +246,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	DocsUsersRemove(arg *RemovePaperDocUser) (err error)
+	DocsUsersRemove(arg *RemovePaperDocUser, opts ...dropbox.CallOption) (err error)
+	// DocsUsersRemoveContext is like DocsUsersRemove but takes a context for cancellation and deadlines.
+	DocsUsersRemoveContext(ctx context.Context, arg *RemovePaperDocUser, opts ...dropbox.CallOption) (err error)
 	// FoldersCreate : Create a new Paper folder with the provided info. Note
 	// that this endpoint will continue to work for content created by users on
 	// the older version of Paper. To check which version of Paper a user is on,
@@ -225,7 +258,9 @@ type Client interface {
 	// <https://www.dropbox.com/lp/developers/reference/paper-migration-guide>
 	// for migration information.
 	// Deprecated:
-	FoldersCreate(arg *PaperFolderCreateArg) (res *PaperFolderCreateResult, err error)
+	FoldersCreate(arg *PaperFolderCreateArg, opts ...dropbox.CallOption) (res *PaperFolderCreateResult, err error)
+	// FoldersCreateContext is like FoldersCreate but takes a context for cancellation and deadlines.
+	FoldersCreateContext(ctx context.Context, arg *PaperFolderCreateArg, opts ...dropbox.CallOption) (res *PaperFolderCreateResult, err error)
 }
 
 type apiImpl dropbox.Context
@@ -236,7 +271,26 @@ type DocsArchiveAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsArchive(arg *RefPaperDoc) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsArchiveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsArchiveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsArchive(arg *RefPaperDoc, opts ...dropbox.CallOption) (err error) {
+	return dbx.DocsArchiveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsArchiveContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `DocsArchive` is deprecated")
 
 	req := dropbox.Request{
@@ -247,11 +301,12 @@ func (dbx *apiImpl) DocsArchive(arg *RefPaperDoc) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsArchiveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -272,7 +327,26 @@ type DocsCreateAPIError struct {
 	EndpointError *PaperDocCreateError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsCreate(arg *PaperDocCreateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsCreateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsCreateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsCreate(arg *PaperDocCreateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error) {
+	return dbx.DocsCreateContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) DocsCreateContext(ctx context.Context, arg *PaperDocCreateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error) {
 	log.Printf("WARNING: API `DocsCreate` is deprecated")
 
 	req := dropbox.Request{
@@ -283,11 +357,12 @@ func (dbx *apiImpl) DocsCreate(arg *PaperDocCreateArgs, content io.Reader) (res
 		Style:        "upload",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr DocsCreateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -312,7 +387,26 @@ type DocsDownloadAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult, content io.ReadCloser, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsDownloadAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsDownloadAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsDownload(arg *PaperDocExport, opts ...dropbox.CallOption) (res *PaperDocExportResult, content io.ReadCloser, err error) {
+	return dbx.DocsDownloadContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsDownloadContext(ctx context.Context, arg *PaperDocExport, opts ...dropbox.CallOption) (res *PaperDocExportResult, content io.ReadCloser, err error) {
 	log.Printf("WARNING: API `DocsDownload` is deprecated")
 
 	req := dropbox.Request{
@@ -323,11 +417,12 @@ func (dbx *apiImpl) DocsDownload(arg *PaperDocExport) (res *PaperDocExportResult
 		Style:        "download",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsDownloadAPIError
 		err = auth.ParseError(err, &appErr)
@@ -352,7 +447,26 @@ type DocsFolderUsersListAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUsersOnFolderResponse, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsFolderUsersListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsFolderUsersListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsFolderUsersList(arg *ListUsersOnFolderArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error) {
+	return dbx.DocsFolderUsersListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsFolderUsersListContext(ctx context.Context, arg *ListUsersOnFolderArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error) {
 	log.Printf("WARNING: API `DocsFolderUsersList` is deprecated")
 
 	req := dropbox.Request{
@@ -363,11 +477,12 @@ func (dbx *apiImpl) DocsFolderUsersList(arg *ListUsersOnFolderArgs) (res *ListUs
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsFolderUsersListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -392,7 +507,28 @@ type DocsFolderUsersListContinueAPIError struct {
 	EndpointError *ListUsersCursorError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs) (res *ListUsersOnFolderResponse, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsFolderUsersListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsFolderUsersListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.CursorError != nil && m.MatchEndpointError(err.CursorError)
+}
+
+func (dbx *apiImpl) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error) {
+	return dbx.DocsFolderUsersListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsFolderUsersListContinueContext(ctx context.Context, arg *ListUsersOnFolderContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error) {
 	log.Printf("WARNING: API `DocsFolderUsersListContinue` is deprecated")
 
 	req := dropbox.Request{
@@ -403,11 +539,12 @@ func (dbx *apiImpl) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueAr
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsFolderUsersListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -432,7 +569,26 @@ type DocsGetFolderInfoAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingPaperDoc, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsGetFolderInfoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsGetFolderInfoAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsGetFolderInfo(arg *RefPaperDoc, opts ...dropbox.CallOption) (res *FoldersContainingPaperDoc, err error) {
+	return dbx.DocsGetFolderInfoContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsGetFolderInfoContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (res *FoldersContainingPaperDoc, err error) {
 	log.Printf("WARNING: API `DocsGetFolderInfo` is deprecated")
 
 	req := dropbox.Request{
@@ -443,11 +599,12 @@ func (dbx *apiImpl) DocsGetFolderInfo(arg *RefPaperDoc) (res *FoldersContainingP
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsGetFolderInfoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -472,7 +629,16 @@ type DocsListAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) DocsList(arg *ListPaperDocsArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error) {
+	return dbx.DocsListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsListContext(ctx context.Context, arg *ListPaperDocsArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error) {
 	log.Printf("WARNING: API `DocsList` is deprecated")
 
 	req := dropbox.Request{
@@ -483,11 +649,12 @@ func (dbx *apiImpl) DocsList(arg *ListPaperDocsArgs) (res *ListPaperDocsResponse
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -512,7 +679,28 @@ type DocsListContinueAPIError struct {
 	EndpointError *ListDocsCursorError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListPaperDocsResponse, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.CursorError != nil && m.MatchEndpointError(err.CursorError)
+}
+
+func (dbx *apiImpl) DocsListContinue(arg *ListPaperDocsContinueArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error) {
+	return dbx.DocsListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsListContinueContext(ctx context.Context, arg *ListPaperDocsContinueArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error) {
 	log.Printf("WARNING: API `DocsListContinue` is deprecated")
 
 	req := dropbox.Request{
@@ -523,11 +711,12 @@ func (dbx *apiImpl) DocsListContinue(arg *ListPaperDocsContinueArgs) (res *ListP
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -552,7 +741,26 @@ type DocsPermanentlyDeleteAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsPermanentlyDelete(arg *RefPaperDoc) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsPermanentlyDeleteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsPermanentlyDeleteAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsPermanentlyDelete(arg *RefPaperDoc, opts ...dropbox.CallOption) (err error) {
+	return dbx.DocsPermanentlyDeleteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsPermanentlyDeleteContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `DocsPermanentlyDelete` is deprecated")
 
 	req := dropbox.Request{
@@ -563,11 +771,12 @@ func (dbx *apiImpl) DocsPermanentlyDelete(arg *RefPaperDoc) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsPermanentlyDeleteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -588,7 +797,26 @@ type DocsSharingPolicyGetAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsSharingPolicyGetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsSharingPolicyGetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsSharingPolicyGet(arg *RefPaperDoc, opts ...dropbox.CallOption) (res *SharingPolicy, err error) {
+	return dbx.DocsSharingPolicyGetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsSharingPolicyGetContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (res *SharingPolicy, err error) {
 	log.Printf("WARNING: API `DocsSharingPolicyGet` is deprecated")
 
 	req := dropbox.Request{
@@ -599,11 +827,12 @@ func (dbx *apiImpl) DocsSharingPolicyGet(arg *RefPaperDoc) (res *SharingPolicy,
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsSharingPolicyGetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -628,7 +857,26 @@ type DocsSharingPolicySetAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsSharingPolicySetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsSharingPolicySetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsSharingPolicySet(arg *PaperDocSharingPolicy, opts ...dropbox.CallOption) (err error) {
+	return dbx.DocsSharingPolicySetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsSharingPolicySetContext(ctx context.Context, arg *PaperDocSharingPolicy, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `DocsSharingPolicySet` is deprecated")
 
 	req := dropbox.Request{
@@ -639,11 +887,12 @@ func (dbx *apiImpl) DocsSharingPolicySet(arg *PaperDocSharingPolicy) (err error)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsSharingPolicySetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -664,7 +913,26 @@ type DocsUpdateAPIError struct {
 	EndpointError *PaperDocUpdateError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUpdate(arg *PaperDocUpdateArgs, content io.Reader) (res *PaperDocCreateUpdateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsUpdateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsUpdateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsUpdate(arg *PaperDocUpdateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error) {
+	return dbx.DocsUpdateContext(context.Background(), arg, content, opts...)
+}
+
+func (dbx *apiImpl) DocsUpdateContext(ctx context.Context, arg *PaperDocUpdateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error) {
 	log.Printf("WARNING: API `DocsUpdate` is deprecated")
 
 	req := dropbox.Request{
@@ -675,11 +943,12 @@ func (dbx *apiImpl) DocsUpdate(arg *PaperDocUpdateArgs, content io.Reader) (res
 		Style:        "upload",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, content)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, content)
 	if err != nil {
 		var appErr DocsUpdateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -704,7 +973,26 @@ type DocsUsersAddAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMemberResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsUsersAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsUsersAddAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsUsersAdd(arg *AddPaperDocUser, opts ...dropbox.CallOption) (res []*AddPaperDocUserMemberResult, err error) {
+	return dbx.DocsUsersAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsUsersAddContext(ctx context.Context, arg *AddPaperDocUser, opts ...dropbox.CallOption) (res []*AddPaperDocUserMemberResult, err error) {
 	log.Printf("WARNING: API `DocsUsersAdd` is deprecated")
 
 	req := dropbox.Request{
@@ -715,11 +1003,12 @@ func (dbx *apiImpl) DocsUsersAdd(arg *AddPaperDocUser) (res []*AddPaperDocUserMe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsUsersAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -744,7 +1033,26 @@ type DocsUsersListAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersOnPaperDocResponse, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsUsersListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsUsersListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsUsersList(arg *ListUsersOnPaperDocArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error) {
+	return dbx.DocsUsersListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsUsersListContext(ctx context.Context, arg *ListUsersOnPaperDocArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error) {
 	log.Printf("WARNING: API `DocsUsersList` is deprecated")
 
 	req := dropbox.Request{
@@ -755,11 +1063,12 @@ func (dbx *apiImpl) DocsUsersList(arg *ListUsersOnPaperDocArgs) (res *ListUsersO
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsUsersListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -784,7 +1093,28 @@ type DocsUsersListContinueAPIError struct {
 	EndpointError *ListUsersCursorError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs) (res *ListUsersOnPaperDocResponse, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsUsersListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsUsersListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.CursorError != nil && m.MatchEndpointError(err.CursorError)
+}
+
+func (dbx *apiImpl) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error) {
+	return dbx.DocsUsersListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsUsersListContinueContext(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error) {
 	log.Printf("WARNING: API `DocsUsersListContinue` is deprecated")
 
 	req := dropbox.Request{
@@ -795,11 +1125,12 @@ func (dbx *apiImpl) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsUsersListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -824,7 +1155,26 @@ type DocsUsersRemoveAPIError struct {
 	EndpointError *DocLookupError `json:"error"`
 }
 
-func (dbx *apiImpl) DocsUsersRemove(arg *RemovePaperDocUser) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DocsUsersRemoveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DocsUsersRemoveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DocsUsersRemove(arg *RemovePaperDocUser, opts ...dropbox.CallOption) (err error) {
+	return dbx.DocsUsersRemoveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DocsUsersRemoveContext(ctx context.Context, arg *RemovePaperDocUser, opts ...dropbox.CallOption) (err error) {
 	log.Printf("WARNING: API `DocsUsersRemove` is deprecated")
 
 	req := dropbox.Request{
@@ -835,11 +1185,12 @@ func (dbx *apiImpl) DocsUsersRemove(arg *RemovePaperDocUser) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DocsUsersRemoveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -860,7 +1211,26 @@ type FoldersCreateAPIError struct {
 	EndpointError *PaperFolderCreateError `json:"error"`
 }
 
-func (dbx *apiImpl) FoldersCreate(arg *PaperFolderCreateArg) (res *PaperFolderCreateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *FoldersCreateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e FoldersCreateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) FoldersCreate(arg *PaperFolderCreateArg, opts ...dropbox.CallOption) (res *PaperFolderCreateResult, err error) {
+	return dbx.FoldersCreateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) FoldersCreateContext(ctx context.Context, arg *PaperFolderCreateArg, opts ...dropbox.CallOption) (res *PaperFolderCreateResult, err error) {
 	log.Printf("WARNING: API `FoldersCreate` is deprecated")
 
 	req := dropbox.Request{
@@ -871,11 +1241,12 @@ func (dbx *apiImpl) FoldersCreate(arg *PaperFolderCreateArg) (res *PaperFolderCr
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr FoldersCreateAPIError
 		err = auth.ParseError(err, &appErr)
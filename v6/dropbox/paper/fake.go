@@ -0,0 +1,310 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package paper
+
+import (
+	"context"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	DocsArchiveFunc                 func(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (err error)
+	DocsCreateFunc                  func(ctx context.Context, arg *PaperDocCreateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error)
+	DocsDownloadFunc                func(ctx context.Context, arg *PaperDocExport, opts ...dropbox.CallOption) (res *PaperDocExportResult, content io.ReadCloser, err error)
+	DocsFolderUsersListFunc         func(ctx context.Context, arg *ListUsersOnFolderArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error)
+	DocsFolderUsersListContinueFunc func(ctx context.Context, arg *ListUsersOnFolderContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error)
+	DocsGetFolderInfoFunc           func(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (res *FoldersContainingPaperDoc, err error)
+	DocsListFunc                    func(ctx context.Context, arg *ListPaperDocsArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error)
+	DocsListContinueFunc            func(ctx context.Context, arg *ListPaperDocsContinueArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error)
+	DocsPermanentlyDeleteFunc       func(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (err error)
+	DocsSharingPolicyGetFunc        func(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (res *SharingPolicy, err error)
+	DocsSharingPolicySetFunc        func(ctx context.Context, arg *PaperDocSharingPolicy, opts ...dropbox.CallOption) (err error)
+	DocsUpdateFunc                  func(ctx context.Context, arg *PaperDocUpdateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error)
+	DocsUsersAddFunc                func(ctx context.Context, arg *AddPaperDocUser, opts ...dropbox.CallOption) (res []*AddPaperDocUserMemberResult, err error)
+	DocsUsersListFunc               func(ctx context.Context, arg *ListUsersOnPaperDocArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error)
+	DocsUsersListContinueFunc       func(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error)
+	DocsUsersRemoveFunc             func(ctx context.Context, arg *RemovePaperDocUser, opts ...dropbox.CallOption) (err error)
+	FoldersCreateFunc               func(ctx context.Context, arg *PaperFolderCreateArg, opts ...dropbox.CallOption) (res *PaperFolderCreateResult, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// DocsArchive implements Client.
+func (f *Fake) DocsArchive(arg *RefPaperDoc, opts ...dropbox.CallOption) (err error) {
+	return f.DocsArchiveContext(context.Background(), arg, opts...)
+}
+
+// DocsArchiveContext implements Client.
+func (f *Fake) DocsArchiveContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (err error) {
+	f.Record("DocsArchive", opts, arg)
+	if f.DocsArchiveFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsArchive")
+		return
+	}
+	return f.DocsArchiveFunc(ctx, arg, opts...)
+}
+
+// DocsCreate implements Client.
+func (f *Fake) DocsCreate(arg *PaperDocCreateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error) {
+	return f.DocsCreateContext(context.Background(), arg, content, opts...)
+}
+
+// DocsCreateContext implements Client.
+func (f *Fake) DocsCreateContext(ctx context.Context, arg *PaperDocCreateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error) {
+	f.Record("DocsCreate", opts, arg, content)
+	if f.DocsCreateFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsCreate")
+		return
+	}
+	return f.DocsCreateFunc(ctx, arg, content, opts...)
+}
+
+// DocsDownload implements Client.
+func (f *Fake) DocsDownload(arg *PaperDocExport, opts ...dropbox.CallOption) (res *PaperDocExportResult, content io.ReadCloser, err error) {
+	return f.DocsDownloadContext(context.Background(), arg, opts...)
+}
+
+// DocsDownloadContext implements Client.
+func (f *Fake) DocsDownloadContext(ctx context.Context, arg *PaperDocExport, opts ...dropbox.CallOption) (res *PaperDocExportResult, content io.ReadCloser, err error) {
+	f.Record("DocsDownload", opts, arg)
+	if f.DocsDownloadFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsDownload")
+		return
+	}
+	return f.DocsDownloadFunc(ctx, arg, opts...)
+}
+
+// DocsFolderUsersList implements Client.
+func (f *Fake) DocsFolderUsersList(arg *ListUsersOnFolderArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error) {
+	return f.DocsFolderUsersListContext(context.Background(), arg, opts...)
+}
+
+// DocsFolderUsersListContext implements Client.
+func (f *Fake) DocsFolderUsersListContext(ctx context.Context, arg *ListUsersOnFolderArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error) {
+	f.Record("DocsFolderUsersList", opts, arg)
+	if f.DocsFolderUsersListFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsFolderUsersList")
+		return
+	}
+	return f.DocsFolderUsersListFunc(ctx, arg, opts...)
+}
+
+// DocsFolderUsersListContinue implements Client.
+func (f *Fake) DocsFolderUsersListContinue(arg *ListUsersOnFolderContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error) {
+	return f.DocsFolderUsersListContinueContext(context.Background(), arg, opts...)
+}
+
+// DocsFolderUsersListContinueContext implements Client.
+func (f *Fake) DocsFolderUsersListContinueContext(ctx context.Context, arg *ListUsersOnFolderContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnFolderResponse, err error) {
+	f.Record("DocsFolderUsersListContinue", opts, arg)
+	if f.DocsFolderUsersListContinueFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsFolderUsersListContinue")
+		return
+	}
+	return f.DocsFolderUsersListContinueFunc(ctx, arg, opts...)
+}
+
+// DocsGetFolderInfo implements Client.
+func (f *Fake) DocsGetFolderInfo(arg *RefPaperDoc, opts ...dropbox.CallOption) (res *FoldersContainingPaperDoc, err error) {
+	return f.DocsGetFolderInfoContext(context.Background(), arg, opts...)
+}
+
+// DocsGetFolderInfoContext implements Client.
+func (f *Fake) DocsGetFolderInfoContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (res *FoldersContainingPaperDoc, err error) {
+	f.Record("DocsGetFolderInfo", opts, arg)
+	if f.DocsGetFolderInfoFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsGetFolderInfo")
+		return
+	}
+	return f.DocsGetFolderInfoFunc(ctx, arg, opts...)
+}
+
+// DocsList implements Client.
+func (f *Fake) DocsList(arg *ListPaperDocsArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error) {
+	return f.DocsListContext(context.Background(), arg, opts...)
+}
+
+// DocsListContext implements Client.
+func (f *Fake) DocsListContext(ctx context.Context, arg *ListPaperDocsArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error) {
+	f.Record("DocsList", opts, arg)
+	if f.DocsListFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsList")
+		return
+	}
+	return f.DocsListFunc(ctx, arg, opts...)
+}
+
+// DocsListContinue implements Client.
+func (f *Fake) DocsListContinue(arg *ListPaperDocsContinueArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error) {
+	return f.DocsListContinueContext(context.Background(), arg, opts...)
+}
+
+// DocsListContinueContext implements Client.
+func (f *Fake) DocsListContinueContext(ctx context.Context, arg *ListPaperDocsContinueArgs, opts ...dropbox.CallOption) (res *ListPaperDocsResponse, err error) {
+	f.Record("DocsListContinue", opts, arg)
+	if f.DocsListContinueFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsListContinue")
+		return
+	}
+	return f.DocsListContinueFunc(ctx, arg, opts...)
+}
+
+// DocsPermanentlyDelete implements Client.
+func (f *Fake) DocsPermanentlyDelete(arg *RefPaperDoc, opts ...dropbox.CallOption) (err error) {
+	return f.DocsPermanentlyDeleteContext(context.Background(), arg, opts...)
+}
+
+// DocsPermanentlyDeleteContext implements Client.
+func (f *Fake) DocsPermanentlyDeleteContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (err error) {
+	f.Record("DocsPermanentlyDelete", opts, arg)
+	if f.DocsPermanentlyDeleteFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsPermanentlyDelete")
+		return
+	}
+	return f.DocsPermanentlyDeleteFunc(ctx, arg, opts...)
+}
+
+// DocsSharingPolicyGet implements Client.
+func (f *Fake) DocsSharingPolicyGet(arg *RefPaperDoc, opts ...dropbox.CallOption) (res *SharingPolicy, err error) {
+	return f.DocsSharingPolicyGetContext(context.Background(), arg, opts...)
+}
+
+// DocsSharingPolicyGetContext implements Client.
+func (f *Fake) DocsSharingPolicyGetContext(ctx context.Context, arg *RefPaperDoc, opts ...dropbox.CallOption) (res *SharingPolicy, err error) {
+	f.Record("DocsSharingPolicyGet", opts, arg)
+	if f.DocsSharingPolicyGetFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsSharingPolicyGet")
+		return
+	}
+	return f.DocsSharingPolicyGetFunc(ctx, arg, opts...)
+}
+
+// DocsSharingPolicySet implements Client.
+func (f *Fake) DocsSharingPolicySet(arg *PaperDocSharingPolicy, opts ...dropbox.CallOption) (err error) {
+	return f.DocsSharingPolicySetContext(context.Background(), arg, opts...)
+}
+
+// DocsSharingPolicySetContext implements Client.
+func (f *Fake) DocsSharingPolicySetContext(ctx context.Context, arg *PaperDocSharingPolicy, opts ...dropbox.CallOption) (err error) {
+	f.Record("DocsSharingPolicySet", opts, arg)
+	if f.DocsSharingPolicySetFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsSharingPolicySet")
+		return
+	}
+	return f.DocsSharingPolicySetFunc(ctx, arg, opts...)
+}
+
+// DocsUpdate implements Client.
+func (f *Fake) DocsUpdate(arg *PaperDocUpdateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error) {
+	return f.DocsUpdateContext(context.Background(), arg, content, opts...)
+}
+
+// DocsUpdateContext implements Client.
+func (f *Fake) DocsUpdateContext(ctx context.Context, arg *PaperDocUpdateArgs, content io.Reader, opts ...dropbox.CallOption) (res *PaperDocCreateUpdateResult, err error) {
+	f.Record("DocsUpdate", opts, arg, content)
+	if f.DocsUpdateFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsUpdate")
+		return
+	}
+	return f.DocsUpdateFunc(ctx, arg, content, opts...)
+}
+
+// DocsUsersAdd implements Client.
+func (f *Fake) DocsUsersAdd(arg *AddPaperDocUser, opts ...dropbox.CallOption) (res []*AddPaperDocUserMemberResult, err error) {
+	return f.DocsUsersAddContext(context.Background(), arg, opts...)
+}
+
+// DocsUsersAddContext implements Client.
+func (f *Fake) DocsUsersAddContext(ctx context.Context, arg *AddPaperDocUser, opts ...dropbox.CallOption) (res []*AddPaperDocUserMemberResult, err error) {
+	f.Record("DocsUsersAdd", opts, arg)
+	if f.DocsUsersAddFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsUsersAdd")
+		return
+	}
+	return f.DocsUsersAddFunc(ctx, arg, opts...)
+}
+
+// DocsUsersList implements Client.
+func (f *Fake) DocsUsersList(arg *ListUsersOnPaperDocArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error) {
+	return f.DocsUsersListContext(context.Background(), arg, opts...)
+}
+
+// DocsUsersListContext implements Client.
+func (f *Fake) DocsUsersListContext(ctx context.Context, arg *ListUsersOnPaperDocArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error) {
+	f.Record("DocsUsersList", opts, arg)
+	if f.DocsUsersListFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsUsersList")
+		return
+	}
+	return f.DocsUsersListFunc(ctx, arg, opts...)
+}
+
+// DocsUsersListContinue implements Client.
+func (f *Fake) DocsUsersListContinue(arg *ListUsersOnPaperDocContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error) {
+	return f.DocsUsersListContinueContext(context.Background(), arg, opts...)
+}
+
+// DocsUsersListContinueContext implements Client.
+func (f *Fake) DocsUsersListContinueContext(ctx context.Context, arg *ListUsersOnPaperDocContinueArgs, opts ...dropbox.CallOption) (res *ListUsersOnPaperDocResponse, err error) {
+	f.Record("DocsUsersListContinue", opts, arg)
+	if f.DocsUsersListContinueFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsUsersListContinue")
+		return
+	}
+	return f.DocsUsersListContinueFunc(ctx, arg, opts...)
+}
+
+// DocsUsersRemove implements Client.
+func (f *Fake) DocsUsersRemove(arg *RemovePaperDocUser, opts ...dropbox.CallOption) (err error) {
+	return f.DocsUsersRemoveContext(context.Background(), arg, opts...)
+}
+
+// DocsUsersRemoveContext implements Client.
+func (f *Fake) DocsUsersRemoveContext(ctx context.Context, arg *RemovePaperDocUser, opts ...dropbox.CallOption) (err error) {
+	f.Record("DocsUsersRemove", opts, arg)
+	if f.DocsUsersRemoveFunc == nil {
+		err = dropbox.NotImplemented("paper", "DocsUsersRemove")
+		return
+	}
+	return f.DocsUsersRemoveFunc(ctx, arg, opts...)
+}
+
+// FoldersCreate implements Client.
+func (f *Fake) FoldersCreate(arg *PaperFolderCreateArg, opts ...dropbox.CallOption) (res *PaperFolderCreateResult, err error) {
+	return f.FoldersCreateContext(context.Background(), arg, opts...)
+}
+
+// FoldersCreateContext implements Client.
+func (f *Fake) FoldersCreateContext(ctx context.Context, arg *PaperFolderCreateArg, opts ...dropbox.CallOption) (res *PaperFolderCreateResult, err error) {
+	f.Record("FoldersCreate", opts, arg)
+	if f.FoldersCreateFunc == nil {
+		err = dropbox.NotImplemented("paper", "FoldersCreate")
+		return
+	}
+	return f.FoldersCreateFunc(ctx, arg, opts...)
+}
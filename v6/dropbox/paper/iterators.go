@@ -0,0 +1,118 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package paper
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// DocsListIterator iterates over the entries returned by `DocsList`, fetching
+// further pages with `DocsListContinue`.
+//
+// Deprecated: `DocsList` is deprecated.
+type DocsListIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListPaperDocsArgs
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []string
+	index      int
+	entry      string
+	err        error
+}
+
+// NewDocsListIterator returns an iterator over the entries of `DocsList` called
+// with arg. Every page is fetched with opts.
+func NewDocsListIterator(ctx context.Context, dbx Client, arg *ListPaperDocsArgs, opts ...dropbox.CallOption) *DocsListIterator {
+	return &DocsListIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeDocsListIterator returns an iterator continuing at cursor, as returned
+// by `DocsListIterator.Cursor`.
+func ResumeDocsListIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *DocsListIterator {
+	return &DocsListIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *DocsListIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *DocsListIterator) Entry() string {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DocsListIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *DocsListIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *DocsListIterator) fetch() {
+	var res *ListPaperDocsResponse
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.DocsListContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.DocsListContinueContext(it.ctx, NewListPaperDocsContinueArgs(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = ""
+	if res.Cursor != nil {
+		it.cursor = res.Cursor.Value
+	}
+	it.more = res.HasMore
+	it.entries = res.DocIds
+	it.index = 0
+}
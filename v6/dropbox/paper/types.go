@@ -317,6 +317,18 @@ func (u *ListDocsCursorError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListDocsCursorError instance
+func (u *ListDocsCursorError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "cursor_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CursorError *PaperApiCursorError `json:"cursor_error"`
+		}{u.Tagged, u.CursorError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListPaperDocsArgs : has no documentation (yet)
 type ListPaperDocsArgs struct {
 	// FilterBy : Allows user to specify how the Paper docs should be filtered.
@@ -454,6 +466,18 @@ func (u *ListUsersCursorError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListUsersCursorError instance
+func (u *ListUsersCursorError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "cursor_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CursorError *PaperApiCursorError `json:"cursor_error"`
+		}{u.Tagged, u.CursorError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListUsersOnFolderArgs : has no documentation (yet)
 type ListUsersOnFolderArgs struct {
 	RefPaperDoc
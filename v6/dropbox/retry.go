@@ -0,0 +1,205 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryOn defines a set of error classes that a RetryPolicy retries.
+type RetryOn uint
+
+const (
+	// RetryOnRateLimit retries requests rejected with 429 Too Many Requests.
+	RetryOnRateLimit RetryOn = 1 << iota
+	// RetryOnServerError retries requests that failed with a 5xx status.
+	RetryOnServerError
+	// RetryOnNetworkError retries requests that failed before a response was
+	// received, e.g. because the connection was reset.
+	RetryOnNetworkError
+
+	// RetryOnAll retries every retryable error class.
+	RetryOnAll = RetryOnRateLimit | RetryOnServerError | RetryOnNetworkError
+)
+
+// RetryPolicy configures automatic retries of failed requests.
+//
+// Upload routes are only retried if their content implements io.Seeker, so
+// it can be rewound before each attempt. Download bodies are never retried
+// once they have been returned to the caller.
+//
+// A request that failed with a server or network error may still have been
+// applied. Routes that are not idempotent, e.g. `files/move_v2`,
+// `files/copy_v2` or `files/delete_v2`, can then fail on retry with a
+// conflict or `not_found` error, although the first attempt succeeded.
+// Leave RetryOnServerError and RetryOnNetworkError out of RetryOn for
+// clients that can not tell these cases apart.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one
+	MaxAttempts int
+	// Delay before the first retry. It doubles with every further retry.
+	InitialBackoff time.Duration
+	// Upper bound for the delay between two attempts. Zero means a bound of
+	// one hour.
+	MaxBackoff time.Duration
+	// Fraction in [0, 1] of each backoff delay that is randomized. Delays are
+	// only shortened, by up to this fraction, so MaxBackoff is never exceeded.
+	Jitter float64
+	// Error classes that are retried
+	RetryOn RetryOn
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for most applications.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Jitter:         0.2,
+		RetryOn:        RetryOnAll,
+	}
+}
+
+// maxBackoff bounds the delay between two attempts if MaxBackoff is zero.
+const maxBackoff = time.Hour
+
+func (p *RetryPolicy) shouldRetry(attempt int, class RetryOn) bool {
+	return p != nil && class != 0 && p.RetryOn&class == class && attempt < p.MaxAttempts
+}
+
+// backoff returns the delay before the attempt following `attempt`.
+// A positive retryAfter, as requested by the server, takes precedence.
+func (p *RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	limit := p.MaxBackoff
+	if limit <= 0 {
+		limit = maxBackoff
+	}
+	d := p.InitialBackoff
+	for i := 1; i < attempt && d < limit; i++ {
+		d *= 2
+	}
+	if d > limit {
+		d = limit
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(rand.Float64() * p.Jitter * float64(d))
+	}
+	return d
+}
+
+// retryClass maps an HTTP status code to the error class it belongs to, or
+// zero if the status is not retryable.
+func retryClass(statusCode int) RetryOn {
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return RetryOnRateLimit
+	case statusCode >= 500 && statusCode <= 599:
+		return RetryOnServerError
+	}
+	return 0
+}
+
+// retryAfter extracts the delay requested by the server, preferring the
+// `retry_after` field of a RateLimitError body over the Retry-After header.
+func retryAfter(header http.Header, body []byte) time.Duration {
+	var rateLimit struct {
+		Error struct {
+			RetryAfter uint64 `json:"retry_after"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &rateLimit) == nil && rateLimit.Error.RetryAfter > 0 {
+		return time.Duration(rateLimit.Error.RetryAfter) * time.Second
+	}
+
+	if secs, err := strconv.Atoi(header.Get("Retry-After")); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	return 0
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// rewindableBody allows an upload body to be sent more than once.
+type rewindableBody struct {
+	r     io.ReadSeeker
+	start int64
+	size  int64
+}
+
+// newRewindableBody returns nil if body can not be rewound.
+func newRewindableBody(body io.Reader) *rewindableBody {
+	r, ok := body.(io.ReadSeeker)
+	if !ok {
+		return nil
+	}
+
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil
+	}
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil
+	}
+	if _, err = r.Seek(start, io.SeekStart); err != nil {
+		return nil
+	}
+
+	return &rewindableBody{r: r, start: start, size: end - start}
+}
+
+func (b *rewindableBody) rewind() error {
+	_, err := b.r.Seek(b.start, io.SeekStart)
+	return err
+}
+
+// setBody attaches the body to httpReq without handing ownership to the
+// transport, which would otherwise close it after the first attempt.
+func (b *rewindableBody) setBody(httpReq *http.Request) {
+	if b.size == 0 {
+		httpReq.Body = http.NoBody
+	} else {
+		httpReq.Body = ioutil.NopCloser(b.r)
+	}
+	httpReq.ContentLength = b.size
+}
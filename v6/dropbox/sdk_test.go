@@ -21,16 +21,27 @@
 package dropbox_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/check"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/sharing"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/users"
+	"golang.org/x/oauth2"
 )
 
 func generateURL(base string, namespace string, route string) string {
@@ -168,6 +179,366 @@ func TestAppError(t *testing.T) {
 	}
 }
 
+func TestAppAuth(t *testing.T) {
+	var auth string
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			if key, secret, ok := r.BasicAuth(); ok {
+				auth = key + ":" + secret
+			}
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			_, _ = fmt.Fprintf(w, `{"result": %q, "entries": [], "cursor": "", "has_more": false}`, auth)
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Token: "token", AppKey: "key", AppSecret: "secret",
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	res, e := check.New(config).App(check.NewEchoArg())
+	if e != nil || res.Result != "key:secret" {
+		t.Errorf("Unexpected app auth: %v, %v\n", res, e)
+	}
+	res, e = check.New(config).User(check.NewEchoArg())
+	if e != nil || res.Result != "Bearer token" {
+		t.Errorf("Unexpected user auth: %v, %v\n", res, e)
+	}
+
+	// Routes accepting both prefer the access token, unless app auth is
+	// requested.
+	var used string
+	onResponse := dropbox.OnResponse(func(res *dropbox.Response) { used = res.Auth })
+	if _, e = files.New(config).ListFolder(files.NewListFolderArg(""), onResponse); e != nil || auth != "Bearer token" || used != "user" {
+		t.Errorf("Unexpected auth %s (%s): %v\n", auth, used, e)
+	}
+	if _, e = files.New(config).ListFolder(files.NewListFolderArg(""), dropbox.WithAppAuth(), onResponse); e != nil || auth != "key:secret" || used != "app" {
+		t.Errorf("Unexpected auth %s (%s): %v\n", auth, used, e)
+	}
+	_, e = check.New(config).User(check.NewEchoArg(), dropbox.WithAppAuth())
+	if _, ok := e.(dropbox.MissingCredentialError); !ok {
+		t.Errorf("Unexpected error: %v\n", e)
+	}
+
+	config.AppSecret = ""
+	_, e = check.New(config).App(check.NewEchoArg())
+	if re, ok := e.(dropbox.MissingCredentialError); !ok || re.Route != "app" || re.Auth != "app" {
+		t.Errorf("Unexpected error: %v\n", e)
+	}
+
+	config.Token = ""
+	_, e = users.New(config).GetCurrentAccount()
+	if _, ok := e.(dropbox.MissingCredentialError); !ok {
+		t.Errorf("Unexpected error: %v\n", e)
+	}
+}
+
+func TestCallOptions(t *testing.T) {
+	var header http.Header
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			_, _ = w.Write([]byte(`{"entries": [], "cursor": "", "has_more": false}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Token: "token", AsMemberID: "dbmid:config", PathRoot: `{".tag": "home"}`,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	dbx := files.New(config)
+
+	_, e := dbx.ListFolder(files.NewListFolderArg(""))
+	if e != nil || header.Get("Dropbox-API-Select-User") != "dbmid:config" || header.Get("Dropbox-API-Path-Root") != `{".tag": "home"}` {
+		t.Errorf("Unexpected headers: %v, %v\n", header, e)
+	}
+
+	_, e = dbx.ListFolder(files.NewListFolderArg(""), dropbox.AsMember("dbmid:call"),
+		dropbox.WithNamespaceID("123"), dropbox.WithHeader("X-Test", "yes"))
+	if e != nil || header.Get("Dropbox-API-Select-User") != "dbmid:call" || header.Get("X-Test") != "yes" ||
+		header.Get("Dropbox-API-Path-Root") != `{".tag": "namespace_id", "namespace_id": "123"}` {
+		t.Errorf("Unexpected headers: %v, %v\n", header, e)
+	}
+
+	_, e = dbx.ListFolder(files.NewListFolderArg(""), dropbox.AsMember(""), dropbox.AsAdmin("dbmid:admin"))
+	if e != nil || header.Get("Dropbox-API-Select-User") != "" || header.Get("Dropbox-API-Select-Admin") != "dbmid:admin" {
+		t.Errorf("Unexpected headers: %v, %v\n", header, e)
+	}
+}
+
+func TestErrorMetadata(t *testing.T) {
+	for _, test := range []struct {
+		status int
+		body   string
+	}{
+		{http.StatusInternalServerError, "internal server error"},
+		{http.StatusUnauthorized, `{"error_summary": "invalid_access_token/..", "error": {".tag": "invalid_access_token"}}`},
+		{http.StatusNotFound, "not found"},
+	} {
+		ts := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Dropbox-Request-Id", "abc123")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+
+		config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+			URLGenerator: func(hostType string, namespace string, route string) string {
+				return generateURL(ts.URL, namespace, route)
+			}}
+		_, e := users.New(config).GetCurrentAccount()
+		ts.Close()
+
+		var apiErr dropbox.APIError
+		if !errors.As(e, &apiErr) {
+			t.Errorf("%d: Unexpected error type: %T\n", test.status, e)
+			continue
+		}
+		if apiErr.Route != "users/get_current_account" || apiErr.StatusCode != test.status || apiErr.RequestID != "abc123" {
+			t.Errorf("%d: Unexpected error metadata: %+v\n", test.status, apiErr)
+		}
+	}
+}
+
+func TestErrorSentinels(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_summary": "path/not_found/..", "error": {".tag": "path", "path": {".tag": "not_found"}}}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Token: "token",
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	_, e := sharing.New(config).CreateSharedLink(sharing.NewCreateSharedLinkArg("/missing"))
+	if _, ok := e.(sharing.CreateSharedLinkAPIError); !ok {
+		t.Fatalf("Unexpected error type: %T\n", e)
+	}
+	// The files.LookupError is nested in an error of the sharing namespace.
+	if !errors.Is(fmt.Errorf("share: %w", e), files.ErrLookupNotFound) {
+		t.Errorf("Expected error to match ErrLookupNotFound: %v\n", e)
+	}
+	if errors.Is(e, files.ErrLookupNotFolder) || errors.Is(e, files.ErrWriteConflict) {
+		t.Errorf("Expected error to only match ErrLookupNotFound: %v\n", e)
+	}
+}
+
+func TestContextCancel(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-done:
+			}
+		}))
+	defer ts.Close()
+	defer close(done)
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := users.New(config)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, e := client.GetCurrentAccountContext(ctx)
+	if !errors.Is(e, context.DeadlineExceeded) {
+		t.Errorf("Unexpected error: %v\n", e)
+	}
+}
+
+func TestRetryRateLimit(t *testing.T) {
+	eString := `{"error_summary": "too_many_requests/..", "error": {"reason": {".tag": "too_many_requests"}, "retry_after": 1}}`
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			if attempts == 1 {
+				w.Header().Set("Retry-After", "10")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(eString))
+				return
+			}
+			_, _ = w.Write([]byte(`{"account_id": "dbid:1", "root_info": {".tag": "user", "root_namespace_id": "1", "home_namespace_id": "1"}}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+		RetryPolicy: dropbox.DefaultRetryPolicy(),
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := users.New(config)
+	start := time.Now()
+	v, e := client.GetCurrentAccount()
+	if e != nil {
+		t.Fatalf("Unexpected error: %v\n", e)
+	}
+	if v.AccountId != "dbid:1" || attempts != 2 {
+		t.Errorf("Unexpected result: %v after %d attempts\n", v, attempts)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second || elapsed >= 10*time.Second {
+		t.Errorf("Retry-After not honored, retried after %v\n", elapsed)
+	}
+}
+
+func TestRetryUpload(t *testing.T) {
+	content := []byte("hello, world")
+	for _, test := range []struct {
+		name     string
+		body     func() io.Reader
+		attempts int
+	}{
+		{
+			name:     "seekable",
+			body:     func() io.Reader { return bytes.NewReader(content) },
+			attempts: 3,
+		},
+		{
+			name:     "not seekable",
+			body:     func() io.Reader { return io.MultiReader(bytes.NewReader(content)) },
+			attempts: 1,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := 0
+			ts := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					attempts++
+					b, _ := ioutil.ReadAll(r.Body)
+					if !bytes.Equal(b, content) {
+						t.Errorf("Unexpected body on attempt %d: %q\n", attempts, b)
+					}
+					if attempts < 3 {
+						http.Error(w, "unavailable", http.StatusServiceUnavailable)
+						return
+					}
+					_, _ = w.Write([]byte(`{"name": "a.txt"}`))
+				}))
+			defer ts.Close()
+
+			config := dropbox.Config{Client: ts.Client(), LogLevel: dropbox.LogDebug,
+				RetryPolicy: &dropbox.RetryPolicy{
+					MaxAttempts:    3,
+					InitialBackoff: time.Millisecond,
+					RetryOn:        dropbox.RetryOnServerError,
+				},
+				URLGenerator: func(hostType string, namespace string, route string) string {
+					return generateURL(ts.URL, namespace, route)
+				}}
+			client := files.New(config)
+			_, e := client.Upload(files.NewUploadArg("/a.txt"), test.body())
+			if attempts != test.attempts {
+				t.Errorf("Unexpected number of attempts: %d\n", attempts)
+			}
+			if _, ok := e.(auth.ServerError); (test.attempts < 3) != ok {
+				t.Errorf("Unexpected error: %v\n", e)
+			}
+		})
+	}
+}
+
+// redirectTransport sends every request to the host of url.
+type redirectTransport struct {
+	url  string
+	base http.RoundTripper
+}
+
+func (t redirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	u, err := url.Parse(t.url)
+	if err != nil {
+		return nil, err
+	}
+	r = r.Clone(r.Context())
+	r.URL.Scheme, r.URL.Host = u.Scheme, u.Host
+	return t.base.RoundTrip(r)
+}
+
+func TestRefreshToken(t *testing.T) {
+	refreshes := 0
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			if r.URL.Path == "/1/oauth2/token" {
+				refreshes++
+				if r.FormValue("grant_type") != "refresh_token" || r.FormValue("refresh_token") != "refresh" ||
+					r.FormValue("client_id") != "key" {
+					t.Errorf("Unexpected refresh request: %v\n", r.Form)
+				}
+				_, _ = w.Write([]byte(`{"access_token": "new", "token_type": "bearer", "expires_in": 14400}`))
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer new" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error_summary": "expired_access_token/..", "error": {".tag": "expired_access_token"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"account_id": "dbid:1", "root_info": {".tag": "user", "root_namespace_id": "1", "home_namespace_id": "1"}}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{Token: "old", RefreshToken: "refresh", AppKey: "key", LogLevel: dropbox.LogDebug,
+		Client: &http.Client{Transport: redirectTransport{ts.URL, ts.Client().Transport}},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := users.New(config)
+	for i := 0; i < 2; i++ {
+		if _, e := client.GetCurrentAccount(); e != nil {
+			t.Fatalf("Unexpected error: %v\n", e)
+		}
+	}
+	if refreshes != 1 {
+		t.Errorf("Unexpected number of refreshes: %d\n", refreshes)
+	}
+}
+
+func TestRejectedTokenSource(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error_summary": "expired_access_token/..", "error": {".tag": "expired_access_token"}}`))
+		}))
+	defer ts.Close()
+
+	config := dropbox.Config{TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "old"}),
+		Client: ts.Client(),
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+	client := users.New(config)
+	if _, e := client.GetCurrentAccount(); !errors.Is(e, dropbox.ErrRejectedToken) {
+		t.Errorf("Unexpected error: %v\n", e)
+	}
+	if requests != 1 {
+		t.Errorf("Unexpected number of requests: %d\n", requests)
+	}
+}
+
+func TestCodeChallenge(t *testing.T) {
+	// Example from RFC 7636, appendix B
+	got := dropbox.CodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	if want := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"; got != want {
+		t.Errorf("CodeChallenge: got %s, want %s\n", got, want)
+	}
+
+	verifier, err := dropbox.NewCodeVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(verifier) < 43 || len(verifier) > 128 {
+		t.Errorf("Invalid code verifier length %d\n", len(verifier))
+	}
+}
+
 func TestHTTPHeaderSafeJSON(t *testing.T) {
 	for _, test := range []struct {
 		name string
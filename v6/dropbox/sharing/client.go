@@ -21,6 +21,7 @@
 package sharing
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log"
@@ -33,20 +34,30 @@ import (
 // Client interface describes all routes in this namespace
 type Client interface {
 	// AddFileMember : Adds specified members to a file.
-	AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error)
+	AddFileMember(arg *AddFileMemberArgs, opts ...dropbox.CallOption) (res []*FileMemberActionResult, err error)
+	// AddFileMemberContext is like AddFileMember but takes a context for cancellation and deadlines.
+	AddFileMemberContext(ctx context.Context, arg *AddFileMemberArgs, opts ...dropbox.CallOption) (res []*FileMemberActionResult, err error)
 	// AddFolderMember : Allows an owner or editor (if the ACL update policy
 	// allows) of a shared folder to add another member. For the new member to
 	// get access to all the functionality for this folder, you will need to
 	// call `mountFolder` on their behalf.
-	AddFolderMember(arg *AddFolderMemberArg) (err error)
+	AddFolderMember(arg *AddFolderMemberArg, opts ...dropbox.CallOption) (err error)
+	// AddFolderMemberContext is like AddFolderMember but takes a context for cancellation and deadlines.
+	AddFolderMemberContext(ctx context.Context, arg *AddFolderMemberArg, opts ...dropbox.CallOption) (err error)
 	// CheckJobStatus : Returns the status of an asynchronous job.
-	CheckJobStatus(arg *async.PollArg) (res *JobStatus, err error)
+	CheckJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *JobStatus, err error)
+	// CheckJobStatusContext is like CheckJobStatus but takes a context for cancellation and deadlines.
+	CheckJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *JobStatus, err error)
 	// CheckRemoveMemberJobStatus : Returns the status of an asynchronous job
 	// for sharing a folder.
-	CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveMemberJobStatus, err error)
+	CheckRemoveMemberJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *RemoveMemberJobStatus, err error)
+	// CheckRemoveMemberJobStatusContext is like CheckRemoveMemberJobStatus but takes a context for cancellation and deadlines.
+	CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RemoveMemberJobStatus, err error)
 	// CheckShareJobStatus : Returns the status of an asynchronous job for
 	// sharing a folder.
-	CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJobStatus, err error)
+	CheckShareJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error)
+	// CheckShareJobStatusContext is like CheckShareJobStatus but takes a context for cancellation and deadlines.
+	CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error)
 	// CreateSharedLink : Create a shared link. If a shared link already exists
 	// for the given path, that link is returned. Previously, it was technically
 	// possible to break a shared link by moving or renaming the corresponding
@@ -54,23 +65,37 @@ type Client interface {
 	// app shouldn't rely on this behavior. Instead, if your app needs to revoke
 	// a shared link, use `revokeSharedLink`.
 	// Deprecated: Use `CreateSharedLinkWithSettings` instead
-	CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error)
+	CreateSharedLink(arg *CreateSharedLinkArg, opts ...dropbox.CallOption) (res *PathLinkMetadata, err error)
+	// CreateSharedLinkContext is like CreateSharedLink but takes a context for cancellation and deadlines.
+	CreateSharedLinkContext(ctx context.Context, arg *CreateSharedLinkArg, opts ...dropbox.CallOption) (res *PathLinkMetadata, err error)
 	// CreateSharedLinkWithSettings : Create a shared link with custom settings.
 	// If no settings are given then the default visibility is
 	// `RequestedVisibility.public` (The resolved visibility, though, may depend
 	// on other aspects such as team and shared folder settings).
-	CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error)
+	CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
+	// CreateSharedLinkWithSettingsContext is like CreateSharedLinkWithSettings but takes a context for cancellation and deadlines.
+	CreateSharedLinkWithSettingsContext(ctx context.Context, arg *CreateSharedLinkWithSettingsArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
 	// GetFileMetadata : Returns shared file metadata.
-	GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMetadata, err error)
+	GetFileMetadata(arg *GetFileMetadataArg, opts ...dropbox.CallOption) (res *SharedFileMetadata, err error)
+	// GetFileMetadataContext is like GetFileMetadata but takes a context for cancellation and deadlines.
+	GetFileMetadataContext(ctx context.Context, arg *GetFileMetadataArg, opts ...dropbox.CallOption) (res *SharedFileMetadata, err error)
 	// GetFileMetadataBatch : Returns shared file metadata.
-	GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error)
+	GetFileMetadataBatch(arg *GetFileMetadataBatchArg, opts ...dropbox.CallOption) (res []*GetFileMetadataBatchResult, err error)
+	// GetFileMetadataBatchContext is like GetFileMetadataBatch but takes a context for cancellation and deadlines.
+	GetFileMetadataBatchContext(ctx context.Context, arg *GetFileMetadataBatchArg, opts ...dropbox.CallOption) (res []*GetFileMetadataBatchResult, err error)
 	// GetFolderMetadata : Returns shared folder metadata by its folder ID.
-	GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMetadata, err error)
+	GetFolderMetadata(arg *GetMetadataArgs, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
+	// GetFolderMetadataContext is like GetFolderMetadata but takes a context for cancellation and deadlines.
+	GetFolderMetadataContext(ctx context.Context, arg *GetMetadataArgs, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
 	// GetSharedLinkFile : Download the shared link's file from a user's
 	// Dropbox.
-	GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error)
+	GetSharedLinkFile(arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, content io.ReadCloser, err error)
+	// GetSharedLinkFileContext is like GetSharedLinkFile but takes a context for cancellation and deadlines.
+	GetSharedLinkFileContext(ctx context.Context, arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, content io.ReadCloser, err error)
 	// GetSharedLinkMetadata : Get the shared link's metadata.
-	GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error)
+	GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
+	// GetSharedLinkMetadataContext is like GetSharedLinkMetadata but takes a context for cancellation and deadlines.
+	GetSharedLinkMetadataContext(ctx context.Context, arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
 	// GetSharedLinks : Returns a list of `LinkMetadata` objects for this user,
 	// including collection links. If no path is given, returns a list of all
 	// shared links for the current user, including collection links, up to a
@@ -78,49 +103,73 @@ type Client interface {
 	// all shared links that allow access to the given path.  Collection links
 	// are never returned in this case.
 	// Deprecated: Use `ListSharedLinks` instead
-	GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error)
+	GetSharedLinks(arg *GetSharedLinksArg, opts ...dropbox.CallOption) (res *GetSharedLinksResult, err error)
+	// GetSharedLinksContext is like GetSharedLinks but takes a context for cancellation and deadlines.
+	GetSharedLinksContext(ctx context.Context, arg *GetSharedLinksArg, opts ...dropbox.CallOption) (res *GetSharedLinksResult, err error)
 	// ListFileMembers : Use to obtain the members who have been invited to a
 	// file, both inherited and uninherited members.
-	ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMembers, err error)
+	ListFileMembers(arg *ListFileMembersArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error)
+	// ListFileMembersContext is like ListFileMembers but takes a context for cancellation and deadlines.
+	ListFileMembersContext(ctx context.Context, arg *ListFileMembersArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error)
 	// ListFileMembersBatch : Get members of multiple files at once. The
 	// arguments to this route are more limited, and the limit on query result
 	// size per file is more strict. To customize the results more, use the
 	// individual file endpoint. Inherited users and groups are not included in
 	// the result, and permissions are not returned for this endpoint.
-	ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error)
+	ListFileMembersBatch(arg *ListFileMembersBatchArg, opts ...dropbox.CallOption) (res []*ListFileMembersBatchResult, err error)
+	// ListFileMembersBatchContext is like ListFileMembersBatch but takes a context for cancellation and deadlines.
+	ListFileMembersBatchContext(ctx context.Context, arg *ListFileMembersBatchArg, opts ...dropbox.CallOption) (res []*ListFileMembersBatchResult, err error)
 	// ListFileMembersContinue : Once a cursor has been retrieved from
 	// `listFileMembers` or `listFileMembersBatch`, use this to paginate through
 	// all shared file members.
-	ListFileMembersContinue(arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error)
+	ListFileMembersContinue(arg *ListFileMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error)
+	// ListFileMembersContinueContext is like ListFileMembersContinue but takes a context for cancellation and deadlines.
+	ListFileMembersContinueContext(ctx context.Context, arg *ListFileMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error)
 	// ListFolderMembers : Returns shared folder membership by its folder ID.
-	ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error)
+	ListFolderMembers(arg *ListFolderMembersArgs, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error)
+	// ListFolderMembersContext is like ListFolderMembers but takes a context for cancellation and deadlines.
+	ListFolderMembersContext(ctx context.Context, arg *ListFolderMembersArgs, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error)
 	// ListFolderMembersContinue : Once a cursor has been retrieved from
 	// `listFolderMembers`, use this to paginate through all shared folder
 	// members.
-	ListFolderMembersContinue(arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error)
+	ListFolderMembersContinue(arg *ListFolderMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error)
+	// ListFolderMembersContinueContext is like ListFolderMembersContinue but takes a context for cancellation and deadlines.
+	ListFolderMembersContinueContext(ctx context.Context, arg *ListFolderMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error)
 	// ListFolders : Return the list of all shared folders the current user has
 	// access to.
-	ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error)
+	ListFolders(arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
+	// ListFoldersContext is like ListFolders but takes a context for cancellation and deadlines.
+	ListFoldersContext(ctx context.Context, arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
 	// ListFoldersContinue : Once a cursor has been retrieved from
 	// `listFolders`, use this to paginate through all shared folders. The
 	// cursor must come from a previous call to `listFolders` or
 	// `listFoldersContinue`.
-	ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error)
+	ListFoldersContinue(arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
+	// ListFoldersContinueContext is like ListFoldersContinue but takes a context for cancellation and deadlines.
+	ListFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
 	// ListMountableFolders : Return the list of all shared folders the current
 	// user can mount or unmount.
-	ListMountableFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error)
+	ListMountableFolders(arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
+	// ListMountableFoldersContext is like ListMountableFolders but takes a context for cancellation and deadlines.
+	ListMountableFoldersContext(ctx context.Context, arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
 	// ListMountableFoldersContinue : Once a cursor has been retrieved from
 	// `listMountableFolders`, use this to paginate through all mountable shared
 	// folders. The cursor must come from a previous call to
 	// `listMountableFolders` or `listMountableFoldersContinue`.
-	ListMountableFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error)
+	ListMountableFoldersContinue(arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
+	// ListMountableFoldersContinueContext is like ListMountableFoldersContinue but takes a context for cancellation and deadlines.
+	ListMountableFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
 	// ListReceivedFiles : Returns a list of all files shared with current user.
 	// Does not include files the user has received via shared folders, and does
 	// not include unclaimed invitations.
-	ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult, err error)
+	ListReceivedFiles(arg *ListFilesArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error)
+	// ListReceivedFilesContext is like ListReceivedFiles but takes a context for cancellation and deadlines.
+	ListReceivedFilesContext(ctx context.Context, arg *ListFilesArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error)
 	// ListReceivedFilesContinue : Get more results with a cursor from
 	// `listReceivedFiles`.
-	ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *ListFilesResult, err error)
+	ListReceivedFilesContinue(arg *ListFilesContinueArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error)
+	// ListReceivedFilesContinueContext is like ListReceivedFilesContinue but takes a context for cancellation and deadlines.
+	ListReceivedFilesContinueContext(ctx context.Context, arg *ListFilesContinueArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error)
 	// ListSharedLinks : List shared links of this user. If no path is given,
 	// returns a list of all shared links for the current user. For members of
 	// business teams using team space and member folders, returns all shared
@@ -132,7 +181,9 @@ type Client interface {
 	// access to the given path - direct links to the given path and links to
 	// parent folders of the given path. Links to parent folders can be
 	// suppressed by setting direct_only to true.
-	ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error)
+	ListSharedLinks(arg *ListSharedLinksArg, opts ...dropbox.CallOption) (res *ListSharedLinksResult, err error)
+	// ListSharedLinksContext is like ListSharedLinks but takes a context for cancellation and deadlines.
+	ListSharedLinksContext(ctx context.Context, arg *ListSharedLinksArg, opts ...dropbox.CallOption) (res *ListSharedLinksResult, err error)
 	// ModifySharedLinkSettings : Modify the shared link's settings. If the
 	// requested visibility conflict with the shared links policy of the team or
 	// the shared folder (in case the linked file is part of a shared folder)
@@ -140,72 +191,145 @@ type Client interface {
 	// `SharedLinkMetadata` will reflect the actual visibility of the shared
 	// link and the `LinkPermissions.requested_visibility` will reflect the
 	// requested visibility.
-	ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error)
+	ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
+	// ModifySharedLinkSettingsContext is like ModifySharedLinkSettings but takes a context for cancellation and deadlines.
+	ModifySharedLinkSettingsContext(ctx context.Context, arg *ModifySharedLinkSettingsArgs, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
 	// MountFolder : The current user mounts the designated folder. Mount a
 	// shared folder for a user after they have been added as a member. Once
 	// mounted, the shared folder will appear in their Dropbox.
-	MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata, err error)
+	MountFolder(arg *MountFolderArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
+	// MountFolderContext is like MountFolder but takes a context for cancellation and deadlines.
+	MountFolderContext(ctx context.Context, arg *MountFolderArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
 	// RelinquishFileMembership : The current user relinquishes their membership
 	// in the designated file. Note that the current user may still have
 	// inherited access to this file through the parent folder.
-	RelinquishFileMembership(arg *RelinquishFileMembershipArg) (err error)
+	RelinquishFileMembership(arg *RelinquishFileMembershipArg, opts ...dropbox.CallOption) (err error)
+	// RelinquishFileMembershipContext is like RelinquishFileMembership but takes a context for cancellation and deadlines.
+	RelinquishFileMembershipContext(ctx context.Context, arg *RelinquishFileMembershipArg, opts ...dropbox.CallOption) (err error)
 	// RelinquishFolderMembership : The current user relinquishes their
 	// membership in the designated shared folder and will no longer have access
 	// to the folder.  A folder owner cannot relinquish membership in their own
 	// folder. This will run synchronously if leave_a_copy is false, and
 	// asynchronously if leave_a_copy is true.
-	RelinquishFolderMembership(arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error)
+	RelinquishFolderMembership(arg *RelinquishFolderMembershipArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	// RelinquishFolderMembershipContext is like RelinquishFolderMembership but takes a context for cancellation and deadlines.
+	RelinquishFolderMembershipContext(ctx context.Context, arg *RelinquishFolderMembershipArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
 	// RemoveFileMember : Identical to remove_file_member_2 but with less
 	// information returned.
 	// Deprecated: Use `RemoveFileMember2` instead
-	RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error)
+	RemoveFileMember(arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberActionIndividualResult, err error)
+	// RemoveFileMemberContext is like RemoveFileMember but takes a context for cancellation and deadlines.
+	RemoveFileMemberContext(ctx context.Context, arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberActionIndividualResult, err error)
 	// RemoveFileMember2 : Removes a specified member from the file.
-	RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error)
+	RemoveFileMember2(arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberRemoveActionResult, err error)
+	// RemoveFileMember2Context is like RemoveFileMember2 but takes a context for cancellation and deadlines.
+	RemoveFileMember2Context(ctx context.Context, arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberRemoveActionResult, err error)
 	// RemoveFolderMember : Allows an owner or editor (if the ACL update policy
 	// allows) of a shared folder to remove another member.
-	RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error)
+	RemoveFolderMember(arg *RemoveFolderMemberArg, opts ...dropbox.CallOption) (res *async.LaunchResultBase, err error)
+	// RemoveFolderMemberContext is like RemoveFolderMember but takes a context for cancellation and deadlines.
+	RemoveFolderMemberContext(ctx context.Context, arg *RemoveFolderMemberArg, opts ...dropbox.CallOption) (res *async.LaunchResultBase, err error)
 	// RevokeSharedLink : Revoke a shared link. Note that even after revoking a
 	// shared link to a file, the file may be accessible if there are shared
 	// links leading to any of the file parent folders. To list all shared links
 	// that enable access to a specific file, you can use the `listSharedLinks`
 	// with the file as the `ListSharedLinksArg.path` argument.
-	RevokeSharedLink(arg *RevokeSharedLinkArg) (err error)
+	RevokeSharedLink(arg *RevokeSharedLinkArg, opts ...dropbox.CallOption) (err error)
+	// RevokeSharedLinkContext is like RevokeSharedLink but takes a context for cancellation and deadlines.
+	RevokeSharedLinkContext(ctx context.Context, arg *RevokeSharedLinkArg, opts ...dropbox.CallOption) (err error)
 	// SetAccessInheritance : Change the inheritance policy of an existing
 	// Shared Folder. Only permitted for shared folders in a shared team root.
 	// If a `ShareFolderLaunch.async_job_id` is returned, you'll need to call
 	// `checkShareJobStatus` until the action completes to get the metadata for
 	// the folder.
-	SetAccessInheritance(arg *SetAccessInheritanceArg) (res *ShareFolderLaunch, err error)
+	SetAccessInheritance(arg *SetAccessInheritanceArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error)
+	// SetAccessInheritanceContext is like SetAccessInheritance but takes a context for cancellation and deadlines.
+	SetAccessInheritanceContext(ctx context.Context, arg *SetAccessInheritanceArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error)
 	// ShareFolder : Share a folder with collaborators. Most sharing will be
 	// completed synchronously. Large folders will be completed asynchronously.
 	// To make testing the async case repeatable, set
 	// `ShareFolderArg.force_async`. If a `ShareFolderLaunch.async_job_id` is
 	// returned, you'll need to call `checkShareJobStatus` until the action
 	// completes to get the metadata for the folder.
-	ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, err error)
+	ShareFolder(arg *ShareFolderArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error)
+	// ShareFolderContext is like ShareFolder but takes a context for cancellation and deadlines.
+	ShareFolderContext(ctx context.Context, arg *ShareFolderArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error)
 	// TransferFolder : Transfer ownership of a shared folder to a member of the
 	// shared folder. User must have `AccessLevel.owner` access to the shared
 	// folder to perform a transfer.
-	TransferFolder(arg *TransferFolderArg) (err error)
+	TransferFolder(arg *TransferFolderArg, opts ...dropbox.CallOption) (err error)
+	// TransferFolderContext is like TransferFolder but takes a context for cancellation and deadlines.
+	TransferFolderContext(ctx context.Context, arg *TransferFolderArg, opts ...dropbox.CallOption) (err error)
 	// UnmountFolder : The current user unmounts the designated folder. They can
 	// re-mount the folder at a later time using `mountFolder`.
-	UnmountFolder(arg *UnmountFolderArg) (err error)
+	UnmountFolder(arg *UnmountFolderArg, opts ...dropbox.CallOption) (err error)
+	// UnmountFolderContext is like UnmountFolder but takes a context for cancellation and deadlines.
+	UnmountFolderContext(ctx context.Context, arg *UnmountFolderArg, opts ...dropbox.CallOption) (err error)
 	// UnshareFile : Remove all members from this file. Does not remove
 	// inherited members.
-	UnshareFile(arg *UnshareFileArg) (err error)
+	UnshareFile(arg *UnshareFileArg, opts ...dropbox.CallOption) (err error)
+	// UnshareFileContext is like UnshareFile but takes a context for cancellation and deadlines.
+	UnshareFileContext(ctx context.Context, arg *UnshareFileArg, opts ...dropbox.CallOption) (err error)
 	// UnshareFolder : Allows a shared folder owner to unshare the folder.
 	// You'll need to call `checkJobStatus` to determine if the action has
 	// completed successfully.
-	UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error)
+	UnshareFolder(arg *UnshareFolderArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	// UnshareFolderContext is like UnshareFolder but takes a context for cancellation and deadlines.
+	UnshareFolderContext(ctx context.Context, arg *UnshareFolderArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
 	// UpdateFileMember : Changes a member's access on a shared file.
-	UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error)
+	UpdateFileMember(arg *UpdateFileMemberArgs, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error)
+	// UpdateFileMemberContext is like UpdateFileMember but takes a context for cancellation and deadlines.
+	UpdateFileMemberContext(ctx context.Context, arg *UpdateFileMemberArgs, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error)
 	// UpdateFolderMember : Allows an owner or editor of a shared folder to
 	// update another member's permissions.
-	UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error)
+	UpdateFolderMember(arg *UpdateFolderMemberArg, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error)
+	// UpdateFolderMemberContext is like UpdateFolderMember but takes a context for cancellation and deadlines.
+	UpdateFolderMemberContext(ctx context.Context, arg *UpdateFolderMemberArg, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error)
 	// UpdateFolderPolicy : Update the sharing policies for a shared folder.
 	// User must have `AccessLevel.owner` access to the shared folder to update
 	// its policies.
-	UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error)
+	UpdateFolderPolicy(arg *UpdateFolderPolicyArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
+	// UpdateFolderPolicyContext is like UpdateFolderPolicy but takes a context for cancellation and deadlines.
+	UpdateFolderPolicyContext(ctx context.Context, arg *UpdateFolderPolicyArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
+}
+
+// Sentinel errors for the tags of unions that are nested in the errors of
+// many routes. The errors of all routes match them with errors.Is if their
+// endpoint error contains the union with the tag.
+var (
+	ErrSharedFolderAccessInvalidId         error = endpointErrorTag{"SharedFolderAccessError", SharedFolderAccessErrorInvalidId}
+	ErrSharedFolderAccessNotAMember        error = endpointErrorTag{"SharedFolderAccessError", SharedFolderAccessErrorNotAMember}
+	ErrSharedFolderAccessEmailUnverified   error = endpointErrorTag{"SharedFolderAccessError", SharedFolderAccessErrorEmailUnverified}
+	ErrSharedFolderAccessUnmounted         error = endpointErrorTag{"SharedFolderAccessError", SharedFolderAccessErrorUnmounted}
+	ErrSharingFileAccessNoPermission       error = endpointErrorTag{"SharingFileAccessError", SharingFileAccessErrorNoPermission}
+	ErrSharingFileAccessInvalidFile        error = endpointErrorTag{"SharingFileAccessError", SharingFileAccessErrorInvalidFile}
+	ErrSharingFileAccessIsFolder           error = endpointErrorTag{"SharingFileAccessError", SharingFileAccessErrorIsFolder}
+	ErrSharingFileAccessInsidePublicFolder error = endpointErrorTag{"SharingFileAccessError", SharingFileAccessErrorInsidePublicFolder}
+	ErrSharingFileAccessInsideOsxPackage   error = endpointErrorTag{"SharingFileAccessError", SharingFileAccessErrorInsideOsxPackage}
+	ErrSharingUserEmailUnverified          error = endpointErrorTag{"SharingUserError", SharingUserErrorEmailUnverified}
+)
+
+// endpointErrorTag matches the unions of a type with a tag.
+type endpointErrorTag struct {
+	union string
+	tag   string
+}
+
+func (e endpointErrorTag) Error() string {
+	return "sharing: " + e.union + " " + e.tag
+}
+
+// MatchEndpointError implements dropbox.EndpointErrorMatcher.
+func (e endpointErrorTag) MatchEndpointError(endpointError interface{}) bool {
+	switch err := endpointError.(type) {
+	case *SharedFolderAccessError:
+		return e.union == "SharedFolderAccessError" && err.Tag == e.tag
+	case *SharingFileAccessError:
+		return e.union == "SharingFileAccessError" && err.Tag == e.tag
+	case *SharingUserError:
+		return e.union == "SharingUserError" && err.Tag == e.tag
+	}
+	return false
 }
 
 type apiImpl dropbox.Context
@@ -216,7 +340,29 @@ type AddFileMemberAPIError struct {
 	EndpointError *AddFileMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActionResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *AddFileMemberAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e AddFileMemberAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.UserError != nil && m.MatchEndpointError(err.UserError) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) AddFileMember(arg *AddFileMemberArgs, opts ...dropbox.CallOption) (res []*FileMemberActionResult, err error) {
+	return dbx.AddFileMemberContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) AddFileMemberContext(ctx context.Context, arg *AddFileMemberArgs, opts ...dropbox.CallOption) (res []*FileMemberActionResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -225,11 +371,12 @@ func (dbx *apiImpl) AddFileMember(arg *AddFileMemberArgs) (res []*FileMemberActi
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr AddFileMemberAPIError
 		err = auth.ParseError(err, &appErr)
@@ -254,7 +401,29 @@ type AddFolderMemberAPIError struct {
 	EndpointError *AddFolderMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) AddFolderMember(arg *AddFolderMemberArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *AddFolderMemberAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e AddFolderMemberAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.BadMember != nil && m.MatchEndpointError(err.BadMember)
+}
+
+func (dbx *apiImpl) AddFolderMember(arg *AddFolderMemberArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.AddFolderMemberContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) AddFolderMemberContext(ctx context.Context, arg *AddFolderMemberArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -263,11 +432,12 @@ func (dbx *apiImpl) AddFolderMember(arg *AddFolderMemberArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr AddFolderMemberAPIError
 		err = auth.ParseError(err, &appErr)
@@ -288,7 +458,26 @@ type CheckJobStatusAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CheckJobStatus(arg *async.PollArg) (res *JobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CheckJobStatusAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CheckJobStatusAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) CheckJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *JobStatus, err error) {
+	return dbx.CheckJobStatusContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CheckJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *JobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -297,11 +486,12 @@ func (dbx *apiImpl) CheckJobStatus(arg *async.PollArg) (res *JobStatus, err erro
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CheckJobStatusAPIError
 		err = auth.ParseError(err, &appErr)
@@ -326,7 +516,26 @@ type CheckRemoveMemberJobStatusAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveMemberJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CheckRemoveMemberJobStatusAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CheckRemoveMemberJobStatusAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) CheckRemoveMemberJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *RemoveMemberJobStatus, err error) {
+	return dbx.CheckRemoveMemberJobStatusContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RemoveMemberJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -335,11 +544,12 @@ func (dbx *apiImpl) CheckRemoveMemberJobStatus(arg *async.PollArg) (res *RemoveM
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CheckRemoveMemberJobStatusAPIError
 		err = auth.ParseError(err, &appErr)
@@ -364,7 +574,26 @@ type CheckShareJobStatusAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CheckShareJobStatusAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CheckShareJobStatusAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) CheckShareJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error) {
+	return dbx.CheckShareJobStatusContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -373,11 +602,12 @@ func (dbx *apiImpl) CheckShareJobStatus(arg *async.PollArg) (res *ShareFolderJob
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CheckShareJobStatusAPIError
 		err = auth.ParseError(err, &appErr)
@@ -402,7 +632,28 @@ type CreateSharedLinkAPIError struct {
 	EndpointError *CreateSharedLinkError `json:"error"`
 }
 
-func (dbx *apiImpl) CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CreateSharedLinkAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CreateSharedLinkAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) CreateSharedLink(arg *CreateSharedLinkArg, opts ...dropbox.CallOption) (res *PathLinkMetadata, err error) {
+	return dbx.CreateSharedLinkContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CreateSharedLinkContext(ctx context.Context, arg *CreateSharedLinkArg, opts ...dropbox.CallOption) (res *PathLinkMetadata, err error) {
 	log.Printf("WARNING: API `CreateSharedLink` is deprecated")
 	log.Printf("Use API `CreateSharedLinkWithSettings` instead")
 
@@ -414,11 +665,12 @@ func (dbx *apiImpl) CreateSharedLink(arg *CreateSharedLinkArg) (res *PathLinkMet
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CreateSharedLinkAPIError
 		err = auth.ParseError(err, &appErr)
@@ -443,7 +695,30 @@ type CreateSharedLinkWithSettingsAPIError struct {
 	EndpointError *CreateSharedLinkWithSettingsError `json:"error"`
 }
 
-func (dbx *apiImpl) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg) (res IsSharedLinkMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *CreateSharedLinkWithSettingsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e CreateSharedLinkWithSettingsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path) ||
+		err.SharedLinkAlreadyExists != nil && m.MatchEndpointError(err.SharedLinkAlreadyExists) ||
+		err.SettingsError != nil && m.MatchEndpointError(err.SettingsError)
+}
+
+func (dbx *apiImpl) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	return dbx.CreateSharedLinkWithSettingsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) CreateSharedLinkWithSettingsContext(ctx context.Context, arg *CreateSharedLinkWithSettingsArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -452,11 +727,12 @@ func (dbx *apiImpl) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettin
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr CreateSharedLinkWithSettingsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -489,7 +765,29 @@ type GetFileMetadataAPIError struct {
 	EndpointError *GetFileMetadataError `json:"error"`
 }
 
-func (dbx *apiImpl) GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetFileMetadataAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetFileMetadataAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.UserError != nil && m.MatchEndpointError(err.UserError) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) GetFileMetadata(arg *GetFileMetadataArg, opts ...dropbox.CallOption) (res *SharedFileMetadata, err error) {
+	return dbx.GetFileMetadataContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetFileMetadataContext(ctx context.Context, arg *GetFileMetadataArg, opts ...dropbox.CallOption) (res *SharedFileMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -498,11 +796,12 @@ func (dbx *apiImpl) GetFileMetadata(arg *GetFileMetadataArg) (res *SharedFileMet
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetFileMetadataAPIError
 		err = auth.ParseError(err, &appErr)
@@ -527,7 +826,26 @@ type GetFileMetadataBatchAPIError struct {
 	EndpointError *SharingUserError `json:"error"`
 }
 
-func (dbx *apiImpl) GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*GetFileMetadataBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetFileMetadataBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetFileMetadataBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetFileMetadataBatch(arg *GetFileMetadataBatchArg, opts ...dropbox.CallOption) (res []*GetFileMetadataBatchResult, err error) {
+	return dbx.GetFileMetadataBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetFileMetadataBatchContext(ctx context.Context, arg *GetFileMetadataBatchArg, opts ...dropbox.CallOption) (res []*GetFileMetadataBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -536,11 +854,12 @@ func (dbx *apiImpl) GetFileMetadataBatch(arg *GetFileMetadataBatchArg) (res []*G
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetFileMetadataBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -565,7 +884,26 @@ type GetFolderMetadataAPIError struct {
 	EndpointError *SharedFolderAccessError `json:"error"`
 }
 
-func (dbx *apiImpl) GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetFolderMetadataAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetFolderMetadataAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetFolderMetadata(arg *GetMetadataArgs, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	return dbx.GetFolderMetadataContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetFolderMetadataContext(ctx context.Context, arg *GetMetadataArgs, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -574,11 +912,12 @@ func (dbx *apiImpl) GetFolderMetadata(arg *GetMetadataArgs) (res *SharedFolderMe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetFolderMetadataAPIError
 		err = auth.ParseError(err, &appErr)
@@ -603,7 +942,26 @@ type GetSharedLinkFileAPIError struct {
 	EndpointError *GetSharedLinkFileError `json:"error"`
 }
 
-func (dbx *apiImpl) GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetSharedLinkFileAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetSharedLinkFileAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetSharedLinkFile(arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	return dbx.GetSharedLinkFileContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetSharedLinkFileContext(ctx context.Context, arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
 	req := dropbox.Request{
 		Host:         "content",
 		Namespace:    "sharing",
@@ -612,11 +970,12 @@ func (dbx *apiImpl) GetSharedLinkFile(arg *GetSharedLinkMetadataArg) (res IsShar
 		Style:        "download",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetSharedLinkFileAPIError
 		err = auth.ParseError(err, &appErr)
@@ -649,7 +1008,26 @@ type GetSharedLinkMetadataAPIError struct {
 	EndpointError *SharedLinkError `json:"error"`
 }
 
-func (dbx *apiImpl) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res IsSharedLinkMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetSharedLinkMetadataAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetSharedLinkMetadataAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	return dbx.GetSharedLinkMetadataContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetSharedLinkMetadataContext(ctx context.Context, arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -658,11 +1036,12 @@ func (dbx *apiImpl) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg) (res Is
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetSharedLinkMetadataAPIError
 		err = auth.ParseError(err, &appErr)
@@ -695,7 +1074,26 @@ type GetSharedLinksAPIError struct {
 	EndpointError *GetSharedLinksError `json:"error"`
 }
 
-func (dbx *apiImpl) GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetSharedLinksAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetSharedLinksAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetSharedLinks(arg *GetSharedLinksArg, opts ...dropbox.CallOption) (res *GetSharedLinksResult, err error) {
+	return dbx.GetSharedLinksContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetSharedLinksContext(ctx context.Context, arg *GetSharedLinksArg, opts ...dropbox.CallOption) (res *GetSharedLinksResult, err error) {
 	log.Printf("WARNING: API `GetSharedLinks` is deprecated")
 	log.Printf("Use API `ListSharedLinks` instead")
 
@@ -707,11 +1105,12 @@ func (dbx *apiImpl) GetSharedLinks(arg *GetSharedLinksArg) (res *GetSharedLinksR
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetSharedLinksAPIError
 		err = auth.ParseError(err, &appErr)
@@ -736,7 +1135,29 @@ type ListFileMembersAPIError struct {
 	EndpointError *ListFileMembersError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMembers, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFileMembersAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFileMembersAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.UserError != nil && m.MatchEndpointError(err.UserError) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) ListFileMembers(arg *ListFileMembersArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error) {
+	return dbx.ListFileMembersContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFileMembersContext(ctx context.Context, arg *ListFileMembersArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -745,11 +1166,12 @@ func (dbx *apiImpl) ListFileMembers(arg *ListFileMembersArg) (res *SharedFileMem
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFileMembersAPIError
 		err = auth.ParseError(err, &appErr)
@@ -774,7 +1196,26 @@ type ListFileMembersBatchAPIError struct {
 	EndpointError *SharingUserError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*ListFileMembersBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFileMembersBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFileMembersBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ListFileMembersBatch(arg *ListFileMembersBatchArg, opts ...dropbox.CallOption) (res []*ListFileMembersBatchResult, err error) {
+	return dbx.ListFileMembersBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFileMembersBatchContext(ctx context.Context, arg *ListFileMembersBatchArg, opts ...dropbox.CallOption) (res []*ListFileMembersBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -783,11 +1224,12 @@ func (dbx *apiImpl) ListFileMembersBatch(arg *ListFileMembersBatchArg) (res []*L
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFileMembersBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -812,7 +1254,29 @@ type ListFileMembersContinueAPIError struct {
 	EndpointError *ListFileMembersContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFileMembersContinue(arg *ListFileMembersContinueArg) (res *SharedFileMembers, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFileMembersContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFileMembersContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.UserError != nil && m.MatchEndpointError(err.UserError) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) ListFileMembersContinue(arg *ListFileMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error) {
+	return dbx.ListFileMembersContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFileMembersContinueContext(ctx context.Context, arg *ListFileMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -821,11 +1285,12 @@ func (dbx *apiImpl) ListFileMembersContinue(arg *ListFileMembersContinueArg) (re
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFileMembersContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -850,7 +1315,26 @@ type ListFolderMembersAPIError struct {
 	EndpointError *SharedFolderAccessError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFolderMembers, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFolderMembersAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFolderMembersAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ListFolderMembers(arg *ListFolderMembersArgs, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error) {
+	return dbx.ListFolderMembersContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFolderMembersContext(ctx context.Context, arg *ListFolderMembersArgs, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -859,11 +1343,12 @@ func (dbx *apiImpl) ListFolderMembers(arg *ListFolderMembersArgs) (res *SharedFo
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFolderMembersAPIError
 		err = auth.ParseError(err, &appErr)
@@ -888,7 +1373,28 @@ type ListFolderMembersContinueAPIError struct {
 	EndpointError *ListFolderMembersContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolderMembersContinue(arg *ListFolderMembersContinueArg) (res *SharedFolderMembers, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFolderMembersContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFolderMembersContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) ListFolderMembersContinue(arg *ListFolderMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error) {
+	return dbx.ListFolderMembersContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFolderMembersContinueContext(ctx context.Context, arg *ListFolderMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -897,11 +1403,12 @@ func (dbx *apiImpl) ListFolderMembersContinue(arg *ListFolderMembersContinueArg)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFolderMembersContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -926,7 +1433,16 @@ type ListFoldersAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFoldersAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) ListFolders(arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	return dbx.ListFoldersContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFoldersContext(ctx context.Context, arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -935,11 +1451,12 @@ func (dbx *apiImpl) ListFolders(arg *ListFoldersArgs) (res *ListFoldersResult, e
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFoldersAPIError
 		err = auth.ParseError(err, &appErr)
@@ -964,7 +1481,26 @@ type ListFoldersContinueAPIError struct {
 	EndpointError *ListFoldersContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListFoldersContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListFoldersContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ListFoldersContinue(arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	return dbx.ListFoldersContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -973,11 +1509,12 @@ func (dbx *apiImpl) ListFoldersContinue(arg *ListFoldersContinueArg) (res *ListF
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListFoldersContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1002,7 +1539,16 @@ type ListMountableFoldersAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) ListMountableFolders(arg *ListFoldersArgs) (res *ListFoldersResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListMountableFoldersAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) ListMountableFolders(arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	return dbx.ListMountableFoldersContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListMountableFoldersContext(ctx context.Context, arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1011,11 +1557,12 @@ func (dbx *apiImpl) ListMountableFolders(arg *ListFoldersArgs) (res *ListFolders
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListMountableFoldersAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1040,7 +1587,26 @@ type ListMountableFoldersContinueAPIError struct {
 	EndpointError *ListFoldersContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListMountableFoldersContinue(arg *ListFoldersContinueArg) (res *ListFoldersResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListMountableFoldersContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListMountableFoldersContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ListMountableFoldersContinue(arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	return dbx.ListMountableFoldersContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListMountableFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1049,11 +1615,12 @@ func (dbx *apiImpl) ListMountableFoldersContinue(arg *ListFoldersContinueArg) (r
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListMountableFoldersContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1078,7 +1645,26 @@ type ListReceivedFilesAPIError struct {
 	EndpointError *SharingUserError `json:"error"`
 }
 
-func (dbx *apiImpl) ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListReceivedFilesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListReceivedFilesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ListReceivedFiles(arg *ListFilesArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error) {
+	return dbx.ListReceivedFilesContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListReceivedFilesContext(ctx context.Context, arg *ListFilesArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1087,11 +1673,12 @@ func (dbx *apiImpl) ListReceivedFiles(arg *ListFilesArg) (res *ListFilesResult,
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListReceivedFilesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1116,7 +1703,28 @@ type ListReceivedFilesContinueAPIError struct {
 	EndpointError *ListFilesContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *ListFilesResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListReceivedFilesContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListReceivedFilesContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.UserError != nil && m.MatchEndpointError(err.UserError)
+}
+
+func (dbx *apiImpl) ListReceivedFilesContinue(arg *ListFilesContinueArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error) {
+	return dbx.ListReceivedFilesContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListReceivedFilesContinueContext(ctx context.Context, arg *ListFilesContinueArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1125,11 +1733,12 @@ func (dbx *apiImpl) ListReceivedFilesContinue(arg *ListFilesContinueArg) (res *L
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListReceivedFilesContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1154,7 +1763,28 @@ type ListSharedLinksAPIError struct {
 	EndpointError *ListSharedLinksError `json:"error"`
 }
 
-func (dbx *apiImpl) ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLinksResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ListSharedLinksAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ListSharedLinksAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.Path != nil && m.MatchEndpointError(err.Path)
+}
+
+func (dbx *apiImpl) ListSharedLinks(arg *ListSharedLinksArg, opts ...dropbox.CallOption) (res *ListSharedLinksResult, err error) {
+	return dbx.ListSharedLinksContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ListSharedLinksContext(ctx context.Context, arg *ListSharedLinksArg, opts ...dropbox.CallOption) (res *ListSharedLinksResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1163,11 +1793,12 @@ func (dbx *apiImpl) ListSharedLinks(arg *ListSharedLinksArg) (res *ListSharedLin
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ListSharedLinksAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1192,7 +1823,28 @@ type ModifySharedLinkSettingsAPIError struct {
 	EndpointError *ModifySharedLinkSettingsError `json:"error"`
 }
 
-func (dbx *apiImpl) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs) (res IsSharedLinkMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ModifySharedLinkSettingsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ModifySharedLinkSettingsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.SettingsError != nil && m.MatchEndpointError(err.SettingsError)
+}
+
+func (dbx *apiImpl) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	return dbx.ModifySharedLinkSettingsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ModifySharedLinkSettingsContext(ctx context.Context, arg *ModifySharedLinkSettingsArgs, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1201,11 +1853,12 @@ func (dbx *apiImpl) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ModifySharedLinkSettingsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1238,7 +1891,28 @@ type MountFolderAPIError struct {
 	EndpointError *MountFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MountFolderAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MountFolderAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) MountFolder(arg *MountFolderArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	return dbx.MountFolderContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MountFolderContext(ctx context.Context, arg *MountFolderArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1247,11 +1921,12 @@ func (dbx *apiImpl) MountFolder(arg *MountFolderArg) (res *SharedFolderMetadata,
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MountFolderAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1276,7 +1951,28 @@ type RelinquishFileMembershipAPIError struct {
 	EndpointError *RelinquishFileMembershipError `json:"error"`
 }
 
-func (dbx *apiImpl) RelinquishFileMembership(arg *RelinquishFileMembershipArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *RelinquishFileMembershipAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e RelinquishFileMembershipAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) RelinquishFileMembership(arg *RelinquishFileMembershipArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.RelinquishFileMembershipContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) RelinquishFileMembershipContext(ctx context.Context, arg *RelinquishFileMembershipArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1285,11 +1981,12 @@ func (dbx *apiImpl) RelinquishFileMembership(arg *RelinquishFileMembershipArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr RelinquishFileMembershipAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1310,7 +2007,28 @@ type RelinquishFolderMembershipAPIError struct {
 	EndpointError *RelinquishFolderMembershipError `json:"error"`
 }
 
-func (dbx *apiImpl) RelinquishFolderMembership(arg *RelinquishFolderMembershipArg) (res *async.LaunchEmptyResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *RelinquishFolderMembershipAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e RelinquishFolderMembershipAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) RelinquishFolderMembership(arg *RelinquishFolderMembershipArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return dbx.RelinquishFolderMembershipContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) RelinquishFolderMembershipContext(ctx context.Context, arg *RelinquishFolderMembershipArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1319,11 +2037,12 @@ func (dbx *apiImpl) RelinquishFolderMembership(arg *RelinquishFolderMembershipAr
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr RelinquishFolderMembershipAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1348,7 +2067,30 @@ type RemoveFileMemberAPIError struct {
 	EndpointError *RemoveFileMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberActionIndividualResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *RemoveFileMemberAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e RemoveFileMemberAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.UserError != nil && m.MatchEndpointError(err.UserError) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.NoExplicitAccess != nil && err.NoExplicitAccess.AccessLevel != nil && m.MatchEndpointError(err.NoExplicitAccess.AccessLevel)
+}
+
+func (dbx *apiImpl) RemoveFileMember(arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberActionIndividualResult, err error) {
+	return dbx.RemoveFileMemberContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) RemoveFileMemberContext(ctx context.Context, arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberActionIndividualResult, err error) {
 	log.Printf("WARNING: API `RemoveFileMember` is deprecated")
 	log.Printf("Use API `RemoveFileMember2` instead")
 
@@ -1360,11 +2102,12 @@ func (dbx *apiImpl) RemoveFileMember(arg *RemoveFileMemberArg) (res *FileMemberA
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr RemoveFileMemberAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1389,7 +2132,30 @@ type RemoveFileMember2APIError struct {
 	EndpointError *RemoveFileMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMemberRemoveActionResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *RemoveFileMember2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e RemoveFileMember2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.UserError != nil && m.MatchEndpointError(err.UserError) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.NoExplicitAccess != nil && err.NoExplicitAccess.AccessLevel != nil && m.MatchEndpointError(err.NoExplicitAccess.AccessLevel)
+}
+
+func (dbx *apiImpl) RemoveFileMember2(arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberRemoveActionResult, err error) {
+	return dbx.RemoveFileMember2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) RemoveFileMember2Context(ctx context.Context, arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberRemoveActionResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1398,11 +2164,12 @@ func (dbx *apiImpl) RemoveFileMember2(arg *RemoveFileMemberArg) (res *FileMember
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr RemoveFileMember2APIError
 		err = auth.ParseError(err, &appErr)
@@ -1427,7 +2194,30 @@ type RemoveFolderMemberAPIError struct {
 	EndpointError *RemoveFolderMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.LaunchResultBase, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *RemoveFolderMemberAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e RemoveFolderMemberAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.MemberError != nil && m.MatchEndpointError(err.MemberError) ||
+		err.MemberError != nil && err.MemberError.NoExplicitAccess != nil && err.MemberError.NoExplicitAccess.AccessLevel != nil && m.MatchEndpointError(err.MemberError.NoExplicitAccess.AccessLevel)
+}
+
+func (dbx *apiImpl) RemoveFolderMember(arg *RemoveFolderMemberArg, opts ...dropbox.CallOption) (res *async.LaunchResultBase, err error) {
+	return dbx.RemoveFolderMemberContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) RemoveFolderMemberContext(ctx context.Context, arg *RemoveFolderMemberArg, opts ...dropbox.CallOption) (res *async.LaunchResultBase, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1436,11 +2226,12 @@ func (dbx *apiImpl) RemoveFolderMember(arg *RemoveFolderMemberArg) (res *async.L
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr RemoveFolderMemberAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1465,7 +2256,26 @@ type RevokeSharedLinkAPIError struct {
 	EndpointError *RevokeSharedLinkError `json:"error"`
 }
 
-func (dbx *apiImpl) RevokeSharedLink(arg *RevokeSharedLinkArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *RevokeSharedLinkAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e RevokeSharedLinkAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) RevokeSharedLink(arg *RevokeSharedLinkArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.RevokeSharedLinkContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) RevokeSharedLinkContext(ctx context.Context, arg *RevokeSharedLinkArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1474,11 +2284,12 @@ func (dbx *apiImpl) RevokeSharedLink(arg *RevokeSharedLinkArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr RevokeSharedLinkAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1499,7 +2310,28 @@ type SetAccessInheritanceAPIError struct {
 	EndpointError *SetAccessInheritanceError `json:"error"`
 }
 
-func (dbx *apiImpl) SetAccessInheritance(arg *SetAccessInheritanceArg) (res *ShareFolderLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *SetAccessInheritanceAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e SetAccessInheritanceAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) SetAccessInheritance(arg *SetAccessInheritanceArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error) {
+	return dbx.SetAccessInheritanceContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) SetAccessInheritanceContext(ctx context.Context, arg *SetAccessInheritanceArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1508,11 +2340,12 @@ func (dbx *apiImpl) SetAccessInheritance(arg *SetAccessInheritanceArg) (res *Sha
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr SetAccessInheritanceAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1537,7 +2370,30 @@ type ShareFolderAPIError struct {
 	EndpointError *ShareFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ShareFolderAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ShareFolderAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.BadPath != nil && m.MatchEndpointError(err.BadPath) ||
+		err.BadPath != nil && err.BadPath.AlreadyShared != nil && err.BadPath.AlreadyShared.AccessType != nil && m.MatchEndpointError(err.BadPath.AlreadyShared.AccessType) ||
+		err.BadPath != nil && err.BadPath.AlreadyShared != nil && err.BadPath.AlreadyShared.AccessInheritance != nil && m.MatchEndpointError(err.BadPath.AlreadyShared.AccessInheritance)
+}
+
+func (dbx *apiImpl) ShareFolder(arg *ShareFolderArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error) {
+	return dbx.ShareFolderContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ShareFolderContext(ctx context.Context, arg *ShareFolderArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1546,11 +2402,12 @@ func (dbx *apiImpl) ShareFolder(arg *ShareFolderArg) (res *ShareFolderLaunch, er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ShareFolderAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1575,7 +2432,28 @@ type TransferFolderAPIError struct {
 	EndpointError *TransferFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) TransferFolder(arg *TransferFolderArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TransferFolderAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TransferFolderAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) TransferFolder(arg *TransferFolderArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.TransferFolderContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TransferFolderContext(ctx context.Context, arg *TransferFolderArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1584,11 +2462,12 @@ func (dbx *apiImpl) TransferFolder(arg *TransferFolderArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TransferFolderAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1609,7 +2488,28 @@ type UnmountFolderAPIError struct {
 	EndpointError *UnmountFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) UnmountFolder(arg *UnmountFolderArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UnmountFolderAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UnmountFolderAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) UnmountFolder(arg *UnmountFolderArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.UnmountFolderContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UnmountFolderContext(ctx context.Context, arg *UnmountFolderArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1618,11 +2518,12 @@ func (dbx *apiImpl) UnmountFolder(arg *UnmountFolderArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UnmountFolderAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1643,7 +2544,29 @@ type UnshareFileAPIError struct {
 	EndpointError *UnshareFileError `json:"error"`
 }
 
-func (dbx *apiImpl) UnshareFile(arg *UnshareFileArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UnshareFileAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UnshareFileAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.UserError != nil && m.MatchEndpointError(err.UserError) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) UnshareFile(arg *UnshareFileArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.UnshareFileContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UnshareFileContext(ctx context.Context, arg *UnshareFileArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1652,11 +2575,12 @@ func (dbx *apiImpl) UnshareFile(arg *UnshareFileArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UnshareFileAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1677,7 +2601,28 @@ type UnshareFolderAPIError struct {
 	EndpointError *UnshareFolderError `json:"error"`
 }
 
-func (dbx *apiImpl) UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmptyResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UnshareFolderAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UnshareFolderAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) UnshareFolder(arg *UnshareFolderArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return dbx.UnshareFolderContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UnshareFolderContext(ctx context.Context, arg *UnshareFolderArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1686,11 +2631,12 @@ func (dbx *apiImpl) UnshareFolder(arg *UnshareFolderArg) (res *async.LaunchEmpty
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UnshareFolderAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1715,7 +2661,29 @@ type UpdateFileMemberAPIError struct {
 	EndpointError *FileMemberActionError `json:"error"`
 }
 
-func (dbx *apiImpl) UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAccessLevelResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UpdateFileMemberAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UpdateFileMemberAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.NoExplicitAccess != nil && err.NoExplicitAccess.AccessLevel != nil && m.MatchEndpointError(err.NoExplicitAccess.AccessLevel)
+}
+
+func (dbx *apiImpl) UpdateFileMember(arg *UpdateFileMemberArgs, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error) {
+	return dbx.UpdateFileMemberContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UpdateFileMemberContext(ctx context.Context, arg *UpdateFileMemberArgs, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1724,11 +2692,12 @@ func (dbx *apiImpl) UpdateFileMember(arg *UpdateFileMemberArgs) (res *MemberAcce
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UpdateFileMemberAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1753,7 +2722,33 @@ type UpdateFolderMemberAPIError struct {
 	EndpointError *UpdateFolderMemberError `json:"error"`
 }
 
-func (dbx *apiImpl) UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberAccessLevelResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UpdateFolderMemberAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UpdateFolderMemberAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.MemberError != nil && m.MatchEndpointError(err.MemberError) ||
+		err.MemberError != nil && err.MemberError.NoExplicitAccess != nil && err.MemberError.NoExplicitAccess.AccessLevel != nil && m.MatchEndpointError(err.MemberError.NoExplicitAccess.AccessLevel) ||
+		err.NoExplicitAccess != nil && m.MatchEndpointError(err.NoExplicitAccess) ||
+		err.NoExplicitAccess != nil && err.NoExplicitAccess.AccessError != nil && m.MatchEndpointError(err.NoExplicitAccess.AccessError) ||
+		err.NoExplicitAccess != nil && err.NoExplicitAccess.BadMember != nil && m.MatchEndpointError(err.NoExplicitAccess.BadMember)
+}
+
+func (dbx *apiImpl) UpdateFolderMember(arg *UpdateFolderMemberArg, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error) {
+	return dbx.UpdateFolderMemberContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UpdateFolderMemberContext(ctx context.Context, arg *UpdateFolderMemberArg, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1762,11 +2757,12 @@ func (dbx *apiImpl) UpdateFolderMember(arg *UpdateFolderMemberArg) (res *MemberA
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UpdateFolderMemberAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1791,7 +2787,28 @@ type UpdateFolderPolicyAPIError struct {
 	EndpointError *UpdateFolderPolicyError `json:"error"`
 }
 
-func (dbx *apiImpl) UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedFolderMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *UpdateFolderPolicyAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e UpdateFolderPolicyAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) UpdateFolderPolicy(arg *UpdateFolderPolicyArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	return dbx.UpdateFolderPolicyContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) UpdateFolderPolicyContext(ctx context.Context, arg *UpdateFolderPolicyArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "sharing",
@@ -1800,11 +2817,12 @@ func (dbx *apiImpl) UpdateFolderPolicy(arg *UpdateFolderPolicyArg) (res *SharedF
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr UpdateFolderPolicyAPIError
 		err = auth.ParseError(err, &appErr)
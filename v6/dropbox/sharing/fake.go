@@ -0,0 +1,711 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sharing
+
+import (
+	"context"
+	"io"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	AddFileMemberFunc                func(ctx context.Context, arg *AddFileMemberArgs, opts ...dropbox.CallOption) (res []*FileMemberActionResult, err error)
+	AddFolderMemberFunc              func(ctx context.Context, arg *AddFolderMemberArg, opts ...dropbox.CallOption) (err error)
+	CheckJobStatusFunc               func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *JobStatus, err error)
+	CheckRemoveMemberJobStatusFunc   func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RemoveMemberJobStatus, err error)
+	CheckShareJobStatusFunc          func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error)
+	CreateSharedLinkFunc             func(ctx context.Context, arg *CreateSharedLinkArg, opts ...dropbox.CallOption) (res *PathLinkMetadata, err error)
+	CreateSharedLinkWithSettingsFunc func(ctx context.Context, arg *CreateSharedLinkWithSettingsArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
+	GetFileMetadataFunc              func(ctx context.Context, arg *GetFileMetadataArg, opts ...dropbox.CallOption) (res *SharedFileMetadata, err error)
+	GetFileMetadataBatchFunc         func(ctx context.Context, arg *GetFileMetadataBatchArg, opts ...dropbox.CallOption) (res []*GetFileMetadataBatchResult, err error)
+	GetFolderMetadataFunc            func(ctx context.Context, arg *GetMetadataArgs, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
+	GetSharedLinkFileFunc            func(ctx context.Context, arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, content io.ReadCloser, err error)
+	GetSharedLinkMetadataFunc        func(ctx context.Context, arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
+	GetSharedLinksFunc               func(ctx context.Context, arg *GetSharedLinksArg, opts ...dropbox.CallOption) (res *GetSharedLinksResult, err error)
+	ListFileMembersFunc              func(ctx context.Context, arg *ListFileMembersArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error)
+	ListFileMembersBatchFunc         func(ctx context.Context, arg *ListFileMembersBatchArg, opts ...dropbox.CallOption) (res []*ListFileMembersBatchResult, err error)
+	ListFileMembersContinueFunc      func(ctx context.Context, arg *ListFileMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error)
+	ListFolderMembersFunc            func(ctx context.Context, arg *ListFolderMembersArgs, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error)
+	ListFolderMembersContinueFunc    func(ctx context.Context, arg *ListFolderMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error)
+	ListFoldersFunc                  func(ctx context.Context, arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
+	ListFoldersContinueFunc          func(ctx context.Context, arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
+	ListMountableFoldersFunc         func(ctx context.Context, arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
+	ListMountableFoldersContinueFunc func(ctx context.Context, arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error)
+	ListReceivedFilesFunc            func(ctx context.Context, arg *ListFilesArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error)
+	ListReceivedFilesContinueFunc    func(ctx context.Context, arg *ListFilesContinueArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error)
+	ListSharedLinksFunc              func(ctx context.Context, arg *ListSharedLinksArg, opts ...dropbox.CallOption) (res *ListSharedLinksResult, err error)
+	ModifySharedLinkSettingsFunc     func(ctx context.Context, arg *ModifySharedLinkSettingsArgs, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error)
+	MountFolderFunc                  func(ctx context.Context, arg *MountFolderArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
+	RelinquishFileMembershipFunc     func(ctx context.Context, arg *RelinquishFileMembershipArg, opts ...dropbox.CallOption) (err error)
+	RelinquishFolderMembershipFunc   func(ctx context.Context, arg *RelinquishFolderMembershipArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	RemoveFileMemberFunc             func(ctx context.Context, arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberActionIndividualResult, err error)
+	RemoveFileMember2Func            func(ctx context.Context, arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberRemoveActionResult, err error)
+	RemoveFolderMemberFunc           func(ctx context.Context, arg *RemoveFolderMemberArg, opts ...dropbox.CallOption) (res *async.LaunchResultBase, err error)
+	RevokeSharedLinkFunc             func(ctx context.Context, arg *RevokeSharedLinkArg, opts ...dropbox.CallOption) (err error)
+	SetAccessInheritanceFunc         func(ctx context.Context, arg *SetAccessInheritanceArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error)
+	ShareFolderFunc                  func(ctx context.Context, arg *ShareFolderArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error)
+	TransferFolderFunc               func(ctx context.Context, arg *TransferFolderArg, opts ...dropbox.CallOption) (err error)
+	UnmountFolderFunc                func(ctx context.Context, arg *UnmountFolderArg, opts ...dropbox.CallOption) (err error)
+	UnshareFileFunc                  func(ctx context.Context, arg *UnshareFileArg, opts ...dropbox.CallOption) (err error)
+	UnshareFolderFunc                func(ctx context.Context, arg *UnshareFolderArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	UpdateFileMemberFunc             func(ctx context.Context, arg *UpdateFileMemberArgs, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error)
+	UpdateFolderMemberFunc           func(ctx context.Context, arg *UpdateFolderMemberArg, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error)
+	UpdateFolderPolicyFunc           func(ctx context.Context, arg *UpdateFolderPolicyArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// AddFileMember implements Client.
+func (f *Fake) AddFileMember(arg *AddFileMemberArgs, opts ...dropbox.CallOption) (res []*FileMemberActionResult, err error) {
+	return f.AddFileMemberContext(context.Background(), arg, opts...)
+}
+
+// AddFileMemberContext implements Client.
+func (f *Fake) AddFileMemberContext(ctx context.Context, arg *AddFileMemberArgs, opts ...dropbox.CallOption) (res []*FileMemberActionResult, err error) {
+	f.Record("AddFileMember", opts, arg)
+	if f.AddFileMemberFunc == nil {
+		err = dropbox.NotImplemented("sharing", "AddFileMember")
+		return
+	}
+	return f.AddFileMemberFunc(ctx, arg, opts...)
+}
+
+// AddFolderMember implements Client.
+func (f *Fake) AddFolderMember(arg *AddFolderMemberArg, opts ...dropbox.CallOption) (err error) {
+	return f.AddFolderMemberContext(context.Background(), arg, opts...)
+}
+
+// AddFolderMemberContext implements Client.
+func (f *Fake) AddFolderMemberContext(ctx context.Context, arg *AddFolderMemberArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("AddFolderMember", opts, arg)
+	if f.AddFolderMemberFunc == nil {
+		err = dropbox.NotImplemented("sharing", "AddFolderMember")
+		return
+	}
+	return f.AddFolderMemberFunc(ctx, arg, opts...)
+}
+
+// CheckJobStatus implements Client.
+func (f *Fake) CheckJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *JobStatus, err error) {
+	return f.CheckJobStatusContext(context.Background(), arg, opts...)
+}
+
+// CheckJobStatusContext implements Client.
+func (f *Fake) CheckJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *JobStatus, err error) {
+	f.Record("CheckJobStatus", opts, arg)
+	if f.CheckJobStatusFunc == nil {
+		err = dropbox.NotImplemented("sharing", "CheckJobStatus")
+		return
+	}
+	return f.CheckJobStatusFunc(ctx, arg, opts...)
+}
+
+// CheckRemoveMemberJobStatus implements Client.
+func (f *Fake) CheckRemoveMemberJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *RemoveMemberJobStatus, err error) {
+	return f.CheckRemoveMemberJobStatusContext(context.Background(), arg, opts...)
+}
+
+// CheckRemoveMemberJobStatusContext implements Client.
+func (f *Fake) CheckRemoveMemberJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *RemoveMemberJobStatus, err error) {
+	f.Record("CheckRemoveMemberJobStatus", opts, arg)
+	if f.CheckRemoveMemberJobStatusFunc == nil {
+		err = dropbox.NotImplemented("sharing", "CheckRemoveMemberJobStatus")
+		return
+	}
+	return f.CheckRemoveMemberJobStatusFunc(ctx, arg, opts...)
+}
+
+// CheckShareJobStatus implements Client.
+func (f *Fake) CheckShareJobStatus(arg *async.PollArg, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error) {
+	return f.CheckShareJobStatusContext(context.Background(), arg, opts...)
+}
+
+// CheckShareJobStatusContext implements Client.
+func (f *Fake) CheckShareJobStatusContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error) {
+	f.Record("CheckShareJobStatus", opts, arg)
+	if f.CheckShareJobStatusFunc == nil {
+		err = dropbox.NotImplemented("sharing", "CheckShareJobStatus")
+		return
+	}
+	return f.CheckShareJobStatusFunc(ctx, arg, opts...)
+}
+
+// CreateSharedLink implements Client.
+func (f *Fake) CreateSharedLink(arg *CreateSharedLinkArg, opts ...dropbox.CallOption) (res *PathLinkMetadata, err error) {
+	return f.CreateSharedLinkContext(context.Background(), arg, opts...)
+}
+
+// CreateSharedLinkContext implements Client.
+func (f *Fake) CreateSharedLinkContext(ctx context.Context, arg *CreateSharedLinkArg, opts ...dropbox.CallOption) (res *PathLinkMetadata, err error) {
+	f.Record("CreateSharedLink", opts, arg)
+	if f.CreateSharedLinkFunc == nil {
+		err = dropbox.NotImplemented("sharing", "CreateSharedLink")
+		return
+	}
+	return f.CreateSharedLinkFunc(ctx, arg, opts...)
+}
+
+// CreateSharedLinkWithSettings implements Client.
+func (f *Fake) CreateSharedLinkWithSettings(arg *CreateSharedLinkWithSettingsArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	return f.CreateSharedLinkWithSettingsContext(context.Background(), arg, opts...)
+}
+
+// CreateSharedLinkWithSettingsContext implements Client.
+func (f *Fake) CreateSharedLinkWithSettingsContext(ctx context.Context, arg *CreateSharedLinkWithSettingsArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	f.Record("CreateSharedLinkWithSettings", opts, arg)
+	if f.CreateSharedLinkWithSettingsFunc == nil {
+		err = dropbox.NotImplemented("sharing", "CreateSharedLinkWithSettings")
+		return
+	}
+	return f.CreateSharedLinkWithSettingsFunc(ctx, arg, opts...)
+}
+
+// GetFileMetadata implements Client.
+func (f *Fake) GetFileMetadata(arg *GetFileMetadataArg, opts ...dropbox.CallOption) (res *SharedFileMetadata, err error) {
+	return f.GetFileMetadataContext(context.Background(), arg, opts...)
+}
+
+// GetFileMetadataContext implements Client.
+func (f *Fake) GetFileMetadataContext(ctx context.Context, arg *GetFileMetadataArg, opts ...dropbox.CallOption) (res *SharedFileMetadata, err error) {
+	f.Record("GetFileMetadata", opts, arg)
+	if f.GetFileMetadataFunc == nil {
+		err = dropbox.NotImplemented("sharing", "GetFileMetadata")
+		return
+	}
+	return f.GetFileMetadataFunc(ctx, arg, opts...)
+}
+
+// GetFileMetadataBatch implements Client.
+func (f *Fake) GetFileMetadataBatch(arg *GetFileMetadataBatchArg, opts ...dropbox.CallOption) (res []*GetFileMetadataBatchResult, err error) {
+	return f.GetFileMetadataBatchContext(context.Background(), arg, opts...)
+}
+
+// GetFileMetadataBatchContext implements Client.
+func (f *Fake) GetFileMetadataBatchContext(ctx context.Context, arg *GetFileMetadataBatchArg, opts ...dropbox.CallOption) (res []*GetFileMetadataBatchResult, err error) {
+	f.Record("GetFileMetadataBatch", opts, arg)
+	if f.GetFileMetadataBatchFunc == nil {
+		err = dropbox.NotImplemented("sharing", "GetFileMetadataBatch")
+		return
+	}
+	return f.GetFileMetadataBatchFunc(ctx, arg, opts...)
+}
+
+// GetFolderMetadata implements Client.
+func (f *Fake) GetFolderMetadata(arg *GetMetadataArgs, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	return f.GetFolderMetadataContext(context.Background(), arg, opts...)
+}
+
+// GetFolderMetadataContext implements Client.
+func (f *Fake) GetFolderMetadataContext(ctx context.Context, arg *GetMetadataArgs, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	f.Record("GetFolderMetadata", opts, arg)
+	if f.GetFolderMetadataFunc == nil {
+		err = dropbox.NotImplemented("sharing", "GetFolderMetadata")
+		return
+	}
+	return f.GetFolderMetadataFunc(ctx, arg, opts...)
+}
+
+// GetSharedLinkFile implements Client.
+func (f *Fake) GetSharedLinkFile(arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	return f.GetSharedLinkFileContext(context.Background(), arg, opts...)
+}
+
+// GetSharedLinkFileContext implements Client.
+func (f *Fake) GetSharedLinkFileContext(ctx context.Context, arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, content io.ReadCloser, err error) {
+	f.Record("GetSharedLinkFile", opts, arg)
+	if f.GetSharedLinkFileFunc == nil {
+		err = dropbox.NotImplemented("sharing", "GetSharedLinkFile")
+		return
+	}
+	return f.GetSharedLinkFileFunc(ctx, arg, opts...)
+}
+
+// GetSharedLinkMetadata implements Client.
+func (f *Fake) GetSharedLinkMetadata(arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	return f.GetSharedLinkMetadataContext(context.Background(), arg, opts...)
+}
+
+// GetSharedLinkMetadataContext implements Client.
+func (f *Fake) GetSharedLinkMetadataContext(ctx context.Context, arg *GetSharedLinkMetadataArg, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	f.Record("GetSharedLinkMetadata", opts, arg)
+	if f.GetSharedLinkMetadataFunc == nil {
+		err = dropbox.NotImplemented("sharing", "GetSharedLinkMetadata")
+		return
+	}
+	return f.GetSharedLinkMetadataFunc(ctx, arg, opts...)
+}
+
+// GetSharedLinks implements Client.
+func (f *Fake) GetSharedLinks(arg *GetSharedLinksArg, opts ...dropbox.CallOption) (res *GetSharedLinksResult, err error) {
+	return f.GetSharedLinksContext(context.Background(), arg, opts...)
+}
+
+// GetSharedLinksContext implements Client.
+func (f *Fake) GetSharedLinksContext(ctx context.Context, arg *GetSharedLinksArg, opts ...dropbox.CallOption) (res *GetSharedLinksResult, err error) {
+	f.Record("GetSharedLinks", opts, arg)
+	if f.GetSharedLinksFunc == nil {
+		err = dropbox.NotImplemented("sharing", "GetSharedLinks")
+		return
+	}
+	return f.GetSharedLinksFunc(ctx, arg, opts...)
+}
+
+// ListFileMembers implements Client.
+func (f *Fake) ListFileMembers(arg *ListFileMembersArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error) {
+	return f.ListFileMembersContext(context.Background(), arg, opts...)
+}
+
+// ListFileMembersContext implements Client.
+func (f *Fake) ListFileMembersContext(ctx context.Context, arg *ListFileMembersArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error) {
+	f.Record("ListFileMembers", opts, arg)
+	if f.ListFileMembersFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListFileMembers")
+		return
+	}
+	return f.ListFileMembersFunc(ctx, arg, opts...)
+}
+
+// ListFileMembersBatch implements Client.
+func (f *Fake) ListFileMembersBatch(arg *ListFileMembersBatchArg, opts ...dropbox.CallOption) (res []*ListFileMembersBatchResult, err error) {
+	return f.ListFileMembersBatchContext(context.Background(), arg, opts...)
+}
+
+// ListFileMembersBatchContext implements Client.
+func (f *Fake) ListFileMembersBatchContext(ctx context.Context, arg *ListFileMembersBatchArg, opts ...dropbox.CallOption) (res []*ListFileMembersBatchResult, err error) {
+	f.Record("ListFileMembersBatch", opts, arg)
+	if f.ListFileMembersBatchFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListFileMembersBatch")
+		return
+	}
+	return f.ListFileMembersBatchFunc(ctx, arg, opts...)
+}
+
+// ListFileMembersContinue implements Client.
+func (f *Fake) ListFileMembersContinue(arg *ListFileMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error) {
+	return f.ListFileMembersContinueContext(context.Background(), arg, opts...)
+}
+
+// ListFileMembersContinueContext implements Client.
+func (f *Fake) ListFileMembersContinueContext(ctx context.Context, arg *ListFileMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFileMembers, err error) {
+	f.Record("ListFileMembersContinue", opts, arg)
+	if f.ListFileMembersContinueFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListFileMembersContinue")
+		return
+	}
+	return f.ListFileMembersContinueFunc(ctx, arg, opts...)
+}
+
+// ListFolderMembers implements Client.
+func (f *Fake) ListFolderMembers(arg *ListFolderMembersArgs, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error) {
+	return f.ListFolderMembersContext(context.Background(), arg, opts...)
+}
+
+// ListFolderMembersContext implements Client.
+func (f *Fake) ListFolderMembersContext(ctx context.Context, arg *ListFolderMembersArgs, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error) {
+	f.Record("ListFolderMembers", opts, arg)
+	if f.ListFolderMembersFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListFolderMembers")
+		return
+	}
+	return f.ListFolderMembersFunc(ctx, arg, opts...)
+}
+
+// ListFolderMembersContinue implements Client.
+func (f *Fake) ListFolderMembersContinue(arg *ListFolderMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error) {
+	return f.ListFolderMembersContinueContext(context.Background(), arg, opts...)
+}
+
+// ListFolderMembersContinueContext implements Client.
+func (f *Fake) ListFolderMembersContinueContext(ctx context.Context, arg *ListFolderMembersContinueArg, opts ...dropbox.CallOption) (res *SharedFolderMembers, err error) {
+	f.Record("ListFolderMembersContinue", opts, arg)
+	if f.ListFolderMembersContinueFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListFolderMembersContinue")
+		return
+	}
+	return f.ListFolderMembersContinueFunc(ctx, arg, opts...)
+}
+
+// ListFolders implements Client.
+func (f *Fake) ListFolders(arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	return f.ListFoldersContext(context.Background(), arg, opts...)
+}
+
+// ListFoldersContext implements Client.
+func (f *Fake) ListFoldersContext(ctx context.Context, arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	f.Record("ListFolders", opts, arg)
+	if f.ListFoldersFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListFolders")
+		return
+	}
+	return f.ListFoldersFunc(ctx, arg, opts...)
+}
+
+// ListFoldersContinue implements Client.
+func (f *Fake) ListFoldersContinue(arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	return f.ListFoldersContinueContext(context.Background(), arg, opts...)
+}
+
+// ListFoldersContinueContext implements Client.
+func (f *Fake) ListFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	f.Record("ListFoldersContinue", opts, arg)
+	if f.ListFoldersContinueFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListFoldersContinue")
+		return
+	}
+	return f.ListFoldersContinueFunc(ctx, arg, opts...)
+}
+
+// ListMountableFolders implements Client.
+func (f *Fake) ListMountableFolders(arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	return f.ListMountableFoldersContext(context.Background(), arg, opts...)
+}
+
+// ListMountableFoldersContext implements Client.
+func (f *Fake) ListMountableFoldersContext(ctx context.Context, arg *ListFoldersArgs, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	f.Record("ListMountableFolders", opts, arg)
+	if f.ListMountableFoldersFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListMountableFolders")
+		return
+	}
+	return f.ListMountableFoldersFunc(ctx, arg, opts...)
+}
+
+// ListMountableFoldersContinue implements Client.
+func (f *Fake) ListMountableFoldersContinue(arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	return f.ListMountableFoldersContinueContext(context.Background(), arg, opts...)
+}
+
+// ListMountableFoldersContinueContext implements Client.
+func (f *Fake) ListMountableFoldersContinueContext(ctx context.Context, arg *ListFoldersContinueArg, opts ...dropbox.CallOption) (res *ListFoldersResult, err error) {
+	f.Record("ListMountableFoldersContinue", opts, arg)
+	if f.ListMountableFoldersContinueFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListMountableFoldersContinue")
+		return
+	}
+	return f.ListMountableFoldersContinueFunc(ctx, arg, opts...)
+}
+
+// ListReceivedFiles implements Client.
+func (f *Fake) ListReceivedFiles(arg *ListFilesArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error) {
+	return f.ListReceivedFilesContext(context.Background(), arg, opts...)
+}
+
+// ListReceivedFilesContext implements Client.
+func (f *Fake) ListReceivedFilesContext(ctx context.Context, arg *ListFilesArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error) {
+	f.Record("ListReceivedFiles", opts, arg)
+	if f.ListReceivedFilesFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListReceivedFiles")
+		return
+	}
+	return f.ListReceivedFilesFunc(ctx, arg, opts...)
+}
+
+// ListReceivedFilesContinue implements Client.
+func (f *Fake) ListReceivedFilesContinue(arg *ListFilesContinueArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error) {
+	return f.ListReceivedFilesContinueContext(context.Background(), arg, opts...)
+}
+
+// ListReceivedFilesContinueContext implements Client.
+func (f *Fake) ListReceivedFilesContinueContext(ctx context.Context, arg *ListFilesContinueArg, opts ...dropbox.CallOption) (res *ListFilesResult, err error) {
+	f.Record("ListReceivedFilesContinue", opts, arg)
+	if f.ListReceivedFilesContinueFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListReceivedFilesContinue")
+		return
+	}
+	return f.ListReceivedFilesContinueFunc(ctx, arg, opts...)
+}
+
+// ListSharedLinks implements Client.
+func (f *Fake) ListSharedLinks(arg *ListSharedLinksArg, opts ...dropbox.CallOption) (res *ListSharedLinksResult, err error) {
+	return f.ListSharedLinksContext(context.Background(), arg, opts...)
+}
+
+// ListSharedLinksContext implements Client.
+func (f *Fake) ListSharedLinksContext(ctx context.Context, arg *ListSharedLinksArg, opts ...dropbox.CallOption) (res *ListSharedLinksResult, err error) {
+	f.Record("ListSharedLinks", opts, arg)
+	if f.ListSharedLinksFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ListSharedLinks")
+		return
+	}
+	return f.ListSharedLinksFunc(ctx, arg, opts...)
+}
+
+// ModifySharedLinkSettings implements Client.
+func (f *Fake) ModifySharedLinkSettings(arg *ModifySharedLinkSettingsArgs, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	return f.ModifySharedLinkSettingsContext(context.Background(), arg, opts...)
+}
+
+// ModifySharedLinkSettingsContext implements Client.
+func (f *Fake) ModifySharedLinkSettingsContext(ctx context.Context, arg *ModifySharedLinkSettingsArgs, opts ...dropbox.CallOption) (res IsSharedLinkMetadata, err error) {
+	f.Record("ModifySharedLinkSettings", opts, arg)
+	if f.ModifySharedLinkSettingsFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ModifySharedLinkSettings")
+		return
+	}
+	return f.ModifySharedLinkSettingsFunc(ctx, arg, opts...)
+}
+
+// MountFolder implements Client.
+func (f *Fake) MountFolder(arg *MountFolderArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	return f.MountFolderContext(context.Background(), arg, opts...)
+}
+
+// MountFolderContext implements Client.
+func (f *Fake) MountFolderContext(ctx context.Context, arg *MountFolderArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	f.Record("MountFolder", opts, arg)
+	if f.MountFolderFunc == nil {
+		err = dropbox.NotImplemented("sharing", "MountFolder")
+		return
+	}
+	return f.MountFolderFunc(ctx, arg, opts...)
+}
+
+// RelinquishFileMembership implements Client.
+func (f *Fake) RelinquishFileMembership(arg *RelinquishFileMembershipArg, opts ...dropbox.CallOption) (err error) {
+	return f.RelinquishFileMembershipContext(context.Background(), arg, opts...)
+}
+
+// RelinquishFileMembershipContext implements Client.
+func (f *Fake) RelinquishFileMembershipContext(ctx context.Context, arg *RelinquishFileMembershipArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("RelinquishFileMembership", opts, arg)
+	if f.RelinquishFileMembershipFunc == nil {
+		err = dropbox.NotImplemented("sharing", "RelinquishFileMembership")
+		return
+	}
+	return f.RelinquishFileMembershipFunc(ctx, arg, opts...)
+}
+
+// RelinquishFolderMembership implements Client.
+func (f *Fake) RelinquishFolderMembership(arg *RelinquishFolderMembershipArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return f.RelinquishFolderMembershipContext(context.Background(), arg, opts...)
+}
+
+// RelinquishFolderMembershipContext implements Client.
+func (f *Fake) RelinquishFolderMembershipContext(ctx context.Context, arg *RelinquishFolderMembershipArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	f.Record("RelinquishFolderMembership", opts, arg)
+	if f.RelinquishFolderMembershipFunc == nil {
+		err = dropbox.NotImplemented("sharing", "RelinquishFolderMembership")
+		return
+	}
+	return f.RelinquishFolderMembershipFunc(ctx, arg, opts...)
+}
+
+// RemoveFileMember implements Client.
+func (f *Fake) RemoveFileMember(arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberActionIndividualResult, err error) {
+	return f.RemoveFileMemberContext(context.Background(), arg, opts...)
+}
+
+// RemoveFileMemberContext implements Client.
+func (f *Fake) RemoveFileMemberContext(ctx context.Context, arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberActionIndividualResult, err error) {
+	f.Record("RemoveFileMember", opts, arg)
+	if f.RemoveFileMemberFunc == nil {
+		err = dropbox.NotImplemented("sharing", "RemoveFileMember")
+		return
+	}
+	return f.RemoveFileMemberFunc(ctx, arg, opts...)
+}
+
+// RemoveFileMember2 implements Client.
+func (f *Fake) RemoveFileMember2(arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberRemoveActionResult, err error) {
+	return f.RemoveFileMember2Context(context.Background(), arg, opts...)
+}
+
+// RemoveFileMember2Context implements Client.
+func (f *Fake) RemoveFileMember2Context(ctx context.Context, arg *RemoveFileMemberArg, opts ...dropbox.CallOption) (res *FileMemberRemoveActionResult, err error) {
+	f.Record("RemoveFileMember2", opts, arg)
+	if f.RemoveFileMember2Func == nil {
+		err = dropbox.NotImplemented("sharing", "RemoveFileMember2")
+		return
+	}
+	return f.RemoveFileMember2Func(ctx, arg, opts...)
+}
+
+// RemoveFolderMember implements Client.
+func (f *Fake) RemoveFolderMember(arg *RemoveFolderMemberArg, opts ...dropbox.CallOption) (res *async.LaunchResultBase, err error) {
+	return f.RemoveFolderMemberContext(context.Background(), arg, opts...)
+}
+
+// RemoveFolderMemberContext implements Client.
+func (f *Fake) RemoveFolderMemberContext(ctx context.Context, arg *RemoveFolderMemberArg, opts ...dropbox.CallOption) (res *async.LaunchResultBase, err error) {
+	f.Record("RemoveFolderMember", opts, arg)
+	if f.RemoveFolderMemberFunc == nil {
+		err = dropbox.NotImplemented("sharing", "RemoveFolderMember")
+		return
+	}
+	return f.RemoveFolderMemberFunc(ctx, arg, opts...)
+}
+
+// RevokeSharedLink implements Client.
+func (f *Fake) RevokeSharedLink(arg *RevokeSharedLinkArg, opts ...dropbox.CallOption) (err error) {
+	return f.RevokeSharedLinkContext(context.Background(), arg, opts...)
+}
+
+// RevokeSharedLinkContext implements Client.
+func (f *Fake) RevokeSharedLinkContext(ctx context.Context, arg *RevokeSharedLinkArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("RevokeSharedLink", opts, arg)
+	if f.RevokeSharedLinkFunc == nil {
+		err = dropbox.NotImplemented("sharing", "RevokeSharedLink")
+		return
+	}
+	return f.RevokeSharedLinkFunc(ctx, arg, opts...)
+}
+
+// SetAccessInheritance implements Client.
+func (f *Fake) SetAccessInheritance(arg *SetAccessInheritanceArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error) {
+	return f.SetAccessInheritanceContext(context.Background(), arg, opts...)
+}
+
+// SetAccessInheritanceContext implements Client.
+func (f *Fake) SetAccessInheritanceContext(ctx context.Context, arg *SetAccessInheritanceArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error) {
+	f.Record("SetAccessInheritance", opts, arg)
+	if f.SetAccessInheritanceFunc == nil {
+		err = dropbox.NotImplemented("sharing", "SetAccessInheritance")
+		return
+	}
+	return f.SetAccessInheritanceFunc(ctx, arg, opts...)
+}
+
+// ShareFolder implements Client.
+func (f *Fake) ShareFolder(arg *ShareFolderArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error) {
+	return f.ShareFolderContext(context.Background(), arg, opts...)
+}
+
+// ShareFolderContext implements Client.
+func (f *Fake) ShareFolderContext(ctx context.Context, arg *ShareFolderArg, opts ...dropbox.CallOption) (res *ShareFolderLaunch, err error) {
+	f.Record("ShareFolder", opts, arg)
+	if f.ShareFolderFunc == nil {
+		err = dropbox.NotImplemented("sharing", "ShareFolder")
+		return
+	}
+	return f.ShareFolderFunc(ctx, arg, opts...)
+}
+
+// TransferFolder implements Client.
+func (f *Fake) TransferFolder(arg *TransferFolderArg, opts ...dropbox.CallOption) (err error) {
+	return f.TransferFolderContext(context.Background(), arg, opts...)
+}
+
+// TransferFolderContext implements Client.
+func (f *Fake) TransferFolderContext(ctx context.Context, arg *TransferFolderArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("TransferFolder", opts, arg)
+	if f.TransferFolderFunc == nil {
+		err = dropbox.NotImplemented("sharing", "TransferFolder")
+		return
+	}
+	return f.TransferFolderFunc(ctx, arg, opts...)
+}
+
+// UnmountFolder implements Client.
+func (f *Fake) UnmountFolder(arg *UnmountFolderArg, opts ...dropbox.CallOption) (err error) {
+	return f.UnmountFolderContext(context.Background(), arg, opts...)
+}
+
+// UnmountFolderContext implements Client.
+func (f *Fake) UnmountFolderContext(ctx context.Context, arg *UnmountFolderArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("UnmountFolder", opts, arg)
+	if f.UnmountFolderFunc == nil {
+		err = dropbox.NotImplemented("sharing", "UnmountFolder")
+		return
+	}
+	return f.UnmountFolderFunc(ctx, arg, opts...)
+}
+
+// UnshareFile implements Client.
+func (f *Fake) UnshareFile(arg *UnshareFileArg, opts ...dropbox.CallOption) (err error) {
+	return f.UnshareFileContext(context.Background(), arg, opts...)
+}
+
+// UnshareFileContext implements Client.
+func (f *Fake) UnshareFileContext(ctx context.Context, arg *UnshareFileArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("UnshareFile", opts, arg)
+	if f.UnshareFileFunc == nil {
+		err = dropbox.NotImplemented("sharing", "UnshareFile")
+		return
+	}
+	return f.UnshareFileFunc(ctx, arg, opts...)
+}
+
+// UnshareFolder implements Client.
+func (f *Fake) UnshareFolder(arg *UnshareFolderArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return f.UnshareFolderContext(context.Background(), arg, opts...)
+}
+
+// UnshareFolderContext implements Client.
+func (f *Fake) UnshareFolderContext(ctx context.Context, arg *UnshareFolderArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	f.Record("UnshareFolder", opts, arg)
+	if f.UnshareFolderFunc == nil {
+		err = dropbox.NotImplemented("sharing", "UnshareFolder")
+		return
+	}
+	return f.UnshareFolderFunc(ctx, arg, opts...)
+}
+
+// UpdateFileMember implements Client.
+func (f *Fake) UpdateFileMember(arg *UpdateFileMemberArgs, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error) {
+	return f.UpdateFileMemberContext(context.Background(), arg, opts...)
+}
+
+// UpdateFileMemberContext implements Client.
+func (f *Fake) UpdateFileMemberContext(ctx context.Context, arg *UpdateFileMemberArgs, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error) {
+	f.Record("UpdateFileMember", opts, arg)
+	if f.UpdateFileMemberFunc == nil {
+		err = dropbox.NotImplemented("sharing", "UpdateFileMember")
+		return
+	}
+	return f.UpdateFileMemberFunc(ctx, arg, opts...)
+}
+
+// UpdateFolderMember implements Client.
+func (f *Fake) UpdateFolderMember(arg *UpdateFolderMemberArg, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error) {
+	return f.UpdateFolderMemberContext(context.Background(), arg, opts...)
+}
+
+// UpdateFolderMemberContext implements Client.
+func (f *Fake) UpdateFolderMemberContext(ctx context.Context, arg *UpdateFolderMemberArg, opts ...dropbox.CallOption) (res *MemberAccessLevelResult, err error) {
+	f.Record("UpdateFolderMember", opts, arg)
+	if f.UpdateFolderMemberFunc == nil {
+		err = dropbox.NotImplemented("sharing", "UpdateFolderMember")
+		return
+	}
+	return f.UpdateFolderMemberFunc(ctx, arg, opts...)
+}
+
+// UpdateFolderPolicy implements Client.
+func (f *Fake) UpdateFolderPolicy(arg *UpdateFolderPolicyArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	return f.UpdateFolderPolicyContext(context.Background(), arg, opts...)
+}
+
+// UpdateFolderPolicyContext implements Client.
+func (f *Fake) UpdateFolderPolicyContext(ctx context.Context, arg *UpdateFolderPolicyArg, opts ...dropbox.CallOption) (res *SharedFolderMetadata, err error) {
+	f.Record("UpdateFolderPolicy", opts, arg)
+	if f.UpdateFolderPolicyFunc == nil {
+		err = dropbox.NotImplemented("sharing", "UpdateFolderPolicy")
+		return
+	}
+	return f.UpdateFolderPolicyFunc(ctx, arg, opts...)
+}
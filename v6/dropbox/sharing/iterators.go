@@ -0,0 +1,372 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sharing
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// ListFoldersIterator iterates over the entries returned by `ListFolders`,
+// fetching further pages with `ListFoldersContinue`.
+type ListFoldersIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListFoldersArgs
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*SharedFolderMetadata
+	index      int
+	entry      *SharedFolderMetadata
+	err        error
+}
+
+// NewListFoldersIterator returns an iterator over the entries of `ListFolders`
+// called with arg. Every page is fetched with opts.
+func NewListFoldersIterator(ctx context.Context, dbx Client, arg *ListFoldersArgs, opts ...dropbox.CallOption) *ListFoldersIterator {
+	return &ListFoldersIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeListFoldersIterator returns an iterator continuing at cursor, as
+// returned by `ListFoldersIterator.Cursor`.
+func ResumeListFoldersIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *ListFoldersIterator {
+	return &ListFoldersIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *ListFoldersIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *ListFoldersIterator) Entry() *SharedFolderMetadata {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ListFoldersIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *ListFoldersIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *ListFoldersIterator) fetch() {
+	var res *ListFoldersResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.ListFoldersContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.ListFoldersContinueContext(it.ctx, NewListFoldersContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = it.cursor != ""
+	it.entries = res.Entries
+	it.index = 0
+}
+
+// ListMountableFoldersIterator iterates over the entries returned by
+// `ListMountableFolders`, fetching further pages with
+// `ListMountableFoldersContinue`.
+type ListMountableFoldersIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListFoldersArgs
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*SharedFolderMetadata
+	index      int
+	entry      *SharedFolderMetadata
+	err        error
+}
+
+// NewListMountableFoldersIterator returns an iterator over the entries of
+// `ListMountableFolders` called with arg. Every page is fetched with opts.
+func NewListMountableFoldersIterator(ctx context.Context, dbx Client, arg *ListFoldersArgs, opts ...dropbox.CallOption) *ListMountableFoldersIterator {
+	return &ListMountableFoldersIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeListMountableFoldersIterator returns an iterator continuing at cursor,
+// as returned by `ListMountableFoldersIterator.Cursor`.
+func ResumeListMountableFoldersIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *ListMountableFoldersIterator {
+	return &ListMountableFoldersIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *ListMountableFoldersIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *ListMountableFoldersIterator) Entry() *SharedFolderMetadata {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ListMountableFoldersIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *ListMountableFoldersIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *ListMountableFoldersIterator) fetch() {
+	var res *ListFoldersResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.ListMountableFoldersContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.ListMountableFoldersContinueContext(it.ctx, NewListFoldersContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = it.cursor != ""
+	it.entries = res.Entries
+	it.index = 0
+}
+
+// ListReceivedFilesIterator iterates over the entries returned by
+// `ListReceivedFiles`, fetching further pages with `ListReceivedFilesContinue`.
+type ListReceivedFilesIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListFilesArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*SharedFileMetadata
+	index      int
+	entry      *SharedFileMetadata
+	err        error
+}
+
+// NewListReceivedFilesIterator returns an iterator over the entries of
+// `ListReceivedFiles` called with arg. Every page is fetched with opts.
+func NewListReceivedFilesIterator(ctx context.Context, dbx Client, arg *ListFilesArg, opts ...dropbox.CallOption) *ListReceivedFilesIterator {
+	return &ListReceivedFilesIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeListReceivedFilesIterator returns an iterator continuing at cursor, as
+// returned by `ListReceivedFilesIterator.Cursor`.
+func ResumeListReceivedFilesIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *ListReceivedFilesIterator {
+	return &ListReceivedFilesIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *ListReceivedFilesIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *ListReceivedFilesIterator) Entry() *SharedFileMetadata {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ListReceivedFilesIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *ListReceivedFilesIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *ListReceivedFilesIterator) fetch() {
+	var res *ListFilesResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.ListReceivedFilesContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.ListReceivedFilesContinueContext(it.ctx, NewListFilesContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = it.cursor != ""
+	it.entries = res.Entries
+	it.index = 0
+}
+
+// ListSharedLinksIterator iterates over the entries returned by
+// `ListSharedLinks`, fetching further pages by calling it again with the cursor
+// of the previous page.
+type ListSharedLinksIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListSharedLinksArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []IsSharedLinkMetadata
+	index      int
+	entry      IsSharedLinkMetadata
+	err        error
+}
+
+// NewListSharedLinksIterator returns an iterator over the entries of
+// `ListSharedLinks` called with arg. Every page is fetched with opts.
+func NewListSharedLinksIterator(ctx context.Context, dbx Client, arg *ListSharedLinksArg, opts ...dropbox.CallOption) *ListSharedLinksIterator {
+	a := *arg
+	return &ListSharedLinksIterator{ctx: ctx, dbx: dbx, opts: opts, arg: &a, cursor: a.Cursor, more: true}
+}
+
+// ResumeListSharedLinksIterator returns an iterator continuing at cursor, as
+// returned by `ListSharedLinksIterator.Cursor`.
+func ResumeListSharedLinksIterator(ctx context.Context, dbx Client, arg *ListSharedLinksArg, cursor string, opts ...dropbox.CallOption) *ListSharedLinksIterator {
+	it := NewListSharedLinksIterator(ctx, dbx, arg, opts...)
+	it.cursor = cursor
+	return it
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *ListSharedLinksIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *ListSharedLinksIterator) Entry() IsSharedLinkMetadata {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ListSharedLinksIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *ListSharedLinksIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *ListSharedLinksIterator) fetch() {
+	var res *ListSharedLinksResult
+	var err error
+	it.arg.Cursor = it.cursor
+	res, err = it.dbx.ListSharedLinksContext(it.ctx, it.arg, it.opts...)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Links
+	it.index = 0
+}
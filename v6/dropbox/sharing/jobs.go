@@ -0,0 +1,171 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sharing
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// RelinquishFolderMembershipAndWait calls `RelinquishFolderMembership`, then
+// polls `CheckJobStatus` with poller until the job is done. A nil poller polls
+// with the default intervals. It returns the final status of the job, and an
+// `async.JobFailedError` if the job did not complete. All calls are made with
+// opts.
+func RelinquishFolderMembershipAndWait(ctx context.Context, dbx Client, arg *RelinquishFolderMembershipArg, poller *async.Poller, opts ...dropbox.CallOption) (res *JobStatus, err error) {
+	launch, err := dbx.RelinquishFolderMembershipContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == async.LaunchEmptyResultAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.CheckJobStatusContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != JobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &JobStatus{Tagged: launch.Tagged}
+	}
+
+	if res.Tag != JobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// RemoveFolderMemberAndWait calls `RemoveFolderMember`, then polls
+// `CheckRemoveMemberJobStatus` with poller until the job is done. A nil poller
+// polls with the default intervals. It returns the final status of the job, and
+// an `async.JobFailedError` if the job did not complete. All calls are made
+// with opts.
+func RemoveFolderMemberAndWait(ctx context.Context, dbx Client, arg *RemoveFolderMemberArg, poller *async.Poller, opts ...dropbox.CallOption) (res *RemoveMemberJobStatus, err error) {
+	launch, err := dbx.RemoveFolderMemberContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == async.LaunchResultBaseAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.CheckRemoveMemberJobStatusContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != RemoveMemberJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &RemoveMemberJobStatus{Tagged: launch.Tagged}
+	}
+
+	if res.Tag != RemoveMemberJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// SetAccessInheritanceAndWait calls `SetAccessInheritance`, then polls
+// `CheckShareJobStatus` with poller until the job is done. A nil poller polls
+// with the default intervals. It returns the final status of the job, and an
+// `async.JobFailedError` if the job did not complete. All calls are made with
+// opts.
+func SetAccessInheritanceAndWait(ctx context.Context, dbx Client, arg *SetAccessInheritanceArg, poller *async.Poller, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error) {
+	launch, err := dbx.SetAccessInheritanceContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == ShareFolderLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.CheckShareJobStatusContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != ShareFolderJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &ShareFolderJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != ShareFolderJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// ShareFolderAndWait calls `ShareFolder`, then polls `CheckShareJobStatus` with
+// poller until the job is done. A nil poller polls with the default intervals.
+// It returns the final status of the job, and an `async.JobFailedError` if the
+// job did not complete. All calls are made with opts.
+func ShareFolderAndWait(ctx context.Context, dbx Client, arg *ShareFolderArg, poller *async.Poller, opts ...dropbox.CallOption) (res *ShareFolderJobStatus, err error) {
+	launch, err := dbx.ShareFolderContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == ShareFolderLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.CheckShareJobStatusContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != ShareFolderJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &ShareFolderJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != ShareFolderJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// UnshareFolderAndWait calls `UnshareFolder`, then polls `CheckJobStatus` with
+// poller until the job is done. A nil poller polls with the default intervals.
+// It returns the final status of the job, and an `async.JobFailedError` if the
+// job did not complete. All calls are made with opts.
+func UnshareFolderAndWait(ctx context.Context, dbx Client, arg *UnshareFolderArg, poller *async.Poller, opts ...dropbox.CallOption) (res *JobStatus, err error) {
+	launch, err := dbx.UnshareFolderContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == async.LaunchEmptyResultAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.CheckJobStatusContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != JobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &JobStatus{Tagged: launch.Tagged}
+	}
+
+	if res.Tag != JobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
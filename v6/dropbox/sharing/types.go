@@ -150,6 +150,23 @@ func (u *AddFileMemberError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AddFileMemberError instance
+func (u *AddFileMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AddFolderMemberArg : has no documentation (yet)
 type AddFolderMemberArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -245,6 +262,33 @@ func (u *AddFolderMemberError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AddFolderMemberError instance
+func (u *AddFolderMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "bad_member":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			BadMember *AddMemberSelectorError `json:"bad_member"`
+		}{u.Tagged, u.BadMember})
+	case "too_many_members":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TooManyMembers uint64 `json:"too_many_members"`
+		}{u.Tagged, u.TooManyMembers})
+	case "too_many_pending_invites":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TooManyPendingInvites uint64 `json:"too_many_pending_invites"`
+		}{u.Tagged, u.TooManyPendingInvites})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AddMember : The member and type of access the member should have when added
 // to a shared folder.
 type AddMember struct {
@@ -320,6 +364,28 @@ func (u *AddMemberSelectorError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AddMemberSelectorError instance
+func (u *AddMemberSelectorError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "invalid_dropbox_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidDropboxId string `json:"invalid_dropbox_id"`
+		}{u.Tagged, u.InvalidDropboxId})
+	case "invalid_email":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidEmail string `json:"invalid_email"`
+		}{u.Tagged, u.InvalidEmail})
+	case "unverified_dropbox_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UnverifiedDropboxId string `json:"unverified_dropbox_id"`
+		}{u.Tagged, u.UnverifiedDropboxId})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RequestedVisibility : The access permission that can be requested by the
 // caller for the shared link. Note that the final resolved visibility of the
 // shared link takes into account other aspects, such as team and shared folder
@@ -524,6 +590,15 @@ func NewCollectionLinkMetadata(Url string, Visibility *Visibility) *CollectionLi
 	return s
 }
 
+// MarshalJSON serializes a CollectionLinkMetadata instance, including its `.tag`
+func (u *CollectionLinkMetadata) MarshalJSON() ([]byte, error) {
+	type wrap CollectionLinkMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "collection"}, (*wrap)(u)})
+}
+
 // CreateSharedLinkArg : has no documentation (yet)
 type CreateSharedLinkArg struct {
 	// Path : The path to share.
@@ -578,6 +653,18 @@ func (u *CreateSharedLinkError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a CreateSharedLinkError instance
+func (u *CreateSharedLinkError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *files.LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CreateSharedLinkWithSettingsArg : has no documentation (yet)
 type CreateSharedLinkWithSettingsArg struct {
 	// Path : The path to be shared by the shared link.
@@ -648,6 +735,28 @@ func (u *CreateSharedLinkWithSettingsError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a CreateSharedLinkWithSettingsError instance
+func (u *CreateSharedLinkWithSettingsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *files.LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	case "shared_link_already_exists":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SharedLinkAlreadyExists *SharedLinkAlreadyExistsMetadata `json:"shared_link_already_exists"`
+		}{u.Tagged, u.SharedLinkAlreadyExists})
+	case "settings_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SettingsError *SharedLinkSettingsError `json:"settings_error"`
+		}{u.Tagged, u.SettingsError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SharedContentLinkMetadataBase : has no documentation (yet)
 type SharedContentLinkMetadataBase struct {
 	// AccessLevel : The access level on the link for this file.
@@ -776,6 +885,28 @@ func (u *FileErrorResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a FileErrorResult instance
+func (u *FileErrorResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "file_not_found_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FileNotFoundError string `json:"file_not_found_error"`
+		}{u.Tagged, u.FileNotFoundError})
+	case "invalid_file_action_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidFileActionError string `json:"invalid_file_action_error"`
+		}{u.Tagged, u.InvalidFileActionError})
+	case "permission_denied_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PermissionDeniedError string `json:"permission_denied_error"`
+		}{u.Tagged, u.PermissionDeniedError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SharedLinkMetadata : The metadata of a shared link.
 type SharedLinkMetadata struct {
 	// Url : URL of the shared link.
@@ -908,6 +1039,15 @@ func NewFileLinkMetadata(Url string, Name string, LinkPermissions *LinkPermissio
 	return s
 }
 
+// MarshalJSON serializes a FileLinkMetadata instance, including its `.tag`
+func (u *FileLinkMetadata) MarshalJSON() ([]byte, error) {
+	type wrap FileLinkMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "file"}, (*wrap)(u)})
+}
+
 // FileMemberActionError : has no documentation (yet)
 type FileMemberActionError struct {
 	dropbox.Tagged
@@ -955,6 +1095,24 @@ func (u *FileMemberActionError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a FileMemberActionError instance
+func (u *FileMemberActionError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "no_explicit_access":
+		type wrap MemberAccessLevelResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoExplicitAccess)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FileMemberActionIndividualResult : has no documentation (yet)
 type FileMemberActionIndividualResult struct {
 	dropbox.Tagged
@@ -1005,6 +1163,23 @@ func (u *FileMemberActionIndividualResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a FileMemberActionIndividualResult instance
+func (u *FileMemberActionIndividualResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Success *AccessLevel `json:"success"`
+		}{u.Tagged, u.Success})
+	case "member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MemberError *FileMemberActionError `json:"member_error"`
+		}{u.Tagged, u.MemberError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FileMemberActionResult : Per-member result for `addFileMember`.
 type FileMemberActionResult struct {
 	// Member : One of specified input members.
@@ -1069,6 +1244,24 @@ func (u *FileMemberRemoveActionResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a FileMemberRemoveActionResult instance
+func (u *FileMemberRemoveActionResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap MemberAccessLevelResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MemberError *FileMemberActionError `json:"member_error"`
+		}{u.Tagged, u.MemberError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FilePermission : Whether the user is allowed to take the sharing action on
 // the file.
 type FilePermission struct {
@@ -1127,6 +1320,15 @@ func NewFolderLinkMetadata(Url string, Name string, LinkPermissions *LinkPermiss
 	return s
 }
 
+// MarshalJSON serializes a FolderLinkMetadata instance, including its `.tag`
+func (u *FolderLinkMetadata) MarshalJSON() ([]byte, error) {
+	type wrap FolderLinkMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "folder"}, (*wrap)(u)})
+}
+
 // FolderPermission : Whether the user is allowed to take the action on the
 // shared folder.
 type FolderPermission struct {
@@ -1271,6 +1473,23 @@ func (u *GetFileMetadataError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetFileMetadataError instance
+func (u *GetFileMetadataError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GetFileMetadataIndividualResult : has no documentation (yet)
 type GetFileMetadataIndividualResult struct {
 	dropbox.Tagged
@@ -1313,6 +1532,24 @@ func (u *GetFileMetadataIndividualResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetFileMetadataIndividualResult instance
+func (u *GetFileMetadataIndividualResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "metadata":
+		type wrap SharedFileMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Metadata)})
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GetMetadataArgs : has no documentation (yet)
 type GetMetadataArgs struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -1424,6 +1661,18 @@ func (u *GetSharedLinksError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetSharedLinksError instance
+func (u *GetSharedLinksError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path string `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GetSharedLinksResult : has no documentation (yet)
 type GetSharedLinksResult struct {
 	// Links : Shared links applicable to the path argument.
@@ -1598,6 +1847,18 @@ func (u *InviteeInfo) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a InviteeInfo instance
+func (u *InviteeInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "email":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Email string `json:"email"`
+		}{u.Tagged, u.Email})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // InviteeMembershipInfo : Information about an invited member of a shared
 // content.
 type InviteeMembershipInfo struct {
@@ -1674,6 +1935,28 @@ func (u *JobError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a JobError instance
+func (u *JobError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "unshare_folder_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UnshareFolderError *UnshareFolderError `json:"unshare_folder_error"`
+		}{u.Tagged, u.UnshareFolderError})
+	case "remove_folder_member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			RemoveFolderMemberError *RemoveFolderMemberError `json:"remove_folder_member_error"`
+		}{u.Tagged, u.RemoveFolderMemberError})
+	case "relinquish_folder_membership_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			RelinquishFolderMembershipError *RelinquishFolderMembershipError `json:"relinquish_folder_membership_error"`
+		}{u.Tagged, u.RelinquishFolderMembershipError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // JobStatus : has no documentation (yet)
 type JobStatus struct {
 	dropbox.Tagged
@@ -1709,6 +1992,18 @@ func (u *JobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a JobStatus instance
+func (u *JobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *JobError `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // LinkAccessLevel : has no documentation (yet)
 type LinkAccessLevel struct {
 	dropbox.Tagged
@@ -1840,6 +2135,18 @@ func (u *LinkExpiry) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LinkExpiry instance
+func (u *LinkExpiry) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "set_expiry":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SetExpiry time.Time `json:"set_expiry"`
+		}{u.Tagged, u.SetExpiry})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // LinkPassword : has no documentation (yet)
 type LinkPassword struct {
 	dropbox.Tagged
@@ -1875,6 +2182,18 @@ func (u *LinkPassword) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LinkPassword instance
+func (u *LinkPassword) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "set_password":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SetPassword string `json:"set_password"`
+		}{u.Tagged, u.SetPassword})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // LinkPermission : Permissions for actions that can be performed on a link.
 type LinkPermission struct {
 	// Action : has no documentation (yet)
@@ -2112,6 +2431,23 @@ func (u *ListFileMembersContinueError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListFileMembersContinueError instance
+func (u *ListFileMembersContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListFileMembersCountResult : has no documentation (yet)
 type ListFileMembersCountResult struct {
 	// Members : A list of members on this file.
@@ -2171,6 +2507,23 @@ func (u *ListFileMembersError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListFileMembersError instance
+func (u *ListFileMembersError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListFileMembersIndividualResult : has no documentation (yet)
 type ListFileMembersIndividualResult struct {
 	dropbox.Tagged
@@ -2214,6 +2567,24 @@ func (u *ListFileMembersIndividualResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListFileMembersIndividualResult instance
+func (u *ListFileMembersIndividualResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "result":
+		type wrap ListFileMembersCountResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Result)})
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListFilesArg : Arguments for `listReceivedFiles`.
 type ListFilesArg struct {
 	// Limit : Number of files to return max per query. Defaults to 100 if no
@@ -2280,6 +2651,18 @@ func (u *ListFilesContinueError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListFilesContinueError instance
+func (u *ListFilesContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListFilesResult : Success results for `listReceivedFiles`.
 type ListFilesResult struct {
 	// Entries : Information about the files shared with current user.
@@ -2377,6 +2760,18 @@ func (u *ListFolderMembersContinueError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListFolderMembersContinueError instance
+func (u *ListFolderMembersContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListFoldersArgs : has no documentation (yet)
 type ListFoldersArgs struct {
 	// Limit : The maximum number of results to return per request.
@@ -2492,6 +2887,18 @@ func (u *ListSharedLinksError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ListSharedLinksError instance
+func (u *ListSharedLinksError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Path *files.LookupError `json:"path"`
+		}{u.Tagged, u.Path})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ListSharedLinksResult : has no documentation (yet)
 type ListSharedLinksResult struct {
 	// Links : Shared links applicable to the path argument.
@@ -2655,6 +3062,23 @@ func (u *MemberSelector) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MemberSelector instance
+func (u *MemberSelector) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "dropbox_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DropboxId string `json:"dropbox_id"`
+		}{u.Tagged, u.DropboxId})
+	case "email":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Email string `json:"email"`
+		}{u.Tagged, u.Email})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ModifySharedLinkSettingsArgs : has no documentation (yet)
 type ModifySharedLinkSettingsArgs struct {
 	// Url : URL of the shared link to change its settings.
@@ -2713,6 +3137,18 @@ func (u *ModifySharedLinkSettingsError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ModifySharedLinkSettingsError instance
+func (u *ModifySharedLinkSettingsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "settings_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SettingsError *SharedLinkSettingsError `json:"settings_error"`
+		}{u.Tagged, u.SettingsError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MountFolderArg : has no documentation (yet)
 type MountFolderArg struct {
 	// SharedFolderId : The ID of the shared folder to mount.
@@ -2773,6 +3209,24 @@ func (u *MountFolderError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MountFolderError instance
+func (u *MountFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "insufficient_quota":
+		type wrap InsufficientQuotaAmounts
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.InsufficientQuota)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ParentFolderAccessInfo : Contains information about a parent folder that a
 // member has access to.
 type ParentFolderAccessInfo struct {
@@ -2813,6 +3267,15 @@ func NewPathLinkMetadata(Url string, Visibility *Visibility, Path string) *PathL
 	return s
 }
 
+// MarshalJSON serializes a PathLinkMetadata instance, including its `.tag`
+func (u *PathLinkMetadata) MarshalJSON() ([]byte, error) {
+	type wrap PathLinkMetadata
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "path"}, (*wrap)(u)})
+}
+
 // PendingUploadMode : Flag to indicate pending upload default (for linking to
 // not-yet-existing paths).
 type PendingUploadMode struct {
@@ -2873,6 +3336,19 @@ func (u *PermissionDeniedReason) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PermissionDeniedReason instance
+func (u *PermissionDeniedReason) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "insufficient_plan":
+		type wrap InsufficientPlan
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.InsufficientPlan)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelinquishFileMembershipArg : has no documentation (yet)
 type RelinquishFileMembershipArg struct {
 	// File : The path or id for the file.
@@ -2922,6 +3398,18 @@ func (u *RelinquishFileMembershipError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelinquishFileMembershipError instance
+func (u *RelinquishFileMembershipError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RelinquishFolderMembershipArg : has no documentation (yet)
 type RelinquishFolderMembershipArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -2980,6 +3468,18 @@ func (u *RelinquishFolderMembershipError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RelinquishFolderMembershipError instance
+func (u *RelinquishFolderMembershipError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RemoveFileMemberArg : Arguments for `removeFileMember2`.
 type RemoveFileMemberArg struct {
 	// File : File from which to remove members.
@@ -3050,6 +3550,29 @@ func (u *RemoveFileMemberError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RemoveFileMemberError instance
+func (u *RemoveFileMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "no_explicit_access":
+		type wrap MemberAccessLevelResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoExplicitAccess)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RemoveFolderMemberArg : has no documentation (yet)
 type RemoveFolderMemberArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -3120,6 +3643,23 @@ func (u *RemoveFolderMemberError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RemoveFolderMemberError instance
+func (u *RemoveFolderMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MemberError *SharedFolderMemberError `json:"member_error"`
+		}{u.Tagged, u.MemberError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RemoveMemberJobStatus : has no documentation (yet)
 type RemoveMemberJobStatus struct {
 	dropbox.Tagged
@@ -3163,6 +3703,24 @@ func (u *RemoveMemberJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RemoveMemberJobStatus instance
+func (u *RemoveMemberJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap MemberAccessLevelResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *RemoveFolderMemberError `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RequestedLinkAccessLevel : has no documentation (yet)
 type RequestedLinkAccessLevel struct {
 	dropbox.Tagged
@@ -3254,6 +3812,18 @@ func (u *SetAccessInheritanceError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SetAccessInheritanceError instance
+func (u *SetAccessInheritanceError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ShareFolderArgBase : has no documentation (yet)
 type ShareFolderArgBase struct {
 	// AclUpdatePolicy : Who can add and remove members of this shared folder.
@@ -3344,6 +3914,18 @@ func (u *ShareFolderErrorBase) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ShareFolderErrorBase instance
+func (u *ShareFolderErrorBase) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "bad_path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			BadPath *SharePathError `json:"bad_path"`
+		}{u.Tagged, u.BadPath})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ShareFolderError : has no documentation (yet)
 type ShareFolderError struct {
 	dropbox.Tagged
@@ -3382,6 +3964,18 @@ func (u *ShareFolderError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ShareFolderError instance
+func (u *ShareFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "bad_path":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			BadPath *SharePathError `json:"bad_path"`
+		}{u.Tagged, u.BadPath})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ShareFolderJobStatus : has no documentation (yet)
 type ShareFolderJobStatus struct {
 	dropbox.Tagged
@@ -3425,6 +4019,24 @@ func (u *ShareFolderJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ShareFolderJobStatus instance
+func (u *ShareFolderJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap SharedFolderMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *ShareFolderError `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ShareFolderLaunch : has no documentation (yet)
 type ShareFolderLaunch struct {
 	dropbox.Tagged
@@ -3470,6 +4082,24 @@ func (u *ShareFolderLaunch) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ShareFolderLaunch instance
+func (u *ShareFolderLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		type wrap SharedFolderMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SharePathError : has no documentation (yet)
 type SharePathError struct {
 	dropbox.Tagged
@@ -3520,6 +4150,19 @@ func (u *SharePathError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SharePathError instance
+func (u *SharePathError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "already_shared":
+		type wrap SharedFolderMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AlreadyShared)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SharedContentLinkMetadata : Metadata of a shared link for a file or folder.
 type SharedContentLinkMetadata struct {
 	SharedContentLinkMetadataBase
@@ -3678,6 +4321,19 @@ func (u *SharedFolderMemberError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SharedFolderMemberError instance
+func (u *SharedFolderMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "no_explicit_access":
+		type wrap MemberAccessLevelResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoExplicitAccess)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SharedFolderMembers : Shared folder user and group membership.
 type SharedFolderMembers struct {
 	// Users : The list of user members of the shared folder.
@@ -3831,6 +4487,18 @@ func (u *SharedLinkAlreadyExistsMetadata) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SharedLinkAlreadyExistsMetadata instance
+func (u *SharedLinkAlreadyExistsMetadata) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "metadata":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Metadata IsSharedLinkMetadata `json:"metadata"`
+		}{u.Tagged, u.Metadata})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SharedLinkPolicy : Who can view shared links in this folder.
 type SharedLinkPolicy struct {
 	dropbox.Tagged
@@ -3989,6 +4657,18 @@ func (u *TransferFolderError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TransferFolderError instance
+func (u *TransferFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UnmountFolderArg : has no documentation (yet)
 type UnmountFolderArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -4038,6 +4718,18 @@ func (u *UnmountFolderError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UnmountFolderError instance
+func (u *UnmountFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UnshareFileArg : Arguments for `unshareFile`.
 type UnshareFileArg struct {
 	// File : The file to unshare.
@@ -4093,6 +4785,23 @@ func (u *UnshareFileError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UnshareFileError instance
+func (u *UnshareFileError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "user_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserError *SharingUserError `json:"user_error"`
+		}{u.Tagged, u.UserError})
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharingFileAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UnshareFolderArg : has no documentation (yet)
 type UnshareFolderArg struct {
 	// SharedFolderId : The ID for the shared folder.
@@ -4149,6 +4858,18 @@ func (u *UnshareFolderError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UnshareFolderError instance
+func (u *UnshareFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UpdateFileMemberArgs : Arguments for `updateFileMember`.
 type UpdateFileMemberArgs struct {
 	// File : File for which we are changing a member's access.
@@ -4244,6 +4965,28 @@ func (u *UpdateFolderMemberError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UpdateFolderMemberError instance
+func (u *UpdateFolderMemberError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "member_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MemberError *SharedFolderMemberError `json:"member_error"`
+		}{u.Tagged, u.MemberError})
+	case "no_explicit_access":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			NoExplicitAccess *AddFolderMemberError `json:"no_explicit_access"`
+		}{u.Tagged, u.NoExplicitAccess})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UpdateFolderPolicyArg : If any of the policies are unset, then they retain
 // their current setting.
 type UpdateFolderPolicyArg struct {
@@ -4316,6 +5059,18 @@ func (u *UpdateFolderPolicyError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UpdateFolderPolicyError instance
+func (u *UpdateFolderPolicyError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *SharedFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UserMembershipInfo : The information about a user member of the shared
 // content.
 type UserMembershipInfo struct {
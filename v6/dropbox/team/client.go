@@ -21,6 +21,7 @@
 package team
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"log"
@@ -34,142 +35,218 @@ import (
 // Client interface describes all routes in this namespace
 type Client interface {
 	// DevicesListMemberDevices : List all device sessions of a team's member.
-	DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error)
+	DevicesListMemberDevices(arg *ListMemberDevicesArg, opts ...dropbox.CallOption) (res *ListMemberDevicesResult, err error)
+	// DevicesListMemberDevicesContext is like DevicesListMemberDevices but takes a context for cancellation and deadlines.
+	DevicesListMemberDevicesContext(ctx context.Context, arg *ListMemberDevicesArg, opts ...dropbox.CallOption) (res *ListMemberDevicesResult, err error)
 	// DevicesListMembersDevices : List all device sessions of a team.
 	// Permission : Team member file access.
-	DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error)
+	DevicesListMembersDevices(arg *ListMembersDevicesArg, opts ...dropbox.CallOption) (res *ListMembersDevicesResult, err error)
+	// DevicesListMembersDevicesContext is like DevicesListMembersDevices but takes a context for cancellation and deadlines.
+	DevicesListMembersDevicesContext(ctx context.Context, arg *ListMembersDevicesArg, opts ...dropbox.CallOption) (res *ListMembersDevicesResult, err error)
 	// DevicesListTeamDevices : List all device sessions of a team. Permission :
 	// Team member file access.
 	// Deprecated: Use `DevicesListMembersDevices` instead
-	DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error)
+	DevicesListTeamDevices(arg *ListTeamDevicesArg, opts ...dropbox.CallOption) (res *ListTeamDevicesResult, err error)
+	// DevicesListTeamDevicesContext is like DevicesListTeamDevices but takes a context for cancellation and deadlines.
+	DevicesListTeamDevicesContext(ctx context.Context, arg *ListTeamDevicesArg, opts ...dropbox.CallOption) (res *ListTeamDevicesResult, err error)
 	// DevicesRevokeDeviceSession : Revoke a device session of a team's member.
-	DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err error)
+	DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg, opts ...dropbox.CallOption) (err error)
+	// DevicesRevokeDeviceSessionContext is like DevicesRevokeDeviceSession but takes a context for cancellation and deadlines.
+	DevicesRevokeDeviceSessionContext(ctx context.Context, arg *RevokeDeviceSessionArg, opts ...dropbox.CallOption) (err error)
 	// DevicesRevokeDeviceSessionBatch : Revoke a list of device sessions of
 	// team members.
-	DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error)
+	DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg, opts ...dropbox.CallOption) (res *RevokeDeviceSessionBatchResult, err error)
+	// DevicesRevokeDeviceSessionBatchContext is like DevicesRevokeDeviceSessionBatch but takes a context for cancellation and deadlines.
+	DevicesRevokeDeviceSessionBatchContext(ctx context.Context, arg *RevokeDeviceSessionBatchArg, opts ...dropbox.CallOption) (res *RevokeDeviceSessionBatchResult, err error)
 	// FeaturesGetValues : Get the values for one or more featues. This route
 	// allows you to check your account's capability for what feature you can
 	// access or what value you have for certain features. Permission : Team
 	// information.
-	FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error)
+	FeaturesGetValues(arg *FeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *FeaturesGetValuesBatchResult, err error)
+	// FeaturesGetValuesContext is like FeaturesGetValues but takes a context for cancellation and deadlines.
+	FeaturesGetValuesContext(ctx context.Context, arg *FeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *FeaturesGetValuesBatchResult, err error)
 	// GetInfo : Retrieves information about a team.
-	GetInfo() (res *TeamGetInfoResult, err error)
+	GetInfo(opts ...dropbox.CallOption) (res *TeamGetInfoResult, err error)
+	// GetInfoContext is like GetInfo but takes a context for cancellation and deadlines.
+	GetInfoContext(ctx context.Context, opts ...dropbox.CallOption) (res *TeamGetInfoResult, err error)
 	// GroupsCreate : Creates a new, empty group, with a requested name.
 	// Permission : Team member management.
-	GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err error)
+	GroupsCreate(arg *GroupCreateArg, opts ...dropbox.CallOption) (res *GroupFullInfo, err error)
+	// GroupsCreateContext is like GroupsCreate but takes a context for cancellation and deadlines.
+	GroupsCreateContext(ctx context.Context, arg *GroupCreateArg, opts ...dropbox.CallOption) (res *GroupFullInfo, err error)
 	// GroupsDelete : Deletes a group. The group is deleted immediately. However
 	// the revoking of group-owned resources may take additional time. Use the
 	// `groupsJobStatusGet` to determine whether this process has completed.
 	// Permission : Team member management.
-	GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResult, err error)
+	GroupsDelete(arg *GroupSelector, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	// GroupsDeleteContext is like GroupsDelete but takes a context for cancellation and deadlines.
+	GroupsDeleteContext(ctx context.Context, arg *GroupSelector, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
 	// GroupsGetInfo : Retrieves information about one or more groups. Note that
 	// the optional field  `GroupFullInfo.members` is not returned for
 	// system-managed groups. Permission : Team Information.
-	GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem, err error)
+	GroupsGetInfo(arg *GroupsSelector, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error)
+	// GroupsGetInfoContext is like GroupsGetInfo but takes a context for cancellation and deadlines.
+	GroupsGetInfoContext(ctx context.Context, arg *GroupsSelector, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error)
 	// GroupsJobStatusGet : Once an async_job_id is returned from
 	// `groupsDelete`, `groupsMembersAdd` , or `groupsMembersRemove` use this
 	// method to poll the status of granting/revoking group members' access to
 	// group-owned resources. Permission : Team member management.
-	GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	GroupsJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
+	// GroupsJobStatusGetContext is like GroupsJobStatusGet but takes a context for cancellation and deadlines.
+	GroupsJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
 	// GroupsList : Lists groups on a team. Permission : Team Information.
-	GroupsList(arg *GroupsListArg) (res *GroupsListResult, err error)
+	GroupsList(arg *GroupsListArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error)
+	// GroupsListContext is like GroupsList but takes a context for cancellation and deadlines.
+	GroupsListContext(ctx context.Context, arg *GroupsListArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error)
 	// GroupsListContinue : Once a cursor has been retrieved from `groupsList`,
 	// use this to paginate through all groups. Permission : Team Information.
-	GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsListResult, err error)
+	GroupsListContinue(arg *GroupsListContinueArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error)
+	// GroupsListContinueContext is like GroupsListContinue but takes a context for cancellation and deadlines.
+	GroupsListContinueContext(ctx context.Context, arg *GroupsListContinueArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error)
 	// GroupsMembersAdd : Adds members to a group. The members are added
 	// immediately. However the granting of group-owned resources may take
 	// additional time. Use the `groupsJobStatusGet` to determine whether this
 	// process has completed. Permission : Team member management.
-	GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error)
+	GroupsMembersAdd(arg *GroupMembersAddArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error)
+	// GroupsMembersAddContext is like GroupsMembersAdd but takes a context for cancellation and deadlines.
+	GroupsMembersAddContext(ctx context.Context, arg *GroupMembersAddArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error)
 	// GroupsMembersList : Lists members of a group. Permission : Team
 	// Information.
-	GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error)
+	GroupsMembersList(arg *GroupsMembersListArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error)
+	// GroupsMembersListContext is like GroupsMembersList but takes a context for cancellation and deadlines.
+	GroupsMembersListContext(ctx context.Context, arg *GroupsMembersListArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error)
 	// GroupsMembersListContinue : Once a cursor has been retrieved from
 	// `groupsMembersList`, use this to paginate through all members of the
 	// group. Permission : Team information.
-	GroupsMembersListContinue(arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error)
+	GroupsMembersListContinue(arg *GroupsMembersListContinueArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error)
+	// GroupsMembersListContinueContext is like GroupsMembersListContinue but takes a context for cancellation and deadlines.
+	GroupsMembersListContinueContext(ctx context.Context, arg *GroupsMembersListContinueArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error)
 	// GroupsMembersRemove : Removes members from a group. The members are
 	// removed immediately. However the revoking of group-owned resources may
 	// take additional time. Use the `groupsJobStatusGet` to determine whether
 	// this process has completed. This method permits removing the only owner
 	// of a group, even in cases where this is not possible via the web client.
 	// Permission : Team member management.
-	GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error)
+	GroupsMembersRemove(arg *GroupMembersRemoveArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error)
+	// GroupsMembersRemoveContext is like GroupsMembersRemove but takes a context for cancellation and deadlines.
+	GroupsMembersRemoveContext(ctx context.Context, arg *GroupMembersRemoveArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error)
 	// GroupsMembersSetAccessType : Sets a member's access type in a group.
 	// Permission : Team member management.
-	GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error)
+	GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error)
+	// GroupsMembersSetAccessTypeContext is like GroupsMembersSetAccessType but takes a context for cancellation and deadlines.
+	GroupsMembersSetAccessTypeContext(ctx context.Context, arg *GroupMembersSetAccessTypeArg, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error)
 	// GroupsUpdate : Updates a group's name and/or external ID. Permission :
 	// Team member management.
-	GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err error)
+	GroupsUpdate(arg *GroupUpdateArgs, opts ...dropbox.CallOption) (res *GroupFullInfo, err error)
+	// GroupsUpdateContext is like GroupsUpdate but takes a context for cancellation and deadlines.
+	GroupsUpdateContext(ctx context.Context, arg *GroupUpdateArgs, opts ...dropbox.CallOption) (res *GroupFullInfo, err error)
 	// LegalHoldsCreatePolicy : Creates new legal hold policy. Note: Legal Holds
 	// is a paid add-on. Not all teams have the feature. Permission : Team
 	// member file access.
-	LegalHoldsCreatePolicy(arg *LegalHoldsPolicyCreateArg) (res *LegalHoldPolicy, err error)
+	LegalHoldsCreatePolicy(arg *LegalHoldsPolicyCreateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
+	// LegalHoldsCreatePolicyContext is like LegalHoldsCreatePolicy but takes a context for cancellation and deadlines.
+	LegalHoldsCreatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyCreateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
 	// LegalHoldsGetPolicy : Gets a legal hold by Id. Note: Legal Holds is a
 	// paid add-on. Not all teams have the feature. Permission : Team member
 	// file access.
-	LegalHoldsGetPolicy(arg *LegalHoldsGetPolicyArg) (res *LegalHoldPolicy, err error)
+	LegalHoldsGetPolicy(arg *LegalHoldsGetPolicyArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
+	// LegalHoldsGetPolicyContext is like LegalHoldsGetPolicy but takes a context for cancellation and deadlines.
+	LegalHoldsGetPolicyContext(ctx context.Context, arg *LegalHoldsGetPolicyArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
 	// LegalHoldsListHeldRevisions : List the file metadata that's under the
 	// hold. Note: Legal Holds is a paid add-on. Not all teams have the feature.
 	// Permission : Team member file access.
-	LegalHoldsListHeldRevisions(arg *LegalHoldsListHeldRevisionsArg) (res *LegalHoldsListHeldRevisionResult, err error)
+	LegalHoldsListHeldRevisions(arg *LegalHoldsListHeldRevisionsArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error)
+	// LegalHoldsListHeldRevisionsContext is like LegalHoldsListHeldRevisions but takes a context for cancellation and deadlines.
+	LegalHoldsListHeldRevisionsContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error)
 	// LegalHoldsListHeldRevisionsContinue : Continue listing the file metadata
 	// that's under the hold. Note: Legal Holds is a paid add-on. Not all teams
 	// have the feature. Permission : Team member file access.
-	LegalHoldsListHeldRevisionsContinue(arg *LegalHoldsListHeldRevisionsContinueArg) (res *LegalHoldsListHeldRevisionResult, err error)
+	LegalHoldsListHeldRevisionsContinue(arg *LegalHoldsListHeldRevisionsContinueArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error)
+	// LegalHoldsListHeldRevisionsContinueContext is like LegalHoldsListHeldRevisionsContinue but takes a context for cancellation and deadlines.
+	LegalHoldsListHeldRevisionsContinueContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsContinueArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error)
 	// LegalHoldsListPolicies : Lists legal holds on a team. Note: Legal Holds
 	// is a paid add-on. Not all teams have the feature. Permission : Team
 	// member file access.
-	LegalHoldsListPolicies(arg *LegalHoldsListPoliciesArg) (res *LegalHoldsListPoliciesResult, err error)
+	LegalHoldsListPolicies(arg *LegalHoldsListPoliciesArg, opts ...dropbox.CallOption) (res *LegalHoldsListPoliciesResult, err error)
+	// LegalHoldsListPoliciesContext is like LegalHoldsListPolicies but takes a context for cancellation and deadlines.
+	LegalHoldsListPoliciesContext(ctx context.Context, arg *LegalHoldsListPoliciesArg, opts ...dropbox.CallOption) (res *LegalHoldsListPoliciesResult, err error)
 	// LegalHoldsReleasePolicy : Releases a legal hold by Id. Note: Legal Holds
 	// is a paid add-on. Not all teams have the feature. Permission : Team
 	// member file access.
-	LegalHoldsReleasePolicy(arg *LegalHoldsPolicyReleaseArg) (err error)
+	LegalHoldsReleasePolicy(arg *LegalHoldsPolicyReleaseArg, opts ...dropbox.CallOption) (err error)
+	// LegalHoldsReleasePolicyContext is like LegalHoldsReleasePolicy but takes a context for cancellation and deadlines.
+	LegalHoldsReleasePolicyContext(ctx context.Context, arg *LegalHoldsPolicyReleaseArg, opts ...dropbox.CallOption) (err error)
 	// LegalHoldsUpdatePolicy : Updates a legal hold. Note: Legal Holds is a
 	// paid add-on. Not all teams have the feature. Permission : Team member
 	// file access.
-	LegalHoldsUpdatePolicy(arg *LegalHoldsPolicyUpdateArg) (res *LegalHoldPolicy, err error)
+	LegalHoldsUpdatePolicy(arg *LegalHoldsPolicyUpdateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
+	// LegalHoldsUpdatePolicyContext is like LegalHoldsUpdatePolicy but takes a context for cancellation and deadlines.
+	LegalHoldsUpdatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyUpdateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
 	// LinkedAppsListMemberLinkedApps : List all linked applications of the team
 	// member. Note, this endpoint does not list any team-linked applications.
-	LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error)
+	LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg, opts ...dropbox.CallOption) (res *ListMemberAppsResult, err error)
+	// LinkedAppsListMemberLinkedAppsContext is like LinkedAppsListMemberLinkedApps but takes a context for cancellation and deadlines.
+	LinkedAppsListMemberLinkedAppsContext(ctx context.Context, arg *ListMemberAppsArg, opts ...dropbox.CallOption) (res *ListMemberAppsResult, err error)
 	// LinkedAppsListMembersLinkedApps : List all applications linked to the
 	// team members' accounts. Note, this endpoint does not list any team-linked
 	// applications.
-	LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error)
+	LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg, opts ...dropbox.CallOption) (res *ListMembersAppsResult, err error)
+	// LinkedAppsListMembersLinkedAppsContext is like LinkedAppsListMembersLinkedApps but takes a context for cancellation and deadlines.
+	LinkedAppsListMembersLinkedAppsContext(ctx context.Context, arg *ListMembersAppsArg, opts ...dropbox.CallOption) (res *ListMembersAppsResult, err error)
 	// LinkedAppsListTeamLinkedApps : List all applications linked to the team
 	// members' accounts. Note, this endpoint doesn't list any team-linked
 	// applications.
 	// Deprecated: Use `LinkedAppsListMembersLinkedApps` instead
-	LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error)
+	LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg, opts ...dropbox.CallOption) (res *ListTeamAppsResult, err error)
+	// LinkedAppsListTeamLinkedAppsContext is like LinkedAppsListTeamLinkedApps but takes a context for cancellation and deadlines.
+	LinkedAppsListTeamLinkedAppsContext(ctx context.Context, arg *ListTeamAppsArg, opts ...dropbox.CallOption) (res *ListTeamAppsResult, err error)
 	// LinkedAppsRevokeLinkedApp : Revoke a linked application of the team
 	// member.
-	LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err error)
+	LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg, opts ...dropbox.CallOption) (err error)
+	// LinkedAppsRevokeLinkedAppContext is like LinkedAppsRevokeLinkedApp but takes a context for cancellation and deadlines.
+	LinkedAppsRevokeLinkedAppContext(ctx context.Context, arg *RevokeLinkedApiAppArg, opts ...dropbox.CallOption) (err error)
 	// LinkedAppsRevokeLinkedAppBatch : Revoke a list of linked applications of
 	// the team members.
-	LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error)
+	LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg, opts ...dropbox.CallOption) (res *RevokeLinkedAppBatchResult, err error)
+	// LinkedAppsRevokeLinkedAppBatchContext is like LinkedAppsRevokeLinkedAppBatch but takes a context for cancellation and deadlines.
+	LinkedAppsRevokeLinkedAppBatchContext(ctx context.Context, arg *RevokeLinkedApiAppBatchArg, opts ...dropbox.CallOption) (res *RevokeLinkedAppBatchResult, err error)
 	// MemberSpaceLimitsExcludedUsersAdd : Add users to member space limits
 	// excluded users list.
-	MemberSpaceLimitsExcludedUsersAdd(arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error)
+	MemberSpaceLimitsExcludedUsersAdd(arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error)
+	// MemberSpaceLimitsExcludedUsersAddContext is like MemberSpaceLimitsExcludedUsersAdd but takes a context for cancellation and deadlines.
+	MemberSpaceLimitsExcludedUsersAddContext(ctx context.Context, arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error)
 	// MemberSpaceLimitsExcludedUsersList : List member space limits excluded
 	// users.
-	MemberSpaceLimitsExcludedUsersList(arg *ExcludedUsersListArg) (res *ExcludedUsersListResult, err error)
+	MemberSpaceLimitsExcludedUsersList(arg *ExcludedUsersListArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error)
+	// MemberSpaceLimitsExcludedUsersListContext is like MemberSpaceLimitsExcludedUsersList but takes a context for cancellation and deadlines.
+	MemberSpaceLimitsExcludedUsersListContext(ctx context.Context, arg *ExcludedUsersListArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error)
 	// MemberSpaceLimitsExcludedUsersListContinue : Continue listing member
 	// space limits excluded users.
-	MemberSpaceLimitsExcludedUsersListContinue(arg *ExcludedUsersListContinueArg) (res *ExcludedUsersListResult, err error)
+	MemberSpaceLimitsExcludedUsersListContinue(arg *ExcludedUsersListContinueArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error)
+	// MemberSpaceLimitsExcludedUsersListContinueContext is like MemberSpaceLimitsExcludedUsersListContinue but takes a context for cancellation and deadlines.
+	MemberSpaceLimitsExcludedUsersListContinueContext(ctx context.Context, arg *ExcludedUsersListContinueArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error)
 	// MemberSpaceLimitsExcludedUsersRemove : Remove users from member space
 	// limits excluded users list.
-	MemberSpaceLimitsExcludedUsersRemove(arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error)
+	MemberSpaceLimitsExcludedUsersRemove(arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error)
+	// MemberSpaceLimitsExcludedUsersRemoveContext is like MemberSpaceLimitsExcludedUsersRemove but takes a context for cancellation and deadlines.
+	MemberSpaceLimitsExcludedUsersRemoveContext(ctx context.Context, arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error)
 	// MemberSpaceLimitsGetCustomQuota : Get users custom quota. Returns none as
 	// the custom quota if none was set. A maximum of 1000 members can be
 	// specified in a single call.
-	MemberSpaceLimitsGetCustomQuota(arg *CustomQuotaUsersArg) (res []*CustomQuotaResult, err error)
+	MemberSpaceLimitsGetCustomQuota(arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error)
+	// MemberSpaceLimitsGetCustomQuotaContext is like MemberSpaceLimitsGetCustomQuota but takes a context for cancellation and deadlines.
+	MemberSpaceLimitsGetCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error)
 	// MemberSpaceLimitsRemoveCustomQuota : Remove users custom quota. A maximum
 	// of 1000 members can be specified in a single call.
-	MemberSpaceLimitsRemoveCustomQuota(arg *CustomQuotaUsersArg) (res []*RemoveCustomQuotaResult, err error)
+	MemberSpaceLimitsRemoveCustomQuota(arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*RemoveCustomQuotaResult, err error)
+	// MemberSpaceLimitsRemoveCustomQuotaContext is like MemberSpaceLimitsRemoveCustomQuota but takes a context for cancellation and deadlines.
+	MemberSpaceLimitsRemoveCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*RemoveCustomQuotaResult, err error)
 	// MemberSpaceLimitsSetCustomQuota : Set users custom quota. Custom quota
 	// has to be at least 15GB. A maximum of 1000 members can be specified in a
 	// single call.
-	MemberSpaceLimitsSetCustomQuota(arg *SetCustomQuotaArg) (res []*CustomQuotaResult, err error)
+	MemberSpaceLimitsSetCustomQuota(arg *SetCustomQuotaArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error)
+	// MemberSpaceLimitsSetCustomQuotaContext is like MemberSpaceLimitsSetCustomQuota but takes a context for cancellation and deadlines.
+	MemberSpaceLimitsSetCustomQuotaContext(ctx context.Context, arg *SetCustomQuotaArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error)
 	// MembersAdd : Adds members to a team. Permission : Team member management
 	// A maximum of 20 members can be specified in a single call. If no Dropbox
 	// account exists with the email address specified, a new Dropbox account
@@ -181,7 +258,9 @@ type Client interface {
 	// member management apps are required to set an initial given_name and
 	// surname for a user to use in the team invitation and for 'Perform as team
 	// member' actions taken on the user before they become 'active'.
-	MembersAddV2(arg *MembersAddV2Arg) (res *MembersAddLaunchV2Result, err error)
+	MembersAddV2(arg *MembersAddV2Arg, opts ...dropbox.CallOption) (res *MembersAddLaunchV2Result, err error)
+	// MembersAddV2Context is like MembersAddV2 but takes a context for cancellation and deadlines.
+	MembersAddV2Context(ctx context.Context, arg *MembersAddV2Arg, opts ...dropbox.CallOption) (res *MembersAddLaunchV2Result, err error)
 	// MembersAdd : Adds members to a team. Permission : Team member management
 	// A maximum of 20 members can be specified in a single call. If no Dropbox
 	// account exists with the email address specified, a new Dropbox account
@@ -193,61 +272,91 @@ type Client interface {
 	// member management apps are required to set an initial given_name and
 	// surname for a user to use in the team invitation and for 'Perform as team
 	// member' actions taken on the user before they become 'active'.
-	MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err error)
+	MembersAdd(arg *MembersAddArg, opts ...dropbox.CallOption) (res *MembersAddLaunch, err error)
+	// MembersAddContext is like MembersAdd but takes a context for cancellation and deadlines.
+	MembersAddContext(ctx context.Context, arg *MembersAddArg, opts ...dropbox.CallOption) (res *MembersAddLaunch, err error)
 	// MembersAddJobStatusGet : Once an async_job_id is returned from
 	// `membersAdd` , use this to poll the status of the asynchronous request.
 	// Permission : Team member management.
-	MembersAddJobStatusGetV2(arg *async.PollArg) (res *MembersAddJobStatusV2Result, err error)
+	MembersAddJobStatusGetV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatusV2Result, err error)
+	// MembersAddJobStatusGetV2Context is like MembersAddJobStatusGetV2 but takes a context for cancellation and deadlines.
+	MembersAddJobStatusGetV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatusV2Result, err error)
 	// MembersAddJobStatusGet : Once an async_job_id is returned from
 	// `membersAdd` , use this to poll the status of the asynchronous request.
 	// Permission : Team member management.
-	MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJobStatus, err error)
+	MembersAddJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatus, err error)
+	// MembersAddJobStatusGetContext is like MembersAddJobStatusGet but takes a context for cancellation and deadlines.
+	MembersAddJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatus, err error)
 	// MembersDeleteProfilePhoto : Deletes a team member's profile photo.
 	// Permission : Team member management.
-	MembersDeleteProfilePhotoV2(arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfoV2Result, err error)
+	MembersDeleteProfilePhotoV2(arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
+	// MembersDeleteProfilePhotoV2Context is like MembersDeleteProfilePhotoV2 but takes a context for cancellation and deadlines.
+	MembersDeleteProfilePhotoV2Context(ctx context.Context, arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
 	// MembersDeleteProfilePhoto : Deletes a team member's profile photo.
 	// Permission : Team member management.
-	MembersDeleteProfilePhoto(arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfo, err error)
+	MembersDeleteProfilePhoto(arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
+	// MembersDeleteProfilePhotoContext is like MembersDeleteProfilePhoto but takes a context for cancellation and deadlines.
+	MembersDeleteProfilePhotoContext(ctx context.Context, arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
 	// MembersGetAvailableTeamMemberRoles : Get available TeamMemberRoles for
 	// the connected team. To be used with `membersSetAdminPermissions`.
 	// Permission : Team member management.
-	MembersGetAvailableTeamMemberRoles() (res *MembersGetAvailableTeamMemberRolesResult, err error)
+	MembersGetAvailableTeamMemberRoles(opts ...dropbox.CallOption) (res *MembersGetAvailableTeamMemberRolesResult, err error)
+	// MembersGetAvailableTeamMemberRolesContext is like MembersGetAvailableTeamMemberRoles but takes a context for cancellation and deadlines.
+	MembersGetAvailableTeamMemberRolesContext(ctx context.Context, opts ...dropbox.CallOption) (res *MembersGetAvailableTeamMemberRolesResult, err error)
 	// MembersGetInfo : Returns information about multiple team members.
 	// Permission : Team information This endpoint will return
 	// `MembersGetInfoItem.id_not_found`, for IDs (or emails) that cannot be
 	// matched to a valid team member.
-	MembersGetInfoV2(arg *MembersGetInfoV2Arg) (res *MembersGetInfoV2Result, err error)
+	MembersGetInfoV2(arg *MembersGetInfoV2Arg, opts ...dropbox.CallOption) (res *MembersGetInfoV2Result, err error)
+	// MembersGetInfoV2Context is like MembersGetInfoV2 but takes a context for cancellation and deadlines.
+	MembersGetInfoV2Context(ctx context.Context, arg *MembersGetInfoV2Arg, opts ...dropbox.CallOption) (res *MembersGetInfoV2Result, err error)
 	// MembersGetInfo : Returns information about multiple team members.
 	// Permission : Team information This endpoint will return
 	// `MembersGetInfoItem.id_not_found`, for IDs (or emails) that cannot be
 	// matched to a valid team member.
-	MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error)
+	MembersGetInfo(arg *MembersGetInfoArgs, opts ...dropbox.CallOption) (res []*MembersGetInfoItem, err error)
+	// MembersGetInfoContext is like MembersGetInfo but takes a context for cancellation and deadlines.
+	MembersGetInfoContext(ctx context.Context, arg *MembersGetInfoArgs, opts ...dropbox.CallOption) (res []*MembersGetInfoItem, err error)
 	// MembersList : Lists members of a team. Permission : Team information.
-	MembersListV2(arg *MembersListArg) (res *MembersListV2Result, err error)
+	MembersListV2(arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error)
+	// MembersListV2Context is like MembersListV2 but takes a context for cancellation and deadlines.
+	MembersListV2Context(ctx context.Context, arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error)
 	// MembersList : Lists members of a team. Permission : Team information.
-	MembersList(arg *MembersListArg) (res *MembersListResult, err error)
+	MembersList(arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListResult, err error)
+	// MembersListContext is like MembersList but takes a context for cancellation and deadlines.
+	MembersListContext(ctx context.Context, arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListResult, err error)
 	// MembersListContinue : Once a cursor has been retrieved from
 	// `membersList`, use this to paginate through all team members. Permission
 	// : Team information.
-	MembersListContinueV2(arg *MembersListContinueArg) (res *MembersListV2Result, err error)
+	MembersListContinueV2(arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error)
+	// MembersListContinueV2Context is like MembersListContinueV2 but takes a context for cancellation and deadlines.
+	MembersListContinueV2Context(ctx context.Context, arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error)
 	// MembersListContinue : Once a cursor has been retrieved from
 	// `membersList`, use this to paginate through all team members. Permission
 	// : Team information.
-	MembersListContinue(arg *MembersListContinueArg) (res *MembersListResult, err error)
+	MembersListContinue(arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListResult, err error)
+	// MembersListContinueContext is like MembersListContinue but takes a context for cancellation and deadlines.
+	MembersListContinueContext(ctx context.Context, arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListResult, err error)
 	// MembersMoveFormerMemberFiles : Moves removed member's files to a
 	// different member. This endpoint initiates an asynchronous job. To obtain
 	// the final result of the job, the client should periodically poll
 	// `membersMoveFormerMemberFilesJobStatusCheck`. Permission : Team member
 	// management.
-	MembersMoveFormerMemberFiles(arg *MembersDataTransferArg) (res *async.LaunchEmptyResult, err error)
+	MembersMoveFormerMemberFiles(arg *MembersDataTransferArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	// MembersMoveFormerMemberFilesContext is like MembersMoveFormerMemberFiles but takes a context for cancellation and deadlines.
+	MembersMoveFormerMemberFilesContext(ctx context.Context, arg *MembersDataTransferArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
 	// MembersMoveFormerMemberFilesJobStatusCheck : Once an async_job_id is
 	// returned from `membersMoveFormerMemberFiles` , use this to poll the
 	// status of the asynchronous request. Permission : Team member management.
-	MembersMoveFormerMemberFilesJobStatusCheck(arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	MembersMoveFormerMemberFilesJobStatusCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
+	// MembersMoveFormerMemberFilesJobStatusCheckContext is like MembersMoveFormerMemberFilesJobStatusCheck but takes a context for cancellation and deadlines.
+	MembersMoveFormerMemberFilesJobStatusCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
 	// MembersRecover : Recover a deleted member. Permission : Team member
 	// management Exactly one of team_member_id, email, or external_id must be
 	// provided to identify the user account.
-	MembersRecover(arg *MembersRecoverArg) (err error)
+	MembersRecover(arg *MembersRecoverArg, opts ...dropbox.CallOption) (err error)
+	// MembersRecoverContext is like MembersRecover but takes a context for cancellation and deadlines.
+	MembersRecoverContext(ctx context.Context, arg *MembersRecoverArg, opts ...dropbox.CallOption) (err error)
 	// MembersRemove : Removes a member from a team. Permission : Team member
 	// management Exactly one of team_member_id, email, or external_id must be
 	// provided to identify the user account. Accounts can be recovered via
@@ -260,135 +369,240 @@ type Client interface {
 	// days for most teams). This endpoint may initiate an asynchronous job. To
 	// obtain the final result of the job, the client should periodically poll
 	// `membersRemoveJobStatusGet`.
-	MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error)
+	MembersRemove(arg *MembersRemoveArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	// MembersRemoveContext is like MembersRemove but takes a context for cancellation and deadlines.
+	MembersRemoveContext(ctx context.Context, arg *MembersRemoveArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
 	// MembersRemoveJobStatusGet : Once an async_job_id is returned from
 	// `membersRemove` , use this to poll the status of the asynchronous
 	// request. Permission : Team member management.
-	MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error)
+	MembersRemoveJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
+	// MembersRemoveJobStatusGetContext is like MembersRemoveJobStatusGet but takes a context for cancellation and deadlines.
+	MembersRemoveJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
 	// MembersSecondaryEmailsAdd : Add secondary emails to users. Permission :
 	// Team member management. Emails that are on verified domains will be
 	// verified automatically. For each email address not on a verified domain a
 	// verification email will be sent.
-	MembersSecondaryEmailsAdd(arg *AddSecondaryEmailsArg) (res *AddSecondaryEmailsResult, err error)
+	MembersSecondaryEmailsAdd(arg *AddSecondaryEmailsArg, opts ...dropbox.CallOption) (res *AddSecondaryEmailsResult, err error)
+	// MembersSecondaryEmailsAddContext is like MembersSecondaryEmailsAdd but takes a context for cancellation and deadlines.
+	MembersSecondaryEmailsAddContext(ctx context.Context, arg *AddSecondaryEmailsArg, opts ...dropbox.CallOption) (res *AddSecondaryEmailsResult, err error)
 	// MembersSecondaryEmailsDelete : Delete secondary emails from users
 	// Permission : Team member management. Users will be notified of deletions
 	// of verified secondary emails at both the secondary email and their
 	// primary email.
-	MembersSecondaryEmailsDelete(arg *DeleteSecondaryEmailsArg) (res *DeleteSecondaryEmailsResult, err error)
+	MembersSecondaryEmailsDelete(arg *DeleteSecondaryEmailsArg, opts ...dropbox.CallOption) (res *DeleteSecondaryEmailsResult, err error)
+	// MembersSecondaryEmailsDeleteContext is like MembersSecondaryEmailsDelete but takes a context for cancellation and deadlines.
+	MembersSecondaryEmailsDeleteContext(ctx context.Context, arg *DeleteSecondaryEmailsArg, opts ...dropbox.CallOption) (res *DeleteSecondaryEmailsResult, err error)
 	// MembersSecondaryEmailsResendVerificationEmails : Resend secondary email
 	// verification emails. Permission : Team member management.
-	MembersSecondaryEmailsResendVerificationEmails(arg *ResendVerificationEmailArg) (res *ResendVerificationEmailResult, err error)
+	MembersSecondaryEmailsResendVerificationEmails(arg *ResendVerificationEmailArg, opts ...dropbox.CallOption) (res *ResendVerificationEmailResult, err error)
+	// MembersSecondaryEmailsResendVerificationEmailsContext is like MembersSecondaryEmailsResendVerificationEmails but takes a context for cancellation and deadlines.
+	MembersSecondaryEmailsResendVerificationEmailsContext(ctx context.Context, arg *ResendVerificationEmailArg, opts ...dropbox.CallOption) (res *ResendVerificationEmailResult, err error)
 	// MembersSendWelcomeEmail : Sends welcome email to pending team member.
 	// Permission : Team member management Exactly one of team_member_id, email,
 	// or external_id must be provided to identify the user account. No-op if
 	// team member is not pending.
-	MembersSendWelcomeEmail(arg *UserSelectorArg) (err error)
+	MembersSendWelcomeEmail(arg *UserSelectorArg, opts ...dropbox.CallOption) (err error)
+	// MembersSendWelcomeEmailContext is like MembersSendWelcomeEmail but takes a context for cancellation and deadlines.
+	MembersSendWelcomeEmailContext(ctx context.Context, arg *UserSelectorArg, opts ...dropbox.CallOption) (err error)
 	// MembersSetAdminPermissions : Updates a team member's permissions.
 	// Permission : Team member management.
-	MembersSetAdminPermissionsV2(arg *MembersSetPermissions2Arg) (res *MembersSetPermissions2Result, err error)
+	MembersSetAdminPermissionsV2(arg *MembersSetPermissions2Arg, opts ...dropbox.CallOption) (res *MembersSetPermissions2Result, err error)
+	// MembersSetAdminPermissionsV2Context is like MembersSetAdminPermissionsV2 but takes a context for cancellation and deadlines.
+	MembersSetAdminPermissionsV2Context(ctx context.Context, arg *MembersSetPermissions2Arg, opts ...dropbox.CallOption) (res *MembersSetPermissions2Result, err error)
 	// MembersSetAdminPermissions : Updates a team member's permissions.
 	// Permission : Team member management.
-	MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error)
+	MembersSetAdminPermissions(arg *MembersSetPermissionsArg, opts ...dropbox.CallOption) (res *MembersSetPermissionsResult, err error)
+	// MembersSetAdminPermissionsContext is like MembersSetAdminPermissions but takes a context for cancellation and deadlines.
+	MembersSetAdminPermissionsContext(ctx context.Context, arg *MembersSetPermissionsArg, opts ...dropbox.CallOption) (res *MembersSetPermissionsResult, err error)
 	// MembersSetProfile : Updates a team member's profile. Permission : Team
 	// member management.
-	MembersSetProfileV2(arg *MembersSetProfileArg) (res *TeamMemberInfoV2Result, err error)
+	MembersSetProfileV2(arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
+	// MembersSetProfileV2Context is like MembersSetProfileV2 but takes a context for cancellation and deadlines.
+	MembersSetProfileV2Context(ctx context.Context, arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
 	// MembersSetProfile : Updates a team member's profile. Permission : Team
 	// member management.
-	MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMemberInfo, err error)
+	MembersSetProfile(arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
+	// MembersSetProfileContext is like MembersSetProfile but takes a context for cancellation and deadlines.
+	MembersSetProfileContext(ctx context.Context, arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
 	// MembersSetProfilePhoto : Updates a team member's profile photo.
 	// Permission : Team member management.
-	MembersSetProfilePhotoV2(arg *MembersSetProfilePhotoArg) (res *TeamMemberInfoV2Result, err error)
+	MembersSetProfilePhotoV2(arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
+	// MembersSetProfilePhotoV2Context is like MembersSetProfilePhotoV2 but takes a context for cancellation and deadlines.
+	MembersSetProfilePhotoV2Context(ctx context.Context, arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
 	// MembersSetProfilePhoto : Updates a team member's profile photo.
 	// Permission : Team member management.
-	MembersSetProfilePhoto(arg *MembersSetProfilePhotoArg) (res *TeamMemberInfo, err error)
+	MembersSetProfilePhoto(arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
+	// MembersSetProfilePhotoContext is like MembersSetProfilePhoto but takes a context for cancellation and deadlines.
+	MembersSetProfilePhotoContext(ctx context.Context, arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
 	// MembersSuspend : Suspend a member from a team. Permission : Team member
 	// management Exactly one of team_member_id, email, or external_id must be
 	// provided to identify the user account.
-	MembersSuspend(arg *MembersDeactivateArg) (err error)
+	MembersSuspend(arg *MembersDeactivateArg, opts ...dropbox.CallOption) (err error)
+	// MembersSuspendContext is like MembersSuspend but takes a context for cancellation and deadlines.
+	MembersSuspendContext(ctx context.Context, arg *MembersDeactivateArg, opts ...dropbox.CallOption) (err error)
 	// MembersUnsuspend : Unsuspend a member from a team. Permission : Team
 	// member management Exactly one of team_member_id, email, or external_id
 	// must be provided to identify the user account.
-	MembersUnsuspend(arg *MembersUnsuspendArg) (err error)
+	MembersUnsuspend(arg *MembersUnsuspendArg, opts ...dropbox.CallOption) (err error)
+	// MembersUnsuspendContext is like MembersUnsuspend but takes a context for cancellation and deadlines.
+	MembersUnsuspendContext(ctx context.Context, arg *MembersUnsuspendArg, opts ...dropbox.CallOption) (err error)
 	// NamespacesList : Returns a list of all team-accessible namespaces. This
 	// list includes team folders, shared folders containing team members, team
 	// members' home namespaces, and team members' app folders. Home namespaces
 	// and app folders are always owned by this team or members of the team, but
 	// shared folders may be owned by other users or other teams. Duplicates may
 	// occur in the list.
-	NamespacesList(arg *TeamNamespacesListArg) (res *TeamNamespacesListResult, err error)
+	NamespacesList(arg *TeamNamespacesListArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error)
+	// NamespacesListContext is like NamespacesList but takes a context for cancellation and deadlines.
+	NamespacesListContext(ctx context.Context, arg *TeamNamespacesListArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error)
 	// NamespacesListContinue : Once a cursor has been retrieved from
 	// `namespacesList`, use this to paginate through all team-accessible
 	// namespaces. Duplicates may occur in the list.
-	NamespacesListContinue(arg *TeamNamespacesListContinueArg) (res *TeamNamespacesListResult, err error)
+	NamespacesListContinue(arg *TeamNamespacesListContinueArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error)
+	// NamespacesListContinueContext is like NamespacesListContinue but takes a context for cancellation and deadlines.
+	NamespacesListContinueContext(ctx context.Context, arg *TeamNamespacesListContinueArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error)
 	// PropertiesTemplateAdd : Permission : Team member file access.
 	// Deprecated:
-	PropertiesTemplateAdd(arg *file_properties.AddTemplateArg) (res *file_properties.AddTemplateResult, err error)
+	PropertiesTemplateAdd(arg *file_properties.AddTemplateArg, opts ...dropbox.CallOption) (res *file_properties.AddTemplateResult, err error)
+	// PropertiesTemplateAddContext is like PropertiesTemplateAdd but takes a context for cancellation and deadlines.
+	PropertiesTemplateAddContext(ctx context.Context, arg *file_properties.AddTemplateArg, opts ...dropbox.CallOption) (res *file_properties.AddTemplateResult, err error)
 	// PropertiesTemplateGet : Permission : Team member file access. The scope
 	// for the route is files.team_metadata.write.
 	// Deprecated:
-	PropertiesTemplateGet(arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error)
+	PropertiesTemplateGet(arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error)
+	// PropertiesTemplateGetContext is like PropertiesTemplateGet but takes a context for cancellation and deadlines.
+	PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error)
 	// PropertiesTemplateList : Permission : Team member file access. The scope
 	// for the route is files.team_metadata.write.
 	// Deprecated:
-	PropertiesTemplateList() (res *file_properties.ListTemplateResult, err error)
+	PropertiesTemplateList(opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error)
+	// PropertiesTemplateListContext is like PropertiesTemplateList but takes a context for cancellation and deadlines.
+	PropertiesTemplateListContext(ctx context.Context, opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error)
 	// PropertiesTemplateUpdate : Permission : Team member file access.
 	// Deprecated:
-	PropertiesTemplateUpdate(arg *file_properties.UpdateTemplateArg) (res *file_properties.UpdateTemplateResult, err error)
+	PropertiesTemplateUpdate(arg *file_properties.UpdateTemplateArg, opts ...dropbox.CallOption) (res *file_properties.UpdateTemplateResult, err error)
+	// PropertiesTemplateUpdateContext is like PropertiesTemplateUpdate but takes a context for cancellation and deadlines.
+	PropertiesTemplateUpdateContext(ctx context.Context, arg *file_properties.UpdateTemplateArg, opts ...dropbox.CallOption) (res *file_properties.UpdateTemplateResult, err error)
 	// ReportsGetActivity : Retrieves reporting data about a team's user
 	// activity. Deprecated: Will be removed on July 1st 2021.
 	// Deprecated:
-	ReportsGetActivity(arg *DateRange) (res *GetActivityReport, err error)
+	ReportsGetActivity(arg *DateRange, opts ...dropbox.CallOption) (res *GetActivityReport, err error)
+	// ReportsGetActivityContext is like ReportsGetActivity but takes a context for cancellation and deadlines.
+	ReportsGetActivityContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetActivityReport, err error)
 	// ReportsGetDevices : Retrieves reporting data about a team's linked
 	// devices. Deprecated: Will be removed on July 1st 2021.
 	// Deprecated:
-	ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, err error)
+	ReportsGetDevices(arg *DateRange, opts ...dropbox.CallOption) (res *GetDevicesReport, err error)
+	// ReportsGetDevicesContext is like ReportsGetDevices but takes a context for cancellation and deadlines.
+	ReportsGetDevicesContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetDevicesReport, err error)
 	// ReportsGetMembership : Retrieves reporting data about a team's
 	// membership. Deprecated: Will be removed on July 1st 2021.
 	// Deprecated:
-	ReportsGetMembership(arg *DateRange) (res *GetMembershipReport, err error)
+	ReportsGetMembership(arg *DateRange, opts ...dropbox.CallOption) (res *GetMembershipReport, err error)
+	// ReportsGetMembershipContext is like ReportsGetMembership but takes a context for cancellation and deadlines.
+	ReportsGetMembershipContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetMembershipReport, err error)
 	// ReportsGetStorage : Retrieves reporting data about a team's storage
 	// usage. Deprecated: Will be removed on July 1st 2021.
 	// Deprecated:
-	ReportsGetStorage(arg *DateRange) (res *GetStorageReport, err error)
+	ReportsGetStorage(arg *DateRange, opts ...dropbox.CallOption) (res *GetStorageReport, err error)
+	// ReportsGetStorageContext is like ReportsGetStorage but takes a context for cancellation and deadlines.
+	ReportsGetStorageContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetStorageReport, err error)
 	// TeamFolderActivate : Sets an archived team folder's status to active.
 	// Permission : Team member file access.
-	TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error)
+	TeamFolderActivate(arg *TeamFolderIdArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
+	// TeamFolderActivateContext is like TeamFolderActivate but takes a context for cancellation and deadlines.
+	TeamFolderActivateContext(ctx context.Context, arg *TeamFolderIdArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
 	// TeamFolderArchive : Sets an active team folder's status to archived and
 	// removes all folder and file members. This endpoint cannot be used for
 	// teams that have a shared team space. Permission : Team member file
 	// access.
-	TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error)
+	TeamFolderArchive(arg *TeamFolderArchiveArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveLaunch, err error)
+	// TeamFolderArchiveContext is like TeamFolderArchive but takes a context for cancellation and deadlines.
+	TeamFolderArchiveContext(ctx context.Context, arg *TeamFolderArchiveArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveLaunch, err error)
 	// TeamFolderArchiveCheck : Returns the status of an asynchronous job for
 	// archiving a team folder. Permission : Team member file access.
-	TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error)
+	TeamFolderArchiveCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveJobStatus, err error)
+	// TeamFolderArchiveCheckContext is like TeamFolderArchiveCheck but takes a context for cancellation and deadlines.
+	TeamFolderArchiveCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveJobStatus, err error)
 	// TeamFolderCreate : Creates a new, active, team folder with no members.
 	// This endpoint can only be used for teams that do not already have a
 	// shared team space. Permission : Team member file access.
-	TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error)
+	TeamFolderCreate(arg *TeamFolderCreateArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
+	// TeamFolderCreateContext is like TeamFolderCreate but takes a context for cancellation and deadlines.
+	TeamFolderCreateContext(ctx context.Context, arg *TeamFolderCreateArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
 	// TeamFolderGetInfo : Retrieves metadata for team folders. Permission :
 	// Team member file access.
-	TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error)
+	TeamFolderGetInfo(arg *TeamFolderIdListArg, opts ...dropbox.CallOption) (res []*TeamFolderGetInfoItem, err error)
+	// TeamFolderGetInfoContext is like TeamFolderGetInfo but takes a context for cancellation and deadlines.
+	TeamFolderGetInfoContext(ctx context.Context, arg *TeamFolderIdListArg, opts ...dropbox.CallOption) (res []*TeamFolderGetInfoItem, err error)
 	// TeamFolderList : Lists all team folders. Permission : Team member file
 	// access.
-	TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListResult, err error)
+	TeamFolderList(arg *TeamFolderListArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error)
+	// TeamFolderListContext is like TeamFolderList but takes a context for cancellation and deadlines.
+	TeamFolderListContext(ctx context.Context, arg *TeamFolderListArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error)
 	// TeamFolderListContinue : Once a cursor has been retrieved from
 	// `teamFolderList`, use this to paginate through all team folders.
 	// Permission : Team member file access.
-	TeamFolderListContinue(arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error)
+	TeamFolderListContinue(arg *TeamFolderListContinueArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error)
+	// TeamFolderListContinueContext is like TeamFolderListContinue but takes a context for cancellation and deadlines.
+	TeamFolderListContinueContext(ctx context.Context, arg *TeamFolderListContinueArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error)
 	// TeamFolderPermanentlyDelete : Permanently deletes an archived team
 	// folder. This endpoint cannot be used for teams that have a shared team
 	// space. Permission : Team member file access.
-	TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error)
+	TeamFolderPermanentlyDelete(arg *TeamFolderIdArg, opts ...dropbox.CallOption) (err error)
+	// TeamFolderPermanentlyDeleteContext is like TeamFolderPermanentlyDelete but takes a context for cancellation and deadlines.
+	TeamFolderPermanentlyDeleteContext(ctx context.Context, arg *TeamFolderIdArg, opts ...dropbox.CallOption) (err error)
 	// TeamFolderRename : Changes an active team folder's name. Permission :
 	// Team member file access.
-	TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error)
+	TeamFolderRename(arg *TeamFolderRenameArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
+	// TeamFolderRenameContext is like TeamFolderRename but takes a context for cancellation and deadlines.
+	TeamFolderRenameContext(ctx context.Context, arg *TeamFolderRenameArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
 	// TeamFolderUpdateSyncSettings : Updates the sync settings on a team folder
 	// or its contents.  Use of this endpoint requires that the team has team
 	// selective sync enabled.
-	TeamFolderUpdateSyncSettings(arg *TeamFolderUpdateSyncSettingsArg) (res *TeamFolderMetadata, err error)
+	TeamFolderUpdateSyncSettings(arg *TeamFolderUpdateSyncSettingsArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
+	// TeamFolderUpdateSyncSettingsContext is like TeamFolderUpdateSyncSettings but takes a context for cancellation and deadlines.
+	TeamFolderUpdateSyncSettingsContext(ctx context.Context, arg *TeamFolderUpdateSyncSettingsArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
 	// TokenGetAuthenticatedAdmin : Returns the member profile of the admin who
 	// generated the team access token used to make the call.
-	TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdminResult, err error)
+	TokenGetAuthenticatedAdmin(opts ...dropbox.CallOption) (res *TokenGetAuthenticatedAdminResult, err error)
+	// TokenGetAuthenticatedAdminContext is like TokenGetAuthenticatedAdmin but takes a context for cancellation and deadlines.
+	TokenGetAuthenticatedAdminContext(ctx context.Context, opts ...dropbox.CallOption) (res *TokenGetAuthenticatedAdminResult, err error)
+}
+
+// Sentinel errors for the tags of unions that are nested in the errors of
+// many routes. The errors of all routes match them with errors.Is if their
+// endpoint error contains the union with the tag.
+var (
+	ErrTeamFolderAccessInvalidTeamFolderId      error = endpointErrorTag{"TeamFolderAccessError", TeamFolderAccessErrorInvalidTeamFolderId}
+	ErrTeamFolderAccessNoAccess                 error = endpointErrorTag{"TeamFolderAccessError", TeamFolderAccessErrorNoAccess}
+	ErrTeamFolderInvalidStatusActive            error = endpointErrorTag{"TeamFolderInvalidStatusError", TeamFolderInvalidStatusErrorActive}
+	ErrTeamFolderInvalidStatusArchived          error = endpointErrorTag{"TeamFolderInvalidStatusError", TeamFolderInvalidStatusErrorArchived}
+	ErrTeamFolderInvalidStatusArchiveInProgress error = endpointErrorTag{"TeamFolderInvalidStatusError", TeamFolderInvalidStatusErrorArchiveInProgress}
+	ErrTeamFolderTeamSharedDropboxDisallowed    error = endpointErrorTag{"TeamFolderTeamSharedDropboxError", TeamFolderTeamSharedDropboxErrorDisallowed}
+)
+
+// endpointErrorTag matches the unions of a type with a tag.
+type endpointErrorTag struct {
+	union string
+	tag   string
+}
+
+func (e endpointErrorTag) Error() string {
+	return "team: " + e.union + " " + e.tag
+}
+
+// MatchEndpointError implements dropbox.EndpointErrorMatcher.
+func (e endpointErrorTag) MatchEndpointError(endpointError interface{}) bool {
+	switch err := endpointError.(type) {
+	case *TeamFolderAccessError:
+		return e.union == "TeamFolderAccessError" && err.Tag == e.tag
+	case *TeamFolderInvalidStatusError:
+		return e.union == "TeamFolderInvalidStatusError" && err.Tag == e.tag
+	case *TeamFolderTeamSharedDropboxError:
+		return e.union == "TeamFolderTeamSharedDropboxError" && err.Tag == e.tag
+	}
+	return false
 }
 
 type apiImpl dropbox.Context
@@ -399,7 +613,26 @@ type DevicesListMemberDevicesAPIError struct {
 	EndpointError *ListMemberDevicesError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *ListMemberDevicesResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DevicesListMemberDevicesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DevicesListMemberDevicesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DevicesListMemberDevices(arg *ListMemberDevicesArg, opts ...dropbox.CallOption) (res *ListMemberDevicesResult, err error) {
+	return dbx.DevicesListMemberDevicesContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DevicesListMemberDevicesContext(ctx context.Context, arg *ListMemberDevicesArg, opts ...dropbox.CallOption) (res *ListMemberDevicesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -408,11 +641,12 @@ func (dbx *apiImpl) DevicesListMemberDevices(arg *ListMemberDevicesArg) (res *Li
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DevicesListMemberDevicesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -437,7 +671,26 @@ type DevicesListMembersDevicesAPIError struct {
 	EndpointError *ListMembersDevicesError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *ListMembersDevicesResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DevicesListMembersDevicesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DevicesListMembersDevicesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DevicesListMembersDevices(arg *ListMembersDevicesArg, opts ...dropbox.CallOption) (res *ListMembersDevicesResult, err error) {
+	return dbx.DevicesListMembersDevicesContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DevicesListMembersDevicesContext(ctx context.Context, arg *ListMembersDevicesArg, opts ...dropbox.CallOption) (res *ListMembersDevicesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -446,11 +699,12 @@ func (dbx *apiImpl) DevicesListMembersDevices(arg *ListMembersDevicesArg) (res *
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DevicesListMembersDevicesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -475,7 +729,26 @@ type DevicesListTeamDevicesAPIError struct {
 	EndpointError *ListTeamDevicesError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTeamDevicesResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DevicesListTeamDevicesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DevicesListTeamDevicesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DevicesListTeamDevices(arg *ListTeamDevicesArg, opts ...dropbox.CallOption) (res *ListTeamDevicesResult, err error) {
+	return dbx.DevicesListTeamDevicesContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DevicesListTeamDevicesContext(ctx context.Context, arg *ListTeamDevicesArg, opts ...dropbox.CallOption) (res *ListTeamDevicesResult, err error) {
 	log.Printf("WARNING: API `DevicesListTeamDevices` is deprecated")
 	log.Printf("Use API `DevicesListMembersDevices` instead")
 
@@ -487,11 +760,12 @@ func (dbx *apiImpl) DevicesListTeamDevices(arg *ListTeamDevicesArg) (res *ListTe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DevicesListTeamDevicesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -516,7 +790,26 @@ type DevicesRevokeDeviceSessionAPIError struct {
 	EndpointError *RevokeDeviceSessionError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DevicesRevokeDeviceSessionAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DevicesRevokeDeviceSessionAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.DevicesRevokeDeviceSessionContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DevicesRevokeDeviceSessionContext(ctx context.Context, arg *RevokeDeviceSessionArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -525,11 +818,12 @@ func (dbx *apiImpl) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg) (err
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DevicesRevokeDeviceSessionAPIError
 		err = auth.ParseError(err, &appErr)
@@ -550,7 +844,26 @@ type DevicesRevokeDeviceSessionBatchAPIError struct {
 	EndpointError *RevokeDeviceSessionBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg) (res *RevokeDeviceSessionBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *DevicesRevokeDeviceSessionBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e DevicesRevokeDeviceSessionBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg, opts ...dropbox.CallOption) (res *RevokeDeviceSessionBatchResult, err error) {
+	return dbx.DevicesRevokeDeviceSessionBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) DevicesRevokeDeviceSessionBatchContext(ctx context.Context, arg *RevokeDeviceSessionBatchArg, opts ...dropbox.CallOption) (res *RevokeDeviceSessionBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -559,11 +872,12 @@ func (dbx *apiImpl) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatc
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr DevicesRevokeDeviceSessionBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -588,7 +902,26 @@ type FeaturesGetValuesAPIError struct {
 	EndpointError *FeaturesGetValuesBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *FeaturesGetValuesBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *FeaturesGetValuesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e FeaturesGetValuesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) FeaturesGetValues(arg *FeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *FeaturesGetValuesBatchResult, err error) {
+	return dbx.FeaturesGetValuesContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) FeaturesGetValuesContext(ctx context.Context, arg *FeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *FeaturesGetValuesBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -597,11 +930,12 @@ func (dbx *apiImpl) FeaturesGetValues(arg *FeaturesGetValuesBatchArg) (res *Feat
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr FeaturesGetValuesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -626,7 +960,16 @@ type GetInfoAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GetInfo() (res *TeamGetInfoResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetInfoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) GetInfo(opts ...dropbox.CallOption) (res *TeamGetInfoResult, err error) {
+	return dbx.GetInfoContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) GetInfoContext(ctx context.Context, opts ...dropbox.CallOption) (res *TeamGetInfoResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -635,11 +978,12 @@ func (dbx *apiImpl) GetInfo() (res *TeamGetInfoResult, err error) {
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetInfoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -664,7 +1008,26 @@ type GroupsCreateAPIError struct {
 	EndpointError *GroupCreateError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsCreateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsCreateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsCreate(arg *GroupCreateArg, opts ...dropbox.CallOption) (res *GroupFullInfo, err error) {
+	return dbx.GroupsCreateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsCreateContext(ctx context.Context, arg *GroupCreateArg, opts ...dropbox.CallOption) (res *GroupFullInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -673,11 +1036,12 @@ func (dbx *apiImpl) GroupsCreate(arg *GroupCreateArg) (res *GroupFullInfo, err e
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsCreateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -702,7 +1066,26 @@ type GroupsDeleteAPIError struct {
 	EndpointError *GroupDeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsDeleteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsDeleteAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsDelete(arg *GroupSelector, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return dbx.GroupsDeleteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsDeleteContext(ctx context.Context, arg *GroupSelector, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -711,11 +1094,12 @@ func (dbx *apiImpl) GroupsDelete(arg *GroupSelector) (res *async.LaunchEmptyResu
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsDeleteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -740,7 +1124,26 @@ type GroupsGetInfoAPIError struct {
 	EndpointError *GroupsGetInfoError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsGetInfoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsGetInfoAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsGetInfo(arg *GroupsSelector, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error) {
+	return dbx.GroupsGetInfoContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsGetInfoContext(ctx context.Context, arg *GroupsSelector, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -749,11 +1152,12 @@ func (dbx *apiImpl) GroupsGetInfo(arg *GroupsSelector) (res []*GroupsGetInfoItem
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsGetInfoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -778,7 +1182,26 @@ type GroupsJobStatusGetAPIError struct {
 	EndpointError *GroupsPollError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsJobStatusGetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsJobStatusGetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	return dbx.GroupsJobStatusGetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -787,11 +1210,12 @@ func (dbx *apiImpl) GroupsJobStatusGet(arg *async.PollArg) (res *async.PollEmpty
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsJobStatusGetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -816,7 +1240,16 @@ type GroupsListAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsList(arg *GroupsListArg) (res *GroupsListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) GroupsList(arg *GroupsListArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error) {
+	return dbx.GroupsListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsListContext(ctx context.Context, arg *GroupsListArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -825,11 +1258,12 @@ func (dbx *apiImpl) GroupsList(arg *GroupsListArg) (res *GroupsListResult, err e
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -854,7 +1288,26 @@ type GroupsListContinueAPIError struct {
 	EndpointError *GroupsListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsListContinue(arg *GroupsListContinueArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error) {
+	return dbx.GroupsListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsListContinueContext(ctx context.Context, arg *GroupsListContinueArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -863,11 +1316,12 @@ func (dbx *apiImpl) GroupsListContinue(arg *GroupsListContinueArg) (res *GroupsL
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -892,7 +1346,26 @@ type GroupsMembersAddAPIError struct {
 	EndpointError *GroupMembersAddError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembersChangeResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsMembersAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsMembersAddAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsMembersAdd(arg *GroupMembersAddArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
+	return dbx.GroupsMembersAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsMembersAddContext(ctx context.Context, arg *GroupMembersAddArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -901,11 +1374,12 @@ func (dbx *apiImpl) GroupsMembersAdd(arg *GroupMembersAddArg) (res *GroupMembers
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsMembersAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -930,7 +1404,26 @@ type GroupsMembersListAPIError struct {
 	EndpointError *GroupSelectorError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMembersListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsMembersListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsMembersListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsMembersList(arg *GroupsMembersListArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error) {
+	return dbx.GroupsMembersListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsMembersListContext(ctx context.Context, arg *GroupsMembersListArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -939,11 +1432,12 @@ func (dbx *apiImpl) GroupsMembersList(arg *GroupsMembersListArg) (res *GroupsMem
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsMembersListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -968,7 +1462,26 @@ type GroupsMembersListContinueAPIError struct {
 	EndpointError *GroupsMembersListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersListContinue(arg *GroupsMembersListContinueArg) (res *GroupsMembersListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsMembersListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsMembersListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsMembersListContinue(arg *GroupsMembersListContinueArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error) {
+	return dbx.GroupsMembersListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsMembersListContinueContext(ctx context.Context, arg *GroupsMembersListContinueArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -977,11 +1490,12 @@ func (dbx *apiImpl) GroupsMembersListContinue(arg *GroupsMembersListContinueArg)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsMembersListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1006,7 +1520,26 @@ type GroupsMembersRemoveAPIError struct {
 	EndpointError *GroupMembersRemoveError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupMembersChangeResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsMembersRemoveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsMembersRemoveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsMembersRemove(arg *GroupMembersRemoveArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
+	return dbx.GroupsMembersRemoveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsMembersRemoveContext(ctx context.Context, arg *GroupMembersRemoveArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1015,11 +1548,12 @@ func (dbx *apiImpl) GroupsMembersRemove(arg *GroupMembersRemoveArg) (res *GroupM
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsMembersRemoveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1044,7 +1578,26 @@ type GroupsMembersSetAccessTypeAPIError struct {
 	EndpointError *GroupMemberSetAccessTypeError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg) (res []*GroupsGetInfoItem, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsMembersSetAccessTypeAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsMembersSetAccessTypeAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error) {
+	return dbx.GroupsMembersSetAccessTypeContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsMembersSetAccessTypeContext(ctx context.Context, arg *GroupMembersSetAccessTypeArg, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1053,11 +1606,12 @@ func (dbx *apiImpl) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsMembersSetAccessTypeAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1082,7 +1636,26 @@ type GroupsUpdateAPIError struct {
 	EndpointError *GroupUpdateError `json:"error"`
 }
 
-func (dbx *apiImpl) GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GroupsUpdateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GroupsUpdateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GroupsUpdate(arg *GroupUpdateArgs, opts ...dropbox.CallOption) (res *GroupFullInfo, err error) {
+	return dbx.GroupsUpdateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GroupsUpdateContext(ctx context.Context, arg *GroupUpdateArgs, opts ...dropbox.CallOption) (res *GroupFullInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1091,11 +1664,12 @@ func (dbx *apiImpl) GroupsUpdate(arg *GroupUpdateArgs) (res *GroupFullInfo, err
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GroupsUpdateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1120,7 +1694,26 @@ type LegalHoldsCreatePolicyAPIError struct {
 	EndpointError *LegalHoldsPolicyCreateError `json:"error"`
 }
 
-func (dbx *apiImpl) LegalHoldsCreatePolicy(arg *LegalHoldsPolicyCreateArg) (res *LegalHoldPolicy, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LegalHoldsCreatePolicyAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LegalHoldsCreatePolicyAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LegalHoldsCreatePolicy(arg *LegalHoldsPolicyCreateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	return dbx.LegalHoldsCreatePolicyContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LegalHoldsCreatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyCreateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1129,11 +1722,12 @@ func (dbx *apiImpl) LegalHoldsCreatePolicy(arg *LegalHoldsPolicyCreateArg) (res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LegalHoldsCreatePolicyAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1158,7 +1752,26 @@ type LegalHoldsGetPolicyAPIError struct {
 	EndpointError *LegalHoldsGetPolicyError `json:"error"`
 }
 
-func (dbx *apiImpl) LegalHoldsGetPolicy(arg *LegalHoldsGetPolicyArg) (res *LegalHoldPolicy, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LegalHoldsGetPolicyAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LegalHoldsGetPolicyAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LegalHoldsGetPolicy(arg *LegalHoldsGetPolicyArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	return dbx.LegalHoldsGetPolicyContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LegalHoldsGetPolicyContext(ctx context.Context, arg *LegalHoldsGetPolicyArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1167,11 +1780,12 @@ func (dbx *apiImpl) LegalHoldsGetPolicy(arg *LegalHoldsGetPolicyArg) (res *Legal
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LegalHoldsGetPolicyAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1196,7 +1810,26 @@ type LegalHoldsListHeldRevisionsAPIError struct {
 	EndpointError *LegalHoldsListHeldRevisionsError `json:"error"`
 }
 
-func (dbx *apiImpl) LegalHoldsListHeldRevisions(arg *LegalHoldsListHeldRevisionsArg) (res *LegalHoldsListHeldRevisionResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LegalHoldsListHeldRevisionsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LegalHoldsListHeldRevisionsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LegalHoldsListHeldRevisions(arg *LegalHoldsListHeldRevisionsArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error) {
+	return dbx.LegalHoldsListHeldRevisionsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LegalHoldsListHeldRevisionsContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1205,11 +1838,12 @@ func (dbx *apiImpl) LegalHoldsListHeldRevisions(arg *LegalHoldsListHeldRevisions
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LegalHoldsListHeldRevisionsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1234,7 +1868,26 @@ type LegalHoldsListHeldRevisionsContinueAPIError struct {
 	EndpointError *LegalHoldsListHeldRevisionsError `json:"error"`
 }
 
-func (dbx *apiImpl) LegalHoldsListHeldRevisionsContinue(arg *LegalHoldsListHeldRevisionsContinueArg) (res *LegalHoldsListHeldRevisionResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LegalHoldsListHeldRevisionsContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LegalHoldsListHeldRevisionsContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LegalHoldsListHeldRevisionsContinue(arg *LegalHoldsListHeldRevisionsContinueArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error) {
+	return dbx.LegalHoldsListHeldRevisionsContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LegalHoldsListHeldRevisionsContinueContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsContinueArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1243,11 +1896,12 @@ func (dbx *apiImpl) LegalHoldsListHeldRevisionsContinue(arg *LegalHoldsListHeldR
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LegalHoldsListHeldRevisionsContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1272,7 +1926,26 @@ type LegalHoldsListPoliciesAPIError struct {
 	EndpointError *LegalHoldsListPoliciesError `json:"error"`
 }
 
-func (dbx *apiImpl) LegalHoldsListPolicies(arg *LegalHoldsListPoliciesArg) (res *LegalHoldsListPoliciesResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LegalHoldsListPoliciesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LegalHoldsListPoliciesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LegalHoldsListPolicies(arg *LegalHoldsListPoliciesArg, opts ...dropbox.CallOption) (res *LegalHoldsListPoliciesResult, err error) {
+	return dbx.LegalHoldsListPoliciesContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LegalHoldsListPoliciesContext(ctx context.Context, arg *LegalHoldsListPoliciesArg, opts ...dropbox.CallOption) (res *LegalHoldsListPoliciesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1281,11 +1954,12 @@ func (dbx *apiImpl) LegalHoldsListPolicies(arg *LegalHoldsListPoliciesArg) (res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LegalHoldsListPoliciesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1310,7 +1984,26 @@ type LegalHoldsReleasePolicyAPIError struct {
 	EndpointError *LegalHoldsPolicyReleaseError `json:"error"`
 }
 
-func (dbx *apiImpl) LegalHoldsReleasePolicy(arg *LegalHoldsPolicyReleaseArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LegalHoldsReleasePolicyAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LegalHoldsReleasePolicyAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LegalHoldsReleasePolicy(arg *LegalHoldsPolicyReleaseArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.LegalHoldsReleasePolicyContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LegalHoldsReleasePolicyContext(ctx context.Context, arg *LegalHoldsPolicyReleaseArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1319,11 +2012,12 @@ func (dbx *apiImpl) LegalHoldsReleasePolicy(arg *LegalHoldsPolicyReleaseArg) (er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LegalHoldsReleasePolicyAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1344,7 +2038,26 @@ type LegalHoldsUpdatePolicyAPIError struct {
 	EndpointError *LegalHoldsPolicyUpdateError `json:"error"`
 }
 
-func (dbx *apiImpl) LegalHoldsUpdatePolicy(arg *LegalHoldsPolicyUpdateArg) (res *LegalHoldPolicy, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LegalHoldsUpdatePolicyAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LegalHoldsUpdatePolicyAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LegalHoldsUpdatePolicy(arg *LegalHoldsPolicyUpdateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	return dbx.LegalHoldsUpdatePolicyContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LegalHoldsUpdatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyUpdateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1353,11 +2066,12 @@ func (dbx *apiImpl) LegalHoldsUpdatePolicy(arg *LegalHoldsPolicyUpdateArg) (res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LegalHoldsUpdatePolicyAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1382,7 +2096,26 @@ type LinkedAppsListMemberLinkedAppsAPIError struct {
 	EndpointError *ListMemberAppsError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res *ListMemberAppsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LinkedAppsListMemberLinkedAppsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LinkedAppsListMemberLinkedAppsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg, opts ...dropbox.CallOption) (res *ListMemberAppsResult, err error) {
+	return dbx.LinkedAppsListMemberLinkedAppsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LinkedAppsListMemberLinkedAppsContext(ctx context.Context, arg *ListMemberAppsArg, opts ...dropbox.CallOption) (res *ListMemberAppsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1391,11 +2124,12 @@ func (dbx *apiImpl) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg) (res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LinkedAppsListMemberLinkedAppsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1420,7 +2154,26 @@ type LinkedAppsListMembersLinkedAppsAPIError struct {
 	EndpointError *ListMembersAppsError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (res *ListMembersAppsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LinkedAppsListMembersLinkedAppsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LinkedAppsListMembersLinkedAppsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg, opts ...dropbox.CallOption) (res *ListMembersAppsResult, err error) {
+	return dbx.LinkedAppsListMembersLinkedAppsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LinkedAppsListMembersLinkedAppsContext(ctx context.Context, arg *ListMembersAppsArg, opts ...dropbox.CallOption) (res *ListMembersAppsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1429,11 +2182,12 @@ func (dbx *apiImpl) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg) (re
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LinkedAppsListMembersLinkedAppsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1458,7 +2212,26 @@ type LinkedAppsListTeamLinkedAppsAPIError struct {
 	EndpointError *ListTeamAppsError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *ListTeamAppsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LinkedAppsListTeamLinkedAppsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LinkedAppsListTeamLinkedAppsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg, opts ...dropbox.CallOption) (res *ListTeamAppsResult, err error) {
+	return dbx.LinkedAppsListTeamLinkedAppsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LinkedAppsListTeamLinkedAppsContext(ctx context.Context, arg *ListTeamAppsArg, opts ...dropbox.CallOption) (res *ListTeamAppsResult, err error) {
 	log.Printf("WARNING: API `LinkedAppsListTeamLinkedApps` is deprecated")
 	log.Printf("Use API `LinkedAppsListMembersLinkedApps` instead")
 
@@ -1470,11 +2243,12 @@ func (dbx *apiImpl) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg) (res *Lis
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LinkedAppsListTeamLinkedAppsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1499,7 +2273,26 @@ type LinkedAppsRevokeLinkedAppAPIError struct {
 	EndpointError *RevokeLinkedAppError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LinkedAppsRevokeLinkedAppAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LinkedAppsRevokeLinkedAppAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.LinkedAppsRevokeLinkedAppContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LinkedAppsRevokeLinkedAppContext(ctx context.Context, arg *RevokeLinkedApiAppArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1508,11 +2301,12 @@ func (dbx *apiImpl) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg) (err e
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LinkedAppsRevokeLinkedAppAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1533,7 +2327,26 @@ type LinkedAppsRevokeLinkedAppBatchAPIError struct {
 	EndpointError *RevokeLinkedAppBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg) (res *RevokeLinkedAppBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *LinkedAppsRevokeLinkedAppBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e LinkedAppsRevokeLinkedAppBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg, opts ...dropbox.CallOption) (res *RevokeLinkedAppBatchResult, err error) {
+	return dbx.LinkedAppsRevokeLinkedAppBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatchContext(ctx context.Context, arg *RevokeLinkedApiAppBatchArg, opts ...dropbox.CallOption) (res *RevokeLinkedAppBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1542,11 +2355,12 @@ func (dbx *apiImpl) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchA
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr LinkedAppsRevokeLinkedAppBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1571,7 +2385,26 @@ type MemberSpaceLimitsExcludedUsersAddAPIError struct {
 	EndpointError *ExcludedUsersUpdateError `json:"error"`
 }
 
-func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersAdd(arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MemberSpaceLimitsExcludedUsersAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MemberSpaceLimitsExcludedUsersAddAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersAdd(arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error) {
+	return dbx.MemberSpaceLimitsExcludedUsersAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersAddContext(ctx context.Context, arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1580,11 +2413,12 @@ func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersAdd(arg *ExcludedUsersUpdateAr
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MemberSpaceLimitsExcludedUsersAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1609,7 +2443,26 @@ type MemberSpaceLimitsExcludedUsersListAPIError struct {
 	EndpointError *ExcludedUsersListError `json:"error"`
 }
 
-func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersList(arg *ExcludedUsersListArg) (res *ExcludedUsersListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MemberSpaceLimitsExcludedUsersListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MemberSpaceLimitsExcludedUsersListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersList(arg *ExcludedUsersListArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error) {
+	return dbx.MemberSpaceLimitsExcludedUsersListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersListContext(ctx context.Context, arg *ExcludedUsersListArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1618,11 +2471,12 @@ func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersList(arg *ExcludedUsersListArg
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MemberSpaceLimitsExcludedUsersListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1647,7 +2501,26 @@ type MemberSpaceLimitsExcludedUsersListContinueAPIError struct {
 	EndpointError *ExcludedUsersListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersListContinue(arg *ExcludedUsersListContinueArg) (res *ExcludedUsersListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MemberSpaceLimitsExcludedUsersListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MemberSpaceLimitsExcludedUsersListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersListContinue(arg *ExcludedUsersListContinueArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error) {
+	return dbx.MemberSpaceLimitsExcludedUsersListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersListContinueContext(ctx context.Context, arg *ExcludedUsersListContinueArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1656,11 +2529,12 @@ func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersListContinue(arg *ExcludedUser
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MemberSpaceLimitsExcludedUsersListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1685,7 +2559,26 @@ type MemberSpaceLimitsExcludedUsersRemoveAPIError struct {
 	EndpointError *ExcludedUsersUpdateError `json:"error"`
 }
 
-func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersRemove(arg *ExcludedUsersUpdateArg) (res *ExcludedUsersUpdateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MemberSpaceLimitsExcludedUsersRemoveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MemberSpaceLimitsExcludedUsersRemoveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersRemove(arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error) {
+	return dbx.MemberSpaceLimitsExcludedUsersRemoveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersRemoveContext(ctx context.Context, arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1694,11 +2587,12 @@ func (dbx *apiImpl) MemberSpaceLimitsExcludedUsersRemove(arg *ExcludedUsersUpdat
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MemberSpaceLimitsExcludedUsersRemoveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1723,7 +2617,26 @@ type MemberSpaceLimitsGetCustomQuotaAPIError struct {
 	EndpointError *CustomQuotaError `json:"error"`
 }
 
-func (dbx *apiImpl) MemberSpaceLimitsGetCustomQuota(arg *CustomQuotaUsersArg) (res []*CustomQuotaResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MemberSpaceLimitsGetCustomQuotaAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MemberSpaceLimitsGetCustomQuotaAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsGetCustomQuota(arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error) {
+	return dbx.MemberSpaceLimitsGetCustomQuotaContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsGetCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1732,11 +2645,12 @@ func (dbx *apiImpl) MemberSpaceLimitsGetCustomQuota(arg *CustomQuotaUsersArg) (r
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MemberSpaceLimitsGetCustomQuotaAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1761,7 +2675,26 @@ type MemberSpaceLimitsRemoveCustomQuotaAPIError struct {
 	EndpointError *CustomQuotaError `json:"error"`
 }
 
-func (dbx *apiImpl) MemberSpaceLimitsRemoveCustomQuota(arg *CustomQuotaUsersArg) (res []*RemoveCustomQuotaResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MemberSpaceLimitsRemoveCustomQuotaAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MemberSpaceLimitsRemoveCustomQuotaAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsRemoveCustomQuota(arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*RemoveCustomQuotaResult, err error) {
+	return dbx.MemberSpaceLimitsRemoveCustomQuotaContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsRemoveCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*RemoveCustomQuotaResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1770,11 +2703,12 @@ func (dbx *apiImpl) MemberSpaceLimitsRemoveCustomQuota(arg *CustomQuotaUsersArg)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MemberSpaceLimitsRemoveCustomQuotaAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1799,7 +2733,26 @@ type MemberSpaceLimitsSetCustomQuotaAPIError struct {
 	EndpointError *SetCustomQuotaError `json:"error"`
 }
 
-func (dbx *apiImpl) MemberSpaceLimitsSetCustomQuota(arg *SetCustomQuotaArg) (res []*CustomQuotaResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MemberSpaceLimitsSetCustomQuotaAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MemberSpaceLimitsSetCustomQuotaAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsSetCustomQuota(arg *SetCustomQuotaArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error) {
+	return dbx.MemberSpaceLimitsSetCustomQuotaContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MemberSpaceLimitsSetCustomQuotaContext(ctx context.Context, arg *SetCustomQuotaArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1808,11 +2761,12 @@ func (dbx *apiImpl) MemberSpaceLimitsSetCustomQuota(arg *SetCustomQuotaArg) (res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MemberSpaceLimitsSetCustomQuotaAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1837,7 +2791,16 @@ type MembersAddV2APIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MembersAddV2(arg *MembersAddV2Arg) (res *MembersAddLaunchV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersAddV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) MembersAddV2(arg *MembersAddV2Arg, opts ...dropbox.CallOption) (res *MembersAddLaunchV2Result, err error) {
+	return dbx.MembersAddV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersAddV2Context(ctx context.Context, arg *MembersAddV2Arg, opts ...dropbox.CallOption) (res *MembersAddLaunchV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1846,11 +2809,12 @@ func (dbx *apiImpl) MembersAddV2(arg *MembersAddV2Arg) (res *MembersAddLaunchV2R
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersAddV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -1875,7 +2839,16 @@ type MembersAddAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) MembersAdd(arg *MembersAddArg, opts ...dropbox.CallOption) (res *MembersAddLaunch, err error) {
+	return dbx.MembersAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersAddContext(ctx context.Context, arg *MembersAddArg, opts ...dropbox.CallOption) (res *MembersAddLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1884,11 +2857,12 @@ func (dbx *apiImpl) MembersAdd(arg *MembersAddArg) (res *MembersAddLaunch, err e
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1913,7 +2887,26 @@ type MembersAddJobStatusGetV2APIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersAddJobStatusGetV2(arg *async.PollArg) (res *MembersAddJobStatusV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersAddJobStatusGetV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersAddJobStatusGetV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersAddJobStatusGetV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatusV2Result, err error) {
+	return dbx.MembersAddJobStatusGetV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersAddJobStatusGetV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatusV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1922,11 +2915,12 @@ func (dbx *apiImpl) MembersAddJobStatusGetV2(arg *async.PollArg) (res *MembersAd
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersAddJobStatusGetV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -1951,7 +2945,26 @@ type MembersAddJobStatusGetAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersAddJobStatusGetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersAddJobStatusGetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersAddJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatus, err error) {
+	return dbx.MembersAddJobStatusGetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersAddJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1960,11 +2973,12 @@ func (dbx *apiImpl) MembersAddJobStatusGet(arg *async.PollArg) (res *MembersAddJ
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersAddJobStatusGetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -1989,7 +3003,26 @@ type MembersDeleteProfilePhotoV2APIError struct {
 	EndpointError *MembersDeleteProfilePhotoError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersDeleteProfilePhotoV2(arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfoV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersDeleteProfilePhotoV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersDeleteProfilePhotoV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersDeleteProfilePhotoV2(arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	return dbx.MembersDeleteProfilePhotoV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersDeleteProfilePhotoV2Context(ctx context.Context, arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -1998,11 +3031,12 @@ func (dbx *apiImpl) MembersDeleteProfilePhotoV2(arg *MembersDeleteProfilePhotoAr
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersDeleteProfilePhotoV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2027,7 +3061,26 @@ type MembersDeleteProfilePhotoAPIError struct {
 	EndpointError *MembersDeleteProfilePhotoError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersDeleteProfilePhoto(arg *MembersDeleteProfilePhotoArg) (res *TeamMemberInfo, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersDeleteProfilePhotoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersDeleteProfilePhotoAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersDeleteProfilePhoto(arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	return dbx.MembersDeleteProfilePhotoContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersDeleteProfilePhotoContext(ctx context.Context, arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2036,11 +3089,12 @@ func (dbx *apiImpl) MembersDeleteProfilePhoto(arg *MembersDeleteProfilePhotoArg)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersDeleteProfilePhotoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2065,7 +3119,16 @@ type MembersGetAvailableTeamMemberRolesAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MembersGetAvailableTeamMemberRoles() (res *MembersGetAvailableTeamMemberRolesResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersGetAvailableTeamMemberRolesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) MembersGetAvailableTeamMemberRoles(opts ...dropbox.CallOption) (res *MembersGetAvailableTeamMemberRolesResult, err error) {
+	return dbx.MembersGetAvailableTeamMemberRolesContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) MembersGetAvailableTeamMemberRolesContext(ctx context.Context, opts ...dropbox.CallOption) (res *MembersGetAvailableTeamMemberRolesResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2074,11 +3137,12 @@ func (dbx *apiImpl) MembersGetAvailableTeamMemberRoles() (res *MembersGetAvailab
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersGetAvailableTeamMemberRolesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2103,7 +3167,26 @@ type MembersGetInfoV2APIError struct {
 	EndpointError *MembersGetInfoError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersGetInfoV2(arg *MembersGetInfoV2Arg) (res *MembersGetInfoV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersGetInfoV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersGetInfoV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersGetInfoV2(arg *MembersGetInfoV2Arg, opts ...dropbox.CallOption) (res *MembersGetInfoV2Result, err error) {
+	return dbx.MembersGetInfoV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersGetInfoV2Context(ctx context.Context, arg *MembersGetInfoV2Arg, opts ...dropbox.CallOption) (res *MembersGetInfoV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2112,11 +3195,12 @@ func (dbx *apiImpl) MembersGetInfoV2(arg *MembersGetInfoV2Arg) (res *MembersGetI
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersGetInfoV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2141,7 +3225,26 @@ type MembersGetInfoAPIError struct {
 	EndpointError *MembersGetInfoError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetInfoItem, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersGetInfoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersGetInfoAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersGetInfo(arg *MembersGetInfoArgs, opts ...dropbox.CallOption) (res []*MembersGetInfoItem, err error) {
+	return dbx.MembersGetInfoContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersGetInfoContext(ctx context.Context, arg *MembersGetInfoArgs, opts ...dropbox.CallOption) (res []*MembersGetInfoItem, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2150,11 +3253,12 @@ func (dbx *apiImpl) MembersGetInfo(arg *MembersGetInfoArgs) (res []*MembersGetIn
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersGetInfoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2179,7 +3283,26 @@ type MembersListV2APIError struct {
 	EndpointError *MembersListError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersListV2(arg *MembersListArg) (res *MembersListV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersListV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersListV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersListV2(arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error) {
+	return dbx.MembersListV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersListV2Context(ctx context.Context, arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2188,11 +3311,12 @@ func (dbx *apiImpl) MembersListV2(arg *MembersListArg) (res *MembersListV2Result
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersListV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2217,7 +3341,26 @@ type MembersListAPIError struct {
 	EndpointError *MembersListError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersList(arg *MembersListArg) (res *MembersListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersList(arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListResult, err error) {
+	return dbx.MembersListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersListContext(ctx context.Context, arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2226,11 +3369,12 @@ func (dbx *apiImpl) MembersList(arg *MembersListArg) (res *MembersListResult, er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2255,7 +3399,26 @@ type MembersListContinueV2APIError struct {
 	EndpointError *MembersListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersListContinueV2(arg *MembersListContinueArg) (res *MembersListV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersListContinueV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersListContinueV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersListContinueV2(arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error) {
+	return dbx.MembersListContinueV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersListContinueV2Context(ctx context.Context, arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2264,11 +3427,12 @@ func (dbx *apiImpl) MembersListContinueV2(arg *MembersListContinueArg) (res *Mem
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersListContinueV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2293,7 +3457,26 @@ type MembersListContinueAPIError struct {
 	EndpointError *MembersListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersListContinue(arg *MembersListContinueArg) (res *MembersListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersListContinue(arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListResult, err error) {
+	return dbx.MembersListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersListContinueContext(ctx context.Context, arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2302,11 +3485,12 @@ func (dbx *apiImpl) MembersListContinue(arg *MembersListContinueArg) (res *Membe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2325,13 +3509,32 @@ func (dbx *apiImpl) MembersListContinue(arg *MembersListContinueArg) (res *Membe
 	return
 }
 
-//MembersMoveFormerMemberFilesAPIError is an error-wrapper for the members/move_former_member_files route
-type MembersMoveFormerMemberFilesAPIError struct {
-	dropbox.APIError
-	EndpointError *MembersTransferFormerMembersFilesError `json:"error"`
+//MembersMoveFormerMemberFilesAPIError is an error-wrapper for the members/move_former_member_files route
+type MembersMoveFormerMemberFilesAPIError struct {
+	dropbox.APIError
+	EndpointError *MembersTransferFormerMembersFilesError `json:"error"`
+}
+
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersMoveFormerMemberFilesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersMoveFormerMemberFilesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersMoveFormerMemberFiles(arg *MembersDataTransferArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return dbx.MembersMoveFormerMemberFilesContext(context.Background(), arg, opts...)
 }
 
-func (dbx *apiImpl) MembersMoveFormerMemberFiles(arg *MembersDataTransferArg) (res *async.LaunchEmptyResult, err error) {
+func (dbx *apiImpl) MembersMoveFormerMemberFilesContext(ctx context.Context, arg *MembersDataTransferArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2340,11 +3543,12 @@ func (dbx *apiImpl) MembersMoveFormerMemberFiles(arg *MembersDataTransferArg) (r
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersMoveFormerMemberFilesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2369,7 +3573,26 @@ type MembersMoveFormerMemberFilesJobStatusCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersMoveFormerMemberFilesJobStatusCheck(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersMoveFormerMemberFilesJobStatusCheckAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersMoveFormerMemberFilesJobStatusCheckAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersMoveFormerMemberFilesJobStatusCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	return dbx.MembersMoveFormerMemberFilesJobStatusCheckContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersMoveFormerMemberFilesJobStatusCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2378,11 +3601,12 @@ func (dbx *apiImpl) MembersMoveFormerMemberFilesJobStatusCheck(arg *async.PollAr
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersMoveFormerMemberFilesJobStatusCheckAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2407,7 +3631,26 @@ type MembersRecoverAPIError struct {
 	EndpointError *MembersRecoverError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersRecover(arg *MembersRecoverArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersRecoverAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersRecoverAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersRecover(arg *MembersRecoverArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.MembersRecoverContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersRecoverContext(ctx context.Context, arg *MembersRecoverArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2416,11 +3659,12 @@ func (dbx *apiImpl) MembersRecover(arg *MembersRecoverArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersRecoverAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2441,7 +3685,26 @@ type MembersRemoveAPIError struct {
 	EndpointError *MembersRemoveError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmptyResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersRemoveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersRemoveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersRemove(arg *MembersRemoveArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return dbx.MembersRemoveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersRemoveContext(ctx context.Context, arg *MembersRemoveArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2450,11 +3713,12 @@ func (dbx *apiImpl) MembersRemove(arg *MembersRemoveArg) (res *async.LaunchEmpty
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersRemoveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2479,7 +3743,26 @@ type MembersRemoveJobStatusGetAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.PollEmptyResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersRemoveJobStatusGetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersRemoveJobStatusGetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersRemoveJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	return dbx.MembersRemoveJobStatusGetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersRemoveJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2488,11 +3771,12 @@ func (dbx *apiImpl) MembersRemoveJobStatusGet(arg *async.PollArg) (res *async.Po
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersRemoveJobStatusGetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2517,7 +3801,26 @@ type MembersSecondaryEmailsAddAPIError struct {
 	EndpointError *AddSecondaryEmailsError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSecondaryEmailsAdd(arg *AddSecondaryEmailsArg) (res *AddSecondaryEmailsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSecondaryEmailsAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSecondaryEmailsAddAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersSecondaryEmailsAdd(arg *AddSecondaryEmailsArg, opts ...dropbox.CallOption) (res *AddSecondaryEmailsResult, err error) {
+	return dbx.MembersSecondaryEmailsAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSecondaryEmailsAddContext(ctx context.Context, arg *AddSecondaryEmailsArg, opts ...dropbox.CallOption) (res *AddSecondaryEmailsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2526,11 +3829,12 @@ func (dbx *apiImpl) MembersSecondaryEmailsAdd(arg *AddSecondaryEmailsArg) (res *
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSecondaryEmailsAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2555,7 +3859,16 @@ type MembersSecondaryEmailsDeleteAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSecondaryEmailsDelete(arg *DeleteSecondaryEmailsArg) (res *DeleteSecondaryEmailsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSecondaryEmailsDeleteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) MembersSecondaryEmailsDelete(arg *DeleteSecondaryEmailsArg, opts ...dropbox.CallOption) (res *DeleteSecondaryEmailsResult, err error) {
+	return dbx.MembersSecondaryEmailsDeleteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSecondaryEmailsDeleteContext(ctx context.Context, arg *DeleteSecondaryEmailsArg, opts ...dropbox.CallOption) (res *DeleteSecondaryEmailsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2564,11 +3877,12 @@ func (dbx *apiImpl) MembersSecondaryEmailsDelete(arg *DeleteSecondaryEmailsArg)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSecondaryEmailsDeleteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2593,7 +3907,16 @@ type MembersSecondaryEmailsResendVerificationEmailsAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSecondaryEmailsResendVerificationEmails(arg *ResendVerificationEmailArg) (res *ResendVerificationEmailResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSecondaryEmailsResendVerificationEmailsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) MembersSecondaryEmailsResendVerificationEmails(arg *ResendVerificationEmailArg, opts ...dropbox.CallOption) (res *ResendVerificationEmailResult, err error) {
+	return dbx.MembersSecondaryEmailsResendVerificationEmailsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSecondaryEmailsResendVerificationEmailsContext(ctx context.Context, arg *ResendVerificationEmailArg, opts ...dropbox.CallOption) (res *ResendVerificationEmailResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2602,11 +3925,12 @@ func (dbx *apiImpl) MembersSecondaryEmailsResendVerificationEmails(arg *ResendVe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSecondaryEmailsResendVerificationEmailsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2631,7 +3955,26 @@ type MembersSendWelcomeEmailAPIError struct {
 	EndpointError *MembersSendWelcomeError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSendWelcomeEmail(arg *UserSelectorArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSendWelcomeEmailAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSendWelcomeEmailAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersSendWelcomeEmail(arg *UserSelectorArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.MembersSendWelcomeEmailContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSendWelcomeEmailContext(ctx context.Context, arg *UserSelectorArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2640,11 +3983,12 @@ func (dbx *apiImpl) MembersSendWelcomeEmail(arg *UserSelectorArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSendWelcomeEmailAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2665,7 +4009,26 @@ type MembersSetAdminPermissionsV2APIError struct {
 	EndpointError *MembersSetPermissions2Error `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSetAdminPermissionsV2(arg *MembersSetPermissions2Arg) (res *MembersSetPermissions2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSetAdminPermissionsV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSetAdminPermissionsV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersSetAdminPermissionsV2(arg *MembersSetPermissions2Arg, opts ...dropbox.CallOption) (res *MembersSetPermissions2Result, err error) {
+	return dbx.MembersSetAdminPermissionsV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSetAdminPermissionsV2Context(ctx context.Context, arg *MembersSetPermissions2Arg, opts ...dropbox.CallOption) (res *MembersSetPermissions2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2674,11 +4037,12 @@ func (dbx *apiImpl) MembersSetAdminPermissionsV2(arg *MembersSetPermissions2Arg)
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSetAdminPermissionsV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2703,7 +4067,26 @@ type MembersSetAdminPermissionsAPIError struct {
 	EndpointError *MembersSetPermissionsError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (res *MembersSetPermissionsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSetAdminPermissionsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSetAdminPermissionsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersSetAdminPermissions(arg *MembersSetPermissionsArg, opts ...dropbox.CallOption) (res *MembersSetPermissionsResult, err error) {
+	return dbx.MembersSetAdminPermissionsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSetAdminPermissionsContext(ctx context.Context, arg *MembersSetPermissionsArg, opts ...dropbox.CallOption) (res *MembersSetPermissionsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2712,11 +4095,12 @@ func (dbx *apiImpl) MembersSetAdminPermissions(arg *MembersSetPermissionsArg) (r
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSetAdminPermissionsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2741,7 +4125,26 @@ type MembersSetProfileV2APIError struct {
 	EndpointError *MembersSetProfileError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSetProfileV2(arg *MembersSetProfileArg) (res *TeamMemberInfoV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSetProfileV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSetProfileV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersSetProfileV2(arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	return dbx.MembersSetProfileV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSetProfileV2Context(ctx context.Context, arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2750,11 +4153,12 @@ func (dbx *apiImpl) MembersSetProfileV2(arg *MembersSetProfileArg) (res *TeamMem
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSetProfileV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2779,7 +4183,26 @@ type MembersSetProfileAPIError struct {
 	EndpointError *MembersSetProfileError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMemberInfo, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSetProfileAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSetProfileAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersSetProfile(arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	return dbx.MembersSetProfileContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSetProfileContext(ctx context.Context, arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2788,11 +4211,12 @@ func (dbx *apiImpl) MembersSetProfile(arg *MembersSetProfileArg) (res *TeamMembe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSetProfileAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2817,7 +4241,28 @@ type MembersSetProfilePhotoV2APIError struct {
 	EndpointError *MembersSetProfilePhotoError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSetProfilePhotoV2(arg *MembersSetProfilePhotoArg) (res *TeamMemberInfoV2Result, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSetProfilePhotoV2APIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSetProfilePhotoV2APIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PhotoError != nil && m.MatchEndpointError(err.PhotoError)
+}
+
+func (dbx *apiImpl) MembersSetProfilePhotoV2(arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	return dbx.MembersSetProfilePhotoV2Context(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSetProfilePhotoV2Context(ctx context.Context, arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2826,11 +4271,12 @@ func (dbx *apiImpl) MembersSetProfilePhotoV2(arg *MembersSetProfilePhotoArg) (re
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSetProfilePhotoV2APIError
 		err = auth.ParseError(err, &appErr)
@@ -2855,7 +4301,28 @@ type MembersSetProfilePhotoAPIError struct {
 	EndpointError *MembersSetProfilePhotoError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSetProfilePhoto(arg *MembersSetProfilePhotoArg) (res *TeamMemberInfo, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSetProfilePhotoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSetProfilePhotoAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.PhotoError != nil && m.MatchEndpointError(err.PhotoError)
+}
+
+func (dbx *apiImpl) MembersSetProfilePhoto(arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	return dbx.MembersSetProfilePhotoContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSetProfilePhotoContext(ctx context.Context, arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2864,11 +4331,12 @@ func (dbx *apiImpl) MembersSetProfilePhoto(arg *MembersSetProfilePhotoArg) (res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSetProfilePhotoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2893,7 +4361,26 @@ type MembersSuspendAPIError struct {
 	EndpointError *MembersSuspendError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersSuspend(arg *MembersDeactivateArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersSuspendAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersSuspendAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersSuspend(arg *MembersDeactivateArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.MembersSuspendContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersSuspendContext(ctx context.Context, arg *MembersDeactivateArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2902,11 +4389,12 @@ func (dbx *apiImpl) MembersSuspend(arg *MembersDeactivateArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersSuspendAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2927,7 +4415,26 @@ type MembersUnsuspendAPIError struct {
 	EndpointError *MembersUnsuspendError `json:"error"`
 }
 
-func (dbx *apiImpl) MembersUnsuspend(arg *MembersUnsuspendArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *MembersUnsuspendAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e MembersUnsuspendAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) MembersUnsuspend(arg *MembersUnsuspendArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.MembersUnsuspendContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) MembersUnsuspendContext(ctx context.Context, arg *MembersUnsuspendArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2936,11 +4443,12 @@ func (dbx *apiImpl) MembersUnsuspend(arg *MembersUnsuspendArg) (err error) {
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr MembersUnsuspendAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2961,7 +4469,26 @@ type NamespacesListAPIError struct {
 	EndpointError *TeamNamespacesListError `json:"error"`
 }
 
-func (dbx *apiImpl) NamespacesList(arg *TeamNamespacesListArg) (res *TeamNamespacesListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *NamespacesListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e NamespacesListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) NamespacesList(arg *TeamNamespacesListArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error) {
+	return dbx.NamespacesListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) NamespacesListContext(ctx context.Context, arg *TeamNamespacesListArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -2970,11 +4497,12 @@ func (dbx *apiImpl) NamespacesList(arg *TeamNamespacesListArg) (res *TeamNamespa
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr NamespacesListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -2999,7 +4527,26 @@ type NamespacesListContinueAPIError struct {
 	EndpointError *TeamNamespacesListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) NamespacesListContinue(arg *TeamNamespacesListContinueArg) (res *TeamNamespacesListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *NamespacesListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e NamespacesListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) NamespacesListContinue(arg *TeamNamespacesListContinueArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error) {
+	return dbx.NamespacesListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) NamespacesListContinueContext(ctx context.Context, arg *TeamNamespacesListContinueArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3008,11 +4555,12 @@ func (dbx *apiImpl) NamespacesListContinue(arg *TeamNamespacesListContinueArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr NamespacesListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3037,7 +4585,26 @@ type PropertiesTemplateAddAPIError struct {
 	EndpointError *file_properties.ModifyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateAdd(arg *file_properties.AddTemplateArg) (res *file_properties.AddTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesTemplateAddAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesTemplateAddAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) PropertiesTemplateAdd(arg *file_properties.AddTemplateArg, opts ...dropbox.CallOption) (res *file_properties.AddTemplateResult, err error) {
+	return dbx.PropertiesTemplateAddContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesTemplateAddContext(ctx context.Context, arg *file_properties.AddTemplateArg, opts ...dropbox.CallOption) (res *file_properties.AddTemplateResult, err error) {
 	log.Printf("WARNING: API `PropertiesTemplateAdd` is deprecated")
 
 	req := dropbox.Request{
@@ -3048,11 +4615,12 @@ func (dbx *apiImpl) PropertiesTemplateAdd(arg *file_properties.AddTemplateArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesTemplateAddAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3077,7 +4645,26 @@ type PropertiesTemplateGetAPIError struct {
 	EndpointError *file_properties.TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateGet(arg *file_properties.GetTemplateArg) (res *file_properties.GetTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesTemplateGetAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesTemplateGetAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) PropertiesTemplateGet(arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error) {
+	return dbx.PropertiesTemplateGetContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error) {
 	log.Printf("WARNING: API `PropertiesTemplateGet` is deprecated")
 
 	req := dropbox.Request{
@@ -3088,11 +4675,12 @@ func (dbx *apiImpl) PropertiesTemplateGet(arg *file_properties.GetTemplateArg) (
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesTemplateGetAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3117,7 +4705,26 @@ type PropertiesTemplateListAPIError struct {
 	EndpointError *file_properties.TemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateList() (res *file_properties.ListTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesTemplateListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesTemplateListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) PropertiesTemplateList(opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error) {
+	return dbx.PropertiesTemplateListContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) PropertiesTemplateListContext(ctx context.Context, opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error) {
 	log.Printf("WARNING: API `PropertiesTemplateList` is deprecated")
 
 	req := dropbox.Request{
@@ -3128,11 +4735,12 @@ func (dbx *apiImpl) PropertiesTemplateList() (res *file_properties.ListTemplateR
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesTemplateListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3157,7 +4765,26 @@ type PropertiesTemplateUpdateAPIError struct {
 	EndpointError *file_properties.ModifyTemplateError `json:"error"`
 }
 
-func (dbx *apiImpl) PropertiesTemplateUpdate(arg *file_properties.UpdateTemplateArg) (res *file_properties.UpdateTemplateResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *PropertiesTemplateUpdateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e PropertiesTemplateUpdateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) PropertiesTemplateUpdate(arg *file_properties.UpdateTemplateArg, opts ...dropbox.CallOption) (res *file_properties.UpdateTemplateResult, err error) {
+	return dbx.PropertiesTemplateUpdateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) PropertiesTemplateUpdateContext(ctx context.Context, arg *file_properties.UpdateTemplateArg, opts ...dropbox.CallOption) (res *file_properties.UpdateTemplateResult, err error) {
 	log.Printf("WARNING: API `PropertiesTemplateUpdate` is deprecated")
 
 	req := dropbox.Request{
@@ -3168,11 +4795,12 @@ func (dbx *apiImpl) PropertiesTemplateUpdate(arg *file_properties.UpdateTemplate
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr PropertiesTemplateUpdateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3197,7 +4825,26 @@ type ReportsGetActivityAPIError struct {
 	EndpointError *DateRangeError `json:"error"`
 }
 
-func (dbx *apiImpl) ReportsGetActivity(arg *DateRange) (res *GetActivityReport, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ReportsGetActivityAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ReportsGetActivityAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ReportsGetActivity(arg *DateRange, opts ...dropbox.CallOption) (res *GetActivityReport, err error) {
+	return dbx.ReportsGetActivityContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ReportsGetActivityContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetActivityReport, err error) {
 	log.Printf("WARNING: API `ReportsGetActivity` is deprecated")
 
 	req := dropbox.Request{
@@ -3208,11 +4855,12 @@ func (dbx *apiImpl) ReportsGetActivity(arg *DateRange) (res *GetActivityReport,
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ReportsGetActivityAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3237,7 +4885,26 @@ type ReportsGetDevicesAPIError struct {
 	EndpointError *DateRangeError `json:"error"`
 }
 
-func (dbx *apiImpl) ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ReportsGetDevicesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ReportsGetDevicesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ReportsGetDevices(arg *DateRange, opts ...dropbox.CallOption) (res *GetDevicesReport, err error) {
+	return dbx.ReportsGetDevicesContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ReportsGetDevicesContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetDevicesReport, err error) {
 	log.Printf("WARNING: API `ReportsGetDevices` is deprecated")
 
 	req := dropbox.Request{
@@ -3248,11 +4915,12 @@ func (dbx *apiImpl) ReportsGetDevices(arg *DateRange) (res *GetDevicesReport, er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ReportsGetDevicesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3277,7 +4945,26 @@ type ReportsGetMembershipAPIError struct {
 	EndpointError *DateRangeError `json:"error"`
 }
 
-func (dbx *apiImpl) ReportsGetMembership(arg *DateRange) (res *GetMembershipReport, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ReportsGetMembershipAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ReportsGetMembershipAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ReportsGetMembership(arg *DateRange, opts ...dropbox.CallOption) (res *GetMembershipReport, err error) {
+	return dbx.ReportsGetMembershipContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ReportsGetMembershipContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetMembershipReport, err error) {
 	log.Printf("WARNING: API `ReportsGetMembership` is deprecated")
 
 	req := dropbox.Request{
@@ -3288,11 +4975,12 @@ func (dbx *apiImpl) ReportsGetMembership(arg *DateRange) (res *GetMembershipRepo
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ReportsGetMembershipAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3317,7 +5005,26 @@ type ReportsGetStorageAPIError struct {
 	EndpointError *DateRangeError `json:"error"`
 }
 
-func (dbx *apiImpl) ReportsGetStorage(arg *DateRange) (res *GetStorageReport, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *ReportsGetStorageAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e ReportsGetStorageAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) ReportsGetStorage(arg *DateRange, opts ...dropbox.CallOption) (res *GetStorageReport, err error) {
+	return dbx.ReportsGetStorageContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) ReportsGetStorageContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetStorageReport, err error) {
 	log.Printf("WARNING: API `ReportsGetStorage` is deprecated")
 
 	req := dropbox.Request{
@@ -3328,11 +5035,12 @@ func (dbx *apiImpl) ReportsGetStorage(arg *DateRange) (res *GetStorageReport, er
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr ReportsGetStorageAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3357,7 +5065,30 @@ type TeamFolderActivateAPIError struct {
 	EndpointError *TeamFolderActivateError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderActivateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderActivateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.StatusError != nil && m.MatchEndpointError(err.StatusError) ||
+		err.TeamSharedDropboxError != nil && m.MatchEndpointError(err.TeamSharedDropboxError)
+}
+
+func (dbx *apiImpl) TeamFolderActivate(arg *TeamFolderIdArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	return dbx.TeamFolderActivateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderActivateContext(ctx context.Context, arg *TeamFolderIdArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3366,11 +5097,12 @@ func (dbx *apiImpl) TeamFolderActivate(arg *TeamFolderIdArg) (res *TeamFolderMet
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderActivateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3395,7 +5127,30 @@ type TeamFolderArchiveAPIError struct {
 	EndpointError *TeamFolderArchiveError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolderArchiveLaunch, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderArchiveAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderArchiveAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.StatusError != nil && m.MatchEndpointError(err.StatusError) ||
+		err.TeamSharedDropboxError != nil && m.MatchEndpointError(err.TeamSharedDropboxError)
+}
+
+func (dbx *apiImpl) TeamFolderArchive(arg *TeamFolderArchiveArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveLaunch, err error) {
+	return dbx.TeamFolderArchiveContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderArchiveContext(ctx context.Context, arg *TeamFolderArchiveArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveLaunch, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3404,11 +5159,12 @@ func (dbx *apiImpl) TeamFolderArchive(arg *TeamFolderArchiveArg) (res *TeamFolde
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderArchiveAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3433,7 +5189,26 @@ type TeamFolderArchiveCheckAPIError struct {
 	EndpointError *async.PollError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderArchiveJobStatus, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderArchiveCheckAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderArchiveCheckAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TeamFolderArchiveCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveJobStatus, err error) {
+	return dbx.TeamFolderArchiveCheckContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderArchiveCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveJobStatus, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3442,11 +5217,12 @@ func (dbx *apiImpl) TeamFolderArchiveCheck(arg *async.PollArg) (res *TeamFolderA
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderArchiveCheckAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3471,7 +5247,29 @@ type TeamFolderCreateAPIError struct {
 	EndpointError *TeamFolderCreateError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderCreateAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderCreateAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.SyncSettingsError != nil && m.MatchEndpointError(err.SyncSettingsError) ||
+		err.SyncSettingsError != nil && err.SyncSettingsError.Path != nil && m.MatchEndpointError(err.SyncSettingsError.Path)
+}
+
+func (dbx *apiImpl) TeamFolderCreate(arg *TeamFolderCreateArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	return dbx.TeamFolderCreateContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderCreateContext(ctx context.Context, arg *TeamFolderCreateArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3480,11 +5278,12 @@ func (dbx *apiImpl) TeamFolderCreate(arg *TeamFolderCreateArg) (res *TeamFolderM
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderCreateAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3509,7 +5308,16 @@ type TeamFolderGetInfoAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFolderGetInfoItem, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderGetInfoAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) TeamFolderGetInfo(arg *TeamFolderIdListArg, opts ...dropbox.CallOption) (res []*TeamFolderGetInfoItem, err error) {
+	return dbx.TeamFolderGetInfoContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderGetInfoContext(ctx context.Context, arg *TeamFolderIdListArg, opts ...dropbox.CallOption) (res []*TeamFolderGetInfoItem, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3518,11 +5326,12 @@ func (dbx *apiImpl) TeamFolderGetInfo(arg *TeamFolderIdListArg) (res []*TeamFold
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderGetInfoAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3547,7 +5356,28 @@ type TeamFolderListAPIError struct {
 	EndpointError *TeamFolderListError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderListAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderListAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError)
+}
+
+func (dbx *apiImpl) TeamFolderList(arg *TeamFolderListArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error) {
+	return dbx.TeamFolderListContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderListContext(ctx context.Context, arg *TeamFolderListArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3556,11 +5386,12 @@ func (dbx *apiImpl) TeamFolderList(arg *TeamFolderListArg) (res *TeamFolderListR
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderListAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3585,7 +5416,26 @@ type TeamFolderListContinueAPIError struct {
 	EndpointError *TeamFolderListContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderListContinue(arg *TeamFolderListContinueArg) (res *TeamFolderListResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderListContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderListContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TeamFolderListContinue(arg *TeamFolderListContinueArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error) {
+	return dbx.TeamFolderListContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderListContinueContext(ctx context.Context, arg *TeamFolderListContinueArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3594,11 +5444,12 @@ func (dbx *apiImpl) TeamFolderListContinue(arg *TeamFolderListContinueArg) (res
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderListContinueAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3623,7 +5474,30 @@ type TeamFolderPermanentlyDeleteAPIError struct {
 	EndpointError *TeamFolderPermanentlyDeleteError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderPermanentlyDeleteAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderPermanentlyDeleteAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.StatusError != nil && m.MatchEndpointError(err.StatusError) ||
+		err.TeamSharedDropboxError != nil && m.MatchEndpointError(err.TeamSharedDropboxError)
+}
+
+func (dbx *apiImpl) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg, opts ...dropbox.CallOption) (err error) {
+	return dbx.TeamFolderPermanentlyDeleteContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderPermanentlyDeleteContext(ctx context.Context, arg *TeamFolderIdArg, opts ...dropbox.CallOption) (err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3632,11 +5506,12 @@ func (dbx *apiImpl) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg) (err error
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderPermanentlyDeleteAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3657,7 +5532,30 @@ type TeamFolderRenameAPIError struct {
 	EndpointError *TeamFolderRenameError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderRenameAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderRenameAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.StatusError != nil && m.MatchEndpointError(err.StatusError) ||
+		err.TeamSharedDropboxError != nil && m.MatchEndpointError(err.TeamSharedDropboxError)
+}
+
+func (dbx *apiImpl) TeamFolderRename(arg *TeamFolderRenameArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	return dbx.TeamFolderRenameContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderRenameContext(ctx context.Context, arg *TeamFolderRenameArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3666,11 +5564,12 @@ func (dbx *apiImpl) TeamFolderRename(arg *TeamFolderRenameArg) (res *TeamFolderM
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderRenameAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3695,7 +5594,32 @@ type TeamFolderUpdateSyncSettingsAPIError struct {
 	EndpointError *TeamFolderUpdateSyncSettingsError `json:"error"`
 }
 
-func (dbx *apiImpl) TeamFolderUpdateSyncSettings(arg *TeamFolderUpdateSyncSettingsArg) (res *TeamFolderMetadata, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TeamFolderUpdateSyncSettingsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TeamFolderUpdateSyncSettingsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	err := e.EndpointError
+	return m.MatchEndpointError(err) ||
+		err.AccessError != nil && m.MatchEndpointError(err.AccessError) ||
+		err.StatusError != nil && m.MatchEndpointError(err.StatusError) ||
+		err.TeamSharedDropboxError != nil && m.MatchEndpointError(err.TeamSharedDropboxError) ||
+		err.SyncSettingsError != nil && m.MatchEndpointError(err.SyncSettingsError) ||
+		err.SyncSettingsError != nil && err.SyncSettingsError.Path != nil && m.MatchEndpointError(err.SyncSettingsError.Path)
+}
+
+func (dbx *apiImpl) TeamFolderUpdateSyncSettings(arg *TeamFolderUpdateSyncSettingsArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	return dbx.TeamFolderUpdateSyncSettingsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) TeamFolderUpdateSyncSettingsContext(ctx context.Context, arg *TeamFolderUpdateSyncSettingsArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3704,11 +5628,12 @@ func (dbx *apiImpl) TeamFolderUpdateSyncSettings(arg *TeamFolderUpdateSyncSettin
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TeamFolderUpdateSyncSettingsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -3733,7 +5658,26 @@ type TokenGetAuthenticatedAdminAPIError struct {
 	EndpointError *TokenGetAuthenticatedAdminError `json:"error"`
 }
 
-func (dbx *apiImpl) TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdminResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *TokenGetAuthenticatedAdminAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e TokenGetAuthenticatedAdminAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) TokenGetAuthenticatedAdmin(opts ...dropbox.CallOption) (res *TokenGetAuthenticatedAdminResult, err error) {
+	return dbx.TokenGetAuthenticatedAdminContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) TokenGetAuthenticatedAdminContext(ctx context.Context, opts ...dropbox.CallOption) (res *TokenGetAuthenticatedAdminResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team",
@@ -3742,11 +5686,12 @@ func (dbx *apiImpl) TokenGetAuthenticatedAdmin() (res *TokenGetAuthenticatedAdmi
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr TokenGetAuthenticatedAdminAPIError
 		err = auth.ParseError(err, &appErr)
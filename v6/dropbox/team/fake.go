@@ -0,0 +1,1463 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/file_properties"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	DevicesListMemberDevicesFunc                       func(ctx context.Context, arg *ListMemberDevicesArg, opts ...dropbox.CallOption) (res *ListMemberDevicesResult, err error)
+	DevicesListMembersDevicesFunc                      func(ctx context.Context, arg *ListMembersDevicesArg, opts ...dropbox.CallOption) (res *ListMembersDevicesResult, err error)
+	DevicesListTeamDevicesFunc                         func(ctx context.Context, arg *ListTeamDevicesArg, opts ...dropbox.CallOption) (res *ListTeamDevicesResult, err error)
+	DevicesRevokeDeviceSessionFunc                     func(ctx context.Context, arg *RevokeDeviceSessionArg, opts ...dropbox.CallOption) (err error)
+	DevicesRevokeDeviceSessionBatchFunc                func(ctx context.Context, arg *RevokeDeviceSessionBatchArg, opts ...dropbox.CallOption) (res *RevokeDeviceSessionBatchResult, err error)
+	FeaturesGetValuesFunc                              func(ctx context.Context, arg *FeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *FeaturesGetValuesBatchResult, err error)
+	GetInfoFunc                                        func(ctx context.Context, opts ...dropbox.CallOption) (res *TeamGetInfoResult, err error)
+	GroupsCreateFunc                                   func(ctx context.Context, arg *GroupCreateArg, opts ...dropbox.CallOption) (res *GroupFullInfo, err error)
+	GroupsDeleteFunc                                   func(ctx context.Context, arg *GroupSelector, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	GroupsGetInfoFunc                                  func(ctx context.Context, arg *GroupsSelector, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error)
+	GroupsJobStatusGetFunc                             func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
+	GroupsListFunc                                     func(ctx context.Context, arg *GroupsListArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error)
+	GroupsListContinueFunc                             func(ctx context.Context, arg *GroupsListContinueArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error)
+	GroupsMembersAddFunc                               func(ctx context.Context, arg *GroupMembersAddArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error)
+	GroupsMembersListFunc                              func(ctx context.Context, arg *GroupsMembersListArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error)
+	GroupsMembersListContinueFunc                      func(ctx context.Context, arg *GroupsMembersListContinueArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error)
+	GroupsMembersRemoveFunc                            func(ctx context.Context, arg *GroupMembersRemoveArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error)
+	GroupsMembersSetAccessTypeFunc                     func(ctx context.Context, arg *GroupMembersSetAccessTypeArg, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error)
+	GroupsUpdateFunc                                   func(ctx context.Context, arg *GroupUpdateArgs, opts ...dropbox.CallOption) (res *GroupFullInfo, err error)
+	LegalHoldsCreatePolicyFunc                         func(ctx context.Context, arg *LegalHoldsPolicyCreateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
+	LegalHoldsGetPolicyFunc                            func(ctx context.Context, arg *LegalHoldsGetPolicyArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
+	LegalHoldsListHeldRevisionsFunc                    func(ctx context.Context, arg *LegalHoldsListHeldRevisionsArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error)
+	LegalHoldsListHeldRevisionsContinueFunc            func(ctx context.Context, arg *LegalHoldsListHeldRevisionsContinueArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error)
+	LegalHoldsListPoliciesFunc                         func(ctx context.Context, arg *LegalHoldsListPoliciesArg, opts ...dropbox.CallOption) (res *LegalHoldsListPoliciesResult, err error)
+	LegalHoldsReleasePolicyFunc                        func(ctx context.Context, arg *LegalHoldsPolicyReleaseArg, opts ...dropbox.CallOption) (err error)
+	LegalHoldsUpdatePolicyFunc                         func(ctx context.Context, arg *LegalHoldsPolicyUpdateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error)
+	LinkedAppsListMemberLinkedAppsFunc                 func(ctx context.Context, arg *ListMemberAppsArg, opts ...dropbox.CallOption) (res *ListMemberAppsResult, err error)
+	LinkedAppsListMembersLinkedAppsFunc                func(ctx context.Context, arg *ListMembersAppsArg, opts ...dropbox.CallOption) (res *ListMembersAppsResult, err error)
+	LinkedAppsListTeamLinkedAppsFunc                   func(ctx context.Context, arg *ListTeamAppsArg, opts ...dropbox.CallOption) (res *ListTeamAppsResult, err error)
+	LinkedAppsRevokeLinkedAppFunc                      func(ctx context.Context, arg *RevokeLinkedApiAppArg, opts ...dropbox.CallOption) (err error)
+	LinkedAppsRevokeLinkedAppBatchFunc                 func(ctx context.Context, arg *RevokeLinkedApiAppBatchArg, opts ...dropbox.CallOption) (res *RevokeLinkedAppBatchResult, err error)
+	MemberSpaceLimitsExcludedUsersAddFunc              func(ctx context.Context, arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error)
+	MemberSpaceLimitsExcludedUsersListFunc             func(ctx context.Context, arg *ExcludedUsersListArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error)
+	MemberSpaceLimitsExcludedUsersListContinueFunc     func(ctx context.Context, arg *ExcludedUsersListContinueArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error)
+	MemberSpaceLimitsExcludedUsersRemoveFunc           func(ctx context.Context, arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error)
+	MemberSpaceLimitsGetCustomQuotaFunc                func(ctx context.Context, arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error)
+	MemberSpaceLimitsRemoveCustomQuotaFunc             func(ctx context.Context, arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*RemoveCustomQuotaResult, err error)
+	MemberSpaceLimitsSetCustomQuotaFunc                func(ctx context.Context, arg *SetCustomQuotaArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error)
+	MembersAddV2Func                                   func(ctx context.Context, arg *MembersAddV2Arg, opts ...dropbox.CallOption) (res *MembersAddLaunchV2Result, err error)
+	MembersAddFunc                                     func(ctx context.Context, arg *MembersAddArg, opts ...dropbox.CallOption) (res *MembersAddLaunch, err error)
+	MembersAddJobStatusGetV2Func                       func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatusV2Result, err error)
+	MembersAddJobStatusGetFunc                         func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatus, err error)
+	MembersDeleteProfilePhotoV2Func                    func(ctx context.Context, arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
+	MembersDeleteProfilePhotoFunc                      func(ctx context.Context, arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
+	MembersGetAvailableTeamMemberRolesFunc             func(ctx context.Context, opts ...dropbox.CallOption) (res *MembersGetAvailableTeamMemberRolesResult, err error)
+	MembersGetInfoV2Func                               func(ctx context.Context, arg *MembersGetInfoV2Arg, opts ...dropbox.CallOption) (res *MembersGetInfoV2Result, err error)
+	MembersGetInfoFunc                                 func(ctx context.Context, arg *MembersGetInfoArgs, opts ...dropbox.CallOption) (res []*MembersGetInfoItem, err error)
+	MembersListV2Func                                  func(ctx context.Context, arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error)
+	MembersListFunc                                    func(ctx context.Context, arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListResult, err error)
+	MembersListContinueV2Func                          func(ctx context.Context, arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error)
+	MembersListContinueFunc                            func(ctx context.Context, arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListResult, err error)
+	MembersMoveFormerMemberFilesFunc                   func(ctx context.Context, arg *MembersDataTransferArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	MembersMoveFormerMemberFilesJobStatusCheckFunc     func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
+	MembersRecoverFunc                                 func(ctx context.Context, arg *MembersRecoverArg, opts ...dropbox.CallOption) (err error)
+	MembersRemoveFunc                                  func(ctx context.Context, arg *MembersRemoveArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error)
+	MembersRemoveJobStatusGetFunc                      func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error)
+	MembersSecondaryEmailsAddFunc                      func(ctx context.Context, arg *AddSecondaryEmailsArg, opts ...dropbox.CallOption) (res *AddSecondaryEmailsResult, err error)
+	MembersSecondaryEmailsDeleteFunc                   func(ctx context.Context, arg *DeleteSecondaryEmailsArg, opts ...dropbox.CallOption) (res *DeleteSecondaryEmailsResult, err error)
+	MembersSecondaryEmailsResendVerificationEmailsFunc func(ctx context.Context, arg *ResendVerificationEmailArg, opts ...dropbox.CallOption) (res *ResendVerificationEmailResult, err error)
+	MembersSendWelcomeEmailFunc                        func(ctx context.Context, arg *UserSelectorArg, opts ...dropbox.CallOption) (err error)
+	MembersSetAdminPermissionsV2Func                   func(ctx context.Context, arg *MembersSetPermissions2Arg, opts ...dropbox.CallOption) (res *MembersSetPermissions2Result, err error)
+	MembersSetAdminPermissionsFunc                     func(ctx context.Context, arg *MembersSetPermissionsArg, opts ...dropbox.CallOption) (res *MembersSetPermissionsResult, err error)
+	MembersSetProfileV2Func                            func(ctx context.Context, arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
+	MembersSetProfileFunc                              func(ctx context.Context, arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
+	MembersSetProfilePhotoV2Func                       func(ctx context.Context, arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error)
+	MembersSetProfilePhotoFunc                         func(ctx context.Context, arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error)
+	MembersSuspendFunc                                 func(ctx context.Context, arg *MembersDeactivateArg, opts ...dropbox.CallOption) (err error)
+	MembersUnsuspendFunc                               func(ctx context.Context, arg *MembersUnsuspendArg, opts ...dropbox.CallOption) (err error)
+	NamespacesListFunc                                 func(ctx context.Context, arg *TeamNamespacesListArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error)
+	NamespacesListContinueFunc                         func(ctx context.Context, arg *TeamNamespacesListContinueArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error)
+	PropertiesTemplateAddFunc                          func(ctx context.Context, arg *file_properties.AddTemplateArg, opts ...dropbox.CallOption) (res *file_properties.AddTemplateResult, err error)
+	PropertiesTemplateGetFunc                          func(ctx context.Context, arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error)
+	PropertiesTemplateListFunc                         func(ctx context.Context, opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error)
+	PropertiesTemplateUpdateFunc                       func(ctx context.Context, arg *file_properties.UpdateTemplateArg, opts ...dropbox.CallOption) (res *file_properties.UpdateTemplateResult, err error)
+	ReportsGetActivityFunc                             func(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetActivityReport, err error)
+	ReportsGetDevicesFunc                              func(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetDevicesReport, err error)
+	ReportsGetMembershipFunc                           func(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetMembershipReport, err error)
+	ReportsGetStorageFunc                              func(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetStorageReport, err error)
+	TeamFolderActivateFunc                             func(ctx context.Context, arg *TeamFolderIdArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
+	TeamFolderArchiveFunc                              func(ctx context.Context, arg *TeamFolderArchiveArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveLaunch, err error)
+	TeamFolderArchiveCheckFunc                         func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveJobStatus, err error)
+	TeamFolderCreateFunc                               func(ctx context.Context, arg *TeamFolderCreateArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
+	TeamFolderGetInfoFunc                              func(ctx context.Context, arg *TeamFolderIdListArg, opts ...dropbox.CallOption) (res []*TeamFolderGetInfoItem, err error)
+	TeamFolderListFunc                                 func(ctx context.Context, arg *TeamFolderListArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error)
+	TeamFolderListContinueFunc                         func(ctx context.Context, arg *TeamFolderListContinueArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error)
+	TeamFolderPermanentlyDeleteFunc                    func(ctx context.Context, arg *TeamFolderIdArg, opts ...dropbox.CallOption) (err error)
+	TeamFolderRenameFunc                               func(ctx context.Context, arg *TeamFolderRenameArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
+	TeamFolderUpdateSyncSettingsFunc                   func(ctx context.Context, arg *TeamFolderUpdateSyncSettingsArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error)
+	TokenGetAuthenticatedAdminFunc                     func(ctx context.Context, opts ...dropbox.CallOption) (res *TokenGetAuthenticatedAdminResult, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// DevicesListMemberDevices implements Client.
+func (f *Fake) DevicesListMemberDevices(arg *ListMemberDevicesArg, opts ...dropbox.CallOption) (res *ListMemberDevicesResult, err error) {
+	return f.DevicesListMemberDevicesContext(context.Background(), arg, opts...)
+}
+
+// DevicesListMemberDevicesContext implements Client.
+func (f *Fake) DevicesListMemberDevicesContext(ctx context.Context, arg *ListMemberDevicesArg, opts ...dropbox.CallOption) (res *ListMemberDevicesResult, err error) {
+	f.Record("DevicesListMemberDevices", opts, arg)
+	if f.DevicesListMemberDevicesFunc == nil {
+		err = dropbox.NotImplemented("team", "DevicesListMemberDevices")
+		return
+	}
+	return f.DevicesListMemberDevicesFunc(ctx, arg, opts...)
+}
+
+// DevicesListMembersDevices implements Client.
+func (f *Fake) DevicesListMembersDevices(arg *ListMembersDevicesArg, opts ...dropbox.CallOption) (res *ListMembersDevicesResult, err error) {
+	return f.DevicesListMembersDevicesContext(context.Background(), arg, opts...)
+}
+
+// DevicesListMembersDevicesContext implements Client.
+func (f *Fake) DevicesListMembersDevicesContext(ctx context.Context, arg *ListMembersDevicesArg, opts ...dropbox.CallOption) (res *ListMembersDevicesResult, err error) {
+	f.Record("DevicesListMembersDevices", opts, arg)
+	if f.DevicesListMembersDevicesFunc == nil {
+		err = dropbox.NotImplemented("team", "DevicesListMembersDevices")
+		return
+	}
+	return f.DevicesListMembersDevicesFunc(ctx, arg, opts...)
+}
+
+// DevicesListTeamDevices implements Client.
+func (f *Fake) DevicesListTeamDevices(arg *ListTeamDevicesArg, opts ...dropbox.CallOption) (res *ListTeamDevicesResult, err error) {
+	return f.DevicesListTeamDevicesContext(context.Background(), arg, opts...)
+}
+
+// DevicesListTeamDevicesContext implements Client.
+func (f *Fake) DevicesListTeamDevicesContext(ctx context.Context, arg *ListTeamDevicesArg, opts ...dropbox.CallOption) (res *ListTeamDevicesResult, err error) {
+	f.Record("DevicesListTeamDevices", opts, arg)
+	if f.DevicesListTeamDevicesFunc == nil {
+		err = dropbox.NotImplemented("team", "DevicesListTeamDevices")
+		return
+	}
+	return f.DevicesListTeamDevicesFunc(ctx, arg, opts...)
+}
+
+// DevicesRevokeDeviceSession implements Client.
+func (f *Fake) DevicesRevokeDeviceSession(arg *RevokeDeviceSessionArg, opts ...dropbox.CallOption) (err error) {
+	return f.DevicesRevokeDeviceSessionContext(context.Background(), arg, opts...)
+}
+
+// DevicesRevokeDeviceSessionContext implements Client.
+func (f *Fake) DevicesRevokeDeviceSessionContext(ctx context.Context, arg *RevokeDeviceSessionArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("DevicesRevokeDeviceSession", opts, arg)
+	if f.DevicesRevokeDeviceSessionFunc == nil {
+		err = dropbox.NotImplemented("team", "DevicesRevokeDeviceSession")
+		return
+	}
+	return f.DevicesRevokeDeviceSessionFunc(ctx, arg, opts...)
+}
+
+// DevicesRevokeDeviceSessionBatch implements Client.
+func (f *Fake) DevicesRevokeDeviceSessionBatch(arg *RevokeDeviceSessionBatchArg, opts ...dropbox.CallOption) (res *RevokeDeviceSessionBatchResult, err error) {
+	return f.DevicesRevokeDeviceSessionBatchContext(context.Background(), arg, opts...)
+}
+
+// DevicesRevokeDeviceSessionBatchContext implements Client.
+func (f *Fake) DevicesRevokeDeviceSessionBatchContext(ctx context.Context, arg *RevokeDeviceSessionBatchArg, opts ...dropbox.CallOption) (res *RevokeDeviceSessionBatchResult, err error) {
+	f.Record("DevicesRevokeDeviceSessionBatch", opts, arg)
+	if f.DevicesRevokeDeviceSessionBatchFunc == nil {
+		err = dropbox.NotImplemented("team", "DevicesRevokeDeviceSessionBatch")
+		return
+	}
+	return f.DevicesRevokeDeviceSessionBatchFunc(ctx, arg, opts...)
+}
+
+// FeaturesGetValues implements Client.
+func (f *Fake) FeaturesGetValues(arg *FeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *FeaturesGetValuesBatchResult, err error) {
+	return f.FeaturesGetValuesContext(context.Background(), arg, opts...)
+}
+
+// FeaturesGetValuesContext implements Client.
+func (f *Fake) FeaturesGetValuesContext(ctx context.Context, arg *FeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *FeaturesGetValuesBatchResult, err error) {
+	f.Record("FeaturesGetValues", opts, arg)
+	if f.FeaturesGetValuesFunc == nil {
+		err = dropbox.NotImplemented("team", "FeaturesGetValues")
+		return
+	}
+	return f.FeaturesGetValuesFunc(ctx, arg, opts...)
+}
+
+// GetInfo implements Client.
+func (f *Fake) GetInfo(opts ...dropbox.CallOption) (res *TeamGetInfoResult, err error) {
+	return f.GetInfoContext(context.Background(), opts...)
+}
+
+// GetInfoContext implements Client.
+func (f *Fake) GetInfoContext(ctx context.Context, opts ...dropbox.CallOption) (res *TeamGetInfoResult, err error) {
+	f.Record("GetInfo", opts)
+	if f.GetInfoFunc == nil {
+		err = dropbox.NotImplemented("team", "GetInfo")
+		return
+	}
+	return f.GetInfoFunc(ctx, opts...)
+}
+
+// GroupsCreate implements Client.
+func (f *Fake) GroupsCreate(arg *GroupCreateArg, opts ...dropbox.CallOption) (res *GroupFullInfo, err error) {
+	return f.GroupsCreateContext(context.Background(), arg, opts...)
+}
+
+// GroupsCreateContext implements Client.
+func (f *Fake) GroupsCreateContext(ctx context.Context, arg *GroupCreateArg, opts ...dropbox.CallOption) (res *GroupFullInfo, err error) {
+	f.Record("GroupsCreate", opts, arg)
+	if f.GroupsCreateFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsCreate")
+		return
+	}
+	return f.GroupsCreateFunc(ctx, arg, opts...)
+}
+
+// GroupsDelete implements Client.
+func (f *Fake) GroupsDelete(arg *GroupSelector, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return f.GroupsDeleteContext(context.Background(), arg, opts...)
+}
+
+// GroupsDeleteContext implements Client.
+func (f *Fake) GroupsDeleteContext(ctx context.Context, arg *GroupSelector, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	f.Record("GroupsDelete", opts, arg)
+	if f.GroupsDeleteFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsDelete")
+		return
+	}
+	return f.GroupsDeleteFunc(ctx, arg, opts...)
+}
+
+// GroupsGetInfo implements Client.
+func (f *Fake) GroupsGetInfo(arg *GroupsSelector, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error) {
+	return f.GroupsGetInfoContext(context.Background(), arg, opts...)
+}
+
+// GroupsGetInfoContext implements Client.
+func (f *Fake) GroupsGetInfoContext(ctx context.Context, arg *GroupsSelector, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error) {
+	f.Record("GroupsGetInfo", opts, arg)
+	if f.GroupsGetInfoFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsGetInfo")
+		return
+	}
+	return f.GroupsGetInfoFunc(ctx, arg, opts...)
+}
+
+// GroupsJobStatusGet implements Client.
+func (f *Fake) GroupsJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	return f.GroupsJobStatusGetContext(context.Background(), arg, opts...)
+}
+
+// GroupsJobStatusGetContext implements Client.
+func (f *Fake) GroupsJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	f.Record("GroupsJobStatusGet", opts, arg)
+	if f.GroupsJobStatusGetFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsJobStatusGet")
+		return
+	}
+	return f.GroupsJobStatusGetFunc(ctx, arg, opts...)
+}
+
+// GroupsList implements Client.
+func (f *Fake) GroupsList(arg *GroupsListArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error) {
+	return f.GroupsListContext(context.Background(), arg, opts...)
+}
+
+// GroupsListContext implements Client.
+func (f *Fake) GroupsListContext(ctx context.Context, arg *GroupsListArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error) {
+	f.Record("GroupsList", opts, arg)
+	if f.GroupsListFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsList")
+		return
+	}
+	return f.GroupsListFunc(ctx, arg, opts...)
+}
+
+// GroupsListContinue implements Client.
+func (f *Fake) GroupsListContinue(arg *GroupsListContinueArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error) {
+	return f.GroupsListContinueContext(context.Background(), arg, opts...)
+}
+
+// GroupsListContinueContext implements Client.
+func (f *Fake) GroupsListContinueContext(ctx context.Context, arg *GroupsListContinueArg, opts ...dropbox.CallOption) (res *GroupsListResult, err error) {
+	f.Record("GroupsListContinue", opts, arg)
+	if f.GroupsListContinueFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsListContinue")
+		return
+	}
+	return f.GroupsListContinueFunc(ctx, arg, opts...)
+}
+
+// GroupsMembersAdd implements Client.
+func (f *Fake) GroupsMembersAdd(arg *GroupMembersAddArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
+	return f.GroupsMembersAddContext(context.Background(), arg, opts...)
+}
+
+// GroupsMembersAddContext implements Client.
+func (f *Fake) GroupsMembersAddContext(ctx context.Context, arg *GroupMembersAddArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
+	f.Record("GroupsMembersAdd", opts, arg)
+	if f.GroupsMembersAddFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsMembersAdd")
+		return
+	}
+	return f.GroupsMembersAddFunc(ctx, arg, opts...)
+}
+
+// GroupsMembersList implements Client.
+func (f *Fake) GroupsMembersList(arg *GroupsMembersListArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error) {
+	return f.GroupsMembersListContext(context.Background(), arg, opts...)
+}
+
+// GroupsMembersListContext implements Client.
+func (f *Fake) GroupsMembersListContext(ctx context.Context, arg *GroupsMembersListArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error) {
+	f.Record("GroupsMembersList", opts, arg)
+	if f.GroupsMembersListFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsMembersList")
+		return
+	}
+	return f.GroupsMembersListFunc(ctx, arg, opts...)
+}
+
+// GroupsMembersListContinue implements Client.
+func (f *Fake) GroupsMembersListContinue(arg *GroupsMembersListContinueArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error) {
+	return f.GroupsMembersListContinueContext(context.Background(), arg, opts...)
+}
+
+// GroupsMembersListContinueContext implements Client.
+func (f *Fake) GroupsMembersListContinueContext(ctx context.Context, arg *GroupsMembersListContinueArg, opts ...dropbox.CallOption) (res *GroupsMembersListResult, err error) {
+	f.Record("GroupsMembersListContinue", opts, arg)
+	if f.GroupsMembersListContinueFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsMembersListContinue")
+		return
+	}
+	return f.GroupsMembersListContinueFunc(ctx, arg, opts...)
+}
+
+// GroupsMembersRemove implements Client.
+func (f *Fake) GroupsMembersRemove(arg *GroupMembersRemoveArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
+	return f.GroupsMembersRemoveContext(context.Background(), arg, opts...)
+}
+
+// GroupsMembersRemoveContext implements Client.
+func (f *Fake) GroupsMembersRemoveContext(ctx context.Context, arg *GroupMembersRemoveArg, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
+	f.Record("GroupsMembersRemove", opts, arg)
+	if f.GroupsMembersRemoveFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsMembersRemove")
+		return
+	}
+	return f.GroupsMembersRemoveFunc(ctx, arg, opts...)
+}
+
+// GroupsMembersSetAccessType implements Client.
+func (f *Fake) GroupsMembersSetAccessType(arg *GroupMembersSetAccessTypeArg, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error) {
+	return f.GroupsMembersSetAccessTypeContext(context.Background(), arg, opts...)
+}
+
+// GroupsMembersSetAccessTypeContext implements Client.
+func (f *Fake) GroupsMembersSetAccessTypeContext(ctx context.Context, arg *GroupMembersSetAccessTypeArg, opts ...dropbox.CallOption) (res []*GroupsGetInfoItem, err error) {
+	f.Record("GroupsMembersSetAccessType", opts, arg)
+	if f.GroupsMembersSetAccessTypeFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsMembersSetAccessType")
+		return
+	}
+	return f.GroupsMembersSetAccessTypeFunc(ctx, arg, opts...)
+}
+
+// GroupsUpdate implements Client.
+func (f *Fake) GroupsUpdate(arg *GroupUpdateArgs, opts ...dropbox.CallOption) (res *GroupFullInfo, err error) {
+	return f.GroupsUpdateContext(context.Background(), arg, opts...)
+}
+
+// GroupsUpdateContext implements Client.
+func (f *Fake) GroupsUpdateContext(ctx context.Context, arg *GroupUpdateArgs, opts ...dropbox.CallOption) (res *GroupFullInfo, err error) {
+	f.Record("GroupsUpdate", opts, arg)
+	if f.GroupsUpdateFunc == nil {
+		err = dropbox.NotImplemented("team", "GroupsUpdate")
+		return
+	}
+	return f.GroupsUpdateFunc(ctx, arg, opts...)
+}
+
+// LegalHoldsCreatePolicy implements Client.
+func (f *Fake) LegalHoldsCreatePolicy(arg *LegalHoldsPolicyCreateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	return f.LegalHoldsCreatePolicyContext(context.Background(), arg, opts...)
+}
+
+// LegalHoldsCreatePolicyContext implements Client.
+func (f *Fake) LegalHoldsCreatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyCreateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	f.Record("LegalHoldsCreatePolicy", opts, arg)
+	if f.LegalHoldsCreatePolicyFunc == nil {
+		err = dropbox.NotImplemented("team", "LegalHoldsCreatePolicy")
+		return
+	}
+	return f.LegalHoldsCreatePolicyFunc(ctx, arg, opts...)
+}
+
+// LegalHoldsGetPolicy implements Client.
+func (f *Fake) LegalHoldsGetPolicy(arg *LegalHoldsGetPolicyArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	return f.LegalHoldsGetPolicyContext(context.Background(), arg, opts...)
+}
+
+// LegalHoldsGetPolicyContext implements Client.
+func (f *Fake) LegalHoldsGetPolicyContext(ctx context.Context, arg *LegalHoldsGetPolicyArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	f.Record("LegalHoldsGetPolicy", opts, arg)
+	if f.LegalHoldsGetPolicyFunc == nil {
+		err = dropbox.NotImplemented("team", "LegalHoldsGetPolicy")
+		return
+	}
+	return f.LegalHoldsGetPolicyFunc(ctx, arg, opts...)
+}
+
+// LegalHoldsListHeldRevisions implements Client.
+func (f *Fake) LegalHoldsListHeldRevisions(arg *LegalHoldsListHeldRevisionsArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error) {
+	return f.LegalHoldsListHeldRevisionsContext(context.Background(), arg, opts...)
+}
+
+// LegalHoldsListHeldRevisionsContext implements Client.
+func (f *Fake) LegalHoldsListHeldRevisionsContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error) {
+	f.Record("LegalHoldsListHeldRevisions", opts, arg)
+	if f.LegalHoldsListHeldRevisionsFunc == nil {
+		err = dropbox.NotImplemented("team", "LegalHoldsListHeldRevisions")
+		return
+	}
+	return f.LegalHoldsListHeldRevisionsFunc(ctx, arg, opts...)
+}
+
+// LegalHoldsListHeldRevisionsContinue implements Client.
+func (f *Fake) LegalHoldsListHeldRevisionsContinue(arg *LegalHoldsListHeldRevisionsContinueArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error) {
+	return f.LegalHoldsListHeldRevisionsContinueContext(context.Background(), arg, opts...)
+}
+
+// LegalHoldsListHeldRevisionsContinueContext implements Client.
+func (f *Fake) LegalHoldsListHeldRevisionsContinueContext(ctx context.Context, arg *LegalHoldsListHeldRevisionsContinueArg, opts ...dropbox.CallOption) (res *LegalHoldsListHeldRevisionResult, err error) {
+	f.Record("LegalHoldsListHeldRevisionsContinue", opts, arg)
+	if f.LegalHoldsListHeldRevisionsContinueFunc == nil {
+		err = dropbox.NotImplemented("team", "LegalHoldsListHeldRevisionsContinue")
+		return
+	}
+	return f.LegalHoldsListHeldRevisionsContinueFunc(ctx, arg, opts...)
+}
+
+// LegalHoldsListPolicies implements Client.
+func (f *Fake) LegalHoldsListPolicies(arg *LegalHoldsListPoliciesArg, opts ...dropbox.CallOption) (res *LegalHoldsListPoliciesResult, err error) {
+	return f.LegalHoldsListPoliciesContext(context.Background(), arg, opts...)
+}
+
+// LegalHoldsListPoliciesContext implements Client.
+func (f *Fake) LegalHoldsListPoliciesContext(ctx context.Context, arg *LegalHoldsListPoliciesArg, opts ...dropbox.CallOption) (res *LegalHoldsListPoliciesResult, err error) {
+	f.Record("LegalHoldsListPolicies", opts, arg)
+	if f.LegalHoldsListPoliciesFunc == nil {
+		err = dropbox.NotImplemented("team", "LegalHoldsListPolicies")
+		return
+	}
+	return f.LegalHoldsListPoliciesFunc(ctx, arg, opts...)
+}
+
+// LegalHoldsReleasePolicy implements Client.
+func (f *Fake) LegalHoldsReleasePolicy(arg *LegalHoldsPolicyReleaseArg, opts ...dropbox.CallOption) (err error) {
+	return f.LegalHoldsReleasePolicyContext(context.Background(), arg, opts...)
+}
+
+// LegalHoldsReleasePolicyContext implements Client.
+func (f *Fake) LegalHoldsReleasePolicyContext(ctx context.Context, arg *LegalHoldsPolicyReleaseArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("LegalHoldsReleasePolicy", opts, arg)
+	if f.LegalHoldsReleasePolicyFunc == nil {
+		err = dropbox.NotImplemented("team", "LegalHoldsReleasePolicy")
+		return
+	}
+	return f.LegalHoldsReleasePolicyFunc(ctx, arg, opts...)
+}
+
+// LegalHoldsUpdatePolicy implements Client.
+func (f *Fake) LegalHoldsUpdatePolicy(arg *LegalHoldsPolicyUpdateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	return f.LegalHoldsUpdatePolicyContext(context.Background(), arg, opts...)
+}
+
+// LegalHoldsUpdatePolicyContext implements Client.
+func (f *Fake) LegalHoldsUpdatePolicyContext(ctx context.Context, arg *LegalHoldsPolicyUpdateArg, opts ...dropbox.CallOption) (res *LegalHoldPolicy, err error) {
+	f.Record("LegalHoldsUpdatePolicy", opts, arg)
+	if f.LegalHoldsUpdatePolicyFunc == nil {
+		err = dropbox.NotImplemented("team", "LegalHoldsUpdatePolicy")
+		return
+	}
+	return f.LegalHoldsUpdatePolicyFunc(ctx, arg, opts...)
+}
+
+// LinkedAppsListMemberLinkedApps implements Client.
+func (f *Fake) LinkedAppsListMemberLinkedApps(arg *ListMemberAppsArg, opts ...dropbox.CallOption) (res *ListMemberAppsResult, err error) {
+	return f.LinkedAppsListMemberLinkedAppsContext(context.Background(), arg, opts...)
+}
+
+// LinkedAppsListMemberLinkedAppsContext implements Client.
+func (f *Fake) LinkedAppsListMemberLinkedAppsContext(ctx context.Context, arg *ListMemberAppsArg, opts ...dropbox.CallOption) (res *ListMemberAppsResult, err error) {
+	f.Record("LinkedAppsListMemberLinkedApps", opts, arg)
+	if f.LinkedAppsListMemberLinkedAppsFunc == nil {
+		err = dropbox.NotImplemented("team", "LinkedAppsListMemberLinkedApps")
+		return
+	}
+	return f.LinkedAppsListMemberLinkedAppsFunc(ctx, arg, opts...)
+}
+
+// LinkedAppsListMembersLinkedApps implements Client.
+func (f *Fake) LinkedAppsListMembersLinkedApps(arg *ListMembersAppsArg, opts ...dropbox.CallOption) (res *ListMembersAppsResult, err error) {
+	return f.LinkedAppsListMembersLinkedAppsContext(context.Background(), arg, opts...)
+}
+
+// LinkedAppsListMembersLinkedAppsContext implements Client.
+func (f *Fake) LinkedAppsListMembersLinkedAppsContext(ctx context.Context, arg *ListMembersAppsArg, opts ...dropbox.CallOption) (res *ListMembersAppsResult, err error) {
+	f.Record("LinkedAppsListMembersLinkedApps", opts, arg)
+	if f.LinkedAppsListMembersLinkedAppsFunc == nil {
+		err = dropbox.NotImplemented("team", "LinkedAppsListMembersLinkedApps")
+		return
+	}
+	return f.LinkedAppsListMembersLinkedAppsFunc(ctx, arg, opts...)
+}
+
+// LinkedAppsListTeamLinkedApps implements Client.
+func (f *Fake) LinkedAppsListTeamLinkedApps(arg *ListTeamAppsArg, opts ...dropbox.CallOption) (res *ListTeamAppsResult, err error) {
+	return f.LinkedAppsListTeamLinkedAppsContext(context.Background(), arg, opts...)
+}
+
+// LinkedAppsListTeamLinkedAppsContext implements Client.
+func (f *Fake) LinkedAppsListTeamLinkedAppsContext(ctx context.Context, arg *ListTeamAppsArg, opts ...dropbox.CallOption) (res *ListTeamAppsResult, err error) {
+	f.Record("LinkedAppsListTeamLinkedApps", opts, arg)
+	if f.LinkedAppsListTeamLinkedAppsFunc == nil {
+		err = dropbox.NotImplemented("team", "LinkedAppsListTeamLinkedApps")
+		return
+	}
+	return f.LinkedAppsListTeamLinkedAppsFunc(ctx, arg, opts...)
+}
+
+// LinkedAppsRevokeLinkedApp implements Client.
+func (f *Fake) LinkedAppsRevokeLinkedApp(arg *RevokeLinkedApiAppArg, opts ...dropbox.CallOption) (err error) {
+	return f.LinkedAppsRevokeLinkedAppContext(context.Background(), arg, opts...)
+}
+
+// LinkedAppsRevokeLinkedAppContext implements Client.
+func (f *Fake) LinkedAppsRevokeLinkedAppContext(ctx context.Context, arg *RevokeLinkedApiAppArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("LinkedAppsRevokeLinkedApp", opts, arg)
+	if f.LinkedAppsRevokeLinkedAppFunc == nil {
+		err = dropbox.NotImplemented("team", "LinkedAppsRevokeLinkedApp")
+		return
+	}
+	return f.LinkedAppsRevokeLinkedAppFunc(ctx, arg, opts...)
+}
+
+// LinkedAppsRevokeLinkedAppBatch implements Client.
+func (f *Fake) LinkedAppsRevokeLinkedAppBatch(arg *RevokeLinkedApiAppBatchArg, opts ...dropbox.CallOption) (res *RevokeLinkedAppBatchResult, err error) {
+	return f.LinkedAppsRevokeLinkedAppBatchContext(context.Background(), arg, opts...)
+}
+
+// LinkedAppsRevokeLinkedAppBatchContext implements Client.
+func (f *Fake) LinkedAppsRevokeLinkedAppBatchContext(ctx context.Context, arg *RevokeLinkedApiAppBatchArg, opts ...dropbox.CallOption) (res *RevokeLinkedAppBatchResult, err error) {
+	f.Record("LinkedAppsRevokeLinkedAppBatch", opts, arg)
+	if f.LinkedAppsRevokeLinkedAppBatchFunc == nil {
+		err = dropbox.NotImplemented("team", "LinkedAppsRevokeLinkedAppBatch")
+		return
+	}
+	return f.LinkedAppsRevokeLinkedAppBatchFunc(ctx, arg, opts...)
+}
+
+// MemberSpaceLimitsExcludedUsersAdd implements Client.
+func (f *Fake) MemberSpaceLimitsExcludedUsersAdd(arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error) {
+	return f.MemberSpaceLimitsExcludedUsersAddContext(context.Background(), arg, opts...)
+}
+
+// MemberSpaceLimitsExcludedUsersAddContext implements Client.
+func (f *Fake) MemberSpaceLimitsExcludedUsersAddContext(ctx context.Context, arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error) {
+	f.Record("MemberSpaceLimitsExcludedUsersAdd", opts, arg)
+	if f.MemberSpaceLimitsExcludedUsersAddFunc == nil {
+		err = dropbox.NotImplemented("team", "MemberSpaceLimitsExcludedUsersAdd")
+		return
+	}
+	return f.MemberSpaceLimitsExcludedUsersAddFunc(ctx, arg, opts...)
+}
+
+// MemberSpaceLimitsExcludedUsersList implements Client.
+func (f *Fake) MemberSpaceLimitsExcludedUsersList(arg *ExcludedUsersListArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error) {
+	return f.MemberSpaceLimitsExcludedUsersListContext(context.Background(), arg, opts...)
+}
+
+// MemberSpaceLimitsExcludedUsersListContext implements Client.
+func (f *Fake) MemberSpaceLimitsExcludedUsersListContext(ctx context.Context, arg *ExcludedUsersListArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error) {
+	f.Record("MemberSpaceLimitsExcludedUsersList", opts, arg)
+	if f.MemberSpaceLimitsExcludedUsersListFunc == nil {
+		err = dropbox.NotImplemented("team", "MemberSpaceLimitsExcludedUsersList")
+		return
+	}
+	return f.MemberSpaceLimitsExcludedUsersListFunc(ctx, arg, opts...)
+}
+
+// MemberSpaceLimitsExcludedUsersListContinue implements Client.
+func (f *Fake) MemberSpaceLimitsExcludedUsersListContinue(arg *ExcludedUsersListContinueArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error) {
+	return f.MemberSpaceLimitsExcludedUsersListContinueContext(context.Background(), arg, opts...)
+}
+
+// MemberSpaceLimitsExcludedUsersListContinueContext implements Client.
+func (f *Fake) MemberSpaceLimitsExcludedUsersListContinueContext(ctx context.Context, arg *ExcludedUsersListContinueArg, opts ...dropbox.CallOption) (res *ExcludedUsersListResult, err error) {
+	f.Record("MemberSpaceLimitsExcludedUsersListContinue", opts, arg)
+	if f.MemberSpaceLimitsExcludedUsersListContinueFunc == nil {
+		err = dropbox.NotImplemented("team", "MemberSpaceLimitsExcludedUsersListContinue")
+		return
+	}
+	return f.MemberSpaceLimitsExcludedUsersListContinueFunc(ctx, arg, opts...)
+}
+
+// MemberSpaceLimitsExcludedUsersRemove implements Client.
+func (f *Fake) MemberSpaceLimitsExcludedUsersRemove(arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error) {
+	return f.MemberSpaceLimitsExcludedUsersRemoveContext(context.Background(), arg, opts...)
+}
+
+// MemberSpaceLimitsExcludedUsersRemoveContext implements Client.
+func (f *Fake) MemberSpaceLimitsExcludedUsersRemoveContext(ctx context.Context, arg *ExcludedUsersUpdateArg, opts ...dropbox.CallOption) (res *ExcludedUsersUpdateResult, err error) {
+	f.Record("MemberSpaceLimitsExcludedUsersRemove", opts, arg)
+	if f.MemberSpaceLimitsExcludedUsersRemoveFunc == nil {
+		err = dropbox.NotImplemented("team", "MemberSpaceLimitsExcludedUsersRemove")
+		return
+	}
+	return f.MemberSpaceLimitsExcludedUsersRemoveFunc(ctx, arg, opts...)
+}
+
+// MemberSpaceLimitsGetCustomQuota implements Client.
+func (f *Fake) MemberSpaceLimitsGetCustomQuota(arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error) {
+	return f.MemberSpaceLimitsGetCustomQuotaContext(context.Background(), arg, opts...)
+}
+
+// MemberSpaceLimitsGetCustomQuotaContext implements Client.
+func (f *Fake) MemberSpaceLimitsGetCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error) {
+	f.Record("MemberSpaceLimitsGetCustomQuota", opts, arg)
+	if f.MemberSpaceLimitsGetCustomQuotaFunc == nil {
+		err = dropbox.NotImplemented("team", "MemberSpaceLimitsGetCustomQuota")
+		return
+	}
+	return f.MemberSpaceLimitsGetCustomQuotaFunc(ctx, arg, opts...)
+}
+
+// MemberSpaceLimitsRemoveCustomQuota implements Client.
+func (f *Fake) MemberSpaceLimitsRemoveCustomQuota(arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*RemoveCustomQuotaResult, err error) {
+	return f.MemberSpaceLimitsRemoveCustomQuotaContext(context.Background(), arg, opts...)
+}
+
+// MemberSpaceLimitsRemoveCustomQuotaContext implements Client.
+func (f *Fake) MemberSpaceLimitsRemoveCustomQuotaContext(ctx context.Context, arg *CustomQuotaUsersArg, opts ...dropbox.CallOption) (res []*RemoveCustomQuotaResult, err error) {
+	f.Record("MemberSpaceLimitsRemoveCustomQuota", opts, arg)
+	if f.MemberSpaceLimitsRemoveCustomQuotaFunc == nil {
+		err = dropbox.NotImplemented("team", "MemberSpaceLimitsRemoveCustomQuota")
+		return
+	}
+	return f.MemberSpaceLimitsRemoveCustomQuotaFunc(ctx, arg, opts...)
+}
+
+// MemberSpaceLimitsSetCustomQuota implements Client.
+func (f *Fake) MemberSpaceLimitsSetCustomQuota(arg *SetCustomQuotaArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error) {
+	return f.MemberSpaceLimitsSetCustomQuotaContext(context.Background(), arg, opts...)
+}
+
+// MemberSpaceLimitsSetCustomQuotaContext implements Client.
+func (f *Fake) MemberSpaceLimitsSetCustomQuotaContext(ctx context.Context, arg *SetCustomQuotaArg, opts ...dropbox.CallOption) (res []*CustomQuotaResult, err error) {
+	f.Record("MemberSpaceLimitsSetCustomQuota", opts, arg)
+	if f.MemberSpaceLimitsSetCustomQuotaFunc == nil {
+		err = dropbox.NotImplemented("team", "MemberSpaceLimitsSetCustomQuota")
+		return
+	}
+	return f.MemberSpaceLimitsSetCustomQuotaFunc(ctx, arg, opts...)
+}
+
+// MembersAddV2 implements Client.
+func (f *Fake) MembersAddV2(arg *MembersAddV2Arg, opts ...dropbox.CallOption) (res *MembersAddLaunchV2Result, err error) {
+	return f.MembersAddV2Context(context.Background(), arg, opts...)
+}
+
+// MembersAddV2Context implements Client.
+func (f *Fake) MembersAddV2Context(ctx context.Context, arg *MembersAddV2Arg, opts ...dropbox.CallOption) (res *MembersAddLaunchV2Result, err error) {
+	f.Record("MembersAddV2", opts, arg)
+	if f.MembersAddV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersAddV2")
+		return
+	}
+	return f.MembersAddV2Func(ctx, arg, opts...)
+}
+
+// MembersAdd implements Client.
+func (f *Fake) MembersAdd(arg *MembersAddArg, opts ...dropbox.CallOption) (res *MembersAddLaunch, err error) {
+	return f.MembersAddContext(context.Background(), arg, opts...)
+}
+
+// MembersAddContext implements Client.
+func (f *Fake) MembersAddContext(ctx context.Context, arg *MembersAddArg, opts ...dropbox.CallOption) (res *MembersAddLaunch, err error) {
+	f.Record("MembersAdd", opts, arg)
+	if f.MembersAddFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersAdd")
+		return
+	}
+	return f.MembersAddFunc(ctx, arg, opts...)
+}
+
+// MembersAddJobStatusGetV2 implements Client.
+func (f *Fake) MembersAddJobStatusGetV2(arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatusV2Result, err error) {
+	return f.MembersAddJobStatusGetV2Context(context.Background(), arg, opts...)
+}
+
+// MembersAddJobStatusGetV2Context implements Client.
+func (f *Fake) MembersAddJobStatusGetV2Context(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatusV2Result, err error) {
+	f.Record("MembersAddJobStatusGetV2", opts, arg)
+	if f.MembersAddJobStatusGetV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersAddJobStatusGetV2")
+		return
+	}
+	return f.MembersAddJobStatusGetV2Func(ctx, arg, opts...)
+}
+
+// MembersAddJobStatusGet implements Client.
+func (f *Fake) MembersAddJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatus, err error) {
+	return f.MembersAddJobStatusGetContext(context.Background(), arg, opts...)
+}
+
+// MembersAddJobStatusGetContext implements Client.
+func (f *Fake) MembersAddJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *MembersAddJobStatus, err error) {
+	f.Record("MembersAddJobStatusGet", opts, arg)
+	if f.MembersAddJobStatusGetFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersAddJobStatusGet")
+		return
+	}
+	return f.MembersAddJobStatusGetFunc(ctx, arg, opts...)
+}
+
+// MembersDeleteProfilePhotoV2 implements Client.
+func (f *Fake) MembersDeleteProfilePhotoV2(arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	return f.MembersDeleteProfilePhotoV2Context(context.Background(), arg, opts...)
+}
+
+// MembersDeleteProfilePhotoV2Context implements Client.
+func (f *Fake) MembersDeleteProfilePhotoV2Context(ctx context.Context, arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	f.Record("MembersDeleteProfilePhotoV2", opts, arg)
+	if f.MembersDeleteProfilePhotoV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersDeleteProfilePhotoV2")
+		return
+	}
+	return f.MembersDeleteProfilePhotoV2Func(ctx, arg, opts...)
+}
+
+// MembersDeleteProfilePhoto implements Client.
+func (f *Fake) MembersDeleteProfilePhoto(arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	return f.MembersDeleteProfilePhotoContext(context.Background(), arg, opts...)
+}
+
+// MembersDeleteProfilePhotoContext implements Client.
+func (f *Fake) MembersDeleteProfilePhotoContext(ctx context.Context, arg *MembersDeleteProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	f.Record("MembersDeleteProfilePhoto", opts, arg)
+	if f.MembersDeleteProfilePhotoFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersDeleteProfilePhoto")
+		return
+	}
+	return f.MembersDeleteProfilePhotoFunc(ctx, arg, opts...)
+}
+
+// MembersGetAvailableTeamMemberRoles implements Client.
+func (f *Fake) MembersGetAvailableTeamMemberRoles(opts ...dropbox.CallOption) (res *MembersGetAvailableTeamMemberRolesResult, err error) {
+	return f.MembersGetAvailableTeamMemberRolesContext(context.Background(), opts...)
+}
+
+// MembersGetAvailableTeamMemberRolesContext implements Client.
+func (f *Fake) MembersGetAvailableTeamMemberRolesContext(ctx context.Context, opts ...dropbox.CallOption) (res *MembersGetAvailableTeamMemberRolesResult, err error) {
+	f.Record("MembersGetAvailableTeamMemberRoles", opts)
+	if f.MembersGetAvailableTeamMemberRolesFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersGetAvailableTeamMemberRoles")
+		return
+	}
+	return f.MembersGetAvailableTeamMemberRolesFunc(ctx, opts...)
+}
+
+// MembersGetInfoV2 implements Client.
+func (f *Fake) MembersGetInfoV2(arg *MembersGetInfoV2Arg, opts ...dropbox.CallOption) (res *MembersGetInfoV2Result, err error) {
+	return f.MembersGetInfoV2Context(context.Background(), arg, opts...)
+}
+
+// MembersGetInfoV2Context implements Client.
+func (f *Fake) MembersGetInfoV2Context(ctx context.Context, arg *MembersGetInfoV2Arg, opts ...dropbox.CallOption) (res *MembersGetInfoV2Result, err error) {
+	f.Record("MembersGetInfoV2", opts, arg)
+	if f.MembersGetInfoV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersGetInfoV2")
+		return
+	}
+	return f.MembersGetInfoV2Func(ctx, arg, opts...)
+}
+
+// MembersGetInfo implements Client.
+func (f *Fake) MembersGetInfo(arg *MembersGetInfoArgs, opts ...dropbox.CallOption) (res []*MembersGetInfoItem, err error) {
+	return f.MembersGetInfoContext(context.Background(), arg, opts...)
+}
+
+// MembersGetInfoContext implements Client.
+func (f *Fake) MembersGetInfoContext(ctx context.Context, arg *MembersGetInfoArgs, opts ...dropbox.CallOption) (res []*MembersGetInfoItem, err error) {
+	f.Record("MembersGetInfo", opts, arg)
+	if f.MembersGetInfoFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersGetInfo")
+		return
+	}
+	return f.MembersGetInfoFunc(ctx, arg, opts...)
+}
+
+// MembersListV2 implements Client.
+func (f *Fake) MembersListV2(arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error) {
+	return f.MembersListV2Context(context.Background(), arg, opts...)
+}
+
+// MembersListV2Context implements Client.
+func (f *Fake) MembersListV2Context(ctx context.Context, arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error) {
+	f.Record("MembersListV2", opts, arg)
+	if f.MembersListV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersListV2")
+		return
+	}
+	return f.MembersListV2Func(ctx, arg, opts...)
+}
+
+// MembersList implements Client.
+func (f *Fake) MembersList(arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListResult, err error) {
+	return f.MembersListContext(context.Background(), arg, opts...)
+}
+
+// MembersListContext implements Client.
+func (f *Fake) MembersListContext(ctx context.Context, arg *MembersListArg, opts ...dropbox.CallOption) (res *MembersListResult, err error) {
+	f.Record("MembersList", opts, arg)
+	if f.MembersListFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersList")
+		return
+	}
+	return f.MembersListFunc(ctx, arg, opts...)
+}
+
+// MembersListContinueV2 implements Client.
+func (f *Fake) MembersListContinueV2(arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error) {
+	return f.MembersListContinueV2Context(context.Background(), arg, opts...)
+}
+
+// MembersListContinueV2Context implements Client.
+func (f *Fake) MembersListContinueV2Context(ctx context.Context, arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListV2Result, err error) {
+	f.Record("MembersListContinueV2", opts, arg)
+	if f.MembersListContinueV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersListContinueV2")
+		return
+	}
+	return f.MembersListContinueV2Func(ctx, arg, opts...)
+}
+
+// MembersListContinue implements Client.
+func (f *Fake) MembersListContinue(arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListResult, err error) {
+	return f.MembersListContinueContext(context.Background(), arg, opts...)
+}
+
+// MembersListContinueContext implements Client.
+func (f *Fake) MembersListContinueContext(ctx context.Context, arg *MembersListContinueArg, opts ...dropbox.CallOption) (res *MembersListResult, err error) {
+	f.Record("MembersListContinue", opts, arg)
+	if f.MembersListContinueFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersListContinue")
+		return
+	}
+	return f.MembersListContinueFunc(ctx, arg, opts...)
+}
+
+// MembersMoveFormerMemberFiles implements Client.
+func (f *Fake) MembersMoveFormerMemberFiles(arg *MembersDataTransferArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return f.MembersMoveFormerMemberFilesContext(context.Background(), arg, opts...)
+}
+
+// MembersMoveFormerMemberFilesContext implements Client.
+func (f *Fake) MembersMoveFormerMemberFilesContext(ctx context.Context, arg *MembersDataTransferArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	f.Record("MembersMoveFormerMemberFiles", opts, arg)
+	if f.MembersMoveFormerMemberFilesFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersMoveFormerMemberFiles")
+		return
+	}
+	return f.MembersMoveFormerMemberFilesFunc(ctx, arg, opts...)
+}
+
+// MembersMoveFormerMemberFilesJobStatusCheck implements Client.
+func (f *Fake) MembersMoveFormerMemberFilesJobStatusCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	return f.MembersMoveFormerMemberFilesJobStatusCheckContext(context.Background(), arg, opts...)
+}
+
+// MembersMoveFormerMemberFilesJobStatusCheckContext implements Client.
+func (f *Fake) MembersMoveFormerMemberFilesJobStatusCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	f.Record("MembersMoveFormerMemberFilesJobStatusCheck", opts, arg)
+	if f.MembersMoveFormerMemberFilesJobStatusCheckFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersMoveFormerMemberFilesJobStatusCheck")
+		return
+	}
+	return f.MembersMoveFormerMemberFilesJobStatusCheckFunc(ctx, arg, opts...)
+}
+
+// MembersRecover implements Client.
+func (f *Fake) MembersRecover(arg *MembersRecoverArg, opts ...dropbox.CallOption) (err error) {
+	return f.MembersRecoverContext(context.Background(), arg, opts...)
+}
+
+// MembersRecoverContext implements Client.
+func (f *Fake) MembersRecoverContext(ctx context.Context, arg *MembersRecoverArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("MembersRecover", opts, arg)
+	if f.MembersRecoverFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersRecover")
+		return
+	}
+	return f.MembersRecoverFunc(ctx, arg, opts...)
+}
+
+// MembersRemove implements Client.
+func (f *Fake) MembersRemove(arg *MembersRemoveArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	return f.MembersRemoveContext(context.Background(), arg, opts...)
+}
+
+// MembersRemoveContext implements Client.
+func (f *Fake) MembersRemoveContext(ctx context.Context, arg *MembersRemoveArg, opts ...dropbox.CallOption) (res *async.LaunchEmptyResult, err error) {
+	f.Record("MembersRemove", opts, arg)
+	if f.MembersRemoveFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersRemove")
+		return
+	}
+	return f.MembersRemoveFunc(ctx, arg, opts...)
+}
+
+// MembersRemoveJobStatusGet implements Client.
+func (f *Fake) MembersRemoveJobStatusGet(arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	return f.MembersRemoveJobStatusGetContext(context.Background(), arg, opts...)
+}
+
+// MembersRemoveJobStatusGetContext implements Client.
+func (f *Fake) MembersRemoveJobStatusGetContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	f.Record("MembersRemoveJobStatusGet", opts, arg)
+	if f.MembersRemoveJobStatusGetFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersRemoveJobStatusGet")
+		return
+	}
+	return f.MembersRemoveJobStatusGetFunc(ctx, arg, opts...)
+}
+
+// MembersSecondaryEmailsAdd implements Client.
+func (f *Fake) MembersSecondaryEmailsAdd(arg *AddSecondaryEmailsArg, opts ...dropbox.CallOption) (res *AddSecondaryEmailsResult, err error) {
+	return f.MembersSecondaryEmailsAddContext(context.Background(), arg, opts...)
+}
+
+// MembersSecondaryEmailsAddContext implements Client.
+func (f *Fake) MembersSecondaryEmailsAddContext(ctx context.Context, arg *AddSecondaryEmailsArg, opts ...dropbox.CallOption) (res *AddSecondaryEmailsResult, err error) {
+	f.Record("MembersSecondaryEmailsAdd", opts, arg)
+	if f.MembersSecondaryEmailsAddFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersSecondaryEmailsAdd")
+		return
+	}
+	return f.MembersSecondaryEmailsAddFunc(ctx, arg, opts...)
+}
+
+// MembersSecondaryEmailsDelete implements Client.
+func (f *Fake) MembersSecondaryEmailsDelete(arg *DeleteSecondaryEmailsArg, opts ...dropbox.CallOption) (res *DeleteSecondaryEmailsResult, err error) {
+	return f.MembersSecondaryEmailsDeleteContext(context.Background(), arg, opts...)
+}
+
+// MembersSecondaryEmailsDeleteContext implements Client.
+func (f *Fake) MembersSecondaryEmailsDeleteContext(ctx context.Context, arg *DeleteSecondaryEmailsArg, opts ...dropbox.CallOption) (res *DeleteSecondaryEmailsResult, err error) {
+	f.Record("MembersSecondaryEmailsDelete", opts, arg)
+	if f.MembersSecondaryEmailsDeleteFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersSecondaryEmailsDelete")
+		return
+	}
+	return f.MembersSecondaryEmailsDeleteFunc(ctx, arg, opts...)
+}
+
+// MembersSecondaryEmailsResendVerificationEmails implements Client.
+func (f *Fake) MembersSecondaryEmailsResendVerificationEmails(arg *ResendVerificationEmailArg, opts ...dropbox.CallOption) (res *ResendVerificationEmailResult, err error) {
+	return f.MembersSecondaryEmailsResendVerificationEmailsContext(context.Background(), arg, opts...)
+}
+
+// MembersSecondaryEmailsResendVerificationEmailsContext implements Client.
+func (f *Fake) MembersSecondaryEmailsResendVerificationEmailsContext(ctx context.Context, arg *ResendVerificationEmailArg, opts ...dropbox.CallOption) (res *ResendVerificationEmailResult, err error) {
+	f.Record("MembersSecondaryEmailsResendVerificationEmails", opts, arg)
+	if f.MembersSecondaryEmailsResendVerificationEmailsFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersSecondaryEmailsResendVerificationEmails")
+		return
+	}
+	return f.MembersSecondaryEmailsResendVerificationEmailsFunc(ctx, arg, opts...)
+}
+
+// MembersSendWelcomeEmail implements Client.
+func (f *Fake) MembersSendWelcomeEmail(arg *UserSelectorArg, opts ...dropbox.CallOption) (err error) {
+	return f.MembersSendWelcomeEmailContext(context.Background(), arg, opts...)
+}
+
+// MembersSendWelcomeEmailContext implements Client.
+func (f *Fake) MembersSendWelcomeEmailContext(ctx context.Context, arg *UserSelectorArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("MembersSendWelcomeEmail", opts, arg)
+	if f.MembersSendWelcomeEmailFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersSendWelcomeEmail")
+		return
+	}
+	return f.MembersSendWelcomeEmailFunc(ctx, arg, opts...)
+}
+
+// MembersSetAdminPermissionsV2 implements Client.
+func (f *Fake) MembersSetAdminPermissionsV2(arg *MembersSetPermissions2Arg, opts ...dropbox.CallOption) (res *MembersSetPermissions2Result, err error) {
+	return f.MembersSetAdminPermissionsV2Context(context.Background(), arg, opts...)
+}
+
+// MembersSetAdminPermissionsV2Context implements Client.
+func (f *Fake) MembersSetAdminPermissionsV2Context(ctx context.Context, arg *MembersSetPermissions2Arg, opts ...dropbox.CallOption) (res *MembersSetPermissions2Result, err error) {
+	f.Record("MembersSetAdminPermissionsV2", opts, arg)
+	if f.MembersSetAdminPermissionsV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersSetAdminPermissionsV2")
+		return
+	}
+	return f.MembersSetAdminPermissionsV2Func(ctx, arg, opts...)
+}
+
+// MembersSetAdminPermissions implements Client.
+func (f *Fake) MembersSetAdminPermissions(arg *MembersSetPermissionsArg, opts ...dropbox.CallOption) (res *MembersSetPermissionsResult, err error) {
+	return f.MembersSetAdminPermissionsContext(context.Background(), arg, opts...)
+}
+
+// MembersSetAdminPermissionsContext implements Client.
+func (f *Fake) MembersSetAdminPermissionsContext(ctx context.Context, arg *MembersSetPermissionsArg, opts ...dropbox.CallOption) (res *MembersSetPermissionsResult, err error) {
+	f.Record("MembersSetAdminPermissions", opts, arg)
+	if f.MembersSetAdminPermissionsFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersSetAdminPermissions")
+		return
+	}
+	return f.MembersSetAdminPermissionsFunc(ctx, arg, opts...)
+}
+
+// MembersSetProfileV2 implements Client.
+func (f *Fake) MembersSetProfileV2(arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	return f.MembersSetProfileV2Context(context.Background(), arg, opts...)
+}
+
+// MembersSetProfileV2Context implements Client.
+func (f *Fake) MembersSetProfileV2Context(ctx context.Context, arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	f.Record("MembersSetProfileV2", opts, arg)
+	if f.MembersSetProfileV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersSetProfileV2")
+		return
+	}
+	return f.MembersSetProfileV2Func(ctx, arg, opts...)
+}
+
+// MembersSetProfile implements Client.
+func (f *Fake) MembersSetProfile(arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	return f.MembersSetProfileContext(context.Background(), arg, opts...)
+}
+
+// MembersSetProfileContext implements Client.
+func (f *Fake) MembersSetProfileContext(ctx context.Context, arg *MembersSetProfileArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	f.Record("MembersSetProfile", opts, arg)
+	if f.MembersSetProfileFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersSetProfile")
+		return
+	}
+	return f.MembersSetProfileFunc(ctx, arg, opts...)
+}
+
+// MembersSetProfilePhotoV2 implements Client.
+func (f *Fake) MembersSetProfilePhotoV2(arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	return f.MembersSetProfilePhotoV2Context(context.Background(), arg, opts...)
+}
+
+// MembersSetProfilePhotoV2Context implements Client.
+func (f *Fake) MembersSetProfilePhotoV2Context(ctx context.Context, arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfoV2Result, err error) {
+	f.Record("MembersSetProfilePhotoV2", opts, arg)
+	if f.MembersSetProfilePhotoV2Func == nil {
+		err = dropbox.NotImplemented("team", "MembersSetProfilePhotoV2")
+		return
+	}
+	return f.MembersSetProfilePhotoV2Func(ctx, arg, opts...)
+}
+
+// MembersSetProfilePhoto implements Client.
+func (f *Fake) MembersSetProfilePhoto(arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	return f.MembersSetProfilePhotoContext(context.Background(), arg, opts...)
+}
+
+// MembersSetProfilePhotoContext implements Client.
+func (f *Fake) MembersSetProfilePhotoContext(ctx context.Context, arg *MembersSetProfilePhotoArg, opts ...dropbox.CallOption) (res *TeamMemberInfo, err error) {
+	f.Record("MembersSetProfilePhoto", opts, arg)
+	if f.MembersSetProfilePhotoFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersSetProfilePhoto")
+		return
+	}
+	return f.MembersSetProfilePhotoFunc(ctx, arg, opts...)
+}
+
+// MembersSuspend implements Client.
+func (f *Fake) MembersSuspend(arg *MembersDeactivateArg, opts ...dropbox.CallOption) (err error) {
+	return f.MembersSuspendContext(context.Background(), arg, opts...)
+}
+
+// MembersSuspendContext implements Client.
+func (f *Fake) MembersSuspendContext(ctx context.Context, arg *MembersDeactivateArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("MembersSuspend", opts, arg)
+	if f.MembersSuspendFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersSuspend")
+		return
+	}
+	return f.MembersSuspendFunc(ctx, arg, opts...)
+}
+
+// MembersUnsuspend implements Client.
+func (f *Fake) MembersUnsuspend(arg *MembersUnsuspendArg, opts ...dropbox.CallOption) (err error) {
+	return f.MembersUnsuspendContext(context.Background(), arg, opts...)
+}
+
+// MembersUnsuspendContext implements Client.
+func (f *Fake) MembersUnsuspendContext(ctx context.Context, arg *MembersUnsuspendArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("MembersUnsuspend", opts, arg)
+	if f.MembersUnsuspendFunc == nil {
+		err = dropbox.NotImplemented("team", "MembersUnsuspend")
+		return
+	}
+	return f.MembersUnsuspendFunc(ctx, arg, opts...)
+}
+
+// NamespacesList implements Client.
+func (f *Fake) NamespacesList(arg *TeamNamespacesListArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error) {
+	return f.NamespacesListContext(context.Background(), arg, opts...)
+}
+
+// NamespacesListContext implements Client.
+func (f *Fake) NamespacesListContext(ctx context.Context, arg *TeamNamespacesListArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error) {
+	f.Record("NamespacesList", opts, arg)
+	if f.NamespacesListFunc == nil {
+		err = dropbox.NotImplemented("team", "NamespacesList")
+		return
+	}
+	return f.NamespacesListFunc(ctx, arg, opts...)
+}
+
+// NamespacesListContinue implements Client.
+func (f *Fake) NamespacesListContinue(arg *TeamNamespacesListContinueArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error) {
+	return f.NamespacesListContinueContext(context.Background(), arg, opts...)
+}
+
+// NamespacesListContinueContext implements Client.
+func (f *Fake) NamespacesListContinueContext(ctx context.Context, arg *TeamNamespacesListContinueArg, opts ...dropbox.CallOption) (res *TeamNamespacesListResult, err error) {
+	f.Record("NamespacesListContinue", opts, arg)
+	if f.NamespacesListContinueFunc == nil {
+		err = dropbox.NotImplemented("team", "NamespacesListContinue")
+		return
+	}
+	return f.NamespacesListContinueFunc(ctx, arg, opts...)
+}
+
+// PropertiesTemplateAdd implements Client.
+func (f *Fake) PropertiesTemplateAdd(arg *file_properties.AddTemplateArg, opts ...dropbox.CallOption) (res *file_properties.AddTemplateResult, err error) {
+	return f.PropertiesTemplateAddContext(context.Background(), arg, opts...)
+}
+
+// PropertiesTemplateAddContext implements Client.
+func (f *Fake) PropertiesTemplateAddContext(ctx context.Context, arg *file_properties.AddTemplateArg, opts ...dropbox.CallOption) (res *file_properties.AddTemplateResult, err error) {
+	f.Record("PropertiesTemplateAdd", opts, arg)
+	if f.PropertiesTemplateAddFunc == nil {
+		err = dropbox.NotImplemented("team", "PropertiesTemplateAdd")
+		return
+	}
+	return f.PropertiesTemplateAddFunc(ctx, arg, opts...)
+}
+
+// PropertiesTemplateGet implements Client.
+func (f *Fake) PropertiesTemplateGet(arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error) {
+	return f.PropertiesTemplateGetContext(context.Background(), arg, opts...)
+}
+
+// PropertiesTemplateGetContext implements Client.
+func (f *Fake) PropertiesTemplateGetContext(ctx context.Context, arg *file_properties.GetTemplateArg, opts ...dropbox.CallOption) (res *file_properties.GetTemplateResult, err error) {
+	f.Record("PropertiesTemplateGet", opts, arg)
+	if f.PropertiesTemplateGetFunc == nil {
+		err = dropbox.NotImplemented("team", "PropertiesTemplateGet")
+		return
+	}
+	return f.PropertiesTemplateGetFunc(ctx, arg, opts...)
+}
+
+// PropertiesTemplateList implements Client.
+func (f *Fake) PropertiesTemplateList(opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error) {
+	return f.PropertiesTemplateListContext(context.Background(), opts...)
+}
+
+// PropertiesTemplateListContext implements Client.
+func (f *Fake) PropertiesTemplateListContext(ctx context.Context, opts ...dropbox.CallOption) (res *file_properties.ListTemplateResult, err error) {
+	f.Record("PropertiesTemplateList", opts)
+	if f.PropertiesTemplateListFunc == nil {
+		err = dropbox.NotImplemented("team", "PropertiesTemplateList")
+		return
+	}
+	return f.PropertiesTemplateListFunc(ctx, opts...)
+}
+
+// PropertiesTemplateUpdate implements Client.
+func (f *Fake) PropertiesTemplateUpdate(arg *file_properties.UpdateTemplateArg, opts ...dropbox.CallOption) (res *file_properties.UpdateTemplateResult, err error) {
+	return f.PropertiesTemplateUpdateContext(context.Background(), arg, opts...)
+}
+
+// PropertiesTemplateUpdateContext implements Client.
+func (f *Fake) PropertiesTemplateUpdateContext(ctx context.Context, arg *file_properties.UpdateTemplateArg, opts ...dropbox.CallOption) (res *file_properties.UpdateTemplateResult, err error) {
+	f.Record("PropertiesTemplateUpdate", opts, arg)
+	if f.PropertiesTemplateUpdateFunc == nil {
+		err = dropbox.NotImplemented("team", "PropertiesTemplateUpdate")
+		return
+	}
+	return f.PropertiesTemplateUpdateFunc(ctx, arg, opts...)
+}
+
+// ReportsGetActivity implements Client.
+func (f *Fake) ReportsGetActivity(arg *DateRange, opts ...dropbox.CallOption) (res *GetActivityReport, err error) {
+	return f.ReportsGetActivityContext(context.Background(), arg, opts...)
+}
+
+// ReportsGetActivityContext implements Client.
+func (f *Fake) ReportsGetActivityContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetActivityReport, err error) {
+	f.Record("ReportsGetActivity", opts, arg)
+	if f.ReportsGetActivityFunc == nil {
+		err = dropbox.NotImplemented("team", "ReportsGetActivity")
+		return
+	}
+	return f.ReportsGetActivityFunc(ctx, arg, opts...)
+}
+
+// ReportsGetDevices implements Client.
+func (f *Fake) ReportsGetDevices(arg *DateRange, opts ...dropbox.CallOption) (res *GetDevicesReport, err error) {
+	return f.ReportsGetDevicesContext(context.Background(), arg, opts...)
+}
+
+// ReportsGetDevicesContext implements Client.
+func (f *Fake) ReportsGetDevicesContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetDevicesReport, err error) {
+	f.Record("ReportsGetDevices", opts, arg)
+	if f.ReportsGetDevicesFunc == nil {
+		err = dropbox.NotImplemented("team", "ReportsGetDevices")
+		return
+	}
+	return f.ReportsGetDevicesFunc(ctx, arg, opts...)
+}
+
+// ReportsGetMembership implements Client.
+func (f *Fake) ReportsGetMembership(arg *DateRange, opts ...dropbox.CallOption) (res *GetMembershipReport, err error) {
+	return f.ReportsGetMembershipContext(context.Background(), arg, opts...)
+}
+
+// ReportsGetMembershipContext implements Client.
+func (f *Fake) ReportsGetMembershipContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetMembershipReport, err error) {
+	f.Record("ReportsGetMembership", opts, arg)
+	if f.ReportsGetMembershipFunc == nil {
+		err = dropbox.NotImplemented("team", "ReportsGetMembership")
+		return
+	}
+	return f.ReportsGetMembershipFunc(ctx, arg, opts...)
+}
+
+// ReportsGetStorage implements Client.
+func (f *Fake) ReportsGetStorage(arg *DateRange, opts ...dropbox.CallOption) (res *GetStorageReport, err error) {
+	return f.ReportsGetStorageContext(context.Background(), arg, opts...)
+}
+
+// ReportsGetStorageContext implements Client.
+func (f *Fake) ReportsGetStorageContext(ctx context.Context, arg *DateRange, opts ...dropbox.CallOption) (res *GetStorageReport, err error) {
+	f.Record("ReportsGetStorage", opts, arg)
+	if f.ReportsGetStorageFunc == nil {
+		err = dropbox.NotImplemented("team", "ReportsGetStorage")
+		return
+	}
+	return f.ReportsGetStorageFunc(ctx, arg, opts...)
+}
+
+// TeamFolderActivate implements Client.
+func (f *Fake) TeamFolderActivate(arg *TeamFolderIdArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	return f.TeamFolderActivateContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderActivateContext implements Client.
+func (f *Fake) TeamFolderActivateContext(ctx context.Context, arg *TeamFolderIdArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	f.Record("TeamFolderActivate", opts, arg)
+	if f.TeamFolderActivateFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderActivate")
+		return
+	}
+	return f.TeamFolderActivateFunc(ctx, arg, opts...)
+}
+
+// TeamFolderArchive implements Client.
+func (f *Fake) TeamFolderArchive(arg *TeamFolderArchiveArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveLaunch, err error) {
+	return f.TeamFolderArchiveContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderArchiveContext implements Client.
+func (f *Fake) TeamFolderArchiveContext(ctx context.Context, arg *TeamFolderArchiveArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveLaunch, err error) {
+	f.Record("TeamFolderArchive", opts, arg)
+	if f.TeamFolderArchiveFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderArchive")
+		return
+	}
+	return f.TeamFolderArchiveFunc(ctx, arg, opts...)
+}
+
+// TeamFolderArchiveCheck implements Client.
+func (f *Fake) TeamFolderArchiveCheck(arg *async.PollArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveJobStatus, err error) {
+	return f.TeamFolderArchiveCheckContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderArchiveCheckContext implements Client.
+func (f *Fake) TeamFolderArchiveCheckContext(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (res *TeamFolderArchiveJobStatus, err error) {
+	f.Record("TeamFolderArchiveCheck", opts, arg)
+	if f.TeamFolderArchiveCheckFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderArchiveCheck")
+		return
+	}
+	return f.TeamFolderArchiveCheckFunc(ctx, arg, opts...)
+}
+
+// TeamFolderCreate implements Client.
+func (f *Fake) TeamFolderCreate(arg *TeamFolderCreateArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	return f.TeamFolderCreateContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderCreateContext implements Client.
+func (f *Fake) TeamFolderCreateContext(ctx context.Context, arg *TeamFolderCreateArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	f.Record("TeamFolderCreate", opts, arg)
+	if f.TeamFolderCreateFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderCreate")
+		return
+	}
+	return f.TeamFolderCreateFunc(ctx, arg, opts...)
+}
+
+// TeamFolderGetInfo implements Client.
+func (f *Fake) TeamFolderGetInfo(arg *TeamFolderIdListArg, opts ...dropbox.CallOption) (res []*TeamFolderGetInfoItem, err error) {
+	return f.TeamFolderGetInfoContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderGetInfoContext implements Client.
+func (f *Fake) TeamFolderGetInfoContext(ctx context.Context, arg *TeamFolderIdListArg, opts ...dropbox.CallOption) (res []*TeamFolderGetInfoItem, err error) {
+	f.Record("TeamFolderGetInfo", opts, arg)
+	if f.TeamFolderGetInfoFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderGetInfo")
+		return
+	}
+	return f.TeamFolderGetInfoFunc(ctx, arg, opts...)
+}
+
+// TeamFolderList implements Client.
+func (f *Fake) TeamFolderList(arg *TeamFolderListArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error) {
+	return f.TeamFolderListContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderListContext implements Client.
+func (f *Fake) TeamFolderListContext(ctx context.Context, arg *TeamFolderListArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error) {
+	f.Record("TeamFolderList", opts, arg)
+	if f.TeamFolderListFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderList")
+		return
+	}
+	return f.TeamFolderListFunc(ctx, arg, opts...)
+}
+
+// TeamFolderListContinue implements Client.
+func (f *Fake) TeamFolderListContinue(arg *TeamFolderListContinueArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error) {
+	return f.TeamFolderListContinueContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderListContinueContext implements Client.
+func (f *Fake) TeamFolderListContinueContext(ctx context.Context, arg *TeamFolderListContinueArg, opts ...dropbox.CallOption) (res *TeamFolderListResult, err error) {
+	f.Record("TeamFolderListContinue", opts, arg)
+	if f.TeamFolderListContinueFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderListContinue")
+		return
+	}
+	return f.TeamFolderListContinueFunc(ctx, arg, opts...)
+}
+
+// TeamFolderPermanentlyDelete implements Client.
+func (f *Fake) TeamFolderPermanentlyDelete(arg *TeamFolderIdArg, opts ...dropbox.CallOption) (err error) {
+	return f.TeamFolderPermanentlyDeleteContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderPermanentlyDeleteContext implements Client.
+func (f *Fake) TeamFolderPermanentlyDeleteContext(ctx context.Context, arg *TeamFolderIdArg, opts ...dropbox.CallOption) (err error) {
+	f.Record("TeamFolderPermanentlyDelete", opts, arg)
+	if f.TeamFolderPermanentlyDeleteFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderPermanentlyDelete")
+		return
+	}
+	return f.TeamFolderPermanentlyDeleteFunc(ctx, arg, opts...)
+}
+
+// TeamFolderRename implements Client.
+func (f *Fake) TeamFolderRename(arg *TeamFolderRenameArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	return f.TeamFolderRenameContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderRenameContext implements Client.
+func (f *Fake) TeamFolderRenameContext(ctx context.Context, arg *TeamFolderRenameArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	f.Record("TeamFolderRename", opts, arg)
+	if f.TeamFolderRenameFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderRename")
+		return
+	}
+	return f.TeamFolderRenameFunc(ctx, arg, opts...)
+}
+
+// TeamFolderUpdateSyncSettings implements Client.
+func (f *Fake) TeamFolderUpdateSyncSettings(arg *TeamFolderUpdateSyncSettingsArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	return f.TeamFolderUpdateSyncSettingsContext(context.Background(), arg, opts...)
+}
+
+// TeamFolderUpdateSyncSettingsContext implements Client.
+func (f *Fake) TeamFolderUpdateSyncSettingsContext(ctx context.Context, arg *TeamFolderUpdateSyncSettingsArg, opts ...dropbox.CallOption) (res *TeamFolderMetadata, err error) {
+	f.Record("TeamFolderUpdateSyncSettings", opts, arg)
+	if f.TeamFolderUpdateSyncSettingsFunc == nil {
+		err = dropbox.NotImplemented("team", "TeamFolderUpdateSyncSettings")
+		return
+	}
+	return f.TeamFolderUpdateSyncSettingsFunc(ctx, arg, opts...)
+}
+
+// TokenGetAuthenticatedAdmin implements Client.
+func (f *Fake) TokenGetAuthenticatedAdmin(opts ...dropbox.CallOption) (res *TokenGetAuthenticatedAdminResult, err error) {
+	return f.TokenGetAuthenticatedAdminContext(context.Background(), opts...)
+}
+
+// TokenGetAuthenticatedAdminContext implements Client.
+func (f *Fake) TokenGetAuthenticatedAdminContext(ctx context.Context, opts ...dropbox.CallOption) (res *TokenGetAuthenticatedAdminResult, err error) {
+	f.Record("TokenGetAuthenticatedAdmin", opts)
+	if f.TokenGetAuthenticatedAdminFunc == nil {
+		err = dropbox.NotImplemented("team", "TokenGetAuthenticatedAdmin")
+		return
+	}
+	return f.TokenGetAuthenticatedAdminFunc(ctx, opts...)
+}
@@ -0,0 +1,983 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team_common"
+)
+
+// DevicesListMembersDevicesIterator iterates over the entries returned by
+// `DevicesListMembersDevices`, fetching further pages by calling it again with
+// the cursor of the previous page.
+type DevicesListMembersDevicesIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListMembersDevicesArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*MemberDevices
+	index      int
+	entry      *MemberDevices
+	err        error
+}
+
+// NewDevicesListMembersDevicesIterator returns an iterator over the entries of
+// `DevicesListMembersDevices` called with arg. Every page is fetched with opts.
+func NewDevicesListMembersDevicesIterator(ctx context.Context, dbx Client, arg *ListMembersDevicesArg, opts ...dropbox.CallOption) *DevicesListMembersDevicesIterator {
+	a := *arg
+	return &DevicesListMembersDevicesIterator{ctx: ctx, dbx: dbx, opts: opts, arg: &a, cursor: a.Cursor, more: true}
+}
+
+// ResumeDevicesListMembersDevicesIterator returns an iterator continuing at
+// cursor, as returned by `DevicesListMembersDevicesIterator.Cursor`.
+func ResumeDevicesListMembersDevicesIterator(ctx context.Context, dbx Client, arg *ListMembersDevicesArg, cursor string, opts ...dropbox.CallOption) *DevicesListMembersDevicesIterator {
+	it := NewDevicesListMembersDevicesIterator(ctx, dbx, arg, opts...)
+	it.cursor = cursor
+	return it
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *DevicesListMembersDevicesIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *DevicesListMembersDevicesIterator) Entry() *MemberDevices {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DevicesListMembersDevicesIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *DevicesListMembersDevicesIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *DevicesListMembersDevicesIterator) fetch() {
+	var res *ListMembersDevicesResult
+	var err error
+	it.arg.Cursor = it.cursor
+	res, err = it.dbx.DevicesListMembersDevicesContext(it.ctx, it.arg, it.opts...)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Devices
+	it.index = 0
+}
+
+// DevicesListTeamDevicesIterator iterates over the entries returned by
+// `DevicesListTeamDevices`, fetching further pages by calling it again with the
+// cursor of the previous page.
+//
+// Deprecated: `DevicesListTeamDevices` is deprecated.
+type DevicesListTeamDevicesIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListTeamDevicesArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*MemberDevices
+	index      int
+	entry      *MemberDevices
+	err        error
+}
+
+// NewDevicesListTeamDevicesIterator returns an iterator over the entries of
+// `DevicesListTeamDevices` called with arg. Every page is fetched with opts.
+func NewDevicesListTeamDevicesIterator(ctx context.Context, dbx Client, arg *ListTeamDevicesArg, opts ...dropbox.CallOption) *DevicesListTeamDevicesIterator {
+	a := *arg
+	return &DevicesListTeamDevicesIterator{ctx: ctx, dbx: dbx, opts: opts, arg: &a, cursor: a.Cursor, more: true}
+}
+
+// ResumeDevicesListTeamDevicesIterator returns an iterator continuing at
+// cursor, as returned by `DevicesListTeamDevicesIterator.Cursor`.
+func ResumeDevicesListTeamDevicesIterator(ctx context.Context, dbx Client, arg *ListTeamDevicesArg, cursor string, opts ...dropbox.CallOption) *DevicesListTeamDevicesIterator {
+	it := NewDevicesListTeamDevicesIterator(ctx, dbx, arg, opts...)
+	it.cursor = cursor
+	return it
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *DevicesListTeamDevicesIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *DevicesListTeamDevicesIterator) Entry() *MemberDevices {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *DevicesListTeamDevicesIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *DevicesListTeamDevicesIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *DevicesListTeamDevicesIterator) fetch() {
+	var res *ListTeamDevicesResult
+	var err error
+	it.arg.Cursor = it.cursor
+	res, err = it.dbx.DevicesListTeamDevicesContext(it.ctx, it.arg, it.opts...)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Devices
+	it.index = 0
+}
+
+// GroupsListIterator iterates over the entries returned by `GroupsList`,
+// fetching further pages with `GroupsListContinue`.
+type GroupsListIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *GroupsListArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*team_common.GroupSummary
+	index      int
+	entry      *team_common.GroupSummary
+	err        error
+}
+
+// NewGroupsListIterator returns an iterator over the entries of `GroupsList`
+// called with arg. Every page is fetched with opts.
+func NewGroupsListIterator(ctx context.Context, dbx Client, arg *GroupsListArg, opts ...dropbox.CallOption) *GroupsListIterator {
+	return &GroupsListIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeGroupsListIterator returns an iterator continuing at cursor, as
+// returned by `GroupsListIterator.Cursor`.
+func ResumeGroupsListIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *GroupsListIterator {
+	return &GroupsListIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *GroupsListIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *GroupsListIterator) Entry() *team_common.GroupSummary {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *GroupsListIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *GroupsListIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *GroupsListIterator) fetch() {
+	var res *GroupsListResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.GroupsListContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.GroupsListContinueContext(it.ctx, NewGroupsListContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Groups
+	it.index = 0
+}
+
+// GroupsMembersListIterator iterates over the entries returned by
+// `GroupsMembersList`, fetching further pages with `GroupsMembersListContinue`.
+type GroupsMembersListIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *GroupsMembersListArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*GroupMemberInfo
+	index      int
+	entry      *GroupMemberInfo
+	err        error
+}
+
+// NewGroupsMembersListIterator returns an iterator over the entries of
+// `GroupsMembersList` called with arg. Every page is fetched with opts.
+func NewGroupsMembersListIterator(ctx context.Context, dbx Client, arg *GroupsMembersListArg, opts ...dropbox.CallOption) *GroupsMembersListIterator {
+	return &GroupsMembersListIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeGroupsMembersListIterator returns an iterator continuing at cursor, as
+// returned by `GroupsMembersListIterator.Cursor`.
+func ResumeGroupsMembersListIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *GroupsMembersListIterator {
+	return &GroupsMembersListIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *GroupsMembersListIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *GroupsMembersListIterator) Entry() *GroupMemberInfo {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *GroupsMembersListIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *GroupsMembersListIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *GroupsMembersListIterator) fetch() {
+	var res *GroupsMembersListResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.GroupsMembersListContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.GroupsMembersListContinueContext(it.ctx, NewGroupsMembersListContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Members
+	it.index = 0
+}
+
+// LinkedAppsListMembersLinkedAppsIterator iterates over the entries returned by
+// `LinkedAppsListMembersLinkedApps`, fetching further pages by calling it again
+// with the cursor of the previous page.
+type LinkedAppsListMembersLinkedAppsIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListMembersAppsArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*MemberLinkedApps
+	index      int
+	entry      *MemberLinkedApps
+	err        error
+}
+
+// NewLinkedAppsListMembersLinkedAppsIterator returns an iterator over the
+// entries of `LinkedAppsListMembersLinkedApps` called with arg. Every page is
+// fetched with opts.
+func NewLinkedAppsListMembersLinkedAppsIterator(ctx context.Context, dbx Client, arg *ListMembersAppsArg, opts ...dropbox.CallOption) *LinkedAppsListMembersLinkedAppsIterator {
+	a := *arg
+	return &LinkedAppsListMembersLinkedAppsIterator{ctx: ctx, dbx: dbx, opts: opts, arg: &a, cursor: a.Cursor, more: true}
+}
+
+// ResumeLinkedAppsListMembersLinkedAppsIterator returns an iterator continuing
+// at cursor, as returned by `LinkedAppsListMembersLinkedAppsIterator.Cursor`.
+func ResumeLinkedAppsListMembersLinkedAppsIterator(ctx context.Context, dbx Client, arg *ListMembersAppsArg, cursor string, opts ...dropbox.CallOption) *LinkedAppsListMembersLinkedAppsIterator {
+	it := NewLinkedAppsListMembersLinkedAppsIterator(ctx, dbx, arg, opts...)
+	it.cursor = cursor
+	return it
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *LinkedAppsListMembersLinkedAppsIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *LinkedAppsListMembersLinkedAppsIterator) Entry() *MemberLinkedApps {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *LinkedAppsListMembersLinkedAppsIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *LinkedAppsListMembersLinkedAppsIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *LinkedAppsListMembersLinkedAppsIterator) fetch() {
+	var res *ListMembersAppsResult
+	var err error
+	it.arg.Cursor = it.cursor
+	res, err = it.dbx.LinkedAppsListMembersLinkedAppsContext(it.ctx, it.arg, it.opts...)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Apps
+	it.index = 0
+}
+
+// LinkedAppsListTeamLinkedAppsIterator iterates over the entries returned by
+// `LinkedAppsListTeamLinkedApps`, fetching further pages by calling it again
+// with the cursor of the previous page.
+//
+// Deprecated: `LinkedAppsListTeamLinkedApps` is deprecated.
+type LinkedAppsListTeamLinkedAppsIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ListTeamAppsArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*MemberLinkedApps
+	index      int
+	entry      *MemberLinkedApps
+	err        error
+}
+
+// NewLinkedAppsListTeamLinkedAppsIterator returns an iterator over the entries
+// of `LinkedAppsListTeamLinkedApps` called with arg. Every page is fetched with
+// opts.
+func NewLinkedAppsListTeamLinkedAppsIterator(ctx context.Context, dbx Client, arg *ListTeamAppsArg, opts ...dropbox.CallOption) *LinkedAppsListTeamLinkedAppsIterator {
+	a := *arg
+	return &LinkedAppsListTeamLinkedAppsIterator{ctx: ctx, dbx: dbx, opts: opts, arg: &a, cursor: a.Cursor, more: true}
+}
+
+// ResumeLinkedAppsListTeamLinkedAppsIterator returns an iterator continuing at
+// cursor, as returned by `LinkedAppsListTeamLinkedAppsIterator.Cursor`.
+func ResumeLinkedAppsListTeamLinkedAppsIterator(ctx context.Context, dbx Client, arg *ListTeamAppsArg, cursor string, opts ...dropbox.CallOption) *LinkedAppsListTeamLinkedAppsIterator {
+	it := NewLinkedAppsListTeamLinkedAppsIterator(ctx, dbx, arg, opts...)
+	it.cursor = cursor
+	return it
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *LinkedAppsListTeamLinkedAppsIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *LinkedAppsListTeamLinkedAppsIterator) Entry() *MemberLinkedApps {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *LinkedAppsListTeamLinkedAppsIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *LinkedAppsListTeamLinkedAppsIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *LinkedAppsListTeamLinkedAppsIterator) fetch() {
+	var res *ListTeamAppsResult
+	var err error
+	it.arg.Cursor = it.cursor
+	res, err = it.dbx.LinkedAppsListTeamLinkedAppsContext(it.ctx, it.arg, it.opts...)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Apps
+	it.index = 0
+}
+
+// MemberSpaceLimitsExcludedUsersListIterator iterates over the entries returned
+// by `MemberSpaceLimitsExcludedUsersList`, fetching further pages with
+// `MemberSpaceLimitsExcludedUsersListContinue`.
+type MemberSpaceLimitsExcludedUsersListIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *ExcludedUsersListArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*MemberProfile
+	index      int
+	entry      *MemberProfile
+	err        error
+}
+
+// NewMemberSpaceLimitsExcludedUsersListIterator returns an iterator over the
+// entries of `MemberSpaceLimitsExcludedUsersList` called with arg. Every page
+// is fetched with opts.
+func NewMemberSpaceLimitsExcludedUsersListIterator(ctx context.Context, dbx Client, arg *ExcludedUsersListArg, opts ...dropbox.CallOption) *MemberSpaceLimitsExcludedUsersListIterator {
+	return &MemberSpaceLimitsExcludedUsersListIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeMemberSpaceLimitsExcludedUsersListIterator returns an iterator
+// continuing at cursor, as returned by
+// `MemberSpaceLimitsExcludedUsersListIterator.Cursor`.
+func ResumeMemberSpaceLimitsExcludedUsersListIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *MemberSpaceLimitsExcludedUsersListIterator {
+	return &MemberSpaceLimitsExcludedUsersListIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *MemberSpaceLimitsExcludedUsersListIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *MemberSpaceLimitsExcludedUsersListIterator) Entry() *MemberProfile {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *MemberSpaceLimitsExcludedUsersListIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *MemberSpaceLimitsExcludedUsersListIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *MemberSpaceLimitsExcludedUsersListIterator) fetch() {
+	var res *ExcludedUsersListResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.MemberSpaceLimitsExcludedUsersListContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.MemberSpaceLimitsExcludedUsersListContinueContext(it.ctx, NewExcludedUsersListContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Users
+	it.index = 0
+}
+
+// MembersListV2Iterator iterates over the entries returned by `MembersListV2`,
+// fetching further pages with `MembersListContinueV2`.
+type MembersListV2Iterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *MembersListArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*TeamMemberInfoV2
+	index      int
+	entry      *TeamMemberInfoV2
+	err        error
+}
+
+// NewMembersListV2Iterator returns an iterator over the entries of
+// `MembersListV2` called with arg. Every page is fetched with opts.
+func NewMembersListV2Iterator(ctx context.Context, dbx Client, arg *MembersListArg, opts ...dropbox.CallOption) *MembersListV2Iterator {
+	return &MembersListV2Iterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeMembersListV2Iterator returns an iterator continuing at cursor, as
+// returned by `MembersListV2Iterator.Cursor`.
+func ResumeMembersListV2Iterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *MembersListV2Iterator {
+	return &MembersListV2Iterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *MembersListV2Iterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *MembersListV2Iterator) Entry() *TeamMemberInfoV2 {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *MembersListV2Iterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *MembersListV2Iterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *MembersListV2Iterator) fetch() {
+	var res *MembersListV2Result
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.MembersListV2Context(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.MembersListContinueV2Context(it.ctx, NewMembersListContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Members
+	it.index = 0
+}
+
+// MembersListIterator iterates over the entries returned by `MembersList`,
+// fetching further pages with `MembersListContinue`.
+type MembersListIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *MembersListArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*TeamMemberInfo
+	index      int
+	entry      *TeamMemberInfo
+	err        error
+}
+
+// NewMembersListIterator returns an iterator over the entries of `MembersList`
+// called with arg. Every page is fetched with opts.
+func NewMembersListIterator(ctx context.Context, dbx Client, arg *MembersListArg, opts ...dropbox.CallOption) *MembersListIterator {
+	return &MembersListIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeMembersListIterator returns an iterator continuing at cursor, as
+// returned by `MembersListIterator.Cursor`.
+func ResumeMembersListIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *MembersListIterator {
+	return &MembersListIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *MembersListIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *MembersListIterator) Entry() *TeamMemberInfo {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *MembersListIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *MembersListIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *MembersListIterator) fetch() {
+	var res *MembersListResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.MembersListContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.MembersListContinueContext(it.ctx, NewMembersListContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Members
+	it.index = 0
+}
+
+// NamespacesListIterator iterates over the entries returned by
+// `NamespacesList`, fetching further pages with `NamespacesListContinue`.
+type NamespacesListIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *TeamNamespacesListArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*NamespaceMetadata
+	index      int
+	entry      *NamespaceMetadata
+	err        error
+}
+
+// NewNamespacesListIterator returns an iterator over the entries of
+// `NamespacesList` called with arg. Every page is fetched with opts.
+func NewNamespacesListIterator(ctx context.Context, dbx Client, arg *TeamNamespacesListArg, opts ...dropbox.CallOption) *NamespacesListIterator {
+	return &NamespacesListIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeNamespacesListIterator returns an iterator continuing at cursor, as
+// returned by `NamespacesListIterator.Cursor`.
+func ResumeNamespacesListIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *NamespacesListIterator {
+	return &NamespacesListIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *NamespacesListIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *NamespacesListIterator) Entry() *NamespaceMetadata {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *NamespacesListIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *NamespacesListIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *NamespacesListIterator) fetch() {
+	var res *TeamNamespacesListResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.NamespacesListContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.NamespacesListContinueContext(it.ctx, NewTeamNamespacesListContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.Namespaces
+	it.index = 0
+}
+
+// TeamFolderListIterator iterates over the entries returned by
+// `TeamFolderList`, fetching further pages with `TeamFolderListContinue`.
+type TeamFolderListIterator struct {
+	ctx        context.Context
+	dbx        Client
+	opts       []dropbox.CallOption
+	arg        *TeamFolderListArg
+	prevCursor string
+	cursor     string
+	more       bool
+	entries    []*TeamFolderMetadata
+	index      int
+	entry      *TeamFolderMetadata
+	err        error
+}
+
+// NewTeamFolderListIterator returns an iterator over the entries of
+// `TeamFolderList` called with arg. Every page is fetched with opts.
+func NewTeamFolderListIterator(ctx context.Context, dbx Client, arg *TeamFolderListArg, opts ...dropbox.CallOption) *TeamFolderListIterator {
+	return &TeamFolderListIterator{ctx: ctx, dbx: dbx, opts: opts, arg: arg, more: true}
+}
+
+// ResumeTeamFolderListIterator returns an iterator continuing at cursor, as
+// returned by `TeamFolderListIterator.Cursor`.
+func ResumeTeamFolderListIterator(ctx context.Context, dbx Client, cursor string, opts ...dropbox.CallOption) *TeamFolderListIterator {
+	return &TeamFolderListIterator{ctx: ctx, dbx: dbx, opts: opts, cursor: cursor, more: true}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false once all entries were returned or an error
+// occurred.
+func (it *TeamFolderListIterator) Next() bool {
+	for it.index >= len(it.entries) {
+		if it.err != nil || !it.more {
+			return false
+		}
+		it.fetch()
+	}
+	it.entry = it.entries[it.index]
+	it.index++
+	return true
+}
+
+// Entry returns the entry the iterator is positioned at.
+func (it *TeamFolderListIterator) Entry() *TeamFolderMetadata {
+	return it.entry
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TeamFolderListIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor from which the iteration can be resumed without
+// skipping entries. Call it after processing the current entry. While the
+// current page has entries left, it is the cursor the page was fetched with,
+// so these entries are returned again after resuming. It is empty if the
+// iteration can not be resumed.
+func (it *TeamFolderListIterator) Cursor() string {
+	if it.index < len(it.entries) {
+		return it.prevCursor
+	}
+	return it.cursor
+}
+
+func (it *TeamFolderListIterator) fetch() {
+	var res *TeamFolderListResult
+	var err error
+	if it.arg != nil {
+		res, err = it.dbx.TeamFolderListContext(it.ctx, it.arg, it.opts...)
+		it.arg = nil
+	} else {
+		res, err = it.dbx.TeamFolderListContinueContext(it.ctx, NewTeamFolderListContinueArg(it.cursor), it.opts...)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.prevCursor = it.cursor
+	it.cursor = res.Cursor
+	it.more = res.HasMore
+	it.entries = res.TeamFolders
+	it.index = 0
+}
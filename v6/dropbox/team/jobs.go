@@ -0,0 +1,253 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+)
+
+// GroupsDeleteAndWait calls `GroupsDelete`, then polls `GroupsJobStatusGet`
+// with poller until the job is done. A nil poller polls with the default
+// intervals. It returns the final status of the job, and an
+// `async.JobFailedError` if the job did not complete. All calls are made with
+// opts.
+func GroupsDeleteAndWait(ctx context.Context, dbx Client, arg *GroupSelector, poller *async.Poller, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	launch, err := dbx.GroupsDeleteContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == async.LaunchEmptyResultAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.GroupsJobStatusGetContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != async.PollEmptyResultInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &async.PollEmptyResult{Tagged: launch.Tagged}
+	}
+
+	if res.Tag != async.PollEmptyResultComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// GroupsMembersAddAndWait calls `GroupsMembersAdd`, then polls
+// `GroupsJobStatusGet` with poller until the job is done, unless the job ID of
+// the result is blank. A nil poller polls with the default intervals. It
+// returns the result of `GroupsMembersAdd`, and an `async.JobFailedError` if
+// the job did not complete. All calls are made with opts.
+func GroupsMembersAddAndWait(ctx context.Context, dbx Client, arg *GroupMembersAddArg, poller *async.Poller, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
+	res, err = dbx.GroupsMembersAddContext(ctx, arg, opts...)
+	if err != nil || strings.TrimSpace(res.AsyncJobId) == "" {
+		return
+	}
+
+	var status *async.PollEmptyResult
+	err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+		status, err = dbx.GroupsJobStatusGetContext(ctx, async.NewPollArg(res.AsyncJobId), opts...)
+		return err == nil && status.Tag != async.PollEmptyResultInProgress, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if status.Tag != async.PollEmptyResultComplete {
+		err = async.JobFailedError{Tag: status.Tag, Status: status}
+	}
+	return
+}
+
+// GroupsMembersRemoveAndWait calls `GroupsMembersRemove`, then polls
+// `GroupsJobStatusGet` with poller until the job is done, unless the job ID of
+// the result is blank. A nil poller polls with the default intervals. It
+// returns the result of `GroupsMembersRemove`, and an `async.JobFailedError` if
+// the job did not complete. All calls are made with opts.
+func GroupsMembersRemoveAndWait(ctx context.Context, dbx Client, arg *GroupMembersRemoveArg, poller *async.Poller, opts ...dropbox.CallOption) (res *GroupMembersChangeResult, err error) {
+	res, err = dbx.GroupsMembersRemoveContext(ctx, arg, opts...)
+	if err != nil || strings.TrimSpace(res.AsyncJobId) == "" {
+		return
+	}
+
+	var status *async.PollEmptyResult
+	err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+		status, err = dbx.GroupsJobStatusGetContext(ctx, async.NewPollArg(res.AsyncJobId), opts...)
+		return err == nil && status.Tag != async.PollEmptyResultInProgress, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if status.Tag != async.PollEmptyResultComplete {
+		err = async.JobFailedError{Tag: status.Tag, Status: status}
+	}
+	return
+}
+
+// MembersAddV2AndWait calls `MembersAddV2`, then polls
+// `MembersAddJobStatusGetV2` with poller until the job is done. A nil poller
+// polls with the default intervals. It returns the final status of the job, and
+// an `async.JobFailedError` if the job did not complete. All calls are made
+// with opts.
+func MembersAddV2AndWait(ctx context.Context, dbx Client, arg *MembersAddV2Arg, poller *async.Poller, opts ...dropbox.CallOption) (res *MembersAddJobStatusV2Result, err error) {
+	launch, err := dbx.MembersAddV2Context(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == MembersAddLaunchV2ResultAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.MembersAddJobStatusGetV2Context(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != MembersAddJobStatusV2ResultInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &MembersAddJobStatusV2Result{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != MembersAddJobStatusV2ResultComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// MembersAddAndWait calls `MembersAdd`, then polls `MembersAddJobStatusGet`
+// with poller until the job is done. A nil poller polls with the default
+// intervals. It returns the final status of the job, and an
+// `async.JobFailedError` if the job did not complete. All calls are made with
+// opts.
+func MembersAddAndWait(ctx context.Context, dbx Client, arg *MembersAddArg, poller *async.Poller, opts ...dropbox.CallOption) (res *MembersAddJobStatus, err error) {
+	launch, err := dbx.MembersAddContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == MembersAddLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.MembersAddJobStatusGetContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != MembersAddJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &MembersAddJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != MembersAddJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// MembersMoveFormerMemberFilesAndWait calls `MembersMoveFormerMemberFiles`,
+// then polls `MembersMoveFormerMemberFilesJobStatusCheck` with poller until the
+// job is done. A nil poller polls with the default intervals. It returns the
+// final status of the job, and an `async.JobFailedError` if the job did not
+// complete. All calls are made with opts.
+func MembersMoveFormerMemberFilesAndWait(ctx context.Context, dbx Client, arg *MembersDataTransferArg, poller *async.Poller, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	launch, err := dbx.MembersMoveFormerMemberFilesContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == async.LaunchEmptyResultAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.MembersMoveFormerMemberFilesJobStatusCheckContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != async.PollEmptyResultInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &async.PollEmptyResult{Tagged: launch.Tagged}
+	}
+
+	if res.Tag != async.PollEmptyResultComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// MembersRemoveAndWait calls `MembersRemove`, then polls
+// `MembersRemoveJobStatusGet` with poller until the job is done. A nil poller
+// polls with the default intervals. It returns the final status of the job, and
+// an `async.JobFailedError` if the job did not complete. All calls are made
+// with opts.
+func MembersRemoveAndWait(ctx context.Context, dbx Client, arg *MembersRemoveArg, poller *async.Poller, opts ...dropbox.CallOption) (res *async.PollEmptyResult, err error) {
+	launch, err := dbx.MembersRemoveContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == async.LaunchEmptyResultAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.MembersRemoveJobStatusGetContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != async.PollEmptyResultInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &async.PollEmptyResult{Tagged: launch.Tagged}
+	}
+
+	if res.Tag != async.PollEmptyResultComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
+
+// TeamFolderArchiveAndWait calls `TeamFolderArchive`, then polls
+// `TeamFolderArchiveCheck` with poller until the job is done. A nil poller
+// polls with the default intervals. It returns the final status of the job, and
+// an `async.JobFailedError` if the job did not complete. All calls are made
+// with opts.
+func TeamFolderArchiveAndWait(ctx context.Context, dbx Client, arg *TeamFolderArchiveArg, poller *async.Poller, opts ...dropbox.CallOption) (res *TeamFolderArchiveJobStatus, err error) {
+	launch, err := dbx.TeamFolderArchiveContext(ctx, arg, opts...)
+	if err != nil {
+		return
+	}
+
+	if launch.Tag == TeamFolderArchiveLaunchAsyncJobId {
+		err = poller.Poll(ctx, func(ctx context.Context) (done bool, err error) {
+			res, err = dbx.TeamFolderArchiveCheckContext(ctx, async.NewPollArg(launch.AsyncJobId), opts...)
+			return err == nil && res.Tag != TeamFolderArchiveJobStatusInProgress, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res = &TeamFolderArchiveJobStatus{Tagged: launch.Tagged, Complete: launch.Complete}
+	}
+
+	if res.Tag != TeamFolderArchiveJobStatusComplete {
+		err = async.JobFailedError{Tag: res.Tag, Status: res}
+	}
+	return
+}
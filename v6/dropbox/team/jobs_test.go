@@ -0,0 +1,72 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/async"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/team"
+)
+
+func TestGroupsMembersAddAndWait(t *testing.T) {
+	ctx := context.Background()
+	poller := &async.Poller{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond, Multiplier: 2}
+	arg := team.NewGroupMembersAddArg(&team.GroupSelector{}, nil)
+	result := &team.GroupMembersChangeResult{AsyncJobId: "job"}
+
+	checks, final := 0, async.PollEmptyResultComplete
+	fake := &team.Fake{
+		GroupsMembersAddFunc: func(ctx context.Context, arg *team.GroupMembersAddArg, opts ...dropbox.CallOption) (*team.GroupMembersChangeResult, error) {
+			return result, nil
+		},
+		GroupsJobStatusGetFunc: func(ctx context.Context, arg *async.PollArg, opts ...dropbox.CallOption) (*async.PollEmptyResult, error) {
+			if arg.AsyncJobId != "job" {
+				return nil, errors.New("unknown job")
+			}
+			checks++
+			if checks <= 2 {
+				return &async.PollEmptyResult{Tagged: dropbox.Tagged{Tag: async.PollEmptyResultInProgress}}, nil
+			}
+			return &async.PollEmptyResult{Tagged: dropbox.Tagged{Tag: final}}, nil
+		},
+	}
+	res, err := team.GroupsMembersAddAndWait(ctx, fake, arg, poller)
+	if err != nil || res != result || checks != 3 {
+		t.Errorf("Unexpected result %+v, %v after %d checks", res, err, checks)
+	}
+
+	checks, final = 0, "other"
+	var jobErr async.JobFailedError
+	if _, err = team.GroupsMembersAddAndWait(ctx, fake, arg, poller); !errors.As(err, &jobErr) || jobErr.Tag != final {
+		t.Errorf("Expected failed job, got %v", err)
+	}
+
+	// The legacy job ID is a single space, with nothing to wait for.
+	checks, result.AsyncJobId = 0, " "
+	if res, err = team.GroupsMembersAddAndWait(ctx, fake, arg, poller); err != nil || res != result || checks != 0 {
+		t.Errorf("Expected no polling, got %+v, %v after %d checks", res, err, checks)
+	}
+}
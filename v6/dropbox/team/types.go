@@ -191,6 +191,59 @@ func (u *AddSecondaryEmailResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AddSecondaryEmailResult instance
+func (u *AddSecondaryEmailResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap secondary_emails.SecondaryEmail
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "unavailable":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Unavailable string `json:"unavailable"`
+		}{u.Tagged, u.Unavailable})
+	case "already_pending":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AlreadyPending string `json:"already_pending"`
+		}{u.Tagged, u.AlreadyPending})
+	case "already_owned_by_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AlreadyOwnedByUser string `json:"already_owned_by_user"`
+		}{u.Tagged, u.AlreadyOwnedByUser})
+	case "reached_limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			ReachedLimit string `json:"reached_limit"`
+		}{u.Tagged, u.ReachedLimit})
+	case "transient_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TransientError string `json:"transient_error"`
+		}{u.Tagged, u.TransientError})
+	case "too_many_updates":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TooManyUpdates string `json:"too_many_updates"`
+		}{u.Tagged, u.TooManyUpdates})
+	case "unknown_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UnknownError string `json:"unknown_error"`
+		}{u.Tagged, u.UnknownError})
+	case "rate_limited":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			RateLimited string `json:"rate_limited"`
+		}{u.Tagged, u.RateLimited})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AddSecondaryEmailsArg : has no documentation (yet)
 type AddSecondaryEmailsArg struct {
 	// NewSecondaryEmails : List of users and secondary emails to add.
@@ -331,6 +384,28 @@ func (u *BaseTeamFolderError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a BaseTeamFolderError instance
+func (u *BaseTeamFolderError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *TeamFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "status_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			StatusError *TeamFolderInvalidStatusError `json:"status_error"`
+		}{u.Tagged, u.StatusError})
+	case "team_shared_dropbox_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamSharedDropboxError *TeamFolderTeamSharedDropboxError `json:"team_shared_dropbox_error"`
+		}{u.Tagged, u.TeamSharedDropboxError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CustomQuotaError : Error returned when getting member custom quota.
 type CustomQuotaError struct {
 	dropbox.Tagged
@@ -384,6 +459,24 @@ func (u *CustomQuotaResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a CustomQuotaResult instance
+func (u *CustomQuotaResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap UserCustomQuotaResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "invalid_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidUser *UserSelectorArg `json:"invalid_user"`
+		}{u.Tagged, u.InvalidUser})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CustomQuotaUsersArg : has no documentation (yet)
 type CustomQuotaUsersArg struct {
 	// Users : List of users.
@@ -478,6 +571,28 @@ func (u *DeleteSecondaryEmailResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a DeleteSecondaryEmailResult instance
+func (u *DeleteSecondaryEmailResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Success string `json:"success"`
+		}{u.Tagged, u.Success})
+	case "not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			NotFound string `json:"not_found"`
+		}{u.Tagged, u.NotFound})
+	case "cannot_remove_primary":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			CannotRemovePrimary string `json:"cannot_remove_primary"`
+		}{u.Tagged, u.CannotRemovePrimary})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // DeleteSecondaryEmailsArg : has no documentation (yet)
 type DeleteSecondaryEmailsArg struct {
 	// EmailsToDelete : List of users and their secondary emails to delete.
@@ -790,6 +905,33 @@ func (u *FeatureValue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a FeatureValue instance
+func (u *FeatureValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "upload_api_rate_limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UploadApiRateLimit *UploadApiRateLimitValue `json:"upload_api_rate_limit"`
+		}{u.Tagged, u.UploadApiRateLimit})
+	case "has_team_shared_dropbox":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			HasTeamSharedDropbox *HasTeamSharedDropboxValue `json:"has_team_shared_dropbox"`
+		}{u.Tagged, u.HasTeamSharedDropbox})
+	case "has_team_file_events":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			HasTeamFileEvents *HasTeamFileEventsValue `json:"has_team_file_events"`
+		}{u.Tagged, u.HasTeamFileEvents})
+	case "has_team_selective_sync":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			HasTeamSelectiveSync *HasTeamSelectiveSyncValue `json:"has_team_selective_sync"`
+		}{u.Tagged, u.HasTeamSelectiveSync})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FeaturesGetValuesBatchArg : has no documentation (yet)
 type FeaturesGetValuesBatchArg struct {
 	// Features : A list of features in `Feature`. If the list is empty, this
@@ -1247,6 +1389,28 @@ func (u *GroupMembersAddError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GroupMembersAddError instance
+func (u *GroupMembersAddError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "members_not_in_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MembersNotInTeam []string `json:"members_not_in_team"`
+		}{u.Tagged, u.MembersNotInTeam})
+	case "users_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UsersNotFound []string `json:"users_not_found"`
+		}{u.Tagged, u.UsersNotFound})
+	case "user_cannot_be_manager_of_company_managed_group":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserCannotBeManagerOfCompanyManagedGroup []string `json:"user_cannot_be_manager_of_company_managed_group"`
+		}{u.Tagged, u.UserCannotBeManagerOfCompanyManagedGroup})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GroupMembersChangeResult : Result returned by `groupsMembersAdd` and
 // `groupsMembersRemove`.
 type GroupMembersChangeResult struct {
@@ -1347,6 +1511,23 @@ func (u *GroupMembersRemoveError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GroupMembersRemoveError instance
+func (u *GroupMembersRemoveError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "members_not_in_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			MembersNotInTeam []string `json:"members_not_in_team"`
+		}{u.Tagged, u.MembersNotInTeam})
+	case "users_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UsersNotFound []string `json:"users_not_found"`
+		}{u.Tagged, u.UsersNotFound})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GroupMembersSelector : Argument for selecting a group and a list of users.
 type GroupMembersSelector struct {
 	// Group : Specify a group.
@@ -1426,6 +1607,23 @@ func (u *GroupSelector) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GroupSelector instance
+func (u *GroupSelector) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "group_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			GroupId string `json:"group_id"`
+		}{u.Tagged, u.GroupId})
+	case "group_external_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			GroupExternalId string `json:"group_external_id"`
+		}{u.Tagged, u.GroupExternalId})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GroupUpdateArgs : has no documentation (yet)
 type GroupUpdateArgs struct {
 	IncludeMembersArg
@@ -1521,6 +1719,24 @@ func (u *GroupsGetInfoItem) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GroupsGetInfoItem instance
+func (u *GroupsGetInfoItem) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "id_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			IdNotFound string `json:"id_not_found"`
+		}{u.Tagged, u.IdNotFound})
+	case "group_info":
+		type wrap GroupFullInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupInfo)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GroupsListArg : has no documentation (yet)
 type GroupsListArg struct {
 	// Limit : Number of results to return per call.
@@ -1697,6 +1913,23 @@ func (u *GroupsSelector) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GroupsSelector instance
+func (u *GroupsSelector) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "group_ids":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			GroupIds []string `json:"group_ids"`
+		}{u.Tagged, u.GroupIds})
+	case "group_external_ids":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			GroupExternalIds []string `json:"group_external_ids"`
+		}{u.Tagged, u.GroupExternalIds})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // HasTeamFileEventsValue : The value for `Feature.has_team_file_events`.
 type HasTeamFileEventsValue struct {
 	dropbox.Tagged
@@ -1731,6 +1964,18 @@ func (u *HasTeamFileEventsValue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a HasTeamFileEventsValue instance
+func (u *HasTeamFileEventsValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "enabled":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Enabled bool `json:"enabled"`
+		}{u.Tagged, u.Enabled})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // HasTeamSelectiveSyncValue : The value for `Feature.has_team_selective_sync`.
 type HasTeamSelectiveSyncValue struct {
 	dropbox.Tagged
@@ -1766,6 +2011,18 @@ func (u *HasTeamSelectiveSyncValue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a HasTeamSelectiveSyncValue instance
+func (u *HasTeamSelectiveSyncValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "has_team_selective_sync":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			HasTeamSelectiveSync bool `json:"has_team_selective_sync"`
+		}{u.Tagged, u.HasTeamSelectiveSync})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // HasTeamSharedDropboxValue : The value for `Feature.has_team_shared_dropbox`.
 type HasTeamSharedDropboxValue struct {
 	dropbox.Tagged
@@ -1800,6 +2057,18 @@ func (u *HasTeamSharedDropboxValue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a HasTeamSharedDropboxValue instance
+func (u *HasTeamSharedDropboxValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "has_team_shared_dropbox":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			HasTeamSharedDropbox bool `json:"has_team_shared_dropbox"`
+		}{u.Tagged, u.HasTeamSharedDropbox})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // LegalHoldHeldRevisionMetadata : has no documentation (yet)
 type LegalHoldHeldRevisionMetadata struct {
 	// NewFilename : The held revision filename.
@@ -2641,6 +2910,63 @@ func (u *MemberAddResultBase) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MemberAddResultBase instance
+func (u *MemberAddResultBase) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team_license_limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamLicenseLimit string `json:"team_license_limit"`
+		}{u.Tagged, u.TeamLicenseLimit})
+	case "free_team_member_limit_reached":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FreeTeamMemberLimitReached string `json:"free_team_member_limit_reached"`
+		}{u.Tagged, u.FreeTeamMemberLimitReached})
+	case "user_already_on_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserAlreadyOnTeam string `json:"user_already_on_team"`
+		}{u.Tagged, u.UserAlreadyOnTeam})
+	case "user_on_another_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserOnAnotherTeam string `json:"user_on_another_team"`
+		}{u.Tagged, u.UserOnAnotherTeam})
+	case "user_already_paired":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserAlreadyPaired string `json:"user_already_paired"`
+		}{u.Tagged, u.UserAlreadyPaired})
+	case "user_migration_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserMigrationFailed string `json:"user_migration_failed"`
+		}{u.Tagged, u.UserMigrationFailed})
+	case "duplicate_external_member_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DuplicateExternalMemberId string `json:"duplicate_external_member_id"`
+		}{u.Tagged, u.DuplicateExternalMemberId})
+	case "duplicate_member_persistent_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DuplicateMemberPersistentId string `json:"duplicate_member_persistent_id"`
+		}{u.Tagged, u.DuplicateMemberPersistentId})
+	case "persistent_id_disabled":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PersistentIdDisabled string `json:"persistent_id_disabled"`
+		}{u.Tagged, u.PersistentIdDisabled})
+	case "user_creation_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserCreationFailed string `json:"user_creation_failed"`
+		}{u.Tagged, u.UserCreationFailed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MemberAddResult : Describes the result of attempting to add a single user to
 // the team. 'success' is the only value indicating that a user was indeed added
 // to the team - the other values explain the type of failure that occurred, and
@@ -2777,6 +3103,69 @@ func (u *MemberAddResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MemberAddResult instance
+func (u *MemberAddResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team_license_limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamLicenseLimit string `json:"team_license_limit"`
+		}{u.Tagged, u.TeamLicenseLimit})
+	case "free_team_member_limit_reached":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FreeTeamMemberLimitReached string `json:"free_team_member_limit_reached"`
+		}{u.Tagged, u.FreeTeamMemberLimitReached})
+	case "user_already_on_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserAlreadyOnTeam string `json:"user_already_on_team"`
+		}{u.Tagged, u.UserAlreadyOnTeam})
+	case "user_on_another_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserOnAnotherTeam string `json:"user_on_another_team"`
+		}{u.Tagged, u.UserOnAnotherTeam})
+	case "user_already_paired":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserAlreadyPaired string `json:"user_already_paired"`
+		}{u.Tagged, u.UserAlreadyPaired})
+	case "user_migration_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserMigrationFailed string `json:"user_migration_failed"`
+		}{u.Tagged, u.UserMigrationFailed})
+	case "duplicate_external_member_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DuplicateExternalMemberId string `json:"duplicate_external_member_id"`
+		}{u.Tagged, u.DuplicateExternalMemberId})
+	case "duplicate_member_persistent_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DuplicateMemberPersistentId string `json:"duplicate_member_persistent_id"`
+		}{u.Tagged, u.DuplicateMemberPersistentId})
+	case "persistent_id_disabled":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PersistentIdDisabled string `json:"persistent_id_disabled"`
+		}{u.Tagged, u.PersistentIdDisabled})
+	case "user_creation_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserCreationFailed string `json:"user_creation_failed"`
+		}{u.Tagged, u.UserCreationFailed})
+	case "success":
+		type wrap TeamMemberInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MemberAddV2Arg : has no documentation (yet)
 type MemberAddV2Arg struct {
 	MemberAddArgBase
@@ -2930,6 +3319,69 @@ func (u *MemberAddV2Result) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MemberAddV2Result instance
+func (u *MemberAddV2Result) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team_license_limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamLicenseLimit string `json:"team_license_limit"`
+		}{u.Tagged, u.TeamLicenseLimit})
+	case "free_team_member_limit_reached":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FreeTeamMemberLimitReached string `json:"free_team_member_limit_reached"`
+		}{u.Tagged, u.FreeTeamMemberLimitReached})
+	case "user_already_on_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserAlreadyOnTeam string `json:"user_already_on_team"`
+		}{u.Tagged, u.UserAlreadyOnTeam})
+	case "user_on_another_team":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserOnAnotherTeam string `json:"user_on_another_team"`
+		}{u.Tagged, u.UserOnAnotherTeam})
+	case "user_already_paired":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserAlreadyPaired string `json:"user_already_paired"`
+		}{u.Tagged, u.UserAlreadyPaired})
+	case "user_migration_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserMigrationFailed string `json:"user_migration_failed"`
+		}{u.Tagged, u.UserMigrationFailed})
+	case "duplicate_external_member_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DuplicateExternalMemberId string `json:"duplicate_external_member_id"`
+		}{u.Tagged, u.DuplicateExternalMemberId})
+	case "duplicate_member_persistent_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			DuplicateMemberPersistentId string `json:"duplicate_member_persistent_id"`
+		}{u.Tagged, u.DuplicateMemberPersistentId})
+	case "persistent_id_disabled":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PersistentIdDisabled string `json:"persistent_id_disabled"`
+		}{u.Tagged, u.PersistentIdDisabled})
+	case "user_creation_failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			UserCreationFailed string `json:"user_creation_failed"`
+		}{u.Tagged, u.UserCreationFailed})
+	case "success":
+		type wrap TeamMemberInfoV2
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MemberDevices : Information on devices of a team's member.
 type MemberDevices struct {
 	// TeamMemberId : The member unique Id.
@@ -3119,6 +3571,23 @@ func (u *MembersAddJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MembersAddJobStatus instance
+func (u *MembersAddJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Complete []*MemberAddResult `json:"complete"`
+		}{u.Tagged, u.Complete})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed string `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MembersAddJobStatusV2Result : has no documentation (yet)
 type MembersAddJobStatusV2Result struct {
 	dropbox.Tagged
@@ -3168,6 +3637,23 @@ func (u *MembersAddJobStatusV2Result) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MembersAddJobStatusV2Result instance
+func (u *MembersAddJobStatusV2Result) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Complete []*MemberAddV2Result `json:"complete"`
+		}{u.Tagged, u.Complete})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed string `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MembersAddLaunch : has no documentation (yet)
 type MembersAddLaunch struct {
 	dropbox.Tagged
@@ -3213,6 +3699,23 @@ func (u *MembersAddLaunch) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MembersAddLaunch instance
+func (u *MembersAddLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Complete []*MemberAddResult `json:"complete"`
+		}{u.Tagged, u.Complete})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MembersAddLaunchV2Result : has no documentation (yet)
 type MembersAddLaunchV2Result struct {
 	dropbox.Tagged
@@ -3259,6 +3762,23 @@ func (u *MembersAddLaunchV2Result) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MembersAddLaunchV2Result instance
+func (u *MembersAddLaunchV2Result) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Complete []*MemberAddV2Result `json:"complete"`
+		}{u.Tagged, u.Complete})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MembersAddV2Arg : has no documentation (yet)
 type MembersAddV2Arg struct {
 	MembersAddArgBase
@@ -3438,6 +3958,18 @@ func (u *MembersGetInfoItemBase) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MembersGetInfoItemBase instance
+func (u *MembersGetInfoItemBase) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "id_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			IdNotFound string `json:"id_not_found"`
+		}{u.Tagged, u.IdNotFound})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MembersGetInfoItem : Describes a result obtained for a single user whose id
 // was specified in the parameter of `membersGetInfo`.
 type MembersGetInfoItem struct {
@@ -3486,6 +4018,24 @@ func (u *MembersGetInfoItem) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MembersGetInfoItem instance
+func (u *MembersGetInfoItem) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "id_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			IdNotFound string `json:"id_not_found"`
+		}{u.Tagged, u.IdNotFound})
+	case "member_info":
+		type wrap TeamMemberInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberInfo)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MembersGetInfoItemV2 : Describes a result obtained for a single user whose id
 // was specified in the parameter of `membersGetInfo`.
 type MembersGetInfoItemV2 struct {
@@ -3535,6 +4085,24 @@ func (u *MembersGetInfoItemV2) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MembersGetInfoItemV2 instance
+func (u *MembersGetInfoItemV2) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "id_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			IdNotFound string `json:"id_not_found"`
+		}{u.Tagged, u.IdNotFound})
+	case "member_info":
+		type wrap TeamMemberInfoV2
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberInfo)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MembersGetInfoV2Arg : has no documentation (yet)
 type MembersGetInfoV2Arg struct {
 	// Members : List of team members.
@@ -3999,6 +4567,18 @@ func (u *MembersSetProfilePhotoError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a MembersSetProfilePhotoError instance
+func (u *MembersSetProfilePhotoError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "photo_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PhotoError *account.SetProfilePhotoError `json:"photo_error"`
+		}{u.Tagged, u.PhotoError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // MembersSuspendError : has no documentation (yet)
 type MembersSuspendError struct {
 	dropbox.Tagged
@@ -4185,6 +4765,23 @@ func (u *RemoveCustomQuotaResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RemoveCustomQuotaResult instance
+func (u *RemoveCustomQuotaResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Success *UserSelectorArg `json:"success"`
+		}{u.Tagged, u.Success})
+	case "invalid_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidUser *UserSelectorArg `json:"invalid_user"`
+		}{u.Tagged, u.InvalidUser})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RemovedStatus : has no documentation (yet)
 type RemovedStatus struct {
 	// IsRecoverable : True if the removed team member is recoverable.
@@ -4260,6 +4857,28 @@ func (u *ResendSecondaryEmailResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ResendSecondaryEmailResult instance
+func (u *ResendSecondaryEmailResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Success string `json:"success"`
+		}{u.Tagged, u.Success})
+	case "not_pending":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			NotPending string `json:"not_pending"`
+		}{u.Tagged, u.NotPending})
+	case "rate_limited":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			RateLimited string `json:"rate_limited"`
+		}{u.Tagged, u.RateLimited})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ResendVerificationEmailArg : has no documentation (yet)
 type ResendVerificationEmailArg struct {
 	// EmailsToResend : List of users and secondary emails to resend
@@ -4354,6 +4973,31 @@ func (u *RevokeDeviceSessionArg) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a RevokeDeviceSessionArg instance
+func (u *RevokeDeviceSessionArg) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "web_session":
+		type wrap DeviceSessionArg
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WebSession)})
+	case "desktop_client":
+		type wrap RevokeDesktopClientArg
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DesktopClient)})
+	case "mobile_client":
+		type wrap DeviceSessionArg
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MobileClient)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // RevokeDeviceSessionBatchArg : has no documentation (yet)
 type RevokeDeviceSessionBatchArg struct {
 	// RevokeDevices : has no documentation (yet)
@@ -4607,6 +5251,28 @@ func (u *TeamFolderActivateError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderActivateError instance
+func (u *TeamFolderActivateError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *TeamFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "status_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			StatusError *TeamFolderInvalidStatusError `json:"status_error"`
+		}{u.Tagged, u.StatusError})
+	case "team_shared_dropbox_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamSharedDropboxError *TeamFolderTeamSharedDropboxError `json:"team_shared_dropbox_error"`
+		}{u.Tagged, u.TeamSharedDropboxError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamFolderIdArg : has no documentation (yet)
 type TeamFolderIdArg struct {
 	// TeamFolderId : The ID of the team folder.
@@ -4685,6 +5351,28 @@ func (u *TeamFolderArchiveError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderArchiveError instance
+func (u *TeamFolderArchiveError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *TeamFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "status_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			StatusError *TeamFolderInvalidStatusError `json:"status_error"`
+		}{u.Tagged, u.StatusError})
+	case "team_shared_dropbox_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamSharedDropboxError *TeamFolderTeamSharedDropboxError `json:"team_shared_dropbox_error"`
+		}{u.Tagged, u.TeamSharedDropboxError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamFolderArchiveJobStatus : has no documentation (yet)
 type TeamFolderArchiveJobStatus struct {
 	dropbox.Tagged
@@ -4730,6 +5418,24 @@ func (u *TeamFolderArchiveJobStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderArchiveJobStatus instance
+func (u *TeamFolderArchiveJobStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "complete":
+		type wrap TeamFolderMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	case "failed":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Failed *TeamFolderArchiveError `json:"failed"`
+		}{u.Tagged, u.Failed})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamFolderArchiveLaunch : has no documentation (yet)
 type TeamFolderArchiveLaunch struct {
 	dropbox.Tagged
@@ -4775,6 +5481,24 @@ func (u *TeamFolderArchiveLaunch) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderArchiveLaunch instance
+func (u *TeamFolderArchiveLaunch) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "async_job_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AsyncJobId string `json:"async_job_id"`
+		}{u.Tagged, u.AsyncJobId})
+	case "complete":
+		type wrap TeamFolderMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Complete)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamFolderCreateArg : has no documentation (yet)
 type TeamFolderCreateArg struct {
 	// Name : Name for the new team folder.
@@ -4828,6 +5552,18 @@ func (u *TeamFolderCreateError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderCreateError instance
+func (u *TeamFolderCreateError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "sync_settings_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SyncSettingsError *files.SyncSettingsError `json:"sync_settings_error"`
+		}{u.Tagged, u.SyncSettingsError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamFolderGetInfoItem : has no documentation (yet)
 type TeamFolderGetInfoItem struct {
 	dropbox.Tagged
@@ -4871,6 +5607,24 @@ func (u *TeamFolderGetInfoItem) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderGetInfoItem instance
+func (u *TeamFolderGetInfoItem) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "id_not_found":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			IdNotFound string `json:"id_not_found"`
+		}{u.Tagged, u.IdNotFound})
+	case "team_folder_metadata":
+		type wrap TeamFolderMetadata
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderMetadata)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamFolderIdListArg : has no documentation (yet)
 type TeamFolderIdListArg struct {
 	// TeamFolderIds : The list of team folder IDs.
@@ -5049,6 +5803,28 @@ func (u *TeamFolderPermanentlyDeleteError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderPermanentlyDeleteError instance
+func (u *TeamFolderPermanentlyDeleteError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *TeamFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "status_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			StatusError *TeamFolderInvalidStatusError `json:"status_error"`
+		}{u.Tagged, u.StatusError})
+	case "team_shared_dropbox_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamSharedDropboxError *TeamFolderTeamSharedDropboxError `json:"team_shared_dropbox_error"`
+		}{u.Tagged, u.TeamSharedDropboxError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamFolderRenameArg : has no documentation (yet)
 type TeamFolderRenameArg struct {
 	TeamFolderIdArg
@@ -5117,6 +5893,28 @@ func (u *TeamFolderRenameError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderRenameError instance
+func (u *TeamFolderRenameError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *TeamFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "status_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			StatusError *TeamFolderInvalidStatusError `json:"status_error"`
+		}{u.Tagged, u.StatusError})
+	case "team_shared_dropbox_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamSharedDropboxError *TeamFolderTeamSharedDropboxError `json:"team_shared_dropbox_error"`
+		}{u.Tagged, u.TeamSharedDropboxError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamFolderStatus : has no documentation (yet)
 type TeamFolderStatus struct {
 	dropbox.Tagged
@@ -5217,6 +6015,33 @@ func (u *TeamFolderUpdateSyncSettingsError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamFolderUpdateSyncSettingsError instance
+func (u *TeamFolderUpdateSyncSettingsError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "access_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			AccessError *TeamFolderAccessError `json:"access_error"`
+		}{u.Tagged, u.AccessError})
+	case "status_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			StatusError *TeamFolderInvalidStatusError `json:"status_error"`
+		}{u.Tagged, u.StatusError})
+	case "team_shared_dropbox_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamSharedDropboxError *TeamFolderTeamSharedDropboxError `json:"team_shared_dropbox_error"`
+		}{u.Tagged, u.TeamSharedDropboxError})
+	case "sync_settings_error":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			SyncSettingsError *files.SyncSettingsError `json:"sync_settings_error"`
+		}{u.Tagged, u.SyncSettingsError})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamGetInfoResult : has no documentation (yet)
 type TeamGetInfoResult struct {
 	// Name : The name of the team.
@@ -5371,6 +6196,19 @@ func (u *TeamMemberStatus) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamMemberStatus instance
+func (u *TeamMemberStatus) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "removed":
+		type wrap RemovedStatus
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Removed)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamMembershipType : has no documentation (yet)
 type TeamMembershipType struct {
 	dropbox.Tagged
@@ -5527,6 +6365,18 @@ func (u *UploadApiRateLimitValue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UploadApiRateLimitValue instance
+func (u *UploadApiRateLimitValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "limit":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Limit uint32 `json:"limit"`
+		}{u.Tagged, u.Limit})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UserAddResult : Result of trying to add secondary emails to a user. 'success'
 // is the only value indicating that a user was successfully retrieved for
 // adding secondary emails. The other values explain the type of error that
@@ -5592,6 +6442,34 @@ func (u *UserAddResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UserAddResult instance
+func (u *UserAddResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap UserSecondaryEmailsResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "invalid_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidUser *UserSelectorArg `json:"invalid_user"`
+		}{u.Tagged, u.InvalidUser})
+	case "unverified":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Unverified *UserSelectorArg `json:"unverified"`
+		}{u.Tagged, u.Unverified})
+	case "placeholder_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PlaceholderUser *UserSelectorArg `json:"placeholder_user"`
+		}{u.Tagged, u.PlaceholderUser})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UserCustomQuotaArg : User and their required custom quota in GB (1 TB = 1024
 // GB).
 type UserCustomQuotaArg struct {
@@ -5689,6 +6567,24 @@ func (u *UserDeleteResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UserDeleteResult instance
+func (u *UserDeleteResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap UserDeleteEmailsResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "invalid_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidUser *UserSelectorArg `json:"invalid_user"`
+		}{u.Tagged, u.InvalidUser})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UserResendEmailsResult : has no documentation (yet)
 type UserResendEmailsResult struct {
 	// User : has no documentation (yet)
@@ -5753,6 +6649,24 @@ func (u *UserResendResult) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UserResendResult instance
+func (u *UserResendResult) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "success":
+		type wrap UserResendEmailsResult
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Success)})
+	case "invalid_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			InvalidUser *UserSelectorArg `json:"invalid_user"`
+		}{u.Tagged, u.InvalidUser})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UserSecondaryEmailsArg : User and a list of secondary emails.
 type UserSecondaryEmailsArg struct {
 	// User : has no documentation (yet)
@@ -5835,6 +6749,28 @@ func (u *UserSelectorArg) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UserSelectorArg instance
+func (u *UserSelectorArg) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team_member_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamMemberId string `json:"team_member_id"`
+		}{u.Tagged, u.TeamMemberId})
+	case "external_id":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			ExternalId string `json:"external_id"`
+		}{u.Tagged, u.ExternalId})
+	case "email":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Email string `json:"email"`
+		}{u.Tagged, u.Email})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UsersSelectorArg : Argument for selecting a list of users, either by
 // team_member_ids, external_ids or emails.
 type UsersSelectorArg struct {
@@ -5884,3 +6820,25 @@ func (u *UsersSelectorArg) UnmarshalJSON(body []byte) error {
 	}
 	return nil
 }
+
+// MarshalJSON serializes a UsersSelectorArg instance
+func (u *UsersSelectorArg) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "team_member_ids":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			TeamMemberIds []string `json:"team_member_ids"`
+		}{u.Tagged, u.TeamMemberIds})
+	case "external_ids":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			ExternalIds []string `json:"external_ids"`
+		}{u.Tagged, u.ExternalIds})
+	case "emails":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Emails []string `json:"emails"`
+		}{u.Tagged, u.Emails})
+	}
+	return json.Marshal(u.Tagged)
+}
@@ -21,6 +21,7 @@
 package team_log
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -40,10 +41,14 @@ type Client interface {
 	// </business/plans-comparison>. Use `features/get_values`
 	// </developers/documentation/http/teams#team-features-get_values> to check
 	// for this feature. Permission : Team Auditing.
-	GetEvents(arg *GetTeamEventsArg) (res *GetTeamEventsResult, err error)
+	GetEvents(arg *GetTeamEventsArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error)
+	// GetEventsContext is like GetEvents but takes a context for cancellation and deadlines.
+	GetEventsContext(ctx context.Context, arg *GetTeamEventsArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error)
 	// GetEventsContinue : Once a cursor has been retrieved from `getEvents`,
 	// use this to paginate through all events. Permission : Team Auditing.
-	GetEventsContinue(arg *GetTeamEventsContinueArg) (res *GetTeamEventsResult, err error)
+	GetEventsContinue(arg *GetTeamEventsContinueArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error)
+	// GetEventsContinueContext is like GetEventsContinue but takes a context for cancellation and deadlines.
+	GetEventsContinueContext(ctx context.Context, arg *GetTeamEventsContinueArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error)
 }
 
 type apiImpl dropbox.Context
@@ -54,7 +59,26 @@ type GetEventsAPIError struct {
 	EndpointError *GetTeamEventsError `json:"error"`
 }
 
-func (dbx *apiImpl) GetEvents(arg *GetTeamEventsArg) (res *GetTeamEventsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetEventsAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetEventsAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetEvents(arg *GetTeamEventsArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error) {
+	return dbx.GetEventsContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetEventsContext(ctx context.Context, arg *GetTeamEventsArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team_log",
@@ -63,11 +87,12 @@ func (dbx *apiImpl) GetEvents(arg *GetTeamEventsArg) (res *GetTeamEventsResult,
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetEventsAPIError
 		err = auth.ParseError(err, &appErr)
@@ -92,7 +117,26 @@ type GetEventsContinueAPIError struct {
 	EndpointError *GetTeamEventsContinueError `json:"error"`
 }
 
-func (dbx *apiImpl) GetEventsContinue(arg *GetTeamEventsContinueArg) (res *GetTeamEventsResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetEventsContinueAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetEventsContinueAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetEventsContinue(arg *GetTeamEventsContinueArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error) {
+	return dbx.GetEventsContinueContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetEventsContinueContext(ctx context.Context, arg *GetTeamEventsContinueArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "team_log",
@@ -101,11 +145,12 @@ func (dbx *apiImpl) GetEventsContinue(arg *GetTeamEventsContinueArg) (res *GetTe
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetEventsContinueAPIError
 		err = auth.ParseError(err, &appErr)
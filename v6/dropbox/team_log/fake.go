@@ -0,0 +1,69 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package team_log
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	GetEventsFunc         func(ctx context.Context, arg *GetTeamEventsArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error)
+	GetEventsContinueFunc func(ctx context.Context, arg *GetTeamEventsContinueArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// GetEvents implements Client.
+func (f *Fake) GetEvents(arg *GetTeamEventsArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error) {
+	return f.GetEventsContext(context.Background(), arg, opts...)
+}
+
+// GetEventsContext implements Client.
+func (f *Fake) GetEventsContext(ctx context.Context, arg *GetTeamEventsArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error) {
+	f.Record("GetEvents", opts, arg)
+	if f.GetEventsFunc == nil {
+		err = dropbox.NotImplemented("team_log", "GetEvents")
+		return
+	}
+	return f.GetEventsFunc(ctx, arg, opts...)
+}
+
+// GetEventsContinue implements Client.
+func (f *Fake) GetEventsContinue(arg *GetTeamEventsContinueArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error) {
+	return f.GetEventsContinueContext(context.Background(), arg, opts...)
+}
+
+// GetEventsContinueContext implements Client.
+func (f *Fake) GetEventsContinueContext(ctx context.Context, arg *GetTeamEventsContinueArg, opts ...dropbox.CallOption) (res *GetTeamEventsResult, err error) {
+	f.Record("GetEventsContinue", opts, arg)
+	if f.GetEventsContinueFunc == nil {
+		err = dropbox.NotImplemented("team_log", "GetEventsContinue")
+		return
+	}
+	return f.GetEventsContinueFunc(ctx, arg, opts...)
+}
@@ -109,6 +109,48 @@ func (u *AccessMethodLogInfo) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AccessMethodLogInfo instance
+func (u *AccessMethodLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "admin_console":
+		type wrap WebSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminConsole)})
+	case "api":
+		type wrap ApiSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Api)})
+	case "content_manager":
+		type wrap WebSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ContentManager)})
+	case "end_user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			EndUser IsSessionLogInfo `json:"end_user"`
+		}{u.Tagged, u.EndUser})
+	case "enterprise_console":
+		type wrap WebSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EnterpriseConsole)})
+	case "sign_in_as":
+		type wrap WebSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SignInAs)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AccountCaptureAvailability : has no documentation (yet)
 type AccountCaptureAvailability struct {
 	dropbox.Tagged
@@ -384,6 +426,30 @@ func (u *ActionDetails) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ActionDetails instance
+func (u *ActionDetails) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "remove_action":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			RemoveAction *MemberRemoveActionType `json:"remove_action"`
+		}{u.Tagged, u.RemoveAction})
+	case "team_invite_details":
+		type wrap TeamInviteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamInviteDetails)})
+	case "team_join_details":
+		type wrap JoinTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamJoinDetails)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // ActorLogInfo : The entity who performed the action.
 type ActorLogInfo struct {
 	dropbox.Tagged
@@ -450,6 +516,34 @@ func (u *ActorLogInfo) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ActorLogInfo instance
+func (u *ActorLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "admin":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Admin IsUserLogInfo `json:"admin"`
+		}{u.Tagged, u.Admin})
+	case "app":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			App IsAppLogInfo `json:"app"`
+		}{u.Tagged, u.App})
+	case "reseller":
+		type wrap ResellerLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Reseller)})
+	case "user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			User IsUserLogInfo `json:"user"`
+		}{u.Tagged, u.User})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // AdminAlertCategoryEnum : Alert category
 type AdminAlertCategoryEnum struct {
 	dropbox.Tagged
@@ -1248,6 +1342,43 @@ func (u *AssetLogInfo) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a AssetLogInfo instance
+func (u *AssetLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "file":
+		type wrap FileLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.File)})
+	case "folder":
+		type wrap FolderLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Folder)})
+	case "paper_document":
+		type wrap PaperDocumentLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocument)})
+	case "paper_folder":
+		type wrap PaperFolderLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolder)})
+	case "showcase_document":
+		type wrap ShowcaseDocumentLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseDocument)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // BackupStatus : Backup status
 type BackupStatus struct {
 	dropbox.Tagged
@@ -2014,6 +2145,37 @@ func (u *ContextLogInfo) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ContextLogInfo instance
+func (u *ContextLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "non_team_member":
+		type wrap NonTeamMemberLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NonTeamMember)})
+	case "organization_team":
+		type wrap TeamLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OrganizationTeam)})
+	case "team_member":
+		type wrap TeamMemberLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMember)})
+	case "trusted_non_team_member":
+		type wrap TrustedNonTeamMemberLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TrustedNonTeamMember)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // CreateFolderDetails : Created folders.
 type CreateFolderDetails struct {
 }
@@ -2348,6 +2510,15 @@ func NewDesktopDeviceSessionLogInfo(HostName string, ClientType *team.DesktopPla
 	return s
 }
 
+// MarshalJSON serializes a DesktopDeviceSessionLogInfo instance, including its `.tag`
+func (u *DesktopDeviceSessionLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap DesktopDeviceSessionLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "desktop_device_session"}, (*wrap)(u)})
+}
+
 // SessionLogInfo : Session's logged information.
 type SessionLogInfo struct {
 	// SessionId : Session ID.
@@ -2447,6 +2618,15 @@ func NewDesktopSessionLogInfo() *DesktopSessionLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a DesktopSessionLogInfo instance, including its `.tag`
+func (u *DesktopSessionLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap DesktopSessionLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "desktop"}, (*wrap)(u)})
+}
+
 // DeviceApprovalsAddExceptionDetails : Added members to device approvals
 // exception list.
 type DeviceApprovalsAddExceptionDetails struct {
@@ -7853,214 +8033,3125 @@ func (u *EventDetails) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
-// EventType : The type of the event with description.
-type EventType struct {
-	dropbox.Tagged
-	// AdminAlertingAlertStateChanged : (admin_alerting) Changed an alert state
-	AdminAlertingAlertStateChanged *AdminAlertingAlertStateChangedType `json:"admin_alerting_alert_state_changed,omitempty"`
-	// AdminAlertingChangedAlertConfig : (admin_alerting) Changed an alert
-	// setting
-	AdminAlertingChangedAlertConfig *AdminAlertingChangedAlertConfigType `json:"admin_alerting_changed_alert_config,omitempty"`
-	// AdminAlertingTriggeredAlert : (admin_alerting) Triggered security alert
-	AdminAlertingTriggeredAlert *AdminAlertingTriggeredAlertType `json:"admin_alerting_triggered_alert,omitempty"`
-	// AppBlockedByPermissions : (apps) Failed to connect app for member
-	AppBlockedByPermissions *AppBlockedByPermissionsType `json:"app_blocked_by_permissions,omitempty"`
-	// AppLinkTeam : (apps) Linked app for team
-	AppLinkTeam *AppLinkTeamType `json:"app_link_team,omitempty"`
-	// AppLinkUser : (apps) Linked app for member
-	AppLinkUser *AppLinkUserType `json:"app_link_user,omitempty"`
-	// AppUnlinkTeam : (apps) Unlinked app for team
-	AppUnlinkTeam *AppUnlinkTeamType `json:"app_unlink_team,omitempty"`
-	// AppUnlinkUser : (apps) Unlinked app for member
-	AppUnlinkUser *AppUnlinkUserType `json:"app_unlink_user,omitempty"`
-	// IntegrationConnected : (apps) Connected integration for member
-	IntegrationConnected *IntegrationConnectedType `json:"integration_connected,omitempty"`
-	// IntegrationDisconnected : (apps) Disconnected integration for member
-	IntegrationDisconnected *IntegrationDisconnectedType `json:"integration_disconnected,omitempty"`
-	// FileAddComment : (comments) Added file comment
-	FileAddComment *FileAddCommentType `json:"file_add_comment,omitempty"`
-	// FileChangeCommentSubscription : (comments) Subscribed to or unsubscribed
-	// from comment notifications for file
-	FileChangeCommentSubscription *FileChangeCommentSubscriptionType `json:"file_change_comment_subscription,omitempty"`
-	// FileDeleteComment : (comments) Deleted file comment
-	FileDeleteComment *FileDeleteCommentType `json:"file_delete_comment,omitempty"`
-	// FileEditComment : (comments) Edited file comment
-	FileEditComment *FileEditCommentType `json:"file_edit_comment,omitempty"`
-	// FileLikeComment : (comments) Liked file comment (deprecated, no longer
-	// logged)
-	FileLikeComment *FileLikeCommentType `json:"file_like_comment,omitempty"`
-	// FileResolveComment : (comments) Resolved file comment
-	FileResolveComment *FileResolveCommentType `json:"file_resolve_comment,omitempty"`
-	// FileUnlikeComment : (comments) Unliked file comment (deprecated, no
-	// longer logged)
-	FileUnlikeComment *FileUnlikeCommentType `json:"file_unlike_comment,omitempty"`
-	// FileUnresolveComment : (comments) Unresolved file comment
-	FileUnresolveComment *FileUnresolveCommentType `json:"file_unresolve_comment,omitempty"`
-	// GovernancePolicyAddFolders : (data_governance) Added folders to policy
-	GovernancePolicyAddFolders *GovernancePolicyAddFoldersType `json:"governance_policy_add_folders,omitempty"`
-	// GovernancePolicyAddFolderFailed : (data_governance) Couldn't add a folder
-	// to a policy
-	GovernancePolicyAddFolderFailed *GovernancePolicyAddFolderFailedType `json:"governance_policy_add_folder_failed,omitempty"`
-	// GovernancePolicyContentDisposed : (data_governance) Content disposed
-	GovernancePolicyContentDisposed *GovernancePolicyContentDisposedType `json:"governance_policy_content_disposed,omitempty"`
-	// GovernancePolicyCreate : (data_governance) Activated a new policy
-	GovernancePolicyCreate *GovernancePolicyCreateType `json:"governance_policy_create,omitempty"`
-	// GovernancePolicyDelete : (data_governance) Deleted a policy
-	GovernancePolicyDelete *GovernancePolicyDeleteType `json:"governance_policy_delete,omitempty"`
-	// GovernancePolicyEditDetails : (data_governance) Edited policy
-	GovernancePolicyEditDetails *GovernancePolicyEditDetailsType `json:"governance_policy_edit_details,omitempty"`
-	// GovernancePolicyEditDuration : (data_governance) Changed policy duration
-	GovernancePolicyEditDuration *GovernancePolicyEditDurationType `json:"governance_policy_edit_duration,omitempty"`
-	// GovernancePolicyExportCreated : (data_governance) Created a policy
-	// download
-	GovernancePolicyExportCreated *GovernancePolicyExportCreatedType `json:"governance_policy_export_created,omitempty"`
-	// GovernancePolicyExportRemoved : (data_governance) Removed a policy
-	// download
-	GovernancePolicyExportRemoved *GovernancePolicyExportRemovedType `json:"governance_policy_export_removed,omitempty"`
-	// GovernancePolicyRemoveFolders : (data_governance) Removed folders from
-	// policy
-	GovernancePolicyRemoveFolders *GovernancePolicyRemoveFoldersType `json:"governance_policy_remove_folders,omitempty"`
-	// GovernancePolicyReportCreated : (data_governance) Created a summary
-	// report for a policy
-	GovernancePolicyReportCreated *GovernancePolicyReportCreatedType `json:"governance_policy_report_created,omitempty"`
-	// GovernancePolicyZipPartDownloaded : (data_governance) Downloaded content
-	// from a policy
-	GovernancePolicyZipPartDownloaded *GovernancePolicyZipPartDownloadedType `json:"governance_policy_zip_part_downloaded,omitempty"`
-	// LegalHoldsActivateAHold : (data_governance) Activated a hold
-	LegalHoldsActivateAHold *LegalHoldsActivateAHoldType `json:"legal_holds_activate_a_hold,omitempty"`
-	// LegalHoldsAddMembers : (data_governance) Added members to a hold
-	LegalHoldsAddMembers *LegalHoldsAddMembersType `json:"legal_holds_add_members,omitempty"`
-	// LegalHoldsChangeHoldDetails : (data_governance) Edited details for a hold
-	LegalHoldsChangeHoldDetails *LegalHoldsChangeHoldDetailsType `json:"legal_holds_change_hold_details,omitempty"`
-	// LegalHoldsChangeHoldName : (data_governance) Renamed a hold
-	LegalHoldsChangeHoldName *LegalHoldsChangeHoldNameType `json:"legal_holds_change_hold_name,omitempty"`
-	// LegalHoldsExportAHold : (data_governance) Exported hold
-	LegalHoldsExportAHold *LegalHoldsExportAHoldType `json:"legal_holds_export_a_hold,omitempty"`
-	// LegalHoldsExportCancelled : (data_governance) Canceled export for a hold
-	LegalHoldsExportCancelled *LegalHoldsExportCancelledType `json:"legal_holds_export_cancelled,omitempty"`
-	// LegalHoldsExportDownloaded : (data_governance) Downloaded export for a
-	// hold
-	LegalHoldsExportDownloaded *LegalHoldsExportDownloadedType `json:"legal_holds_export_downloaded,omitempty"`
-	// LegalHoldsExportRemoved : (data_governance) Removed export for a hold
-	LegalHoldsExportRemoved *LegalHoldsExportRemovedType `json:"legal_holds_export_removed,omitempty"`
-	// LegalHoldsReleaseAHold : (data_governance) Released a hold
-	LegalHoldsReleaseAHold *LegalHoldsReleaseAHoldType `json:"legal_holds_release_a_hold,omitempty"`
-	// LegalHoldsRemoveMembers : (data_governance) Removed members from a hold
-	LegalHoldsRemoveMembers *LegalHoldsRemoveMembersType `json:"legal_holds_remove_members,omitempty"`
-	// LegalHoldsReportAHold : (data_governance) Created a summary report for a
-	// hold
-	LegalHoldsReportAHold *LegalHoldsReportAHoldType `json:"legal_holds_report_a_hold,omitempty"`
-	// DeviceChangeIpDesktop : (devices) Changed IP address associated with
-	// active desktop session
-	DeviceChangeIpDesktop *DeviceChangeIpDesktopType `json:"device_change_ip_desktop,omitempty"`
-	// DeviceChangeIpMobile : (devices) Changed IP address associated with
-	// active mobile session
-	DeviceChangeIpMobile *DeviceChangeIpMobileType `json:"device_change_ip_mobile,omitempty"`
-	// DeviceChangeIpWeb : (devices) Changed IP address associated with active
-	// web session
-	DeviceChangeIpWeb *DeviceChangeIpWebType `json:"device_change_ip_web,omitempty"`
-	// DeviceDeleteOnUnlinkFail : (devices) Failed to delete all files from
-	// unlinked device
-	DeviceDeleteOnUnlinkFail *DeviceDeleteOnUnlinkFailType `json:"device_delete_on_unlink_fail,omitempty"`
-	// DeviceDeleteOnUnlinkSuccess : (devices) Deleted all files from unlinked
-	// device
-	DeviceDeleteOnUnlinkSuccess *DeviceDeleteOnUnlinkSuccessType `json:"device_delete_on_unlink_success,omitempty"`
-	// DeviceLinkFail : (devices) Failed to link device
-	DeviceLinkFail *DeviceLinkFailType `json:"device_link_fail,omitempty"`
-	// DeviceLinkSuccess : (devices) Linked device
-	DeviceLinkSuccess *DeviceLinkSuccessType `json:"device_link_success,omitempty"`
-	// DeviceManagementDisabled : (devices) Disabled device management
-	// (deprecated, no longer logged)
-	DeviceManagementDisabled *DeviceManagementDisabledType `json:"device_management_disabled,omitempty"`
-	// DeviceManagementEnabled : (devices) Enabled device management
-	// (deprecated, no longer logged)
-	DeviceManagementEnabled *DeviceManagementEnabledType `json:"device_management_enabled,omitempty"`
-	// DeviceSyncBackupStatusChanged : (devices) Enabled/disabled backup for
-	// computer
-	DeviceSyncBackupStatusChanged *DeviceSyncBackupStatusChangedType `json:"device_sync_backup_status_changed,omitempty"`
-	// DeviceUnlink : (devices) Disconnected device
-	DeviceUnlink *DeviceUnlinkType `json:"device_unlink,omitempty"`
-	// DropboxPasswordsExported : (devices) Exported passwords
-	DropboxPasswordsExported *DropboxPasswordsExportedType `json:"dropbox_passwords_exported,omitempty"`
-	// DropboxPasswordsNewDeviceEnrolled : (devices) Enrolled new Dropbox
-	// Passwords device
-	DropboxPasswordsNewDeviceEnrolled *DropboxPasswordsNewDeviceEnrolledType `json:"dropbox_passwords_new_device_enrolled,omitempty"`
-	// EmmRefreshAuthToken : (devices) Refreshed auth token used for setting up
-	// EMM
-	EmmRefreshAuthToken *EmmRefreshAuthTokenType `json:"emm_refresh_auth_token,omitempty"`
-	// ExternalDriveBackupEligibilityStatusChecked : (devices) Checked external
-	// drive backup eligibility status
-	ExternalDriveBackupEligibilityStatusChecked *ExternalDriveBackupEligibilityStatusCheckedType `json:"external_drive_backup_eligibility_status_checked,omitempty"`
-	// ExternalDriveBackupStatusChanged : (devices) Modified external drive
-	// backup
-	ExternalDriveBackupStatusChanged *ExternalDriveBackupStatusChangedType `json:"external_drive_backup_status_changed,omitempty"`
-	// AccountCaptureChangeAvailability : (domains) Granted/revoked option to
-	// enable account capture on team domains
-	AccountCaptureChangeAvailability *AccountCaptureChangeAvailabilityType `json:"account_capture_change_availability,omitempty"`
-	// AccountCaptureMigrateAccount : (domains) Account-captured user migrated
-	// account to team
-	AccountCaptureMigrateAccount *AccountCaptureMigrateAccountType `json:"account_capture_migrate_account,omitempty"`
-	// AccountCaptureNotificationEmailsSent : (domains) Sent account capture
-	// email to all unmanaged members
-	AccountCaptureNotificationEmailsSent *AccountCaptureNotificationEmailsSentType `json:"account_capture_notification_emails_sent,omitempty"`
-	// AccountCaptureRelinquishAccount : (domains) Account-captured user changed
-	// account email to personal email
-	AccountCaptureRelinquishAccount *AccountCaptureRelinquishAccountType `json:"account_capture_relinquish_account,omitempty"`
-	// DisabledDomainInvites : (domains) Disabled domain invites (deprecated, no
-	// longer logged)
-	DisabledDomainInvites *DisabledDomainInvitesType `json:"disabled_domain_invites,omitempty"`
-	// DomainInvitesApproveRequestToJoinTeam : (domains) Approved user's request
-	// to join team
-	DomainInvitesApproveRequestToJoinTeam *DomainInvitesApproveRequestToJoinTeamType `json:"domain_invites_approve_request_to_join_team,omitempty"`
-	// DomainInvitesDeclineRequestToJoinTeam : (domains) Declined user's request
-	// to join team
-	DomainInvitesDeclineRequestToJoinTeam *DomainInvitesDeclineRequestToJoinTeamType `json:"domain_invites_decline_request_to_join_team,omitempty"`
-	// DomainInvitesEmailExistingUsers : (domains) Sent domain invites to
-	// existing domain accounts (deprecated, no longer logged)
-	DomainInvitesEmailExistingUsers *DomainInvitesEmailExistingUsersType `json:"domain_invites_email_existing_users,omitempty"`
-	// DomainInvitesRequestToJoinTeam : (domains) Requested to join team
-	DomainInvitesRequestToJoinTeam *DomainInvitesRequestToJoinTeamType `json:"domain_invites_request_to_join_team,omitempty"`
-	// DomainInvitesSetInviteNewUserPrefToNo : (domains) Disabled "Automatically
-	// invite new users" (deprecated, no longer logged)
-	DomainInvitesSetInviteNewUserPrefToNo *DomainInvitesSetInviteNewUserPrefToNoType `json:"domain_invites_set_invite_new_user_pref_to_no,omitempty"`
-	// DomainInvitesSetInviteNewUserPrefToYes : (domains) Enabled "Automatically
-	// invite new users" (deprecated, no longer logged)
-	DomainInvitesSetInviteNewUserPrefToYes *DomainInvitesSetInviteNewUserPrefToYesType `json:"domain_invites_set_invite_new_user_pref_to_yes,omitempty"`
-	// DomainVerificationAddDomainFail : (domains) Failed to verify team domain
-	DomainVerificationAddDomainFail *DomainVerificationAddDomainFailType `json:"domain_verification_add_domain_fail,omitempty"`
-	// DomainVerificationAddDomainSuccess : (domains) Verified team domain
-	DomainVerificationAddDomainSuccess *DomainVerificationAddDomainSuccessType `json:"domain_verification_add_domain_success,omitempty"`
-	// DomainVerificationRemoveDomain : (domains) Removed domain from list of
-	// verified team domains
-	DomainVerificationRemoveDomain *DomainVerificationRemoveDomainType `json:"domain_verification_remove_domain,omitempty"`
-	// EnabledDomainInvites : (domains) Enabled domain invites (deprecated, no
-	// longer logged)
-	EnabledDomainInvites *EnabledDomainInvitesType `json:"enabled_domain_invites,omitempty"`
-	// ApplyNamingConvention : (file_operations) Applied naming convention
-	ApplyNamingConvention *ApplyNamingConventionType `json:"apply_naming_convention,omitempty"`
-	// CreateFolder : (file_operations) Created folders (deprecated, no longer
-	// logged)
-	CreateFolder *CreateFolderType `json:"create_folder,omitempty"`
-	// FileAdd : (file_operations) Added files and/or folders
-	FileAdd *FileAddType `json:"file_add,omitempty"`
-	// FileCopy : (file_operations) Copied files and/or folders
-	FileCopy *FileCopyType `json:"file_copy,omitempty"`
-	// FileDelete : (file_operations) Deleted files and/or folders
-	FileDelete *FileDeleteType `json:"file_delete,omitempty"`
-	// FileDownload : (file_operations) Downloaded files and/or folders
-	FileDownload *FileDownloadType `json:"file_download,omitempty"`
-	// FileEdit : (file_operations) Edited files
-	FileEdit *FileEditType `json:"file_edit,omitempty"`
-	// FileGetCopyReference : (file_operations) Created copy reference to
-	// file/folder
-	FileGetCopyReference *FileGetCopyReferenceType `json:"file_get_copy_reference,omitempty"`
-	// FileLockingLockStatusChanged : (file_operations) Locked/unlocked editing
-	// for a file
-	FileLockingLockStatusChanged *FileLockingLockStatusChangedType `json:"file_locking_lock_status_changed,omitempty"`
-	// FileMove : (file_operations) Moved files and/or folders
-	FileMove *FileMoveType `json:"file_move,omitempty"`
-	// FilePermanentlyDelete : (file_operations) Permanently deleted files
-	// and/or folders
+// MarshalJSON serializes a EventDetails instance
+func (u *EventDetails) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "admin_alerting_alert_state_changed_details":
+		type wrap AdminAlertingAlertStateChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminAlertingAlertStateChangedDetails)})
+	case "admin_alerting_changed_alert_config_details":
+		type wrap AdminAlertingChangedAlertConfigDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminAlertingChangedAlertConfigDetails)})
+	case "admin_alerting_triggered_alert_details":
+		type wrap AdminAlertingTriggeredAlertDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminAlertingTriggeredAlertDetails)})
+	case "app_blocked_by_permissions_details":
+		type wrap AppBlockedByPermissionsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppBlockedByPermissionsDetails)})
+	case "app_link_team_details":
+		type wrap AppLinkTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppLinkTeamDetails)})
+	case "app_link_user_details":
+		type wrap AppLinkUserDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppLinkUserDetails)})
+	case "app_unlink_team_details":
+		type wrap AppUnlinkTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppUnlinkTeamDetails)})
+	case "app_unlink_user_details":
+		type wrap AppUnlinkUserDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppUnlinkUserDetails)})
+	case "integration_connected_details":
+		type wrap IntegrationConnectedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.IntegrationConnectedDetails)})
+	case "integration_disconnected_details":
+		type wrap IntegrationDisconnectedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.IntegrationDisconnectedDetails)})
+	case "file_add_comment_details":
+		type wrap FileAddCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileAddCommentDetails)})
+	case "file_change_comment_subscription_details":
+		type wrap FileChangeCommentSubscriptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileChangeCommentSubscriptionDetails)})
+	case "file_delete_comment_details":
+		type wrap FileDeleteCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileDeleteCommentDetails)})
+	case "file_edit_comment_details":
+		type wrap FileEditCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileEditCommentDetails)})
+	case "file_like_comment_details":
+		type wrap FileLikeCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileLikeCommentDetails)})
+	case "file_resolve_comment_details":
+		type wrap FileResolveCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileResolveCommentDetails)})
+	case "file_unlike_comment_details":
+		type wrap FileUnlikeCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileUnlikeCommentDetails)})
+	case "file_unresolve_comment_details":
+		type wrap FileUnresolveCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileUnresolveCommentDetails)})
+	case "governance_policy_add_folders_details":
+		type wrap GovernancePolicyAddFoldersDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyAddFoldersDetails)})
+	case "governance_policy_add_folder_failed_details":
+		type wrap GovernancePolicyAddFolderFailedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyAddFolderFailedDetails)})
+	case "governance_policy_content_disposed_details":
+		type wrap GovernancePolicyContentDisposedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyContentDisposedDetails)})
+	case "governance_policy_create_details":
+		type wrap GovernancePolicyCreateDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyCreateDetails)})
+	case "governance_policy_delete_details":
+		type wrap GovernancePolicyDeleteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyDeleteDetails)})
+	case "governance_policy_edit_details_details":
+		type wrap GovernancePolicyEditDetailsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyEditDetailsDetails)})
+	case "governance_policy_edit_duration_details":
+		type wrap GovernancePolicyEditDurationDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyEditDurationDetails)})
+	case "governance_policy_export_created_details":
+		type wrap GovernancePolicyExportCreatedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyExportCreatedDetails)})
+	case "governance_policy_export_removed_details":
+		type wrap GovernancePolicyExportRemovedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyExportRemovedDetails)})
+	case "governance_policy_remove_folders_details":
+		type wrap GovernancePolicyRemoveFoldersDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyRemoveFoldersDetails)})
+	case "governance_policy_report_created_details":
+		type wrap GovernancePolicyReportCreatedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyReportCreatedDetails)})
+	case "governance_policy_zip_part_downloaded_details":
+		type wrap GovernancePolicyZipPartDownloadedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyZipPartDownloadedDetails)})
+	case "legal_holds_activate_a_hold_details":
+		type wrap LegalHoldsActivateAHoldDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsActivateAHoldDetails)})
+	case "legal_holds_add_members_details":
+		type wrap LegalHoldsAddMembersDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsAddMembersDetails)})
+	case "legal_holds_change_hold_details_details":
+		type wrap LegalHoldsChangeHoldDetailsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsChangeHoldDetailsDetails)})
+	case "legal_holds_change_hold_name_details":
+		type wrap LegalHoldsChangeHoldNameDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsChangeHoldNameDetails)})
+	case "legal_holds_export_a_hold_details":
+		type wrap LegalHoldsExportAHoldDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsExportAHoldDetails)})
+	case "legal_holds_export_cancelled_details":
+		type wrap LegalHoldsExportCancelledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsExportCancelledDetails)})
+	case "legal_holds_export_downloaded_details":
+		type wrap LegalHoldsExportDownloadedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsExportDownloadedDetails)})
+	case "legal_holds_export_removed_details":
+		type wrap LegalHoldsExportRemovedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsExportRemovedDetails)})
+	case "legal_holds_release_a_hold_details":
+		type wrap LegalHoldsReleaseAHoldDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsReleaseAHoldDetails)})
+	case "legal_holds_remove_members_details":
+		type wrap LegalHoldsRemoveMembersDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsRemoveMembersDetails)})
+	case "legal_holds_report_a_hold_details":
+		type wrap LegalHoldsReportAHoldDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsReportAHoldDetails)})
+	case "device_change_ip_desktop_details":
+		type wrap DeviceChangeIpDesktopDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceChangeIpDesktopDetails)})
+	case "device_change_ip_mobile_details":
+		type wrap DeviceChangeIpMobileDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceChangeIpMobileDetails)})
+	case "device_change_ip_web_details":
+		type wrap DeviceChangeIpWebDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceChangeIpWebDetails)})
+	case "device_delete_on_unlink_fail_details":
+		type wrap DeviceDeleteOnUnlinkFailDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceDeleteOnUnlinkFailDetails)})
+	case "device_delete_on_unlink_success_details":
+		type wrap DeviceDeleteOnUnlinkSuccessDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceDeleteOnUnlinkSuccessDetails)})
+	case "device_link_fail_details":
+		type wrap DeviceLinkFailDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceLinkFailDetails)})
+	case "device_link_success_details":
+		type wrap DeviceLinkSuccessDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceLinkSuccessDetails)})
+	case "device_management_disabled_details":
+		type wrap DeviceManagementDisabledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceManagementDisabledDetails)})
+	case "device_management_enabled_details":
+		type wrap DeviceManagementEnabledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceManagementEnabledDetails)})
+	case "device_sync_backup_status_changed_details":
+		type wrap DeviceSyncBackupStatusChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceSyncBackupStatusChangedDetails)})
+	case "device_unlink_details":
+		type wrap DeviceUnlinkDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceUnlinkDetails)})
+	case "dropbox_passwords_exported_details":
+		type wrap DropboxPasswordsExportedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DropboxPasswordsExportedDetails)})
+	case "dropbox_passwords_new_device_enrolled_details":
+		type wrap DropboxPasswordsNewDeviceEnrolledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DropboxPasswordsNewDeviceEnrolledDetails)})
+	case "emm_refresh_auth_token_details":
+		type wrap EmmRefreshAuthTokenDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmRefreshAuthTokenDetails)})
+	case "external_drive_backup_eligibility_status_checked_details":
+		type wrap ExternalDriveBackupEligibilityStatusCheckedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalDriveBackupEligibilityStatusCheckedDetails)})
+	case "external_drive_backup_status_changed_details":
+		type wrap ExternalDriveBackupStatusChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalDriveBackupStatusChangedDetails)})
+	case "account_capture_change_availability_details":
+		type wrap AccountCaptureChangeAvailabilityDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureChangeAvailabilityDetails)})
+	case "account_capture_migrate_account_details":
+		type wrap AccountCaptureMigrateAccountDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureMigrateAccountDetails)})
+	case "account_capture_notification_emails_sent_details":
+		type wrap AccountCaptureNotificationEmailsSentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureNotificationEmailsSentDetails)})
+	case "account_capture_relinquish_account_details":
+		type wrap AccountCaptureRelinquishAccountDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureRelinquishAccountDetails)})
+	case "disabled_domain_invites_details":
+		type wrap DisabledDomainInvitesDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DisabledDomainInvitesDetails)})
+	case "domain_invites_approve_request_to_join_team_details":
+		type wrap DomainInvitesApproveRequestToJoinTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesApproveRequestToJoinTeamDetails)})
+	case "domain_invites_decline_request_to_join_team_details":
+		type wrap DomainInvitesDeclineRequestToJoinTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesDeclineRequestToJoinTeamDetails)})
+	case "domain_invites_email_existing_users_details":
+		type wrap DomainInvitesEmailExistingUsersDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesEmailExistingUsersDetails)})
+	case "domain_invites_request_to_join_team_details":
+		type wrap DomainInvitesRequestToJoinTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesRequestToJoinTeamDetails)})
+	case "domain_invites_set_invite_new_user_pref_to_no_details":
+		type wrap DomainInvitesSetInviteNewUserPrefToNoDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesSetInviteNewUserPrefToNoDetails)})
+	case "domain_invites_set_invite_new_user_pref_to_yes_details":
+		type wrap DomainInvitesSetInviteNewUserPrefToYesDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesSetInviteNewUserPrefToYesDetails)})
+	case "domain_verification_add_domain_fail_details":
+		type wrap DomainVerificationAddDomainFailDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainVerificationAddDomainFailDetails)})
+	case "domain_verification_add_domain_success_details":
+		type wrap DomainVerificationAddDomainSuccessDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainVerificationAddDomainSuccessDetails)})
+	case "domain_verification_remove_domain_details":
+		type wrap DomainVerificationRemoveDomainDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainVerificationRemoveDomainDetails)})
+	case "enabled_domain_invites_details":
+		type wrap EnabledDomainInvitesDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EnabledDomainInvitesDetails)})
+	case "apply_naming_convention_details":
+		type wrap ApplyNamingConventionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ApplyNamingConventionDetails)})
+	case "create_folder_details":
+		type wrap CreateFolderDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CreateFolderDetails)})
+	case "file_add_details":
+		type wrap FileAddDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileAddDetails)})
+	case "file_copy_details":
+		type wrap FileCopyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileCopyDetails)})
+	case "file_delete_details":
+		type wrap FileDeleteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileDeleteDetails)})
+	case "file_download_details":
+		type wrap FileDownloadDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileDownloadDetails)})
+	case "file_edit_details":
+		type wrap FileEditDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileEditDetails)})
+	case "file_get_copy_reference_details":
+		type wrap FileGetCopyReferenceDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileGetCopyReferenceDetails)})
+	case "file_locking_lock_status_changed_details":
+		type wrap FileLockingLockStatusChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileLockingLockStatusChangedDetails)})
+	case "file_move_details":
+		type wrap FileMoveDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileMoveDetails)})
+	case "file_permanently_delete_details":
+		type wrap FilePermanentlyDeleteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FilePermanentlyDeleteDetails)})
+	case "file_preview_details":
+		type wrap FilePreviewDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FilePreviewDetails)})
+	case "file_rename_details":
+		type wrap FileRenameDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRenameDetails)})
+	case "file_restore_details":
+		type wrap FileRestoreDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRestoreDetails)})
+	case "file_revert_details":
+		type wrap FileRevertDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRevertDetails)})
+	case "file_rollback_changes_details":
+		type wrap FileRollbackChangesDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRollbackChangesDetails)})
+	case "file_save_copy_reference_details":
+		type wrap FileSaveCopyReferenceDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileSaveCopyReferenceDetails)})
+	case "folder_overview_description_changed_details":
+		type wrap FolderOverviewDescriptionChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FolderOverviewDescriptionChangedDetails)})
+	case "folder_overview_item_pinned_details":
+		type wrap FolderOverviewItemPinnedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FolderOverviewItemPinnedDetails)})
+	case "folder_overview_item_unpinned_details":
+		type wrap FolderOverviewItemUnpinnedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FolderOverviewItemUnpinnedDetails)})
+	case "object_label_added_details":
+		type wrap ObjectLabelAddedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ObjectLabelAddedDetails)})
+	case "object_label_removed_details":
+		type wrap ObjectLabelRemovedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ObjectLabelRemovedDetails)})
+	case "object_label_updated_value_details":
+		type wrap ObjectLabelUpdatedValueDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ObjectLabelUpdatedValueDetails)})
+	case "organize_folder_with_tidy_details":
+		type wrap OrganizeFolderWithTidyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OrganizeFolderWithTidyDetails)})
+	case "rewind_folder_details":
+		type wrap RewindFolderDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.RewindFolderDetails)})
+	case "undo_naming_convention_details":
+		type wrap UndoNamingConventionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UndoNamingConventionDetails)})
+	case "undo_organize_folder_with_tidy_details":
+		type wrap UndoOrganizeFolderWithTidyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UndoOrganizeFolderWithTidyDetails)})
+	case "user_tags_added_details":
+		type wrap UserTagsAddedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UserTagsAddedDetails)})
+	case "user_tags_removed_details":
+		type wrap UserTagsRemovedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UserTagsRemovedDetails)})
+	case "email_ingest_receive_file_details":
+		type wrap EmailIngestReceiveFileDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmailIngestReceiveFileDetails)})
+	case "file_request_change_details":
+		type wrap FileRequestChangeDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestChangeDetails)})
+	case "file_request_close_details":
+		type wrap FileRequestCloseDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestCloseDetails)})
+	case "file_request_create_details":
+		type wrap FileRequestCreateDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestCreateDetails)})
+	case "file_request_delete_details":
+		type wrap FileRequestDeleteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestDeleteDetails)})
+	case "file_request_receive_file_details":
+		type wrap FileRequestReceiveFileDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestReceiveFileDetails)})
+	case "group_add_external_id_details":
+		type wrap GroupAddExternalIdDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupAddExternalIdDetails)})
+	case "group_add_member_details":
+		type wrap GroupAddMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupAddMemberDetails)})
+	case "group_change_external_id_details":
+		type wrap GroupChangeExternalIdDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupChangeExternalIdDetails)})
+	case "group_change_management_type_details":
+		type wrap GroupChangeManagementTypeDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupChangeManagementTypeDetails)})
+	case "group_change_member_role_details":
+		type wrap GroupChangeMemberRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupChangeMemberRoleDetails)})
+	case "group_create_details":
+		type wrap GroupCreateDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupCreateDetails)})
+	case "group_delete_details":
+		type wrap GroupDeleteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupDeleteDetails)})
+	case "group_description_updated_details":
+		type wrap GroupDescriptionUpdatedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupDescriptionUpdatedDetails)})
+	case "group_join_policy_updated_details":
+		type wrap GroupJoinPolicyUpdatedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupJoinPolicyUpdatedDetails)})
+	case "group_moved_details":
+		type wrap GroupMovedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupMovedDetails)})
+	case "group_remove_external_id_details":
+		type wrap GroupRemoveExternalIdDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupRemoveExternalIdDetails)})
+	case "group_remove_member_details":
+		type wrap GroupRemoveMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupRemoveMemberDetails)})
+	case "group_rename_details":
+		type wrap GroupRenameDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupRenameDetails)})
+	case "account_lock_or_unlocked_details":
+		type wrap AccountLockOrUnlockedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountLockOrUnlockedDetails)})
+	case "emm_error_details":
+		type wrap EmmErrorDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmErrorDetails)})
+	case "guest_admin_signed_in_via_trusted_teams_details":
+		type wrap GuestAdminSignedInViaTrustedTeamsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GuestAdminSignedInViaTrustedTeamsDetails)})
+	case "guest_admin_signed_out_via_trusted_teams_details":
+		type wrap GuestAdminSignedOutViaTrustedTeamsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GuestAdminSignedOutViaTrustedTeamsDetails)})
+	case "login_fail_details":
+		type wrap LoginFailDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LoginFailDetails)})
+	case "login_success_details":
+		type wrap LoginSuccessDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LoginSuccessDetails)})
+	case "logout_details":
+		type wrap LogoutDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LogoutDetails)})
+	case "reseller_support_session_end_details":
+		type wrap ResellerSupportSessionEndDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ResellerSupportSessionEndDetails)})
+	case "reseller_support_session_start_details":
+		type wrap ResellerSupportSessionStartDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ResellerSupportSessionStartDetails)})
+	case "sign_in_as_session_end_details":
+		type wrap SignInAsSessionEndDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SignInAsSessionEndDetails)})
+	case "sign_in_as_session_start_details":
+		type wrap SignInAsSessionStartDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SignInAsSessionStartDetails)})
+	case "sso_error_details":
+		type wrap SsoErrorDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoErrorDetails)})
+	case "create_team_invite_link_details":
+		type wrap CreateTeamInviteLinkDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CreateTeamInviteLinkDetails)})
+	case "delete_team_invite_link_details":
+		type wrap DeleteTeamInviteLinkDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeleteTeamInviteLinkDetails)})
+	case "member_add_external_id_details":
+		type wrap MemberAddExternalIdDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberAddExternalIdDetails)})
+	case "member_add_name_details":
+		type wrap MemberAddNameDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberAddNameDetails)})
+	case "member_change_admin_role_details":
+		type wrap MemberChangeAdminRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeAdminRoleDetails)})
+	case "member_change_email_details":
+		type wrap MemberChangeEmailDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeEmailDetails)})
+	case "member_change_external_id_details":
+		type wrap MemberChangeExternalIdDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeExternalIdDetails)})
+	case "member_change_membership_type_details":
+		type wrap MemberChangeMembershipTypeDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeMembershipTypeDetails)})
+	case "member_change_name_details":
+		type wrap MemberChangeNameDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeNameDetails)})
+	case "member_change_reseller_role_details":
+		type wrap MemberChangeResellerRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeResellerRoleDetails)})
+	case "member_change_status_details":
+		type wrap MemberChangeStatusDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeStatusDetails)})
+	case "member_delete_manual_contacts_details":
+		type wrap MemberDeleteManualContactsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberDeleteManualContactsDetails)})
+	case "member_delete_profile_photo_details":
+		type wrap MemberDeleteProfilePhotoDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberDeleteProfilePhotoDetails)})
+	case "member_permanently_delete_account_contents_details":
+		type wrap MemberPermanentlyDeleteAccountContentsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberPermanentlyDeleteAccountContentsDetails)})
+	case "member_remove_external_id_details":
+		type wrap MemberRemoveExternalIdDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberRemoveExternalIdDetails)})
+	case "member_set_profile_photo_details":
+		type wrap MemberSetProfilePhotoDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSetProfilePhotoDetails)})
+	case "member_space_limits_add_custom_quota_details":
+		type wrap MemberSpaceLimitsAddCustomQuotaDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsAddCustomQuotaDetails)})
+	case "member_space_limits_change_custom_quota_details":
+		type wrap MemberSpaceLimitsChangeCustomQuotaDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsChangeCustomQuotaDetails)})
+	case "member_space_limits_change_status_details":
+		type wrap MemberSpaceLimitsChangeStatusDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsChangeStatusDetails)})
+	case "member_space_limits_remove_custom_quota_details":
+		type wrap MemberSpaceLimitsRemoveCustomQuotaDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsRemoveCustomQuotaDetails)})
+	case "member_suggest_details":
+		type wrap MemberSuggestDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSuggestDetails)})
+	case "member_transfer_account_contents_details":
+		type wrap MemberTransferAccountContentsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberTransferAccountContentsDetails)})
+	case "pending_secondary_email_added_details":
+		type wrap PendingSecondaryEmailAddedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PendingSecondaryEmailAddedDetails)})
+	case "secondary_email_deleted_details":
+		type wrap SecondaryEmailDeletedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryEmailDeletedDetails)})
+	case "secondary_email_verified_details":
+		type wrap SecondaryEmailVerifiedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryEmailVerifiedDetails)})
+	case "secondary_mails_policy_changed_details":
+		type wrap SecondaryMailsPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryMailsPolicyChangedDetails)})
+	case "binder_add_page_details":
+		type wrap BinderAddPageDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderAddPageDetails)})
+	case "binder_add_section_details":
+		type wrap BinderAddSectionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderAddSectionDetails)})
+	case "binder_remove_page_details":
+		type wrap BinderRemovePageDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderRemovePageDetails)})
+	case "binder_remove_section_details":
+		type wrap BinderRemoveSectionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderRemoveSectionDetails)})
+	case "binder_rename_page_details":
+		type wrap BinderRenamePageDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderRenamePageDetails)})
+	case "binder_rename_section_details":
+		type wrap BinderRenameSectionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderRenameSectionDetails)})
+	case "binder_reorder_page_details":
+		type wrap BinderReorderPageDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderReorderPageDetails)})
+	case "binder_reorder_section_details":
+		type wrap BinderReorderSectionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderReorderSectionDetails)})
+	case "paper_content_add_member_details":
+		type wrap PaperContentAddMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentAddMemberDetails)})
+	case "paper_content_add_to_folder_details":
+		type wrap PaperContentAddToFolderDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentAddToFolderDetails)})
+	case "paper_content_archive_details":
+		type wrap PaperContentArchiveDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentArchiveDetails)})
+	case "paper_content_create_details":
+		type wrap PaperContentCreateDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentCreateDetails)})
+	case "paper_content_permanently_delete_details":
+		type wrap PaperContentPermanentlyDeleteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentPermanentlyDeleteDetails)})
+	case "paper_content_remove_from_folder_details":
+		type wrap PaperContentRemoveFromFolderDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentRemoveFromFolderDetails)})
+	case "paper_content_remove_member_details":
+		type wrap PaperContentRemoveMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentRemoveMemberDetails)})
+	case "paper_content_rename_details":
+		type wrap PaperContentRenameDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentRenameDetails)})
+	case "paper_content_restore_details":
+		type wrap PaperContentRestoreDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentRestoreDetails)})
+	case "paper_doc_add_comment_details":
+		type wrap PaperDocAddCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocAddCommentDetails)})
+	case "paper_doc_change_member_role_details":
+		type wrap PaperDocChangeMemberRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocChangeMemberRoleDetails)})
+	case "paper_doc_change_sharing_policy_details":
+		type wrap PaperDocChangeSharingPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocChangeSharingPolicyDetails)})
+	case "paper_doc_change_subscription_details":
+		type wrap PaperDocChangeSubscriptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocChangeSubscriptionDetails)})
+	case "paper_doc_deleted_details":
+		type wrap PaperDocDeletedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocDeletedDetails)})
+	case "paper_doc_delete_comment_details":
+		type wrap PaperDocDeleteCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocDeleteCommentDetails)})
+	case "paper_doc_download_details":
+		type wrap PaperDocDownloadDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocDownloadDetails)})
+	case "paper_doc_edit_details":
+		type wrap PaperDocEditDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocEditDetails)})
+	case "paper_doc_edit_comment_details":
+		type wrap PaperDocEditCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocEditCommentDetails)})
+	case "paper_doc_followed_details":
+		type wrap PaperDocFollowedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocFollowedDetails)})
+	case "paper_doc_mention_details":
+		type wrap PaperDocMentionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocMentionDetails)})
+	case "paper_doc_ownership_changed_details":
+		type wrap PaperDocOwnershipChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocOwnershipChangedDetails)})
+	case "paper_doc_request_access_details":
+		type wrap PaperDocRequestAccessDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocRequestAccessDetails)})
+	case "paper_doc_resolve_comment_details":
+		type wrap PaperDocResolveCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocResolveCommentDetails)})
+	case "paper_doc_revert_details":
+		type wrap PaperDocRevertDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocRevertDetails)})
+	case "paper_doc_slack_share_details":
+		type wrap PaperDocSlackShareDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocSlackShareDetails)})
+	case "paper_doc_team_invite_details":
+		type wrap PaperDocTeamInviteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocTeamInviteDetails)})
+	case "paper_doc_trashed_details":
+		type wrap PaperDocTrashedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocTrashedDetails)})
+	case "paper_doc_unresolve_comment_details":
+		type wrap PaperDocUnresolveCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocUnresolveCommentDetails)})
+	case "paper_doc_untrashed_details":
+		type wrap PaperDocUntrashedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocUntrashedDetails)})
+	case "paper_doc_view_details":
+		type wrap PaperDocViewDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocViewDetails)})
+	case "paper_external_view_allow_details":
+		type wrap PaperExternalViewAllowDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperExternalViewAllowDetails)})
+	case "paper_external_view_default_team_details":
+		type wrap PaperExternalViewDefaultTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperExternalViewDefaultTeamDetails)})
+	case "paper_external_view_forbid_details":
+		type wrap PaperExternalViewForbidDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperExternalViewForbidDetails)})
+	case "paper_folder_change_subscription_details":
+		type wrap PaperFolderChangeSubscriptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolderChangeSubscriptionDetails)})
+	case "paper_folder_deleted_details":
+		type wrap PaperFolderDeletedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolderDeletedDetails)})
+	case "paper_folder_followed_details":
+		type wrap PaperFolderFollowedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolderFollowedDetails)})
+	case "paper_folder_team_invite_details":
+		type wrap PaperFolderTeamInviteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolderTeamInviteDetails)})
+	case "paper_published_link_change_permission_details":
+		type wrap PaperPublishedLinkChangePermissionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperPublishedLinkChangePermissionDetails)})
+	case "paper_published_link_create_details":
+		type wrap PaperPublishedLinkCreateDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperPublishedLinkCreateDetails)})
+	case "paper_published_link_disabled_details":
+		type wrap PaperPublishedLinkDisabledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperPublishedLinkDisabledDetails)})
+	case "paper_published_link_view_details":
+		type wrap PaperPublishedLinkViewDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperPublishedLinkViewDetails)})
+	case "password_change_details":
+		type wrap PasswordChangeDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PasswordChangeDetails)})
+	case "password_reset_details":
+		type wrap PasswordResetDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PasswordResetDetails)})
+	case "password_reset_all_details":
+		type wrap PasswordResetAllDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PasswordResetAllDetails)})
+	case "classification_create_report_details":
+		type wrap ClassificationCreateReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ClassificationCreateReportDetails)})
+	case "classification_create_report_fail_details":
+		type wrap ClassificationCreateReportFailDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ClassificationCreateReportFailDetails)})
+	case "emm_create_exceptions_report_details":
+		type wrap EmmCreateExceptionsReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmCreateExceptionsReportDetails)})
+	case "emm_create_usage_report_details":
+		type wrap EmmCreateUsageReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmCreateUsageReportDetails)})
+	case "export_members_report_details":
+		type wrap ExportMembersReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExportMembersReportDetails)})
+	case "export_members_report_fail_details":
+		type wrap ExportMembersReportFailDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExportMembersReportFailDetails)})
+	case "external_sharing_create_report_details":
+		type wrap ExternalSharingCreateReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalSharingCreateReportDetails)})
+	case "external_sharing_report_failed_details":
+		type wrap ExternalSharingReportFailedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalSharingReportFailedDetails)})
+	case "no_expiration_link_gen_create_report_details":
+		type wrap NoExpirationLinkGenCreateReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoExpirationLinkGenCreateReportDetails)})
+	case "no_expiration_link_gen_report_failed_details":
+		type wrap NoExpirationLinkGenReportFailedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoExpirationLinkGenReportFailedDetails)})
+	case "no_password_link_gen_create_report_details":
+		type wrap NoPasswordLinkGenCreateReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoPasswordLinkGenCreateReportDetails)})
+	case "no_password_link_gen_report_failed_details":
+		type wrap NoPasswordLinkGenReportFailedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoPasswordLinkGenReportFailedDetails)})
+	case "no_password_link_view_create_report_details":
+		type wrap NoPasswordLinkViewCreateReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoPasswordLinkViewCreateReportDetails)})
+	case "no_password_link_view_report_failed_details":
+		type wrap NoPasswordLinkViewReportFailedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoPasswordLinkViewReportFailedDetails)})
+	case "outdated_link_view_create_report_details":
+		type wrap OutdatedLinkViewCreateReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OutdatedLinkViewCreateReportDetails)})
+	case "outdated_link_view_report_failed_details":
+		type wrap OutdatedLinkViewReportFailedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OutdatedLinkViewReportFailedDetails)})
+	case "paper_admin_export_start_details":
+		type wrap PaperAdminExportStartDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperAdminExportStartDetails)})
+	case "smart_sync_create_admin_privilege_report_details":
+		type wrap SmartSyncCreateAdminPrivilegeReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmartSyncCreateAdminPrivilegeReportDetails)})
+	case "team_activity_create_report_details":
+		type wrap TeamActivityCreateReportDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamActivityCreateReportDetails)})
+	case "team_activity_create_report_fail_details":
+		type wrap TeamActivityCreateReportFailDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamActivityCreateReportFailDetails)})
+	case "collection_share_details":
+		type wrap CollectionShareDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CollectionShareDetails)})
+	case "file_transfers_file_add_details":
+		type wrap FileTransfersFileAddDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersFileAddDetails)})
+	case "file_transfers_transfer_delete_details":
+		type wrap FileTransfersTransferDeleteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersTransferDeleteDetails)})
+	case "file_transfers_transfer_download_details":
+		type wrap FileTransfersTransferDownloadDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersTransferDownloadDetails)})
+	case "file_transfers_transfer_send_details":
+		type wrap FileTransfersTransferSendDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersTransferSendDetails)})
+	case "file_transfers_transfer_view_details":
+		type wrap FileTransfersTransferViewDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersTransferViewDetails)})
+	case "note_acl_invite_only_details":
+		type wrap NoteAclInviteOnlyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteAclInviteOnlyDetails)})
+	case "note_acl_link_details":
+		type wrap NoteAclLinkDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteAclLinkDetails)})
+	case "note_acl_team_link_details":
+		type wrap NoteAclTeamLinkDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteAclTeamLinkDetails)})
+	case "note_shared_details":
+		type wrap NoteSharedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteSharedDetails)})
+	case "note_share_receive_details":
+		type wrap NoteShareReceiveDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteShareReceiveDetails)})
+	case "open_note_shared_details":
+		type wrap OpenNoteSharedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OpenNoteSharedDetails)})
+	case "sf_add_group_details":
+		type wrap SfAddGroupDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfAddGroupDetails)})
+	case "sf_allow_non_members_to_view_shared_links_details":
+		type wrap SfAllowNonMembersToViewSharedLinksDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfAllowNonMembersToViewSharedLinksDetails)})
+	case "sf_external_invite_warn_details":
+		type wrap SfExternalInviteWarnDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfExternalInviteWarnDetails)})
+	case "sf_fb_invite_details":
+		type wrap SfFbInviteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfFbInviteDetails)})
+	case "sf_fb_invite_change_role_details":
+		type wrap SfFbInviteChangeRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfFbInviteChangeRoleDetails)})
+	case "sf_fb_uninvite_details":
+		type wrap SfFbUninviteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfFbUninviteDetails)})
+	case "sf_invite_group_details":
+		type wrap SfInviteGroupDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfInviteGroupDetails)})
+	case "sf_team_grant_access_details":
+		type wrap SfTeamGrantAccessDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamGrantAccessDetails)})
+	case "sf_team_invite_details":
+		type wrap SfTeamInviteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamInviteDetails)})
+	case "sf_team_invite_change_role_details":
+		type wrap SfTeamInviteChangeRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamInviteChangeRoleDetails)})
+	case "sf_team_join_details":
+		type wrap SfTeamJoinDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamJoinDetails)})
+	case "sf_team_join_from_oob_link_details":
+		type wrap SfTeamJoinFromOobLinkDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamJoinFromOobLinkDetails)})
+	case "sf_team_uninvite_details":
+		type wrap SfTeamUninviteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamUninviteDetails)})
+	case "shared_content_add_invitees_details":
+		type wrap SharedContentAddInviteesDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentAddInviteesDetails)})
+	case "shared_content_add_link_expiry_details":
+		type wrap SharedContentAddLinkExpiryDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentAddLinkExpiryDetails)})
+	case "shared_content_add_link_password_details":
+		type wrap SharedContentAddLinkPasswordDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentAddLinkPasswordDetails)})
+	case "shared_content_add_member_details":
+		type wrap SharedContentAddMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentAddMemberDetails)})
+	case "shared_content_change_downloads_policy_details":
+		type wrap SharedContentChangeDownloadsPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeDownloadsPolicyDetails)})
+	case "shared_content_change_invitee_role_details":
+		type wrap SharedContentChangeInviteeRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeInviteeRoleDetails)})
+	case "shared_content_change_link_audience_details":
+		type wrap SharedContentChangeLinkAudienceDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeLinkAudienceDetails)})
+	case "shared_content_change_link_expiry_details":
+		type wrap SharedContentChangeLinkExpiryDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeLinkExpiryDetails)})
+	case "shared_content_change_link_password_details":
+		type wrap SharedContentChangeLinkPasswordDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeLinkPasswordDetails)})
+	case "shared_content_change_member_role_details":
+		type wrap SharedContentChangeMemberRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeMemberRoleDetails)})
+	case "shared_content_change_viewer_info_policy_details":
+		type wrap SharedContentChangeViewerInfoPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeViewerInfoPolicyDetails)})
+	case "shared_content_claim_invitation_details":
+		type wrap SharedContentClaimInvitationDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentClaimInvitationDetails)})
+	case "shared_content_copy_details":
+		type wrap SharedContentCopyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentCopyDetails)})
+	case "shared_content_download_details":
+		type wrap SharedContentDownloadDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentDownloadDetails)})
+	case "shared_content_relinquish_membership_details":
+		type wrap SharedContentRelinquishMembershipDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRelinquishMembershipDetails)})
+	case "shared_content_remove_invitees_details":
+		type wrap SharedContentRemoveInviteesDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRemoveInviteesDetails)})
+	case "shared_content_remove_link_expiry_details":
+		type wrap SharedContentRemoveLinkExpiryDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRemoveLinkExpiryDetails)})
+	case "shared_content_remove_link_password_details":
+		type wrap SharedContentRemoveLinkPasswordDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRemoveLinkPasswordDetails)})
+	case "shared_content_remove_member_details":
+		type wrap SharedContentRemoveMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRemoveMemberDetails)})
+	case "shared_content_request_access_details":
+		type wrap SharedContentRequestAccessDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRequestAccessDetails)})
+	case "shared_content_restore_invitees_details":
+		type wrap SharedContentRestoreInviteesDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRestoreInviteesDetails)})
+	case "shared_content_restore_member_details":
+		type wrap SharedContentRestoreMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRestoreMemberDetails)})
+	case "shared_content_unshare_details":
+		type wrap SharedContentUnshareDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentUnshareDetails)})
+	case "shared_content_view_details":
+		type wrap SharedContentViewDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentViewDetails)})
+	case "shared_folder_change_link_policy_details":
+		type wrap SharedFolderChangeLinkPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderChangeLinkPolicyDetails)})
+	case "shared_folder_change_members_inheritance_policy_details":
+		type wrap SharedFolderChangeMembersInheritancePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderChangeMembersInheritancePolicyDetails)})
+	case "shared_folder_change_members_management_policy_details":
+		type wrap SharedFolderChangeMembersManagementPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderChangeMembersManagementPolicyDetails)})
+	case "shared_folder_change_members_policy_details":
+		type wrap SharedFolderChangeMembersPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderChangeMembersPolicyDetails)})
+	case "shared_folder_create_details":
+		type wrap SharedFolderCreateDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderCreateDetails)})
+	case "shared_folder_decline_invitation_details":
+		type wrap SharedFolderDeclineInvitationDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderDeclineInvitationDetails)})
+	case "shared_folder_mount_details":
+		type wrap SharedFolderMountDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderMountDetails)})
+	case "shared_folder_nest_details":
+		type wrap SharedFolderNestDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderNestDetails)})
+	case "shared_folder_transfer_ownership_details":
+		type wrap SharedFolderTransferOwnershipDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderTransferOwnershipDetails)})
+	case "shared_folder_unmount_details":
+		type wrap SharedFolderUnmountDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderUnmountDetails)})
+	case "shared_link_add_expiry_details":
+		type wrap SharedLinkAddExpiryDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkAddExpiryDetails)})
+	case "shared_link_change_expiry_details":
+		type wrap SharedLinkChangeExpiryDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkChangeExpiryDetails)})
+	case "shared_link_change_visibility_details":
+		type wrap SharedLinkChangeVisibilityDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkChangeVisibilityDetails)})
+	case "shared_link_copy_details":
+		type wrap SharedLinkCopyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkCopyDetails)})
+	case "shared_link_create_details":
+		type wrap SharedLinkCreateDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkCreateDetails)})
+	case "shared_link_disable_details":
+		type wrap SharedLinkDisableDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkDisableDetails)})
+	case "shared_link_download_details":
+		type wrap SharedLinkDownloadDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkDownloadDetails)})
+	case "shared_link_remove_expiry_details":
+		type wrap SharedLinkRemoveExpiryDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkRemoveExpiryDetails)})
+	case "shared_link_settings_add_expiration_details":
+		type wrap SharedLinkSettingsAddExpirationDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsAddExpirationDetails)})
+	case "shared_link_settings_add_password_details":
+		type wrap SharedLinkSettingsAddPasswordDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsAddPasswordDetails)})
+	case "shared_link_settings_allow_download_disabled_details":
+		type wrap SharedLinkSettingsAllowDownloadDisabledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsAllowDownloadDisabledDetails)})
+	case "shared_link_settings_allow_download_enabled_details":
+		type wrap SharedLinkSettingsAllowDownloadEnabledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsAllowDownloadEnabledDetails)})
+	case "shared_link_settings_change_audience_details":
+		type wrap SharedLinkSettingsChangeAudienceDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsChangeAudienceDetails)})
+	case "shared_link_settings_change_expiration_details":
+		type wrap SharedLinkSettingsChangeExpirationDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsChangeExpirationDetails)})
+	case "shared_link_settings_change_password_details":
+		type wrap SharedLinkSettingsChangePasswordDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsChangePasswordDetails)})
+	case "shared_link_settings_remove_expiration_details":
+		type wrap SharedLinkSettingsRemoveExpirationDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsRemoveExpirationDetails)})
+	case "shared_link_settings_remove_password_details":
+		type wrap SharedLinkSettingsRemovePasswordDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsRemovePasswordDetails)})
+	case "shared_link_share_details":
+		type wrap SharedLinkShareDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkShareDetails)})
+	case "shared_link_view_details":
+		type wrap SharedLinkViewDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkViewDetails)})
+	case "shared_note_opened_details":
+		type wrap SharedNoteOpenedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedNoteOpenedDetails)})
+	case "shmodel_disable_downloads_details":
+		type wrap ShmodelDisableDownloadsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShmodelDisableDownloadsDetails)})
+	case "shmodel_enable_downloads_details":
+		type wrap ShmodelEnableDownloadsDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShmodelEnableDownloadsDetails)})
+	case "shmodel_group_share_details":
+		type wrap ShmodelGroupShareDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShmodelGroupShareDetails)})
+	case "showcase_access_granted_details":
+		type wrap ShowcaseAccessGrantedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseAccessGrantedDetails)})
+	case "showcase_add_member_details":
+		type wrap ShowcaseAddMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseAddMemberDetails)})
+	case "showcase_archived_details":
+		type wrap ShowcaseArchivedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseArchivedDetails)})
+	case "showcase_created_details":
+		type wrap ShowcaseCreatedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseCreatedDetails)})
+	case "showcase_delete_comment_details":
+		type wrap ShowcaseDeleteCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseDeleteCommentDetails)})
+	case "showcase_edited_details":
+		type wrap ShowcaseEditedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseEditedDetails)})
+	case "showcase_edit_comment_details":
+		type wrap ShowcaseEditCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseEditCommentDetails)})
+	case "showcase_file_added_details":
+		type wrap ShowcaseFileAddedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseFileAddedDetails)})
+	case "showcase_file_download_details":
+		type wrap ShowcaseFileDownloadDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseFileDownloadDetails)})
+	case "showcase_file_removed_details":
+		type wrap ShowcaseFileRemovedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseFileRemovedDetails)})
+	case "showcase_file_view_details":
+		type wrap ShowcaseFileViewDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseFileViewDetails)})
+	case "showcase_permanently_deleted_details":
+		type wrap ShowcasePermanentlyDeletedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcasePermanentlyDeletedDetails)})
+	case "showcase_post_comment_details":
+		type wrap ShowcasePostCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcasePostCommentDetails)})
+	case "showcase_remove_member_details":
+		type wrap ShowcaseRemoveMemberDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseRemoveMemberDetails)})
+	case "showcase_renamed_details":
+		type wrap ShowcaseRenamedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseRenamedDetails)})
+	case "showcase_request_access_details":
+		type wrap ShowcaseRequestAccessDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseRequestAccessDetails)})
+	case "showcase_resolve_comment_details":
+		type wrap ShowcaseResolveCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseResolveCommentDetails)})
+	case "showcase_restored_details":
+		type wrap ShowcaseRestoredDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseRestoredDetails)})
+	case "showcase_trashed_details":
+		type wrap ShowcaseTrashedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseTrashedDetails)})
+	case "showcase_trashed_deprecated_details":
+		type wrap ShowcaseTrashedDeprecatedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseTrashedDeprecatedDetails)})
+	case "showcase_unresolve_comment_details":
+		type wrap ShowcaseUnresolveCommentDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseUnresolveCommentDetails)})
+	case "showcase_untrashed_details":
+		type wrap ShowcaseUntrashedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseUntrashedDetails)})
+	case "showcase_untrashed_deprecated_details":
+		type wrap ShowcaseUntrashedDeprecatedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseUntrashedDeprecatedDetails)})
+	case "showcase_view_details":
+		type wrap ShowcaseViewDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseViewDetails)})
+	case "sso_add_cert_details":
+		type wrap SsoAddCertDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoAddCertDetails)})
+	case "sso_add_login_url_details":
+		type wrap SsoAddLoginUrlDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoAddLoginUrlDetails)})
+	case "sso_add_logout_url_details":
+		type wrap SsoAddLogoutUrlDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoAddLogoutUrlDetails)})
+	case "sso_change_cert_details":
+		type wrap SsoChangeCertDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangeCertDetails)})
+	case "sso_change_login_url_details":
+		type wrap SsoChangeLoginUrlDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangeLoginUrlDetails)})
+	case "sso_change_logout_url_details":
+		type wrap SsoChangeLogoutUrlDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangeLogoutUrlDetails)})
+	case "sso_change_saml_identity_mode_details":
+		type wrap SsoChangeSamlIdentityModeDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangeSamlIdentityModeDetails)})
+	case "sso_remove_cert_details":
+		type wrap SsoRemoveCertDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoRemoveCertDetails)})
+	case "sso_remove_login_url_details":
+		type wrap SsoRemoveLoginUrlDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoRemoveLoginUrlDetails)})
+	case "sso_remove_logout_url_details":
+		type wrap SsoRemoveLogoutUrlDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoRemoveLogoutUrlDetails)})
+	case "team_folder_change_status_details":
+		type wrap TeamFolderChangeStatusDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderChangeStatusDetails)})
+	case "team_folder_create_details":
+		type wrap TeamFolderCreateDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderCreateDetails)})
+	case "team_folder_downgrade_details":
+		type wrap TeamFolderDowngradeDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderDowngradeDetails)})
+	case "team_folder_permanently_delete_details":
+		type wrap TeamFolderPermanentlyDeleteDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderPermanentlyDeleteDetails)})
+	case "team_folder_rename_details":
+		type wrap TeamFolderRenameDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderRenameDetails)})
+	case "team_selective_sync_settings_changed_details":
+		type wrap TeamSelectiveSyncSettingsChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamSelectiveSyncSettingsChangedDetails)})
+	case "account_capture_change_policy_details":
+		type wrap AccountCaptureChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureChangePolicyDetails)})
+	case "admin_email_reminders_changed_details":
+		type wrap AdminEmailRemindersChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminEmailRemindersChangedDetails)})
+	case "allow_download_disabled_details":
+		type wrap AllowDownloadDisabledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AllowDownloadDisabledDetails)})
+	case "allow_download_enabled_details":
+		type wrap AllowDownloadEnabledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AllowDownloadEnabledDetails)})
+	case "app_permissions_changed_details":
+		type wrap AppPermissionsChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppPermissionsChangedDetails)})
+	case "camera_uploads_policy_changed_details":
+		type wrap CameraUploadsPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CameraUploadsPolicyChangedDetails)})
+	case "capture_transcript_policy_changed_details":
+		type wrap CaptureTranscriptPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CaptureTranscriptPolicyChangedDetails)})
+	case "classification_change_policy_details":
+		type wrap ClassificationChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ClassificationChangePolicyDetails)})
+	case "computer_backup_policy_changed_details":
+		type wrap ComputerBackupPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ComputerBackupPolicyChangedDetails)})
+	case "content_administration_policy_changed_details":
+		type wrap ContentAdministrationPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ContentAdministrationPolicyChangedDetails)})
+	case "data_placement_restriction_change_policy_details":
+		type wrap DataPlacementRestrictionChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DataPlacementRestrictionChangePolicyDetails)})
+	case "data_placement_restriction_satisfy_policy_details":
+		type wrap DataPlacementRestrictionSatisfyPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DataPlacementRestrictionSatisfyPolicyDetails)})
+	case "device_approvals_add_exception_details":
+		type wrap DeviceApprovalsAddExceptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsAddExceptionDetails)})
+	case "device_approvals_change_desktop_policy_details":
+		type wrap DeviceApprovalsChangeDesktopPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsChangeDesktopPolicyDetails)})
+	case "device_approvals_change_mobile_policy_details":
+		type wrap DeviceApprovalsChangeMobilePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsChangeMobilePolicyDetails)})
+	case "device_approvals_change_overage_action_details":
+		type wrap DeviceApprovalsChangeOverageActionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsChangeOverageActionDetails)})
+	case "device_approvals_change_unlink_action_details":
+		type wrap DeviceApprovalsChangeUnlinkActionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsChangeUnlinkActionDetails)})
+	case "device_approvals_remove_exception_details":
+		type wrap DeviceApprovalsRemoveExceptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsRemoveExceptionDetails)})
+	case "directory_restrictions_add_members_details":
+		type wrap DirectoryRestrictionsAddMembersDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DirectoryRestrictionsAddMembersDetails)})
+	case "directory_restrictions_remove_members_details":
+		type wrap DirectoryRestrictionsRemoveMembersDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DirectoryRestrictionsRemoveMembersDetails)})
+	case "dropbox_passwords_policy_changed_details":
+		type wrap DropboxPasswordsPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DropboxPasswordsPolicyChangedDetails)})
+	case "email_ingest_policy_changed_details":
+		type wrap EmailIngestPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmailIngestPolicyChangedDetails)})
+	case "emm_add_exception_details":
+		type wrap EmmAddExceptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmAddExceptionDetails)})
+	case "emm_change_policy_details":
+		type wrap EmmChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmChangePolicyDetails)})
+	case "emm_remove_exception_details":
+		type wrap EmmRemoveExceptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmRemoveExceptionDetails)})
+	case "extended_version_history_change_policy_details":
+		type wrap ExtendedVersionHistoryChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExtendedVersionHistoryChangePolicyDetails)})
+	case "external_drive_backup_policy_changed_details":
+		type wrap ExternalDriveBackupPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalDriveBackupPolicyChangedDetails)})
+	case "file_comments_change_policy_details":
+		type wrap FileCommentsChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileCommentsChangePolicyDetails)})
+	case "file_locking_policy_changed_details":
+		type wrap FileLockingPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileLockingPolicyChangedDetails)})
+	case "file_provider_migration_policy_changed_details":
+		type wrap FileProviderMigrationPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileProviderMigrationPolicyChangedDetails)})
+	case "file_requests_change_policy_details":
+		type wrap FileRequestsChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestsChangePolicyDetails)})
+	case "file_requests_emails_enabled_details":
+		type wrap FileRequestsEmailsEnabledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestsEmailsEnabledDetails)})
+	case "file_requests_emails_restricted_to_team_only_details":
+		type wrap FileRequestsEmailsRestrictedToTeamOnlyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestsEmailsRestrictedToTeamOnlyDetails)})
+	case "file_transfers_policy_changed_details":
+		type wrap FileTransfersPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersPolicyChangedDetails)})
+	case "google_sso_change_policy_details":
+		type wrap GoogleSsoChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GoogleSsoChangePolicyDetails)})
+	case "group_user_management_change_policy_details":
+		type wrap GroupUserManagementChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupUserManagementChangePolicyDetails)})
+	case "integration_policy_changed_details":
+		type wrap IntegrationPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.IntegrationPolicyChangedDetails)})
+	case "invite_acceptance_email_policy_changed_details":
+		type wrap InviteAcceptanceEmailPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.InviteAcceptanceEmailPolicyChangedDetails)})
+	case "member_requests_change_policy_details":
+		type wrap MemberRequestsChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberRequestsChangePolicyDetails)})
+	case "member_send_invite_policy_changed_details":
+		type wrap MemberSendInvitePolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSendInvitePolicyChangedDetails)})
+	case "member_space_limits_add_exception_details":
+		type wrap MemberSpaceLimitsAddExceptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsAddExceptionDetails)})
+	case "member_space_limits_change_caps_type_policy_details":
+		type wrap MemberSpaceLimitsChangeCapsTypePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsChangeCapsTypePolicyDetails)})
+	case "member_space_limits_change_policy_details":
+		type wrap MemberSpaceLimitsChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsChangePolicyDetails)})
+	case "member_space_limits_remove_exception_details":
+		type wrap MemberSpaceLimitsRemoveExceptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsRemoveExceptionDetails)})
+	case "member_suggestions_change_policy_details":
+		type wrap MemberSuggestionsChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSuggestionsChangePolicyDetails)})
+	case "microsoft_office_addin_change_policy_details":
+		type wrap MicrosoftOfficeAddinChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MicrosoftOfficeAddinChangePolicyDetails)})
+	case "network_control_change_policy_details":
+		type wrap NetworkControlChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NetworkControlChangePolicyDetails)})
+	case "paper_change_deployment_policy_details":
+		type wrap PaperChangeDeploymentPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperChangeDeploymentPolicyDetails)})
+	case "paper_change_member_link_policy_details":
+		type wrap PaperChangeMemberLinkPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperChangeMemberLinkPolicyDetails)})
+	case "paper_change_member_policy_details":
+		type wrap PaperChangeMemberPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperChangeMemberPolicyDetails)})
+	case "paper_change_policy_details":
+		type wrap PaperChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperChangePolicyDetails)})
+	case "paper_default_folder_policy_changed_details":
+		type wrap PaperDefaultFolderPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDefaultFolderPolicyChangedDetails)})
+	case "paper_desktop_policy_changed_details":
+		type wrap PaperDesktopPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDesktopPolicyChangedDetails)})
+	case "paper_enabled_users_group_addition_details":
+		type wrap PaperEnabledUsersGroupAdditionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperEnabledUsersGroupAdditionDetails)})
+	case "paper_enabled_users_group_removal_details":
+		type wrap PaperEnabledUsersGroupRemovalDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperEnabledUsersGroupRemovalDetails)})
+	case "password_strength_requirements_change_policy_details":
+		type wrap PasswordStrengthRequirementsChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PasswordStrengthRequirementsChangePolicyDetails)})
+	case "permanent_delete_change_policy_details":
+		type wrap PermanentDeleteChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PermanentDeleteChangePolicyDetails)})
+	case "reseller_support_change_policy_details":
+		type wrap ResellerSupportChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ResellerSupportChangePolicyDetails)})
+	case "rewind_policy_changed_details":
+		type wrap RewindPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.RewindPolicyChangedDetails)})
+	case "send_for_signature_policy_changed_details":
+		type wrap SendForSignaturePolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SendForSignaturePolicyChangedDetails)})
+	case "sharing_change_folder_join_policy_details":
+		type wrap SharingChangeFolderJoinPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeFolderJoinPolicyDetails)})
+	case "sharing_change_link_allow_change_expiration_policy_details":
+		type wrap SharingChangeLinkAllowChangeExpirationPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeLinkAllowChangeExpirationPolicyDetails)})
+	case "sharing_change_link_default_expiration_policy_details":
+		type wrap SharingChangeLinkDefaultExpirationPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeLinkDefaultExpirationPolicyDetails)})
+	case "sharing_change_link_enforce_password_policy_details":
+		type wrap SharingChangeLinkEnforcePasswordPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeLinkEnforcePasswordPolicyDetails)})
+	case "sharing_change_link_policy_details":
+		type wrap SharingChangeLinkPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeLinkPolicyDetails)})
+	case "sharing_change_member_policy_details":
+		type wrap SharingChangeMemberPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeMemberPolicyDetails)})
+	case "showcase_change_download_policy_details":
+		type wrap ShowcaseChangeDownloadPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseChangeDownloadPolicyDetails)})
+	case "showcase_change_enabled_policy_details":
+		type wrap ShowcaseChangeEnabledPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseChangeEnabledPolicyDetails)})
+	case "showcase_change_external_sharing_policy_details":
+		type wrap ShowcaseChangeExternalSharingPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseChangeExternalSharingPolicyDetails)})
+	case "smarter_smart_sync_policy_changed_details":
+		type wrap SmarterSmartSyncPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmarterSmartSyncPolicyChangedDetails)})
+	case "smart_sync_change_policy_details":
+		type wrap SmartSyncChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmartSyncChangePolicyDetails)})
+	case "smart_sync_not_opt_out_details":
+		type wrap SmartSyncNotOptOutDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmartSyncNotOptOutDetails)})
+	case "smart_sync_opt_out_details":
+		type wrap SmartSyncOptOutDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmartSyncOptOutDetails)})
+	case "sso_change_policy_details":
+		type wrap SsoChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangePolicyDetails)})
+	case "team_branding_policy_changed_details":
+		type wrap TeamBrandingPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamBrandingPolicyChangedDetails)})
+	case "team_extensions_policy_changed_details":
+		type wrap TeamExtensionsPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamExtensionsPolicyChangedDetails)})
+	case "team_selective_sync_policy_changed_details":
+		type wrap TeamSelectiveSyncPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamSelectiveSyncPolicyChangedDetails)})
+	case "team_sharing_whitelist_subjects_changed_details":
+		type wrap TeamSharingWhitelistSubjectsChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamSharingWhitelistSubjectsChangedDetails)})
+	case "tfa_add_exception_details":
+		type wrap TfaAddExceptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaAddExceptionDetails)})
+	case "tfa_change_policy_details":
+		type wrap TfaChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaChangePolicyDetails)})
+	case "tfa_remove_exception_details":
+		type wrap TfaRemoveExceptionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaRemoveExceptionDetails)})
+	case "two_account_change_policy_details":
+		type wrap TwoAccountChangePolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TwoAccountChangePolicyDetails)})
+	case "viewer_info_policy_changed_details":
+		type wrap ViewerInfoPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ViewerInfoPolicyChangedDetails)})
+	case "watermarking_policy_changed_details":
+		type wrap WatermarkingPolicyChangedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WatermarkingPolicyChangedDetails)})
+	case "web_sessions_change_active_session_limit_details":
+		type wrap WebSessionsChangeActiveSessionLimitDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WebSessionsChangeActiveSessionLimitDetails)})
+	case "web_sessions_change_fixed_length_policy_details":
+		type wrap WebSessionsChangeFixedLengthPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WebSessionsChangeFixedLengthPolicyDetails)})
+	case "web_sessions_change_idle_length_policy_details":
+		type wrap WebSessionsChangeIdleLengthPolicyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WebSessionsChangeIdleLengthPolicyDetails)})
+	case "data_residency_migration_request_successful_details":
+		type wrap DataResidencyMigrationRequestSuccessfulDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DataResidencyMigrationRequestSuccessfulDetails)})
+	case "data_residency_migration_request_unsuccessful_details":
+		type wrap DataResidencyMigrationRequestUnsuccessfulDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DataResidencyMigrationRequestUnsuccessfulDetails)})
+	case "team_merge_from_details":
+		type wrap TeamMergeFromDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeFromDetails)})
+	case "team_merge_to_details":
+		type wrap TeamMergeToDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeToDetails)})
+	case "team_profile_add_background_details":
+		type wrap TeamProfileAddBackgroundDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileAddBackgroundDetails)})
+	case "team_profile_add_logo_details":
+		type wrap TeamProfileAddLogoDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileAddLogoDetails)})
+	case "team_profile_change_background_details":
+		type wrap TeamProfileChangeBackgroundDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileChangeBackgroundDetails)})
+	case "team_profile_change_default_language_details":
+		type wrap TeamProfileChangeDefaultLanguageDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileChangeDefaultLanguageDetails)})
+	case "team_profile_change_logo_details":
+		type wrap TeamProfileChangeLogoDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileChangeLogoDetails)})
+	case "team_profile_change_name_details":
+		type wrap TeamProfileChangeNameDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileChangeNameDetails)})
+	case "team_profile_remove_background_details":
+		type wrap TeamProfileRemoveBackgroundDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileRemoveBackgroundDetails)})
+	case "team_profile_remove_logo_details":
+		type wrap TeamProfileRemoveLogoDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileRemoveLogoDetails)})
+	case "tfa_add_backup_phone_details":
+		type wrap TfaAddBackupPhoneDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaAddBackupPhoneDetails)})
+	case "tfa_add_security_key_details":
+		type wrap TfaAddSecurityKeyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaAddSecurityKeyDetails)})
+	case "tfa_change_backup_phone_details":
+		type wrap TfaChangeBackupPhoneDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaChangeBackupPhoneDetails)})
+	case "tfa_change_status_details":
+		type wrap TfaChangeStatusDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaChangeStatusDetails)})
+	case "tfa_remove_backup_phone_details":
+		type wrap TfaRemoveBackupPhoneDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaRemoveBackupPhoneDetails)})
+	case "tfa_remove_security_key_details":
+		type wrap TfaRemoveSecurityKeyDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaRemoveSecurityKeyDetails)})
+	case "tfa_reset_details":
+		type wrap TfaResetDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaResetDetails)})
+	case "changed_enterprise_admin_role_details":
+		type wrap ChangedEnterpriseAdminRoleDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ChangedEnterpriseAdminRoleDetails)})
+	case "changed_enterprise_connected_team_status_details":
+		type wrap ChangedEnterpriseConnectedTeamStatusDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ChangedEnterpriseConnectedTeamStatusDetails)})
+	case "ended_enterprise_admin_session_details":
+		type wrap EndedEnterpriseAdminSessionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EndedEnterpriseAdminSessionDetails)})
+	case "ended_enterprise_admin_session_deprecated_details":
+		type wrap EndedEnterpriseAdminSessionDeprecatedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EndedEnterpriseAdminSessionDeprecatedDetails)})
+	case "enterprise_settings_locking_details":
+		type wrap EnterpriseSettingsLockingDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EnterpriseSettingsLockingDetails)})
+	case "guest_admin_change_status_details":
+		type wrap GuestAdminChangeStatusDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GuestAdminChangeStatusDetails)})
+	case "started_enterprise_admin_session_details":
+		type wrap StartedEnterpriseAdminSessionDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.StartedEnterpriseAdminSessionDetails)})
+	case "team_merge_request_accepted_details":
+		type wrap TeamMergeRequestAcceptedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestAcceptedDetails)})
+	case "team_merge_request_accepted_shown_to_primary_team_details":
+		type wrap TeamMergeRequestAcceptedShownToPrimaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestAcceptedShownToPrimaryTeamDetails)})
+	case "team_merge_request_accepted_shown_to_secondary_team_details":
+		type wrap TeamMergeRequestAcceptedShownToSecondaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestAcceptedShownToSecondaryTeamDetails)})
+	case "team_merge_request_auto_canceled_details":
+		type wrap TeamMergeRequestAutoCanceledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestAutoCanceledDetails)})
+	case "team_merge_request_canceled_details":
+		type wrap TeamMergeRequestCanceledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestCanceledDetails)})
+	case "team_merge_request_canceled_shown_to_primary_team_details":
+		type wrap TeamMergeRequestCanceledShownToPrimaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestCanceledShownToPrimaryTeamDetails)})
+	case "team_merge_request_canceled_shown_to_secondary_team_details":
+		type wrap TeamMergeRequestCanceledShownToSecondaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestCanceledShownToSecondaryTeamDetails)})
+	case "team_merge_request_expired_details":
+		type wrap TeamMergeRequestExpiredDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestExpiredDetails)})
+	case "team_merge_request_expired_shown_to_primary_team_details":
+		type wrap TeamMergeRequestExpiredShownToPrimaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestExpiredShownToPrimaryTeamDetails)})
+	case "team_merge_request_expired_shown_to_secondary_team_details":
+		type wrap TeamMergeRequestExpiredShownToSecondaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestExpiredShownToSecondaryTeamDetails)})
+	case "team_merge_request_rejected_shown_to_primary_team_details":
+		type wrap TeamMergeRequestRejectedShownToPrimaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestRejectedShownToPrimaryTeamDetails)})
+	case "team_merge_request_rejected_shown_to_secondary_team_details":
+		type wrap TeamMergeRequestRejectedShownToSecondaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestRejectedShownToSecondaryTeamDetails)})
+	case "team_merge_request_reminder_details":
+		type wrap TeamMergeRequestReminderDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestReminderDetails)})
+	case "team_merge_request_reminder_shown_to_primary_team_details":
+		type wrap TeamMergeRequestReminderShownToPrimaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestReminderShownToPrimaryTeamDetails)})
+	case "team_merge_request_reminder_shown_to_secondary_team_details":
+		type wrap TeamMergeRequestReminderShownToSecondaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestReminderShownToSecondaryTeamDetails)})
+	case "team_merge_request_revoked_details":
+		type wrap TeamMergeRequestRevokedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestRevokedDetails)})
+	case "team_merge_request_sent_shown_to_primary_team_details":
+		type wrap TeamMergeRequestSentShownToPrimaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestSentShownToPrimaryTeamDetails)})
+	case "team_merge_request_sent_shown_to_secondary_team_details":
+		type wrap TeamMergeRequestSentShownToSecondaryTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestSentShownToSecondaryTeamDetails)})
+	case "missing_details":
+		type wrap MissingDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MissingDetails)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
+// EventType : The type of the event with description.
+type EventType struct {
+	dropbox.Tagged
+	// AdminAlertingAlertStateChanged : (admin_alerting) Changed an alert state
+	AdminAlertingAlertStateChanged *AdminAlertingAlertStateChangedType `json:"admin_alerting_alert_state_changed,omitempty"`
+	// AdminAlertingChangedAlertConfig : (admin_alerting) Changed an alert
+	// setting
+	AdminAlertingChangedAlertConfig *AdminAlertingChangedAlertConfigType `json:"admin_alerting_changed_alert_config,omitempty"`
+	// AdminAlertingTriggeredAlert : (admin_alerting) Triggered security alert
+	AdminAlertingTriggeredAlert *AdminAlertingTriggeredAlertType `json:"admin_alerting_triggered_alert,omitempty"`
+	// AppBlockedByPermissions : (apps) Failed to connect app for member
+	AppBlockedByPermissions *AppBlockedByPermissionsType `json:"app_blocked_by_permissions,omitempty"`
+	// AppLinkTeam : (apps) Linked app for team
+	AppLinkTeam *AppLinkTeamType `json:"app_link_team,omitempty"`
+	// AppLinkUser : (apps) Linked app for member
+	AppLinkUser *AppLinkUserType `json:"app_link_user,omitempty"`
+	// AppUnlinkTeam : (apps) Unlinked app for team
+	AppUnlinkTeam *AppUnlinkTeamType `json:"app_unlink_team,omitempty"`
+	// AppUnlinkUser : (apps) Unlinked app for member
+	AppUnlinkUser *AppUnlinkUserType `json:"app_unlink_user,omitempty"`
+	// IntegrationConnected : (apps) Connected integration for member
+	IntegrationConnected *IntegrationConnectedType `json:"integration_connected,omitempty"`
+	// IntegrationDisconnected : (apps) Disconnected integration for member
+	IntegrationDisconnected *IntegrationDisconnectedType `json:"integration_disconnected,omitempty"`
+	// FileAddComment : (comments) Added file comment
+	FileAddComment *FileAddCommentType `json:"file_add_comment,omitempty"`
+	// FileChangeCommentSubscription : (comments) Subscribed to or unsubscribed
+	// from comment notifications for file
+	FileChangeCommentSubscription *FileChangeCommentSubscriptionType `json:"file_change_comment_subscription,omitempty"`
+	// FileDeleteComment : (comments) Deleted file comment
+	FileDeleteComment *FileDeleteCommentType `json:"file_delete_comment,omitempty"`
+	// FileEditComment : (comments) Edited file comment
+	FileEditComment *FileEditCommentType `json:"file_edit_comment,omitempty"`
+	// FileLikeComment : (comments) Liked file comment (deprecated, no longer
+	// logged)
+	FileLikeComment *FileLikeCommentType `json:"file_like_comment,omitempty"`
+	// FileResolveComment : (comments) Resolved file comment
+	FileResolveComment *FileResolveCommentType `json:"file_resolve_comment,omitempty"`
+	// FileUnlikeComment : (comments) Unliked file comment (deprecated, no
+	// longer logged)
+	FileUnlikeComment *FileUnlikeCommentType `json:"file_unlike_comment,omitempty"`
+	// FileUnresolveComment : (comments) Unresolved file comment
+	FileUnresolveComment *FileUnresolveCommentType `json:"file_unresolve_comment,omitempty"`
+	// GovernancePolicyAddFolders : (data_governance) Added folders to policy
+	GovernancePolicyAddFolders *GovernancePolicyAddFoldersType `json:"governance_policy_add_folders,omitempty"`
+	// GovernancePolicyAddFolderFailed : (data_governance) Couldn't add a folder
+	// to a policy
+	GovernancePolicyAddFolderFailed *GovernancePolicyAddFolderFailedType `json:"governance_policy_add_folder_failed,omitempty"`
+	// GovernancePolicyContentDisposed : (data_governance) Content disposed
+	GovernancePolicyContentDisposed *GovernancePolicyContentDisposedType `json:"governance_policy_content_disposed,omitempty"`
+	// GovernancePolicyCreate : (data_governance) Activated a new policy
+	GovernancePolicyCreate *GovernancePolicyCreateType `json:"governance_policy_create,omitempty"`
+	// GovernancePolicyDelete : (data_governance) Deleted a policy
+	GovernancePolicyDelete *GovernancePolicyDeleteType `json:"governance_policy_delete,omitempty"`
+	// GovernancePolicyEditDetails : (data_governance) Edited policy
+	GovernancePolicyEditDetails *GovernancePolicyEditDetailsType `json:"governance_policy_edit_details,omitempty"`
+	// GovernancePolicyEditDuration : (data_governance) Changed policy duration
+	GovernancePolicyEditDuration *GovernancePolicyEditDurationType `json:"governance_policy_edit_duration,omitempty"`
+	// GovernancePolicyExportCreated : (data_governance) Created a policy
+	// download
+	GovernancePolicyExportCreated *GovernancePolicyExportCreatedType `json:"governance_policy_export_created,omitempty"`
+	// GovernancePolicyExportRemoved : (data_governance) Removed a policy
+	// download
+	GovernancePolicyExportRemoved *GovernancePolicyExportRemovedType `json:"governance_policy_export_removed,omitempty"`
+	// GovernancePolicyRemoveFolders : (data_governance) Removed folders from
+	// policy
+	GovernancePolicyRemoveFolders *GovernancePolicyRemoveFoldersType `json:"governance_policy_remove_folders,omitempty"`
+	// GovernancePolicyReportCreated : (data_governance) Created a summary
+	// report for a policy
+	GovernancePolicyReportCreated *GovernancePolicyReportCreatedType `json:"governance_policy_report_created,omitempty"`
+	// GovernancePolicyZipPartDownloaded : (data_governance) Downloaded content
+	// from a policy
+	GovernancePolicyZipPartDownloaded *GovernancePolicyZipPartDownloadedType `json:"governance_policy_zip_part_downloaded,omitempty"`
+	// LegalHoldsActivateAHold : (data_governance) Activated a hold
+	LegalHoldsActivateAHold *LegalHoldsActivateAHoldType `json:"legal_holds_activate_a_hold,omitempty"`
+	// LegalHoldsAddMembers : (data_governance) Added members to a hold
+	LegalHoldsAddMembers *LegalHoldsAddMembersType `json:"legal_holds_add_members,omitempty"`
+	// LegalHoldsChangeHoldDetails : (data_governance) Edited details for a hold
+	LegalHoldsChangeHoldDetails *LegalHoldsChangeHoldDetailsType `json:"legal_holds_change_hold_details,omitempty"`
+	// LegalHoldsChangeHoldName : (data_governance) Renamed a hold
+	LegalHoldsChangeHoldName *LegalHoldsChangeHoldNameType `json:"legal_holds_change_hold_name,omitempty"`
+	// LegalHoldsExportAHold : (data_governance) Exported hold
+	LegalHoldsExportAHold *LegalHoldsExportAHoldType `json:"legal_holds_export_a_hold,omitempty"`
+	// LegalHoldsExportCancelled : (data_governance) Canceled export for a hold
+	LegalHoldsExportCancelled *LegalHoldsExportCancelledType `json:"legal_holds_export_cancelled,omitempty"`
+	// LegalHoldsExportDownloaded : (data_governance) Downloaded export for a
+	// hold
+	LegalHoldsExportDownloaded *LegalHoldsExportDownloadedType `json:"legal_holds_export_downloaded,omitempty"`
+	// LegalHoldsExportRemoved : (data_governance) Removed export for a hold
+	LegalHoldsExportRemoved *LegalHoldsExportRemovedType `json:"legal_holds_export_removed,omitempty"`
+	// LegalHoldsReleaseAHold : (data_governance) Released a hold
+	LegalHoldsReleaseAHold *LegalHoldsReleaseAHoldType `json:"legal_holds_release_a_hold,omitempty"`
+	// LegalHoldsRemoveMembers : (data_governance) Removed members from a hold
+	LegalHoldsRemoveMembers *LegalHoldsRemoveMembersType `json:"legal_holds_remove_members,omitempty"`
+	// LegalHoldsReportAHold : (data_governance) Created a summary report for a
+	// hold
+	LegalHoldsReportAHold *LegalHoldsReportAHoldType `json:"legal_holds_report_a_hold,omitempty"`
+	// DeviceChangeIpDesktop : (devices) Changed IP address associated with
+	// active desktop session
+	DeviceChangeIpDesktop *DeviceChangeIpDesktopType `json:"device_change_ip_desktop,omitempty"`
+	// DeviceChangeIpMobile : (devices) Changed IP address associated with
+	// active mobile session
+	DeviceChangeIpMobile *DeviceChangeIpMobileType `json:"device_change_ip_mobile,omitempty"`
+	// DeviceChangeIpWeb : (devices) Changed IP address associated with active
+	// web session
+	DeviceChangeIpWeb *DeviceChangeIpWebType `json:"device_change_ip_web,omitempty"`
+	// DeviceDeleteOnUnlinkFail : (devices) Failed to delete all files from
+	// unlinked device
+	DeviceDeleteOnUnlinkFail *DeviceDeleteOnUnlinkFailType `json:"device_delete_on_unlink_fail,omitempty"`
+	// DeviceDeleteOnUnlinkSuccess : (devices) Deleted all files from unlinked
+	// device
+	DeviceDeleteOnUnlinkSuccess *DeviceDeleteOnUnlinkSuccessType `json:"device_delete_on_unlink_success,omitempty"`
+	// DeviceLinkFail : (devices) Failed to link device
+	DeviceLinkFail *DeviceLinkFailType `json:"device_link_fail,omitempty"`
+	// DeviceLinkSuccess : (devices) Linked device
+	DeviceLinkSuccess *DeviceLinkSuccessType `json:"device_link_success,omitempty"`
+	// DeviceManagementDisabled : (devices) Disabled device management
+	// (deprecated, no longer logged)
+	DeviceManagementDisabled *DeviceManagementDisabledType `json:"device_management_disabled,omitempty"`
+	// DeviceManagementEnabled : (devices) Enabled device management
+	// (deprecated, no longer logged)
+	DeviceManagementEnabled *DeviceManagementEnabledType `json:"device_management_enabled,omitempty"`
+	// DeviceSyncBackupStatusChanged : (devices) Enabled/disabled backup for
+	// computer
+	DeviceSyncBackupStatusChanged *DeviceSyncBackupStatusChangedType `json:"device_sync_backup_status_changed,omitempty"`
+	// DeviceUnlink : (devices) Disconnected device
+	DeviceUnlink *DeviceUnlinkType `json:"device_unlink,omitempty"`
+	// DropboxPasswordsExported : (devices) Exported passwords
+	DropboxPasswordsExported *DropboxPasswordsExportedType `json:"dropbox_passwords_exported,omitempty"`
+	// DropboxPasswordsNewDeviceEnrolled : (devices) Enrolled new Dropbox
+	// Passwords device
+	DropboxPasswordsNewDeviceEnrolled *DropboxPasswordsNewDeviceEnrolledType `json:"dropbox_passwords_new_device_enrolled,omitempty"`
+	// EmmRefreshAuthToken : (devices) Refreshed auth token used for setting up
+	// EMM
+	EmmRefreshAuthToken *EmmRefreshAuthTokenType `json:"emm_refresh_auth_token,omitempty"`
+	// ExternalDriveBackupEligibilityStatusChecked : (devices) Checked external
+	// drive backup eligibility status
+	ExternalDriveBackupEligibilityStatusChecked *ExternalDriveBackupEligibilityStatusCheckedType `json:"external_drive_backup_eligibility_status_checked,omitempty"`
+	// ExternalDriveBackupStatusChanged : (devices) Modified external drive
+	// backup
+	ExternalDriveBackupStatusChanged *ExternalDriveBackupStatusChangedType `json:"external_drive_backup_status_changed,omitempty"`
+	// AccountCaptureChangeAvailability : (domains) Granted/revoked option to
+	// enable account capture on team domains
+	AccountCaptureChangeAvailability *AccountCaptureChangeAvailabilityType `json:"account_capture_change_availability,omitempty"`
+	// AccountCaptureMigrateAccount : (domains) Account-captured user migrated
+	// account to team
+	AccountCaptureMigrateAccount *AccountCaptureMigrateAccountType `json:"account_capture_migrate_account,omitempty"`
+	// AccountCaptureNotificationEmailsSent : (domains) Sent account capture
+	// email to all unmanaged members
+	AccountCaptureNotificationEmailsSent *AccountCaptureNotificationEmailsSentType `json:"account_capture_notification_emails_sent,omitempty"`
+	// AccountCaptureRelinquishAccount : (domains) Account-captured user changed
+	// account email to personal email
+	AccountCaptureRelinquishAccount *AccountCaptureRelinquishAccountType `json:"account_capture_relinquish_account,omitempty"`
+	// DisabledDomainInvites : (domains) Disabled domain invites (deprecated, no
+	// longer logged)
+	DisabledDomainInvites *DisabledDomainInvitesType `json:"disabled_domain_invites,omitempty"`
+	// DomainInvitesApproveRequestToJoinTeam : (domains) Approved user's request
+	// to join team
+	DomainInvitesApproveRequestToJoinTeam *DomainInvitesApproveRequestToJoinTeamType `json:"domain_invites_approve_request_to_join_team,omitempty"`
+	// DomainInvitesDeclineRequestToJoinTeam : (domains) Declined user's request
+	// to join team
+	DomainInvitesDeclineRequestToJoinTeam *DomainInvitesDeclineRequestToJoinTeamType `json:"domain_invites_decline_request_to_join_team,omitempty"`
+	// DomainInvitesEmailExistingUsers : (domains) Sent domain invites to
+	// existing domain accounts (deprecated, no longer logged)
+	DomainInvitesEmailExistingUsers *DomainInvitesEmailExistingUsersType `json:"domain_invites_email_existing_users,omitempty"`
+	// DomainInvitesRequestToJoinTeam : (domains) Requested to join team
+	DomainInvitesRequestToJoinTeam *DomainInvitesRequestToJoinTeamType `json:"domain_invites_request_to_join_team,omitempty"`
+	// DomainInvitesSetInviteNewUserPrefToNo : (domains) Disabled "Automatically
+	// invite new users" (deprecated, no longer logged)
+	DomainInvitesSetInviteNewUserPrefToNo *DomainInvitesSetInviteNewUserPrefToNoType `json:"domain_invites_set_invite_new_user_pref_to_no,omitempty"`
+	// DomainInvitesSetInviteNewUserPrefToYes : (domains) Enabled "Automatically
+	// invite new users" (deprecated, no longer logged)
+	DomainInvitesSetInviteNewUserPrefToYes *DomainInvitesSetInviteNewUserPrefToYesType `json:"domain_invites_set_invite_new_user_pref_to_yes,omitempty"`
+	// DomainVerificationAddDomainFail : (domains) Failed to verify team domain
+	DomainVerificationAddDomainFail *DomainVerificationAddDomainFailType `json:"domain_verification_add_domain_fail,omitempty"`
+	// DomainVerificationAddDomainSuccess : (domains) Verified team domain
+	DomainVerificationAddDomainSuccess *DomainVerificationAddDomainSuccessType `json:"domain_verification_add_domain_success,omitempty"`
+	// DomainVerificationRemoveDomain : (domains) Removed domain from list of
+	// verified team domains
+	DomainVerificationRemoveDomain *DomainVerificationRemoveDomainType `json:"domain_verification_remove_domain,omitempty"`
+	// EnabledDomainInvites : (domains) Enabled domain invites (deprecated, no
+	// longer logged)
+	EnabledDomainInvites *EnabledDomainInvitesType `json:"enabled_domain_invites,omitempty"`
+	// ApplyNamingConvention : (file_operations) Applied naming convention
+	ApplyNamingConvention *ApplyNamingConventionType `json:"apply_naming_convention,omitempty"`
+	// CreateFolder : (file_operations) Created folders (deprecated, no longer
+	// logged)
+	CreateFolder *CreateFolderType `json:"create_folder,omitempty"`
+	// FileAdd : (file_operations) Added files and/or folders
+	FileAdd *FileAddType `json:"file_add,omitempty"`
+	// FileCopy : (file_operations) Copied files and/or folders
+	FileCopy *FileCopyType `json:"file_copy,omitempty"`
+	// FileDelete : (file_operations) Deleted files and/or folders
+	FileDelete *FileDeleteType `json:"file_delete,omitempty"`
+	// FileDownload : (file_operations) Downloaded files and/or folders
+	FileDownload *FileDownloadType `json:"file_download,omitempty"`
+	// FileEdit : (file_operations) Edited files
+	FileEdit *FileEditType `json:"file_edit,omitempty"`
+	// FileGetCopyReference : (file_operations) Created copy reference to
+	// file/folder
+	FileGetCopyReference *FileGetCopyReferenceType `json:"file_get_copy_reference,omitempty"`
+	// FileLockingLockStatusChanged : (file_operations) Locked/unlocked editing
+	// for a file
+	FileLockingLockStatusChanged *FileLockingLockStatusChangedType `json:"file_locking_lock_status_changed,omitempty"`
+	// FileMove : (file_operations) Moved files and/or folders
+	FileMove *FileMoveType `json:"file_move,omitempty"`
+	// FilePermanentlyDelete : (file_operations) Permanently deleted files
+	// and/or folders
 	FilePermanentlyDelete *FilePermanentlyDeleteType `json:"file_permanently_delete,omitempty"`
 	// FilePreview : (file_operations) Previewed files and/or folders
 	FilePreview *FilePreviewType `json:"file_preview,omitempty"`
@@ -9596,2435 +12687,5340 @@ const (
 	EventTypeOther                                        = "other"
 )
 
-// UnmarshalJSON deserializes into a EventType instance
-func (u *EventType) UnmarshalJSON(body []byte) error {
-	type wrap struct {
-		dropbox.Tagged
-	}
-	var w wrap
-	var err error
-	if err = json.Unmarshal(body, &w); err != nil {
-		return err
-	}
-	u.Tag = w.Tag
-	switch u.Tag {
-	case "admin_alerting_alert_state_changed":
-		if err = json.Unmarshal(body, &u.AdminAlertingAlertStateChanged); err != nil {
+// UnmarshalJSON deserializes into a EventType instance
+func (u *EventType) UnmarshalJSON(body []byte) error {
+	type wrap struct {
+		dropbox.Tagged
+	}
+	var w wrap
+	var err error
+	if err = json.Unmarshal(body, &w); err != nil {
+		return err
+	}
+	u.Tag = w.Tag
+	switch u.Tag {
+	case "admin_alerting_alert_state_changed":
+		if err = json.Unmarshal(body, &u.AdminAlertingAlertStateChanged); err != nil {
+			return err
+		}
+
+	case "admin_alerting_changed_alert_config":
+		if err = json.Unmarshal(body, &u.AdminAlertingChangedAlertConfig); err != nil {
+			return err
+		}
+
+	case "admin_alerting_triggered_alert":
+		if err = json.Unmarshal(body, &u.AdminAlertingTriggeredAlert); err != nil {
+			return err
+		}
+
+	case "app_blocked_by_permissions":
+		if err = json.Unmarshal(body, &u.AppBlockedByPermissions); err != nil {
+			return err
+		}
+
+	case "app_link_team":
+		if err = json.Unmarshal(body, &u.AppLinkTeam); err != nil {
+			return err
+		}
+
+	case "app_link_user":
+		if err = json.Unmarshal(body, &u.AppLinkUser); err != nil {
+			return err
+		}
+
+	case "app_unlink_team":
+		if err = json.Unmarshal(body, &u.AppUnlinkTeam); err != nil {
+			return err
+		}
+
+	case "app_unlink_user":
+		if err = json.Unmarshal(body, &u.AppUnlinkUser); err != nil {
+			return err
+		}
+
+	case "integration_connected":
+		if err = json.Unmarshal(body, &u.IntegrationConnected); err != nil {
+			return err
+		}
+
+	case "integration_disconnected":
+		if err = json.Unmarshal(body, &u.IntegrationDisconnected); err != nil {
+			return err
+		}
+
+	case "file_add_comment":
+		if err = json.Unmarshal(body, &u.FileAddComment); err != nil {
+			return err
+		}
+
+	case "file_change_comment_subscription":
+		if err = json.Unmarshal(body, &u.FileChangeCommentSubscription); err != nil {
+			return err
+		}
+
+	case "file_delete_comment":
+		if err = json.Unmarshal(body, &u.FileDeleteComment); err != nil {
+			return err
+		}
+
+	case "file_edit_comment":
+		if err = json.Unmarshal(body, &u.FileEditComment); err != nil {
+			return err
+		}
+
+	case "file_like_comment":
+		if err = json.Unmarshal(body, &u.FileLikeComment); err != nil {
+			return err
+		}
+
+	case "file_resolve_comment":
+		if err = json.Unmarshal(body, &u.FileResolveComment); err != nil {
+			return err
+		}
+
+	case "file_unlike_comment":
+		if err = json.Unmarshal(body, &u.FileUnlikeComment); err != nil {
+			return err
+		}
+
+	case "file_unresolve_comment":
+		if err = json.Unmarshal(body, &u.FileUnresolveComment); err != nil {
+			return err
+		}
+
+	case "governance_policy_add_folders":
+		if err = json.Unmarshal(body, &u.GovernancePolicyAddFolders); err != nil {
+			return err
+		}
+
+	case "governance_policy_add_folder_failed":
+		if err = json.Unmarshal(body, &u.GovernancePolicyAddFolderFailed); err != nil {
+			return err
+		}
+
+	case "governance_policy_content_disposed":
+		if err = json.Unmarshal(body, &u.GovernancePolicyContentDisposed); err != nil {
+			return err
+		}
+
+	case "governance_policy_create":
+		if err = json.Unmarshal(body, &u.GovernancePolicyCreate); err != nil {
+			return err
+		}
+
+	case "governance_policy_delete":
+		if err = json.Unmarshal(body, &u.GovernancePolicyDelete); err != nil {
+			return err
+		}
+
+	case "governance_policy_edit_details":
+		if err = json.Unmarshal(body, &u.GovernancePolicyEditDetails); err != nil {
+			return err
+		}
+
+	case "governance_policy_edit_duration":
+		if err = json.Unmarshal(body, &u.GovernancePolicyEditDuration); err != nil {
+			return err
+		}
+
+	case "governance_policy_export_created":
+		if err = json.Unmarshal(body, &u.GovernancePolicyExportCreated); err != nil {
+			return err
+		}
+
+	case "governance_policy_export_removed":
+		if err = json.Unmarshal(body, &u.GovernancePolicyExportRemoved); err != nil {
+			return err
+		}
+
+	case "governance_policy_remove_folders":
+		if err = json.Unmarshal(body, &u.GovernancePolicyRemoveFolders); err != nil {
+			return err
+		}
+
+	case "governance_policy_report_created":
+		if err = json.Unmarshal(body, &u.GovernancePolicyReportCreated); err != nil {
+			return err
+		}
+
+	case "governance_policy_zip_part_downloaded":
+		if err = json.Unmarshal(body, &u.GovernancePolicyZipPartDownloaded); err != nil {
+			return err
+		}
+
+	case "legal_holds_activate_a_hold":
+		if err = json.Unmarshal(body, &u.LegalHoldsActivateAHold); err != nil {
+			return err
+		}
+
+	case "legal_holds_add_members":
+		if err = json.Unmarshal(body, &u.LegalHoldsAddMembers); err != nil {
+			return err
+		}
+
+	case "legal_holds_change_hold_details":
+		if err = json.Unmarshal(body, &u.LegalHoldsChangeHoldDetails); err != nil {
+			return err
+		}
+
+	case "legal_holds_change_hold_name":
+		if err = json.Unmarshal(body, &u.LegalHoldsChangeHoldName); err != nil {
+			return err
+		}
+
+	case "legal_holds_export_a_hold":
+		if err = json.Unmarshal(body, &u.LegalHoldsExportAHold); err != nil {
+			return err
+		}
+
+	case "legal_holds_export_cancelled":
+		if err = json.Unmarshal(body, &u.LegalHoldsExportCancelled); err != nil {
+			return err
+		}
+
+	case "legal_holds_export_downloaded":
+		if err = json.Unmarshal(body, &u.LegalHoldsExportDownloaded); err != nil {
+			return err
+		}
+
+	case "legal_holds_export_removed":
+		if err = json.Unmarshal(body, &u.LegalHoldsExportRemoved); err != nil {
+			return err
+		}
+
+	case "legal_holds_release_a_hold":
+		if err = json.Unmarshal(body, &u.LegalHoldsReleaseAHold); err != nil {
+			return err
+		}
+
+	case "legal_holds_remove_members":
+		if err = json.Unmarshal(body, &u.LegalHoldsRemoveMembers); err != nil {
+			return err
+		}
+
+	case "legal_holds_report_a_hold":
+		if err = json.Unmarshal(body, &u.LegalHoldsReportAHold); err != nil {
+			return err
+		}
+
+	case "device_change_ip_desktop":
+		if err = json.Unmarshal(body, &u.DeviceChangeIpDesktop); err != nil {
+			return err
+		}
+
+	case "device_change_ip_mobile":
+		if err = json.Unmarshal(body, &u.DeviceChangeIpMobile); err != nil {
+			return err
+		}
+
+	case "device_change_ip_web":
+		if err = json.Unmarshal(body, &u.DeviceChangeIpWeb); err != nil {
+			return err
+		}
+
+	case "device_delete_on_unlink_fail":
+		if err = json.Unmarshal(body, &u.DeviceDeleteOnUnlinkFail); err != nil {
+			return err
+		}
+
+	case "device_delete_on_unlink_success":
+		if err = json.Unmarshal(body, &u.DeviceDeleteOnUnlinkSuccess); err != nil {
+			return err
+		}
+
+	case "device_link_fail":
+		if err = json.Unmarshal(body, &u.DeviceLinkFail); err != nil {
+			return err
+		}
+
+	case "device_link_success":
+		if err = json.Unmarshal(body, &u.DeviceLinkSuccess); err != nil {
+			return err
+		}
+
+	case "device_management_disabled":
+		if err = json.Unmarshal(body, &u.DeviceManagementDisabled); err != nil {
+			return err
+		}
+
+	case "device_management_enabled":
+		if err = json.Unmarshal(body, &u.DeviceManagementEnabled); err != nil {
+			return err
+		}
+
+	case "device_sync_backup_status_changed":
+		if err = json.Unmarshal(body, &u.DeviceSyncBackupStatusChanged); err != nil {
+			return err
+		}
+
+	case "device_unlink":
+		if err = json.Unmarshal(body, &u.DeviceUnlink); err != nil {
+			return err
+		}
+
+	case "dropbox_passwords_exported":
+		if err = json.Unmarshal(body, &u.DropboxPasswordsExported); err != nil {
+			return err
+		}
+
+	case "dropbox_passwords_new_device_enrolled":
+		if err = json.Unmarshal(body, &u.DropboxPasswordsNewDeviceEnrolled); err != nil {
+			return err
+		}
+
+	case "emm_refresh_auth_token":
+		if err = json.Unmarshal(body, &u.EmmRefreshAuthToken); err != nil {
+			return err
+		}
+
+	case "external_drive_backup_eligibility_status_checked":
+		if err = json.Unmarshal(body, &u.ExternalDriveBackupEligibilityStatusChecked); err != nil {
+			return err
+		}
+
+	case "external_drive_backup_status_changed":
+		if err = json.Unmarshal(body, &u.ExternalDriveBackupStatusChanged); err != nil {
+			return err
+		}
+
+	case "account_capture_change_availability":
+		if err = json.Unmarshal(body, &u.AccountCaptureChangeAvailability); err != nil {
+			return err
+		}
+
+	case "account_capture_migrate_account":
+		if err = json.Unmarshal(body, &u.AccountCaptureMigrateAccount); err != nil {
+			return err
+		}
+
+	case "account_capture_notification_emails_sent":
+		if err = json.Unmarshal(body, &u.AccountCaptureNotificationEmailsSent); err != nil {
+			return err
+		}
+
+	case "account_capture_relinquish_account":
+		if err = json.Unmarshal(body, &u.AccountCaptureRelinquishAccount); err != nil {
+			return err
+		}
+
+	case "disabled_domain_invites":
+		if err = json.Unmarshal(body, &u.DisabledDomainInvites); err != nil {
+			return err
+		}
+
+	case "domain_invites_approve_request_to_join_team":
+		if err = json.Unmarshal(body, &u.DomainInvitesApproveRequestToJoinTeam); err != nil {
+			return err
+		}
+
+	case "domain_invites_decline_request_to_join_team":
+		if err = json.Unmarshal(body, &u.DomainInvitesDeclineRequestToJoinTeam); err != nil {
+			return err
+		}
+
+	case "domain_invites_email_existing_users":
+		if err = json.Unmarshal(body, &u.DomainInvitesEmailExistingUsers); err != nil {
+			return err
+		}
+
+	case "domain_invites_request_to_join_team":
+		if err = json.Unmarshal(body, &u.DomainInvitesRequestToJoinTeam); err != nil {
+			return err
+		}
+
+	case "domain_invites_set_invite_new_user_pref_to_no":
+		if err = json.Unmarshal(body, &u.DomainInvitesSetInviteNewUserPrefToNo); err != nil {
+			return err
+		}
+
+	case "domain_invites_set_invite_new_user_pref_to_yes":
+		if err = json.Unmarshal(body, &u.DomainInvitesSetInviteNewUserPrefToYes); err != nil {
+			return err
+		}
+
+	case "domain_verification_add_domain_fail":
+		if err = json.Unmarshal(body, &u.DomainVerificationAddDomainFail); err != nil {
+			return err
+		}
+
+	case "domain_verification_add_domain_success":
+		if err = json.Unmarshal(body, &u.DomainVerificationAddDomainSuccess); err != nil {
+			return err
+		}
+
+	case "domain_verification_remove_domain":
+		if err = json.Unmarshal(body, &u.DomainVerificationRemoveDomain); err != nil {
+			return err
+		}
+
+	case "enabled_domain_invites":
+		if err = json.Unmarshal(body, &u.EnabledDomainInvites); err != nil {
+			return err
+		}
+
+	case "apply_naming_convention":
+		if err = json.Unmarshal(body, &u.ApplyNamingConvention); err != nil {
+			return err
+		}
+
+	case "create_folder":
+		if err = json.Unmarshal(body, &u.CreateFolder); err != nil {
+			return err
+		}
+
+	case "file_add":
+		if err = json.Unmarshal(body, &u.FileAdd); err != nil {
+			return err
+		}
+
+	case "file_copy":
+		if err = json.Unmarshal(body, &u.FileCopy); err != nil {
+			return err
+		}
+
+	case "file_delete":
+		if err = json.Unmarshal(body, &u.FileDelete); err != nil {
+			return err
+		}
+
+	case "file_download":
+		if err = json.Unmarshal(body, &u.FileDownload); err != nil {
+			return err
+		}
+
+	case "file_edit":
+		if err = json.Unmarshal(body, &u.FileEdit); err != nil {
+			return err
+		}
+
+	case "file_get_copy_reference":
+		if err = json.Unmarshal(body, &u.FileGetCopyReference); err != nil {
+			return err
+		}
+
+	case "file_locking_lock_status_changed":
+		if err = json.Unmarshal(body, &u.FileLockingLockStatusChanged); err != nil {
+			return err
+		}
+
+	case "file_move":
+		if err = json.Unmarshal(body, &u.FileMove); err != nil {
+			return err
+		}
+
+	case "file_permanently_delete":
+		if err = json.Unmarshal(body, &u.FilePermanentlyDelete); err != nil {
+			return err
+		}
+
+	case "file_preview":
+		if err = json.Unmarshal(body, &u.FilePreview); err != nil {
+			return err
+		}
+
+	case "file_rename":
+		if err = json.Unmarshal(body, &u.FileRename); err != nil {
+			return err
+		}
+
+	case "file_restore":
+		if err = json.Unmarshal(body, &u.FileRestore); err != nil {
+			return err
+		}
+
+	case "file_revert":
+		if err = json.Unmarshal(body, &u.FileRevert); err != nil {
+			return err
+		}
+
+	case "file_rollback_changes":
+		if err = json.Unmarshal(body, &u.FileRollbackChanges); err != nil {
+			return err
+		}
+
+	case "file_save_copy_reference":
+		if err = json.Unmarshal(body, &u.FileSaveCopyReference); err != nil {
+			return err
+		}
+
+	case "folder_overview_description_changed":
+		if err = json.Unmarshal(body, &u.FolderOverviewDescriptionChanged); err != nil {
+			return err
+		}
+
+	case "folder_overview_item_pinned":
+		if err = json.Unmarshal(body, &u.FolderOverviewItemPinned); err != nil {
+			return err
+		}
+
+	case "folder_overview_item_unpinned":
+		if err = json.Unmarshal(body, &u.FolderOverviewItemUnpinned); err != nil {
+			return err
+		}
+
+	case "object_label_added":
+		if err = json.Unmarshal(body, &u.ObjectLabelAdded); err != nil {
+			return err
+		}
+
+	case "object_label_removed":
+		if err = json.Unmarshal(body, &u.ObjectLabelRemoved); err != nil {
+			return err
+		}
+
+	case "object_label_updated_value":
+		if err = json.Unmarshal(body, &u.ObjectLabelUpdatedValue); err != nil {
+			return err
+		}
+
+	case "organize_folder_with_tidy":
+		if err = json.Unmarshal(body, &u.OrganizeFolderWithTidy); err != nil {
+			return err
+		}
+
+	case "rewind_folder":
+		if err = json.Unmarshal(body, &u.RewindFolder); err != nil {
+			return err
+		}
+
+	case "undo_naming_convention":
+		if err = json.Unmarshal(body, &u.UndoNamingConvention); err != nil {
+			return err
+		}
+
+	case "undo_organize_folder_with_tidy":
+		if err = json.Unmarshal(body, &u.UndoOrganizeFolderWithTidy); err != nil {
+			return err
+		}
+
+	case "user_tags_added":
+		if err = json.Unmarshal(body, &u.UserTagsAdded); err != nil {
+			return err
+		}
+
+	case "user_tags_removed":
+		if err = json.Unmarshal(body, &u.UserTagsRemoved); err != nil {
+			return err
+		}
+
+	case "email_ingest_receive_file":
+		if err = json.Unmarshal(body, &u.EmailIngestReceiveFile); err != nil {
+			return err
+		}
+
+	case "file_request_change":
+		if err = json.Unmarshal(body, &u.FileRequestChange); err != nil {
+			return err
+		}
+
+	case "file_request_close":
+		if err = json.Unmarshal(body, &u.FileRequestClose); err != nil {
+			return err
+		}
+
+	case "file_request_create":
+		if err = json.Unmarshal(body, &u.FileRequestCreate); err != nil {
+			return err
+		}
+
+	case "file_request_delete":
+		if err = json.Unmarshal(body, &u.FileRequestDelete); err != nil {
+			return err
+		}
+
+	case "file_request_receive_file":
+		if err = json.Unmarshal(body, &u.FileRequestReceiveFile); err != nil {
+			return err
+		}
+
+	case "group_add_external_id":
+		if err = json.Unmarshal(body, &u.GroupAddExternalId); err != nil {
+			return err
+		}
+
+	case "group_add_member":
+		if err = json.Unmarshal(body, &u.GroupAddMember); err != nil {
+			return err
+		}
+
+	case "group_change_external_id":
+		if err = json.Unmarshal(body, &u.GroupChangeExternalId); err != nil {
+			return err
+		}
+
+	case "group_change_management_type":
+		if err = json.Unmarshal(body, &u.GroupChangeManagementType); err != nil {
+			return err
+		}
+
+	case "group_change_member_role":
+		if err = json.Unmarshal(body, &u.GroupChangeMemberRole); err != nil {
+			return err
+		}
+
+	case "group_create":
+		if err = json.Unmarshal(body, &u.GroupCreate); err != nil {
+			return err
+		}
+
+	case "group_delete":
+		if err = json.Unmarshal(body, &u.GroupDelete); err != nil {
+			return err
+		}
+
+	case "group_description_updated":
+		if err = json.Unmarshal(body, &u.GroupDescriptionUpdated); err != nil {
+			return err
+		}
+
+	case "group_join_policy_updated":
+		if err = json.Unmarshal(body, &u.GroupJoinPolicyUpdated); err != nil {
+			return err
+		}
+
+	case "group_moved":
+		if err = json.Unmarshal(body, &u.GroupMoved); err != nil {
+			return err
+		}
+
+	case "group_remove_external_id":
+		if err = json.Unmarshal(body, &u.GroupRemoveExternalId); err != nil {
+			return err
+		}
+
+	case "group_remove_member":
+		if err = json.Unmarshal(body, &u.GroupRemoveMember); err != nil {
+			return err
+		}
+
+	case "group_rename":
+		if err = json.Unmarshal(body, &u.GroupRename); err != nil {
+			return err
+		}
+
+	case "account_lock_or_unlocked":
+		if err = json.Unmarshal(body, &u.AccountLockOrUnlocked); err != nil {
+			return err
+		}
+
+	case "emm_error":
+		if err = json.Unmarshal(body, &u.EmmError); err != nil {
+			return err
+		}
+
+	case "guest_admin_signed_in_via_trusted_teams":
+		if err = json.Unmarshal(body, &u.GuestAdminSignedInViaTrustedTeams); err != nil {
+			return err
+		}
+
+	case "guest_admin_signed_out_via_trusted_teams":
+		if err = json.Unmarshal(body, &u.GuestAdminSignedOutViaTrustedTeams); err != nil {
+			return err
+		}
+
+	case "login_fail":
+		if err = json.Unmarshal(body, &u.LoginFail); err != nil {
+			return err
+		}
+
+	case "login_success":
+		if err = json.Unmarshal(body, &u.LoginSuccess); err != nil {
+			return err
+		}
+
+	case "logout":
+		if err = json.Unmarshal(body, &u.Logout); err != nil {
+			return err
+		}
+
+	case "reseller_support_session_end":
+		if err = json.Unmarshal(body, &u.ResellerSupportSessionEnd); err != nil {
+			return err
+		}
+
+	case "reseller_support_session_start":
+		if err = json.Unmarshal(body, &u.ResellerSupportSessionStart); err != nil {
+			return err
+		}
+
+	case "sign_in_as_session_end":
+		if err = json.Unmarshal(body, &u.SignInAsSessionEnd); err != nil {
+			return err
+		}
+
+	case "sign_in_as_session_start":
+		if err = json.Unmarshal(body, &u.SignInAsSessionStart); err != nil {
+			return err
+		}
+
+	case "sso_error":
+		if err = json.Unmarshal(body, &u.SsoError); err != nil {
+			return err
+		}
+
+	case "create_team_invite_link":
+		if err = json.Unmarshal(body, &u.CreateTeamInviteLink); err != nil {
+			return err
+		}
+
+	case "delete_team_invite_link":
+		if err = json.Unmarshal(body, &u.DeleteTeamInviteLink); err != nil {
+			return err
+		}
+
+	case "member_add_external_id":
+		if err = json.Unmarshal(body, &u.MemberAddExternalId); err != nil {
+			return err
+		}
+
+	case "member_add_name":
+		if err = json.Unmarshal(body, &u.MemberAddName); err != nil {
+			return err
+		}
+
+	case "member_change_admin_role":
+		if err = json.Unmarshal(body, &u.MemberChangeAdminRole); err != nil {
+			return err
+		}
+
+	case "member_change_email":
+		if err = json.Unmarshal(body, &u.MemberChangeEmail); err != nil {
+			return err
+		}
+
+	case "member_change_external_id":
+		if err = json.Unmarshal(body, &u.MemberChangeExternalId); err != nil {
+			return err
+		}
+
+	case "member_change_membership_type":
+		if err = json.Unmarshal(body, &u.MemberChangeMembershipType); err != nil {
+			return err
+		}
+
+	case "member_change_name":
+		if err = json.Unmarshal(body, &u.MemberChangeName); err != nil {
+			return err
+		}
+
+	case "member_change_reseller_role":
+		if err = json.Unmarshal(body, &u.MemberChangeResellerRole); err != nil {
+			return err
+		}
+
+	case "member_change_status":
+		if err = json.Unmarshal(body, &u.MemberChangeStatus); err != nil {
+			return err
+		}
+
+	case "member_delete_manual_contacts":
+		if err = json.Unmarshal(body, &u.MemberDeleteManualContacts); err != nil {
+			return err
+		}
+
+	case "member_delete_profile_photo":
+		if err = json.Unmarshal(body, &u.MemberDeleteProfilePhoto); err != nil {
+			return err
+		}
+
+	case "member_permanently_delete_account_contents":
+		if err = json.Unmarshal(body, &u.MemberPermanentlyDeleteAccountContents); err != nil {
+			return err
+		}
+
+	case "member_remove_external_id":
+		if err = json.Unmarshal(body, &u.MemberRemoveExternalId); err != nil {
+			return err
+		}
+
+	case "member_set_profile_photo":
+		if err = json.Unmarshal(body, &u.MemberSetProfilePhoto); err != nil {
+			return err
+		}
+
+	case "member_space_limits_add_custom_quota":
+		if err = json.Unmarshal(body, &u.MemberSpaceLimitsAddCustomQuota); err != nil {
+			return err
+		}
+
+	case "member_space_limits_change_custom_quota":
+		if err = json.Unmarshal(body, &u.MemberSpaceLimitsChangeCustomQuota); err != nil {
+			return err
+		}
+
+	case "member_space_limits_change_status":
+		if err = json.Unmarshal(body, &u.MemberSpaceLimitsChangeStatus); err != nil {
+			return err
+		}
+
+	case "member_space_limits_remove_custom_quota":
+		if err = json.Unmarshal(body, &u.MemberSpaceLimitsRemoveCustomQuota); err != nil {
+			return err
+		}
+
+	case "member_suggest":
+		if err = json.Unmarshal(body, &u.MemberSuggest); err != nil {
+			return err
+		}
+
+	case "member_transfer_account_contents":
+		if err = json.Unmarshal(body, &u.MemberTransferAccountContents); err != nil {
+			return err
+		}
+
+	case "pending_secondary_email_added":
+		if err = json.Unmarshal(body, &u.PendingSecondaryEmailAdded); err != nil {
+			return err
+		}
+
+	case "secondary_email_deleted":
+		if err = json.Unmarshal(body, &u.SecondaryEmailDeleted); err != nil {
+			return err
+		}
+
+	case "secondary_email_verified":
+		if err = json.Unmarshal(body, &u.SecondaryEmailVerified); err != nil {
+			return err
+		}
+
+	case "secondary_mails_policy_changed":
+		if err = json.Unmarshal(body, &u.SecondaryMailsPolicyChanged); err != nil {
+			return err
+		}
+
+	case "binder_add_page":
+		if err = json.Unmarshal(body, &u.BinderAddPage); err != nil {
+			return err
+		}
+
+	case "binder_add_section":
+		if err = json.Unmarshal(body, &u.BinderAddSection); err != nil {
+			return err
+		}
+
+	case "binder_remove_page":
+		if err = json.Unmarshal(body, &u.BinderRemovePage); err != nil {
+			return err
+		}
+
+	case "binder_remove_section":
+		if err = json.Unmarshal(body, &u.BinderRemoveSection); err != nil {
+			return err
+		}
+
+	case "binder_rename_page":
+		if err = json.Unmarshal(body, &u.BinderRenamePage); err != nil {
+			return err
+		}
+
+	case "binder_rename_section":
+		if err = json.Unmarshal(body, &u.BinderRenameSection); err != nil {
+			return err
+		}
+
+	case "binder_reorder_page":
+		if err = json.Unmarshal(body, &u.BinderReorderPage); err != nil {
+			return err
+		}
+
+	case "binder_reorder_section":
+		if err = json.Unmarshal(body, &u.BinderReorderSection); err != nil {
+			return err
+		}
+
+	case "paper_content_add_member":
+		if err = json.Unmarshal(body, &u.PaperContentAddMember); err != nil {
+			return err
+		}
+
+	case "paper_content_add_to_folder":
+		if err = json.Unmarshal(body, &u.PaperContentAddToFolder); err != nil {
+			return err
+		}
+
+	case "paper_content_archive":
+		if err = json.Unmarshal(body, &u.PaperContentArchive); err != nil {
+			return err
+		}
+
+	case "paper_content_create":
+		if err = json.Unmarshal(body, &u.PaperContentCreate); err != nil {
+			return err
+		}
+
+	case "paper_content_permanently_delete":
+		if err = json.Unmarshal(body, &u.PaperContentPermanentlyDelete); err != nil {
+			return err
+		}
+
+	case "paper_content_remove_from_folder":
+		if err = json.Unmarshal(body, &u.PaperContentRemoveFromFolder); err != nil {
+			return err
+		}
+
+	case "paper_content_remove_member":
+		if err = json.Unmarshal(body, &u.PaperContentRemoveMember); err != nil {
+			return err
+		}
+
+	case "paper_content_rename":
+		if err = json.Unmarshal(body, &u.PaperContentRename); err != nil {
+			return err
+		}
+
+	case "paper_content_restore":
+		if err = json.Unmarshal(body, &u.PaperContentRestore); err != nil {
+			return err
+		}
+
+	case "paper_doc_add_comment":
+		if err = json.Unmarshal(body, &u.PaperDocAddComment); err != nil {
+			return err
+		}
+
+	case "paper_doc_change_member_role":
+		if err = json.Unmarshal(body, &u.PaperDocChangeMemberRole); err != nil {
+			return err
+		}
+
+	case "paper_doc_change_sharing_policy":
+		if err = json.Unmarshal(body, &u.PaperDocChangeSharingPolicy); err != nil {
+			return err
+		}
+
+	case "paper_doc_change_subscription":
+		if err = json.Unmarshal(body, &u.PaperDocChangeSubscription); err != nil {
+			return err
+		}
+
+	case "paper_doc_deleted":
+		if err = json.Unmarshal(body, &u.PaperDocDeleted); err != nil {
+			return err
+		}
+
+	case "paper_doc_delete_comment":
+		if err = json.Unmarshal(body, &u.PaperDocDeleteComment); err != nil {
+			return err
+		}
+
+	case "paper_doc_download":
+		if err = json.Unmarshal(body, &u.PaperDocDownload); err != nil {
+			return err
+		}
+
+	case "paper_doc_edit":
+		if err = json.Unmarshal(body, &u.PaperDocEdit); err != nil {
+			return err
+		}
+
+	case "paper_doc_edit_comment":
+		if err = json.Unmarshal(body, &u.PaperDocEditComment); err != nil {
+			return err
+		}
+
+	case "paper_doc_followed":
+		if err = json.Unmarshal(body, &u.PaperDocFollowed); err != nil {
+			return err
+		}
+
+	case "paper_doc_mention":
+		if err = json.Unmarshal(body, &u.PaperDocMention); err != nil {
+			return err
+		}
+
+	case "paper_doc_ownership_changed":
+		if err = json.Unmarshal(body, &u.PaperDocOwnershipChanged); err != nil {
+			return err
+		}
+
+	case "paper_doc_request_access":
+		if err = json.Unmarshal(body, &u.PaperDocRequestAccess); err != nil {
+			return err
+		}
+
+	case "paper_doc_resolve_comment":
+		if err = json.Unmarshal(body, &u.PaperDocResolveComment); err != nil {
+			return err
+		}
+
+	case "paper_doc_revert":
+		if err = json.Unmarshal(body, &u.PaperDocRevert); err != nil {
+			return err
+		}
+
+	case "paper_doc_slack_share":
+		if err = json.Unmarshal(body, &u.PaperDocSlackShare); err != nil {
+			return err
+		}
+
+	case "paper_doc_team_invite":
+		if err = json.Unmarshal(body, &u.PaperDocTeamInvite); err != nil {
+			return err
+		}
+
+	case "paper_doc_trashed":
+		if err = json.Unmarshal(body, &u.PaperDocTrashed); err != nil {
+			return err
+		}
+
+	case "paper_doc_unresolve_comment":
+		if err = json.Unmarshal(body, &u.PaperDocUnresolveComment); err != nil {
+			return err
+		}
+
+	case "paper_doc_untrashed":
+		if err = json.Unmarshal(body, &u.PaperDocUntrashed); err != nil {
+			return err
+		}
+
+	case "paper_doc_view":
+		if err = json.Unmarshal(body, &u.PaperDocView); err != nil {
+			return err
+		}
+
+	case "paper_external_view_allow":
+		if err = json.Unmarshal(body, &u.PaperExternalViewAllow); err != nil {
+			return err
+		}
+
+	case "paper_external_view_default_team":
+		if err = json.Unmarshal(body, &u.PaperExternalViewDefaultTeam); err != nil {
+			return err
+		}
+
+	case "paper_external_view_forbid":
+		if err = json.Unmarshal(body, &u.PaperExternalViewForbid); err != nil {
+			return err
+		}
+
+	case "paper_folder_change_subscription":
+		if err = json.Unmarshal(body, &u.PaperFolderChangeSubscription); err != nil {
+			return err
+		}
+
+	case "paper_folder_deleted":
+		if err = json.Unmarshal(body, &u.PaperFolderDeleted); err != nil {
+			return err
+		}
+
+	case "paper_folder_followed":
+		if err = json.Unmarshal(body, &u.PaperFolderFollowed); err != nil {
+			return err
+		}
+
+	case "paper_folder_team_invite":
+		if err = json.Unmarshal(body, &u.PaperFolderTeamInvite); err != nil {
+			return err
+		}
+
+	case "paper_published_link_change_permission":
+		if err = json.Unmarshal(body, &u.PaperPublishedLinkChangePermission); err != nil {
+			return err
+		}
+
+	case "paper_published_link_create":
+		if err = json.Unmarshal(body, &u.PaperPublishedLinkCreate); err != nil {
+			return err
+		}
+
+	case "paper_published_link_disabled":
+		if err = json.Unmarshal(body, &u.PaperPublishedLinkDisabled); err != nil {
+			return err
+		}
+
+	case "paper_published_link_view":
+		if err = json.Unmarshal(body, &u.PaperPublishedLinkView); err != nil {
+			return err
+		}
+
+	case "password_change":
+		if err = json.Unmarshal(body, &u.PasswordChange); err != nil {
+			return err
+		}
+
+	case "password_reset":
+		if err = json.Unmarshal(body, &u.PasswordReset); err != nil {
+			return err
+		}
+
+	case "password_reset_all":
+		if err = json.Unmarshal(body, &u.PasswordResetAll); err != nil {
+			return err
+		}
+
+	case "classification_create_report":
+		if err = json.Unmarshal(body, &u.ClassificationCreateReport); err != nil {
+			return err
+		}
+
+	case "classification_create_report_fail":
+		if err = json.Unmarshal(body, &u.ClassificationCreateReportFail); err != nil {
+			return err
+		}
+
+	case "emm_create_exceptions_report":
+		if err = json.Unmarshal(body, &u.EmmCreateExceptionsReport); err != nil {
+			return err
+		}
+
+	case "emm_create_usage_report":
+		if err = json.Unmarshal(body, &u.EmmCreateUsageReport); err != nil {
+			return err
+		}
+
+	case "export_members_report":
+		if err = json.Unmarshal(body, &u.ExportMembersReport); err != nil {
+			return err
+		}
+
+	case "export_members_report_fail":
+		if err = json.Unmarshal(body, &u.ExportMembersReportFail); err != nil {
+			return err
+		}
+
+	case "external_sharing_create_report":
+		if err = json.Unmarshal(body, &u.ExternalSharingCreateReport); err != nil {
+			return err
+		}
+
+	case "external_sharing_report_failed":
+		if err = json.Unmarshal(body, &u.ExternalSharingReportFailed); err != nil {
+			return err
+		}
+
+	case "no_expiration_link_gen_create_report":
+		if err = json.Unmarshal(body, &u.NoExpirationLinkGenCreateReport); err != nil {
+			return err
+		}
+
+	case "no_expiration_link_gen_report_failed":
+		if err = json.Unmarshal(body, &u.NoExpirationLinkGenReportFailed); err != nil {
+			return err
+		}
+
+	case "no_password_link_gen_create_report":
+		if err = json.Unmarshal(body, &u.NoPasswordLinkGenCreateReport); err != nil {
+			return err
+		}
+
+	case "no_password_link_gen_report_failed":
+		if err = json.Unmarshal(body, &u.NoPasswordLinkGenReportFailed); err != nil {
+			return err
+		}
+
+	case "no_password_link_view_create_report":
+		if err = json.Unmarshal(body, &u.NoPasswordLinkViewCreateReport); err != nil {
+			return err
+		}
+
+	case "no_password_link_view_report_failed":
+		if err = json.Unmarshal(body, &u.NoPasswordLinkViewReportFailed); err != nil {
+			return err
+		}
+
+	case "outdated_link_view_create_report":
+		if err = json.Unmarshal(body, &u.OutdatedLinkViewCreateReport); err != nil {
+			return err
+		}
+
+	case "outdated_link_view_report_failed":
+		if err = json.Unmarshal(body, &u.OutdatedLinkViewReportFailed); err != nil {
+			return err
+		}
+
+	case "paper_admin_export_start":
+		if err = json.Unmarshal(body, &u.PaperAdminExportStart); err != nil {
+			return err
+		}
+
+	case "smart_sync_create_admin_privilege_report":
+		if err = json.Unmarshal(body, &u.SmartSyncCreateAdminPrivilegeReport); err != nil {
+			return err
+		}
+
+	case "team_activity_create_report":
+		if err = json.Unmarshal(body, &u.TeamActivityCreateReport); err != nil {
+			return err
+		}
+
+	case "team_activity_create_report_fail":
+		if err = json.Unmarshal(body, &u.TeamActivityCreateReportFail); err != nil {
+			return err
+		}
+
+	case "collection_share":
+		if err = json.Unmarshal(body, &u.CollectionShare); err != nil {
+			return err
+		}
+
+	case "file_transfers_file_add":
+		if err = json.Unmarshal(body, &u.FileTransfersFileAdd); err != nil {
+			return err
+		}
+
+	case "file_transfers_transfer_delete":
+		if err = json.Unmarshal(body, &u.FileTransfersTransferDelete); err != nil {
+			return err
+		}
+
+	case "file_transfers_transfer_download":
+		if err = json.Unmarshal(body, &u.FileTransfersTransferDownload); err != nil {
+			return err
+		}
+
+	case "file_transfers_transfer_send":
+		if err = json.Unmarshal(body, &u.FileTransfersTransferSend); err != nil {
+			return err
+		}
+
+	case "file_transfers_transfer_view":
+		if err = json.Unmarshal(body, &u.FileTransfersTransferView); err != nil {
+			return err
+		}
+
+	case "note_acl_invite_only":
+		if err = json.Unmarshal(body, &u.NoteAclInviteOnly); err != nil {
+			return err
+		}
+
+	case "note_acl_link":
+		if err = json.Unmarshal(body, &u.NoteAclLink); err != nil {
+			return err
+		}
+
+	case "note_acl_team_link":
+		if err = json.Unmarshal(body, &u.NoteAclTeamLink); err != nil {
+			return err
+		}
+
+	case "note_shared":
+		if err = json.Unmarshal(body, &u.NoteShared); err != nil {
+			return err
+		}
+
+	case "note_share_receive":
+		if err = json.Unmarshal(body, &u.NoteShareReceive); err != nil {
+			return err
+		}
+
+	case "open_note_shared":
+		if err = json.Unmarshal(body, &u.OpenNoteShared); err != nil {
+			return err
+		}
+
+	case "sf_add_group":
+		if err = json.Unmarshal(body, &u.SfAddGroup); err != nil {
+			return err
+		}
+
+	case "sf_allow_non_members_to_view_shared_links":
+		if err = json.Unmarshal(body, &u.SfAllowNonMembersToViewSharedLinks); err != nil {
+			return err
+		}
+
+	case "sf_external_invite_warn":
+		if err = json.Unmarshal(body, &u.SfExternalInviteWarn); err != nil {
+			return err
+		}
+
+	case "sf_fb_invite":
+		if err = json.Unmarshal(body, &u.SfFbInvite); err != nil {
+			return err
+		}
+
+	case "sf_fb_invite_change_role":
+		if err = json.Unmarshal(body, &u.SfFbInviteChangeRole); err != nil {
+			return err
+		}
+
+	case "sf_fb_uninvite":
+		if err = json.Unmarshal(body, &u.SfFbUninvite); err != nil {
+			return err
+		}
+
+	case "sf_invite_group":
+		if err = json.Unmarshal(body, &u.SfInviteGroup); err != nil {
+			return err
+		}
+
+	case "sf_team_grant_access":
+		if err = json.Unmarshal(body, &u.SfTeamGrantAccess); err != nil {
+			return err
+		}
+
+	case "sf_team_invite":
+		if err = json.Unmarshal(body, &u.SfTeamInvite); err != nil {
+			return err
+		}
+
+	case "sf_team_invite_change_role":
+		if err = json.Unmarshal(body, &u.SfTeamInviteChangeRole); err != nil {
+			return err
+		}
+
+	case "sf_team_join":
+		if err = json.Unmarshal(body, &u.SfTeamJoin); err != nil {
+			return err
+		}
+
+	case "sf_team_join_from_oob_link":
+		if err = json.Unmarshal(body, &u.SfTeamJoinFromOobLink); err != nil {
+			return err
+		}
+
+	case "sf_team_uninvite":
+		if err = json.Unmarshal(body, &u.SfTeamUninvite); err != nil {
+			return err
+		}
+
+	case "shared_content_add_invitees":
+		if err = json.Unmarshal(body, &u.SharedContentAddInvitees); err != nil {
+			return err
+		}
+
+	case "shared_content_add_link_expiry":
+		if err = json.Unmarshal(body, &u.SharedContentAddLinkExpiry); err != nil {
+			return err
+		}
+
+	case "shared_content_add_link_password":
+		if err = json.Unmarshal(body, &u.SharedContentAddLinkPassword); err != nil {
+			return err
+		}
+
+	case "shared_content_add_member":
+		if err = json.Unmarshal(body, &u.SharedContentAddMember); err != nil {
+			return err
+		}
+
+	case "shared_content_change_downloads_policy":
+		if err = json.Unmarshal(body, &u.SharedContentChangeDownloadsPolicy); err != nil {
+			return err
+		}
+
+	case "shared_content_change_invitee_role":
+		if err = json.Unmarshal(body, &u.SharedContentChangeInviteeRole); err != nil {
+			return err
+		}
+
+	case "shared_content_change_link_audience":
+		if err = json.Unmarshal(body, &u.SharedContentChangeLinkAudience); err != nil {
+			return err
+		}
+
+	case "shared_content_change_link_expiry":
+		if err = json.Unmarshal(body, &u.SharedContentChangeLinkExpiry); err != nil {
+			return err
+		}
+
+	case "shared_content_change_link_password":
+		if err = json.Unmarshal(body, &u.SharedContentChangeLinkPassword); err != nil {
+			return err
+		}
+
+	case "shared_content_change_member_role":
+		if err = json.Unmarshal(body, &u.SharedContentChangeMemberRole); err != nil {
+			return err
+		}
+
+	case "shared_content_change_viewer_info_policy":
+		if err = json.Unmarshal(body, &u.SharedContentChangeViewerInfoPolicy); err != nil {
+			return err
+		}
+
+	case "shared_content_claim_invitation":
+		if err = json.Unmarshal(body, &u.SharedContentClaimInvitation); err != nil {
+			return err
+		}
+
+	case "shared_content_copy":
+		if err = json.Unmarshal(body, &u.SharedContentCopy); err != nil {
+			return err
+		}
+
+	case "shared_content_download":
+		if err = json.Unmarshal(body, &u.SharedContentDownload); err != nil {
+			return err
+		}
+
+	case "shared_content_relinquish_membership":
+		if err = json.Unmarshal(body, &u.SharedContentRelinquishMembership); err != nil {
+			return err
+		}
+
+	case "shared_content_remove_invitees":
+		if err = json.Unmarshal(body, &u.SharedContentRemoveInvitees); err != nil {
+			return err
+		}
+
+	case "shared_content_remove_link_expiry":
+		if err = json.Unmarshal(body, &u.SharedContentRemoveLinkExpiry); err != nil {
+			return err
+		}
+
+	case "shared_content_remove_link_password":
+		if err = json.Unmarshal(body, &u.SharedContentRemoveLinkPassword); err != nil {
+			return err
+		}
+
+	case "shared_content_remove_member":
+		if err = json.Unmarshal(body, &u.SharedContentRemoveMember); err != nil {
+			return err
+		}
+
+	case "shared_content_request_access":
+		if err = json.Unmarshal(body, &u.SharedContentRequestAccess); err != nil {
+			return err
+		}
+
+	case "shared_content_restore_invitees":
+		if err = json.Unmarshal(body, &u.SharedContentRestoreInvitees); err != nil {
+			return err
+		}
+
+	case "shared_content_restore_member":
+		if err = json.Unmarshal(body, &u.SharedContentRestoreMember); err != nil {
+			return err
+		}
+
+	case "shared_content_unshare":
+		if err = json.Unmarshal(body, &u.SharedContentUnshare); err != nil {
+			return err
+		}
+
+	case "shared_content_view":
+		if err = json.Unmarshal(body, &u.SharedContentView); err != nil {
+			return err
+		}
+
+	case "shared_folder_change_link_policy":
+		if err = json.Unmarshal(body, &u.SharedFolderChangeLinkPolicy); err != nil {
+			return err
+		}
+
+	case "shared_folder_change_members_inheritance_policy":
+		if err = json.Unmarshal(body, &u.SharedFolderChangeMembersInheritancePolicy); err != nil {
+			return err
+		}
+
+	case "shared_folder_change_members_management_policy":
+		if err = json.Unmarshal(body, &u.SharedFolderChangeMembersManagementPolicy); err != nil {
+			return err
+		}
+
+	case "shared_folder_change_members_policy":
+		if err = json.Unmarshal(body, &u.SharedFolderChangeMembersPolicy); err != nil {
+			return err
+		}
+
+	case "shared_folder_create":
+		if err = json.Unmarshal(body, &u.SharedFolderCreate); err != nil {
+			return err
+		}
+
+	case "shared_folder_decline_invitation":
+		if err = json.Unmarshal(body, &u.SharedFolderDeclineInvitation); err != nil {
+			return err
+		}
+
+	case "shared_folder_mount":
+		if err = json.Unmarshal(body, &u.SharedFolderMount); err != nil {
+			return err
+		}
+
+	case "shared_folder_nest":
+		if err = json.Unmarshal(body, &u.SharedFolderNest); err != nil {
+			return err
+		}
+
+	case "shared_folder_transfer_ownership":
+		if err = json.Unmarshal(body, &u.SharedFolderTransferOwnership); err != nil {
+			return err
+		}
+
+	case "shared_folder_unmount":
+		if err = json.Unmarshal(body, &u.SharedFolderUnmount); err != nil {
+			return err
+		}
+
+	case "shared_link_add_expiry":
+		if err = json.Unmarshal(body, &u.SharedLinkAddExpiry); err != nil {
+			return err
+		}
+
+	case "shared_link_change_expiry":
+		if err = json.Unmarshal(body, &u.SharedLinkChangeExpiry); err != nil {
+			return err
+		}
+
+	case "shared_link_change_visibility":
+		if err = json.Unmarshal(body, &u.SharedLinkChangeVisibility); err != nil {
+			return err
+		}
+
+	case "shared_link_copy":
+		if err = json.Unmarshal(body, &u.SharedLinkCopy); err != nil {
+			return err
+		}
+
+	case "shared_link_create":
+		if err = json.Unmarshal(body, &u.SharedLinkCreate); err != nil {
+			return err
+		}
+
+	case "shared_link_disable":
+		if err = json.Unmarshal(body, &u.SharedLinkDisable); err != nil {
+			return err
+		}
+
+	case "shared_link_download":
+		if err = json.Unmarshal(body, &u.SharedLinkDownload); err != nil {
+			return err
+		}
+
+	case "shared_link_remove_expiry":
+		if err = json.Unmarshal(body, &u.SharedLinkRemoveExpiry); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_add_expiration":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsAddExpiration); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_add_password":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsAddPassword); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_allow_download_disabled":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsAllowDownloadDisabled); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_allow_download_enabled":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsAllowDownloadEnabled); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_change_audience":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsChangeAudience); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_change_expiration":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsChangeExpiration); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_change_password":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsChangePassword); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_remove_expiration":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsRemoveExpiration); err != nil {
+			return err
+		}
+
+	case "shared_link_settings_remove_password":
+		if err = json.Unmarshal(body, &u.SharedLinkSettingsRemovePassword); err != nil {
+			return err
+		}
+
+	case "shared_link_share":
+		if err = json.Unmarshal(body, &u.SharedLinkShare); err != nil {
+			return err
+		}
+
+	case "shared_link_view":
+		if err = json.Unmarshal(body, &u.SharedLinkView); err != nil {
+			return err
+		}
+
+	case "shared_note_opened":
+		if err = json.Unmarshal(body, &u.SharedNoteOpened); err != nil {
+			return err
+		}
+
+	case "shmodel_disable_downloads":
+		if err = json.Unmarshal(body, &u.ShmodelDisableDownloads); err != nil {
+			return err
+		}
+
+	case "shmodel_enable_downloads":
+		if err = json.Unmarshal(body, &u.ShmodelEnableDownloads); err != nil {
+			return err
+		}
+
+	case "shmodel_group_share":
+		if err = json.Unmarshal(body, &u.ShmodelGroupShare); err != nil {
+			return err
+		}
+
+	case "showcase_access_granted":
+		if err = json.Unmarshal(body, &u.ShowcaseAccessGranted); err != nil {
+			return err
+		}
+
+	case "showcase_add_member":
+		if err = json.Unmarshal(body, &u.ShowcaseAddMember); err != nil {
+			return err
+		}
+
+	case "showcase_archived":
+		if err = json.Unmarshal(body, &u.ShowcaseArchived); err != nil {
+			return err
+		}
+
+	case "showcase_created":
+		if err = json.Unmarshal(body, &u.ShowcaseCreated); err != nil {
+			return err
+		}
+
+	case "showcase_delete_comment":
+		if err = json.Unmarshal(body, &u.ShowcaseDeleteComment); err != nil {
+			return err
+		}
+
+	case "showcase_edited":
+		if err = json.Unmarshal(body, &u.ShowcaseEdited); err != nil {
+			return err
+		}
+
+	case "showcase_edit_comment":
+		if err = json.Unmarshal(body, &u.ShowcaseEditComment); err != nil {
+			return err
+		}
+
+	case "showcase_file_added":
+		if err = json.Unmarshal(body, &u.ShowcaseFileAdded); err != nil {
+			return err
+		}
+
+	case "showcase_file_download":
+		if err = json.Unmarshal(body, &u.ShowcaseFileDownload); err != nil {
+			return err
+		}
+
+	case "showcase_file_removed":
+		if err = json.Unmarshal(body, &u.ShowcaseFileRemoved); err != nil {
+			return err
+		}
+
+	case "showcase_file_view":
+		if err = json.Unmarshal(body, &u.ShowcaseFileView); err != nil {
+			return err
+		}
+
+	case "showcase_permanently_deleted":
+		if err = json.Unmarshal(body, &u.ShowcasePermanentlyDeleted); err != nil {
+			return err
+		}
+
+	case "showcase_post_comment":
+		if err = json.Unmarshal(body, &u.ShowcasePostComment); err != nil {
+			return err
+		}
+
+	case "showcase_remove_member":
+		if err = json.Unmarshal(body, &u.ShowcaseRemoveMember); err != nil {
+			return err
+		}
+
+	case "showcase_renamed":
+		if err = json.Unmarshal(body, &u.ShowcaseRenamed); err != nil {
+			return err
+		}
+
+	case "showcase_request_access":
+		if err = json.Unmarshal(body, &u.ShowcaseRequestAccess); err != nil {
+			return err
+		}
+
+	case "showcase_resolve_comment":
+		if err = json.Unmarshal(body, &u.ShowcaseResolveComment); err != nil {
+			return err
+		}
+
+	case "showcase_restored":
+		if err = json.Unmarshal(body, &u.ShowcaseRestored); err != nil {
+			return err
+		}
+
+	case "showcase_trashed":
+		if err = json.Unmarshal(body, &u.ShowcaseTrashed); err != nil {
+			return err
+		}
+
+	case "showcase_trashed_deprecated":
+		if err = json.Unmarshal(body, &u.ShowcaseTrashedDeprecated); err != nil {
+			return err
+		}
+
+	case "showcase_unresolve_comment":
+		if err = json.Unmarshal(body, &u.ShowcaseUnresolveComment); err != nil {
+			return err
+		}
+
+	case "showcase_untrashed":
+		if err = json.Unmarshal(body, &u.ShowcaseUntrashed); err != nil {
+			return err
+		}
+
+	case "showcase_untrashed_deprecated":
+		if err = json.Unmarshal(body, &u.ShowcaseUntrashedDeprecated); err != nil {
 			return err
 		}
 
-	case "admin_alerting_changed_alert_config":
-		if err = json.Unmarshal(body, &u.AdminAlertingChangedAlertConfig); err != nil {
+	case "showcase_view":
+		if err = json.Unmarshal(body, &u.ShowcaseView); err != nil {
 			return err
 		}
 
-	case "admin_alerting_triggered_alert":
-		if err = json.Unmarshal(body, &u.AdminAlertingTriggeredAlert); err != nil {
+	case "sso_add_cert":
+		if err = json.Unmarshal(body, &u.SsoAddCert); err != nil {
 			return err
 		}
 
-	case "app_blocked_by_permissions":
-		if err = json.Unmarshal(body, &u.AppBlockedByPermissions); err != nil {
+	case "sso_add_login_url":
+		if err = json.Unmarshal(body, &u.SsoAddLoginUrl); err != nil {
 			return err
 		}
 
-	case "app_link_team":
-		if err = json.Unmarshal(body, &u.AppLinkTeam); err != nil {
+	case "sso_add_logout_url":
+		if err = json.Unmarshal(body, &u.SsoAddLogoutUrl); err != nil {
 			return err
 		}
 
-	case "app_link_user":
-		if err = json.Unmarshal(body, &u.AppLinkUser); err != nil {
+	case "sso_change_cert":
+		if err = json.Unmarshal(body, &u.SsoChangeCert); err != nil {
 			return err
 		}
 
-	case "app_unlink_team":
-		if err = json.Unmarshal(body, &u.AppUnlinkTeam); err != nil {
+	case "sso_change_login_url":
+		if err = json.Unmarshal(body, &u.SsoChangeLoginUrl); err != nil {
 			return err
 		}
 
-	case "app_unlink_user":
-		if err = json.Unmarshal(body, &u.AppUnlinkUser); err != nil {
+	case "sso_change_logout_url":
+		if err = json.Unmarshal(body, &u.SsoChangeLogoutUrl); err != nil {
 			return err
 		}
 
-	case "integration_connected":
-		if err = json.Unmarshal(body, &u.IntegrationConnected); err != nil {
+	case "sso_change_saml_identity_mode":
+		if err = json.Unmarshal(body, &u.SsoChangeSamlIdentityMode); err != nil {
 			return err
 		}
 
-	case "integration_disconnected":
-		if err = json.Unmarshal(body, &u.IntegrationDisconnected); err != nil {
+	case "sso_remove_cert":
+		if err = json.Unmarshal(body, &u.SsoRemoveCert); err != nil {
 			return err
 		}
 
-	case "file_add_comment":
-		if err = json.Unmarshal(body, &u.FileAddComment); err != nil {
+	case "sso_remove_login_url":
+		if err = json.Unmarshal(body, &u.SsoRemoveLoginUrl); err != nil {
 			return err
 		}
 
-	case "file_change_comment_subscription":
-		if err = json.Unmarshal(body, &u.FileChangeCommentSubscription); err != nil {
+	case "sso_remove_logout_url":
+		if err = json.Unmarshal(body, &u.SsoRemoveLogoutUrl); err != nil {
 			return err
 		}
 
-	case "file_delete_comment":
-		if err = json.Unmarshal(body, &u.FileDeleteComment); err != nil {
+	case "team_folder_change_status":
+		if err = json.Unmarshal(body, &u.TeamFolderChangeStatus); err != nil {
 			return err
 		}
 
-	case "file_edit_comment":
-		if err = json.Unmarshal(body, &u.FileEditComment); err != nil {
+	case "team_folder_create":
+		if err = json.Unmarshal(body, &u.TeamFolderCreate); err != nil {
 			return err
 		}
 
-	case "file_like_comment":
-		if err = json.Unmarshal(body, &u.FileLikeComment); err != nil {
+	case "team_folder_downgrade":
+		if err = json.Unmarshal(body, &u.TeamFolderDowngrade); err != nil {
 			return err
 		}
 
-	case "file_resolve_comment":
-		if err = json.Unmarshal(body, &u.FileResolveComment); err != nil {
+	case "team_folder_permanently_delete":
+		if err = json.Unmarshal(body, &u.TeamFolderPermanentlyDelete); err != nil {
 			return err
 		}
 
-	case "file_unlike_comment":
-		if err = json.Unmarshal(body, &u.FileUnlikeComment); err != nil {
+	case "team_folder_rename":
+		if err = json.Unmarshal(body, &u.TeamFolderRename); err != nil {
 			return err
 		}
 
-	case "file_unresolve_comment":
-		if err = json.Unmarshal(body, &u.FileUnresolveComment); err != nil {
+	case "team_selective_sync_settings_changed":
+		if err = json.Unmarshal(body, &u.TeamSelectiveSyncSettingsChanged); err != nil {
 			return err
 		}
 
-	case "governance_policy_add_folders":
-		if err = json.Unmarshal(body, &u.GovernancePolicyAddFolders); err != nil {
+	case "account_capture_change_policy":
+		if err = json.Unmarshal(body, &u.AccountCaptureChangePolicy); err != nil {
 			return err
 		}
 
-	case "governance_policy_add_folder_failed":
-		if err = json.Unmarshal(body, &u.GovernancePolicyAddFolderFailed); err != nil {
+	case "admin_email_reminders_changed":
+		if err = json.Unmarshal(body, &u.AdminEmailRemindersChanged); err != nil {
 			return err
 		}
 
-	case "governance_policy_content_disposed":
-		if err = json.Unmarshal(body, &u.GovernancePolicyContentDisposed); err != nil {
+	case "allow_download_disabled":
+		if err = json.Unmarshal(body, &u.AllowDownloadDisabled); err != nil {
 			return err
 		}
 
-	case "governance_policy_create":
-		if err = json.Unmarshal(body, &u.GovernancePolicyCreate); err != nil {
+	case "allow_download_enabled":
+		if err = json.Unmarshal(body, &u.AllowDownloadEnabled); err != nil {
 			return err
 		}
 
-	case "governance_policy_delete":
-		if err = json.Unmarshal(body, &u.GovernancePolicyDelete); err != nil {
+	case "app_permissions_changed":
+		if err = json.Unmarshal(body, &u.AppPermissionsChanged); err != nil {
 			return err
 		}
 
-	case "governance_policy_edit_details":
-		if err = json.Unmarshal(body, &u.GovernancePolicyEditDetails); err != nil {
+	case "camera_uploads_policy_changed":
+		if err = json.Unmarshal(body, &u.CameraUploadsPolicyChanged); err != nil {
 			return err
 		}
 
-	case "governance_policy_edit_duration":
-		if err = json.Unmarshal(body, &u.GovernancePolicyEditDuration); err != nil {
+	case "capture_transcript_policy_changed":
+		if err = json.Unmarshal(body, &u.CaptureTranscriptPolicyChanged); err != nil {
 			return err
 		}
 
-	case "governance_policy_export_created":
-		if err = json.Unmarshal(body, &u.GovernancePolicyExportCreated); err != nil {
+	case "classification_change_policy":
+		if err = json.Unmarshal(body, &u.ClassificationChangePolicy); err != nil {
 			return err
 		}
 
-	case "governance_policy_export_removed":
-		if err = json.Unmarshal(body, &u.GovernancePolicyExportRemoved); err != nil {
+	case "computer_backup_policy_changed":
+		if err = json.Unmarshal(body, &u.ComputerBackupPolicyChanged); err != nil {
 			return err
 		}
 
-	case "governance_policy_remove_folders":
-		if err = json.Unmarshal(body, &u.GovernancePolicyRemoveFolders); err != nil {
+	case "content_administration_policy_changed":
+		if err = json.Unmarshal(body, &u.ContentAdministrationPolicyChanged); err != nil {
+			return err
+		}
+
+	case "data_placement_restriction_change_policy":
+		if err = json.Unmarshal(body, &u.DataPlacementRestrictionChangePolicy); err != nil {
+			return err
+		}
+
+	case "data_placement_restriction_satisfy_policy":
+		if err = json.Unmarshal(body, &u.DataPlacementRestrictionSatisfyPolicy); err != nil {
+			return err
+		}
+
+	case "device_approvals_add_exception":
+		if err = json.Unmarshal(body, &u.DeviceApprovalsAddException); err != nil {
+			return err
+		}
+
+	case "device_approvals_change_desktop_policy":
+		if err = json.Unmarshal(body, &u.DeviceApprovalsChangeDesktopPolicy); err != nil {
+			return err
+		}
+
+	case "device_approvals_change_mobile_policy":
+		if err = json.Unmarshal(body, &u.DeviceApprovalsChangeMobilePolicy); err != nil {
+			return err
+		}
+
+	case "device_approvals_change_overage_action":
+		if err = json.Unmarshal(body, &u.DeviceApprovalsChangeOverageAction); err != nil {
+			return err
+		}
+
+	case "device_approvals_change_unlink_action":
+		if err = json.Unmarshal(body, &u.DeviceApprovalsChangeUnlinkAction); err != nil {
+			return err
+		}
+
+	case "device_approvals_remove_exception":
+		if err = json.Unmarshal(body, &u.DeviceApprovalsRemoveException); err != nil {
+			return err
+		}
+
+	case "directory_restrictions_add_members":
+		if err = json.Unmarshal(body, &u.DirectoryRestrictionsAddMembers); err != nil {
+			return err
+		}
+
+	case "directory_restrictions_remove_members":
+		if err = json.Unmarshal(body, &u.DirectoryRestrictionsRemoveMembers); err != nil {
+			return err
+		}
+
+	case "dropbox_passwords_policy_changed":
+		if err = json.Unmarshal(body, &u.DropboxPasswordsPolicyChanged); err != nil {
+			return err
+		}
+
+	case "email_ingest_policy_changed":
+		if err = json.Unmarshal(body, &u.EmailIngestPolicyChanged); err != nil {
+			return err
+		}
+
+	case "emm_add_exception":
+		if err = json.Unmarshal(body, &u.EmmAddException); err != nil {
+			return err
+		}
+
+	case "emm_change_policy":
+		if err = json.Unmarshal(body, &u.EmmChangePolicy); err != nil {
+			return err
+		}
+
+	case "emm_remove_exception":
+		if err = json.Unmarshal(body, &u.EmmRemoveException); err != nil {
+			return err
+		}
+
+	case "extended_version_history_change_policy":
+		if err = json.Unmarshal(body, &u.ExtendedVersionHistoryChangePolicy); err != nil {
+			return err
+		}
+
+	case "external_drive_backup_policy_changed":
+		if err = json.Unmarshal(body, &u.ExternalDriveBackupPolicyChanged); err != nil {
+			return err
+		}
+
+	case "file_comments_change_policy":
+		if err = json.Unmarshal(body, &u.FileCommentsChangePolicy); err != nil {
+			return err
+		}
+
+	case "file_locking_policy_changed":
+		if err = json.Unmarshal(body, &u.FileLockingPolicyChanged); err != nil {
+			return err
+		}
+
+	case "file_provider_migration_policy_changed":
+		if err = json.Unmarshal(body, &u.FileProviderMigrationPolicyChanged); err != nil {
+			return err
+		}
+
+	case "file_requests_change_policy":
+		if err = json.Unmarshal(body, &u.FileRequestsChangePolicy); err != nil {
+			return err
+		}
+
+	case "file_requests_emails_enabled":
+		if err = json.Unmarshal(body, &u.FileRequestsEmailsEnabled); err != nil {
+			return err
+		}
+
+	case "file_requests_emails_restricted_to_team_only":
+		if err = json.Unmarshal(body, &u.FileRequestsEmailsRestrictedToTeamOnly); err != nil {
+			return err
+		}
+
+	case "file_transfers_policy_changed":
+		if err = json.Unmarshal(body, &u.FileTransfersPolicyChanged); err != nil {
+			return err
+		}
+
+	case "google_sso_change_policy":
+		if err = json.Unmarshal(body, &u.GoogleSsoChangePolicy); err != nil {
+			return err
+		}
+
+	case "group_user_management_change_policy":
+		if err = json.Unmarshal(body, &u.GroupUserManagementChangePolicy); err != nil {
 			return err
 		}
 
-	case "governance_policy_report_created":
-		if err = json.Unmarshal(body, &u.GovernancePolicyReportCreated); err != nil {
+	case "integration_policy_changed":
+		if err = json.Unmarshal(body, &u.IntegrationPolicyChanged); err != nil {
 			return err
 		}
 
-	case "governance_policy_zip_part_downloaded":
-		if err = json.Unmarshal(body, &u.GovernancePolicyZipPartDownloaded); err != nil {
+	case "invite_acceptance_email_policy_changed":
+		if err = json.Unmarshal(body, &u.InviteAcceptanceEmailPolicyChanged); err != nil {
 			return err
 		}
 
-	case "legal_holds_activate_a_hold":
-		if err = json.Unmarshal(body, &u.LegalHoldsActivateAHold); err != nil {
+	case "member_requests_change_policy":
+		if err = json.Unmarshal(body, &u.MemberRequestsChangePolicy); err != nil {
 			return err
 		}
 
-	case "legal_holds_add_members":
-		if err = json.Unmarshal(body, &u.LegalHoldsAddMembers); err != nil {
+	case "member_send_invite_policy_changed":
+		if err = json.Unmarshal(body, &u.MemberSendInvitePolicyChanged); err != nil {
 			return err
 		}
 
-	case "legal_holds_change_hold_details":
-		if err = json.Unmarshal(body, &u.LegalHoldsChangeHoldDetails); err != nil {
+	case "member_space_limits_add_exception":
+		if err = json.Unmarshal(body, &u.MemberSpaceLimitsAddException); err != nil {
 			return err
 		}
 
-	case "legal_holds_change_hold_name":
-		if err = json.Unmarshal(body, &u.LegalHoldsChangeHoldName); err != nil {
+	case "member_space_limits_change_caps_type_policy":
+		if err = json.Unmarshal(body, &u.MemberSpaceLimitsChangeCapsTypePolicy); err != nil {
 			return err
 		}
 
-	case "legal_holds_export_a_hold":
-		if err = json.Unmarshal(body, &u.LegalHoldsExportAHold); err != nil {
+	case "member_space_limits_change_policy":
+		if err = json.Unmarshal(body, &u.MemberSpaceLimitsChangePolicy); err != nil {
 			return err
 		}
 
-	case "legal_holds_export_cancelled":
-		if err = json.Unmarshal(body, &u.LegalHoldsExportCancelled); err != nil {
+	case "member_space_limits_remove_exception":
+		if err = json.Unmarshal(body, &u.MemberSpaceLimitsRemoveException); err != nil {
 			return err
 		}
 
-	case "legal_holds_export_downloaded":
-		if err = json.Unmarshal(body, &u.LegalHoldsExportDownloaded); err != nil {
+	case "member_suggestions_change_policy":
+		if err = json.Unmarshal(body, &u.MemberSuggestionsChangePolicy); err != nil {
 			return err
 		}
 
-	case "legal_holds_export_removed":
-		if err = json.Unmarshal(body, &u.LegalHoldsExportRemoved); err != nil {
+	case "microsoft_office_addin_change_policy":
+		if err = json.Unmarshal(body, &u.MicrosoftOfficeAddinChangePolicy); err != nil {
 			return err
 		}
 
-	case "legal_holds_release_a_hold":
-		if err = json.Unmarshal(body, &u.LegalHoldsReleaseAHold); err != nil {
+	case "network_control_change_policy":
+		if err = json.Unmarshal(body, &u.NetworkControlChangePolicy); err != nil {
 			return err
 		}
 
-	case "legal_holds_remove_members":
-		if err = json.Unmarshal(body, &u.LegalHoldsRemoveMembers); err != nil {
+	case "paper_change_deployment_policy":
+		if err = json.Unmarshal(body, &u.PaperChangeDeploymentPolicy); err != nil {
 			return err
 		}
 
-	case "legal_holds_report_a_hold":
-		if err = json.Unmarshal(body, &u.LegalHoldsReportAHold); err != nil {
+	case "paper_change_member_link_policy":
+		if err = json.Unmarshal(body, &u.PaperChangeMemberLinkPolicy); err != nil {
 			return err
 		}
 
-	case "device_change_ip_desktop":
-		if err = json.Unmarshal(body, &u.DeviceChangeIpDesktop); err != nil {
+	case "paper_change_member_policy":
+		if err = json.Unmarshal(body, &u.PaperChangeMemberPolicy); err != nil {
 			return err
 		}
 
-	case "device_change_ip_mobile":
-		if err = json.Unmarshal(body, &u.DeviceChangeIpMobile); err != nil {
+	case "paper_change_policy":
+		if err = json.Unmarshal(body, &u.PaperChangePolicy); err != nil {
 			return err
 		}
 
-	case "device_change_ip_web":
-		if err = json.Unmarshal(body, &u.DeviceChangeIpWeb); err != nil {
+	case "paper_default_folder_policy_changed":
+		if err = json.Unmarshal(body, &u.PaperDefaultFolderPolicyChanged); err != nil {
 			return err
 		}
 
-	case "device_delete_on_unlink_fail":
-		if err = json.Unmarshal(body, &u.DeviceDeleteOnUnlinkFail); err != nil {
+	case "paper_desktop_policy_changed":
+		if err = json.Unmarshal(body, &u.PaperDesktopPolicyChanged); err != nil {
 			return err
 		}
 
-	case "device_delete_on_unlink_success":
-		if err = json.Unmarshal(body, &u.DeviceDeleteOnUnlinkSuccess); err != nil {
+	case "paper_enabled_users_group_addition":
+		if err = json.Unmarshal(body, &u.PaperEnabledUsersGroupAddition); err != nil {
 			return err
 		}
 
-	case "device_link_fail":
-		if err = json.Unmarshal(body, &u.DeviceLinkFail); err != nil {
+	case "paper_enabled_users_group_removal":
+		if err = json.Unmarshal(body, &u.PaperEnabledUsersGroupRemoval); err != nil {
 			return err
 		}
 
-	case "device_link_success":
-		if err = json.Unmarshal(body, &u.DeviceLinkSuccess); err != nil {
+	case "password_strength_requirements_change_policy":
+		if err = json.Unmarshal(body, &u.PasswordStrengthRequirementsChangePolicy); err != nil {
 			return err
 		}
 
-	case "device_management_disabled":
-		if err = json.Unmarshal(body, &u.DeviceManagementDisabled); err != nil {
+	case "permanent_delete_change_policy":
+		if err = json.Unmarshal(body, &u.PermanentDeleteChangePolicy); err != nil {
 			return err
 		}
 
-	case "device_management_enabled":
-		if err = json.Unmarshal(body, &u.DeviceManagementEnabled); err != nil {
+	case "reseller_support_change_policy":
+		if err = json.Unmarshal(body, &u.ResellerSupportChangePolicy); err != nil {
 			return err
 		}
 
-	case "device_sync_backup_status_changed":
-		if err = json.Unmarshal(body, &u.DeviceSyncBackupStatusChanged); err != nil {
+	case "rewind_policy_changed":
+		if err = json.Unmarshal(body, &u.RewindPolicyChanged); err != nil {
 			return err
 		}
 
-	case "device_unlink":
-		if err = json.Unmarshal(body, &u.DeviceUnlink); err != nil {
+	case "send_for_signature_policy_changed":
+		if err = json.Unmarshal(body, &u.SendForSignaturePolicyChanged); err != nil {
 			return err
 		}
 
-	case "dropbox_passwords_exported":
-		if err = json.Unmarshal(body, &u.DropboxPasswordsExported); err != nil {
+	case "sharing_change_folder_join_policy":
+		if err = json.Unmarshal(body, &u.SharingChangeFolderJoinPolicy); err != nil {
 			return err
 		}
 
-	case "dropbox_passwords_new_device_enrolled":
-		if err = json.Unmarshal(body, &u.DropboxPasswordsNewDeviceEnrolled); err != nil {
+	case "sharing_change_link_allow_change_expiration_policy":
+		if err = json.Unmarshal(body, &u.SharingChangeLinkAllowChangeExpirationPolicy); err != nil {
 			return err
 		}
 
-	case "emm_refresh_auth_token":
-		if err = json.Unmarshal(body, &u.EmmRefreshAuthToken); err != nil {
+	case "sharing_change_link_default_expiration_policy":
+		if err = json.Unmarshal(body, &u.SharingChangeLinkDefaultExpirationPolicy); err != nil {
 			return err
 		}
 
-	case "external_drive_backup_eligibility_status_checked":
-		if err = json.Unmarshal(body, &u.ExternalDriveBackupEligibilityStatusChecked); err != nil {
+	case "sharing_change_link_enforce_password_policy":
+		if err = json.Unmarshal(body, &u.SharingChangeLinkEnforcePasswordPolicy); err != nil {
 			return err
 		}
 
-	case "external_drive_backup_status_changed":
-		if err = json.Unmarshal(body, &u.ExternalDriveBackupStatusChanged); err != nil {
+	case "sharing_change_link_policy":
+		if err = json.Unmarshal(body, &u.SharingChangeLinkPolicy); err != nil {
 			return err
 		}
 
-	case "account_capture_change_availability":
-		if err = json.Unmarshal(body, &u.AccountCaptureChangeAvailability); err != nil {
+	case "sharing_change_member_policy":
+		if err = json.Unmarshal(body, &u.SharingChangeMemberPolicy); err != nil {
 			return err
 		}
 
-	case "account_capture_migrate_account":
-		if err = json.Unmarshal(body, &u.AccountCaptureMigrateAccount); err != nil {
+	case "showcase_change_download_policy":
+		if err = json.Unmarshal(body, &u.ShowcaseChangeDownloadPolicy); err != nil {
 			return err
 		}
 
-	case "account_capture_notification_emails_sent":
-		if err = json.Unmarshal(body, &u.AccountCaptureNotificationEmailsSent); err != nil {
+	case "showcase_change_enabled_policy":
+		if err = json.Unmarshal(body, &u.ShowcaseChangeEnabledPolicy); err != nil {
 			return err
 		}
 
-	case "account_capture_relinquish_account":
-		if err = json.Unmarshal(body, &u.AccountCaptureRelinquishAccount); err != nil {
+	case "showcase_change_external_sharing_policy":
+		if err = json.Unmarshal(body, &u.ShowcaseChangeExternalSharingPolicy); err != nil {
 			return err
 		}
 
-	case "disabled_domain_invites":
-		if err = json.Unmarshal(body, &u.DisabledDomainInvites); err != nil {
+	case "smarter_smart_sync_policy_changed":
+		if err = json.Unmarshal(body, &u.SmarterSmartSyncPolicyChanged); err != nil {
 			return err
 		}
 
-	case "domain_invites_approve_request_to_join_team":
-		if err = json.Unmarshal(body, &u.DomainInvitesApproveRequestToJoinTeam); err != nil {
+	case "smart_sync_change_policy":
+		if err = json.Unmarshal(body, &u.SmartSyncChangePolicy); err != nil {
 			return err
 		}
 
-	case "domain_invites_decline_request_to_join_team":
-		if err = json.Unmarshal(body, &u.DomainInvitesDeclineRequestToJoinTeam); err != nil {
+	case "smart_sync_not_opt_out":
+		if err = json.Unmarshal(body, &u.SmartSyncNotOptOut); err != nil {
 			return err
 		}
 
-	case "domain_invites_email_existing_users":
-		if err = json.Unmarshal(body, &u.DomainInvitesEmailExistingUsers); err != nil {
+	case "smart_sync_opt_out":
+		if err = json.Unmarshal(body, &u.SmartSyncOptOut); err != nil {
 			return err
 		}
 
-	case "domain_invites_request_to_join_team":
-		if err = json.Unmarshal(body, &u.DomainInvitesRequestToJoinTeam); err != nil {
+	case "sso_change_policy":
+		if err = json.Unmarshal(body, &u.SsoChangePolicy); err != nil {
 			return err
 		}
 
-	case "domain_invites_set_invite_new_user_pref_to_no":
-		if err = json.Unmarshal(body, &u.DomainInvitesSetInviteNewUserPrefToNo); err != nil {
+	case "team_branding_policy_changed":
+		if err = json.Unmarshal(body, &u.TeamBrandingPolicyChanged); err != nil {
 			return err
 		}
 
-	case "domain_invites_set_invite_new_user_pref_to_yes":
-		if err = json.Unmarshal(body, &u.DomainInvitesSetInviteNewUserPrefToYes); err != nil {
+	case "team_extensions_policy_changed":
+		if err = json.Unmarshal(body, &u.TeamExtensionsPolicyChanged); err != nil {
 			return err
 		}
 
-	case "domain_verification_add_domain_fail":
-		if err = json.Unmarshal(body, &u.DomainVerificationAddDomainFail); err != nil {
+	case "team_selective_sync_policy_changed":
+		if err = json.Unmarshal(body, &u.TeamSelectiveSyncPolicyChanged); err != nil {
 			return err
 		}
 
-	case "domain_verification_add_domain_success":
-		if err = json.Unmarshal(body, &u.DomainVerificationAddDomainSuccess); err != nil {
+	case "team_sharing_whitelist_subjects_changed":
+		if err = json.Unmarshal(body, &u.TeamSharingWhitelistSubjectsChanged); err != nil {
 			return err
 		}
 
-	case "domain_verification_remove_domain":
-		if err = json.Unmarshal(body, &u.DomainVerificationRemoveDomain); err != nil {
+	case "tfa_add_exception":
+		if err = json.Unmarshal(body, &u.TfaAddException); err != nil {
 			return err
 		}
 
-	case "enabled_domain_invites":
-		if err = json.Unmarshal(body, &u.EnabledDomainInvites); err != nil {
+	case "tfa_change_policy":
+		if err = json.Unmarshal(body, &u.TfaChangePolicy); err != nil {
 			return err
 		}
 
-	case "apply_naming_convention":
-		if err = json.Unmarshal(body, &u.ApplyNamingConvention); err != nil {
+	case "tfa_remove_exception":
+		if err = json.Unmarshal(body, &u.TfaRemoveException); err != nil {
 			return err
 		}
 
-	case "create_folder":
-		if err = json.Unmarshal(body, &u.CreateFolder); err != nil {
+	case "two_account_change_policy":
+		if err = json.Unmarshal(body, &u.TwoAccountChangePolicy); err != nil {
 			return err
 		}
 
-	case "file_add":
-		if err = json.Unmarshal(body, &u.FileAdd); err != nil {
+	case "viewer_info_policy_changed":
+		if err = json.Unmarshal(body, &u.ViewerInfoPolicyChanged); err != nil {
 			return err
 		}
 
-	case "file_copy":
-		if err = json.Unmarshal(body, &u.FileCopy); err != nil {
+	case "watermarking_policy_changed":
+		if err = json.Unmarshal(body, &u.WatermarkingPolicyChanged); err != nil {
 			return err
 		}
 
-	case "file_delete":
-		if err = json.Unmarshal(body, &u.FileDelete); err != nil {
+	case "web_sessions_change_active_session_limit":
+		if err = json.Unmarshal(body, &u.WebSessionsChangeActiveSessionLimit); err != nil {
 			return err
 		}
 
-	case "file_download":
-		if err = json.Unmarshal(body, &u.FileDownload); err != nil {
+	case "web_sessions_change_fixed_length_policy":
+		if err = json.Unmarshal(body, &u.WebSessionsChangeFixedLengthPolicy); err != nil {
 			return err
 		}
 
-	case "file_edit":
-		if err = json.Unmarshal(body, &u.FileEdit); err != nil {
+	case "web_sessions_change_idle_length_policy":
+		if err = json.Unmarshal(body, &u.WebSessionsChangeIdleLengthPolicy); err != nil {
 			return err
 		}
 
-	case "file_get_copy_reference":
-		if err = json.Unmarshal(body, &u.FileGetCopyReference); err != nil {
+	case "data_residency_migration_request_successful":
+		if err = json.Unmarshal(body, &u.DataResidencyMigrationRequestSuccessful); err != nil {
 			return err
 		}
 
-	case "file_locking_lock_status_changed":
-		if err = json.Unmarshal(body, &u.FileLockingLockStatusChanged); err != nil {
+	case "data_residency_migration_request_unsuccessful":
+		if err = json.Unmarshal(body, &u.DataResidencyMigrationRequestUnsuccessful); err != nil {
 			return err
 		}
 
-	case "file_move":
-		if err = json.Unmarshal(body, &u.FileMove); err != nil {
+	case "team_merge_from":
+		if err = json.Unmarshal(body, &u.TeamMergeFrom); err != nil {
 			return err
 		}
 
-	case "file_permanently_delete":
-		if err = json.Unmarshal(body, &u.FilePermanentlyDelete); err != nil {
+	case "team_merge_to":
+		if err = json.Unmarshal(body, &u.TeamMergeTo); err != nil {
 			return err
 		}
 
-	case "file_preview":
-		if err = json.Unmarshal(body, &u.FilePreview); err != nil {
+	case "team_profile_add_background":
+		if err = json.Unmarshal(body, &u.TeamProfileAddBackground); err != nil {
 			return err
 		}
 
-	case "file_rename":
-		if err = json.Unmarshal(body, &u.FileRename); err != nil {
+	case "team_profile_add_logo":
+		if err = json.Unmarshal(body, &u.TeamProfileAddLogo); err != nil {
 			return err
 		}
 
-	case "file_restore":
-		if err = json.Unmarshal(body, &u.FileRestore); err != nil {
+	case "team_profile_change_background":
+		if err = json.Unmarshal(body, &u.TeamProfileChangeBackground); err != nil {
 			return err
 		}
 
-	case "file_revert":
-		if err = json.Unmarshal(body, &u.FileRevert); err != nil {
+	case "team_profile_change_default_language":
+		if err = json.Unmarshal(body, &u.TeamProfileChangeDefaultLanguage); err != nil {
 			return err
 		}
 
-	case "file_rollback_changes":
-		if err = json.Unmarshal(body, &u.FileRollbackChanges); err != nil {
+	case "team_profile_change_logo":
+		if err = json.Unmarshal(body, &u.TeamProfileChangeLogo); err != nil {
 			return err
 		}
 
-	case "file_save_copy_reference":
-		if err = json.Unmarshal(body, &u.FileSaveCopyReference); err != nil {
+	case "team_profile_change_name":
+		if err = json.Unmarshal(body, &u.TeamProfileChangeName); err != nil {
 			return err
 		}
 
-	case "folder_overview_description_changed":
-		if err = json.Unmarshal(body, &u.FolderOverviewDescriptionChanged); err != nil {
+	case "team_profile_remove_background":
+		if err = json.Unmarshal(body, &u.TeamProfileRemoveBackground); err != nil {
 			return err
 		}
 
-	case "folder_overview_item_pinned":
-		if err = json.Unmarshal(body, &u.FolderOverviewItemPinned); err != nil {
+	case "team_profile_remove_logo":
+		if err = json.Unmarshal(body, &u.TeamProfileRemoveLogo); err != nil {
 			return err
 		}
 
-	case "folder_overview_item_unpinned":
-		if err = json.Unmarshal(body, &u.FolderOverviewItemUnpinned); err != nil {
+	case "tfa_add_backup_phone":
+		if err = json.Unmarshal(body, &u.TfaAddBackupPhone); err != nil {
 			return err
 		}
 
-	case "object_label_added":
-		if err = json.Unmarshal(body, &u.ObjectLabelAdded); err != nil {
+	case "tfa_add_security_key":
+		if err = json.Unmarshal(body, &u.TfaAddSecurityKey); err != nil {
 			return err
 		}
 
-	case "object_label_removed":
-		if err = json.Unmarshal(body, &u.ObjectLabelRemoved); err != nil {
+	case "tfa_change_backup_phone":
+		if err = json.Unmarshal(body, &u.TfaChangeBackupPhone); err != nil {
 			return err
 		}
 
-	case "object_label_updated_value":
-		if err = json.Unmarshal(body, &u.ObjectLabelUpdatedValue); err != nil {
+	case "tfa_change_status":
+		if err = json.Unmarshal(body, &u.TfaChangeStatus); err != nil {
 			return err
 		}
 
-	case "organize_folder_with_tidy":
-		if err = json.Unmarshal(body, &u.OrganizeFolderWithTidy); err != nil {
+	case "tfa_remove_backup_phone":
+		if err = json.Unmarshal(body, &u.TfaRemoveBackupPhone); err != nil {
 			return err
 		}
 
-	case "rewind_folder":
-		if err = json.Unmarshal(body, &u.RewindFolder); err != nil {
+	case "tfa_remove_security_key":
+		if err = json.Unmarshal(body, &u.TfaRemoveSecurityKey); err != nil {
 			return err
 		}
 
-	case "undo_naming_convention":
-		if err = json.Unmarshal(body, &u.UndoNamingConvention); err != nil {
+	case "tfa_reset":
+		if err = json.Unmarshal(body, &u.TfaReset); err != nil {
 			return err
 		}
 
-	case "undo_organize_folder_with_tidy":
-		if err = json.Unmarshal(body, &u.UndoOrganizeFolderWithTidy); err != nil {
+	case "changed_enterprise_admin_role":
+		if err = json.Unmarshal(body, &u.ChangedEnterpriseAdminRole); err != nil {
 			return err
 		}
 
-	case "user_tags_added":
-		if err = json.Unmarshal(body, &u.UserTagsAdded); err != nil {
+	case "changed_enterprise_connected_team_status":
+		if err = json.Unmarshal(body, &u.ChangedEnterpriseConnectedTeamStatus); err != nil {
 			return err
 		}
 
-	case "user_tags_removed":
-		if err = json.Unmarshal(body, &u.UserTagsRemoved); err != nil {
+	case "ended_enterprise_admin_session":
+		if err = json.Unmarshal(body, &u.EndedEnterpriseAdminSession); err != nil {
 			return err
 		}
 
-	case "email_ingest_receive_file":
-		if err = json.Unmarshal(body, &u.EmailIngestReceiveFile); err != nil {
+	case "ended_enterprise_admin_session_deprecated":
+		if err = json.Unmarshal(body, &u.EndedEnterpriseAdminSessionDeprecated); err != nil {
 			return err
 		}
 
-	case "file_request_change":
-		if err = json.Unmarshal(body, &u.FileRequestChange); err != nil {
+	case "enterprise_settings_locking":
+		if err = json.Unmarshal(body, &u.EnterpriseSettingsLocking); err != nil {
 			return err
 		}
 
-	case "file_request_close":
-		if err = json.Unmarshal(body, &u.FileRequestClose); err != nil {
+	case "guest_admin_change_status":
+		if err = json.Unmarshal(body, &u.GuestAdminChangeStatus); err != nil {
 			return err
 		}
 
-	case "file_request_create":
-		if err = json.Unmarshal(body, &u.FileRequestCreate); err != nil {
+	case "started_enterprise_admin_session":
+		if err = json.Unmarshal(body, &u.StartedEnterpriseAdminSession); err != nil {
 			return err
 		}
 
-	case "file_request_delete":
-		if err = json.Unmarshal(body, &u.FileRequestDelete); err != nil {
+	case "team_merge_request_accepted":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestAccepted); err != nil {
 			return err
 		}
 
-	case "file_request_receive_file":
-		if err = json.Unmarshal(body, &u.FileRequestReceiveFile); err != nil {
+	case "team_merge_request_accepted_shown_to_primary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestAcceptedShownToPrimaryTeam); err != nil {
 			return err
 		}
 
-	case "group_add_external_id":
-		if err = json.Unmarshal(body, &u.GroupAddExternalId); err != nil {
+	case "team_merge_request_accepted_shown_to_secondary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestAcceptedShownToSecondaryTeam); err != nil {
 			return err
 		}
 
-	case "group_add_member":
-		if err = json.Unmarshal(body, &u.GroupAddMember); err != nil {
+	case "team_merge_request_auto_canceled":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestAutoCanceled); err != nil {
 			return err
 		}
 
-	case "group_change_external_id":
-		if err = json.Unmarshal(body, &u.GroupChangeExternalId); err != nil {
+	case "team_merge_request_canceled":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestCanceled); err != nil {
 			return err
 		}
 
-	case "group_change_management_type":
-		if err = json.Unmarshal(body, &u.GroupChangeManagementType); err != nil {
+	case "team_merge_request_canceled_shown_to_primary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestCanceledShownToPrimaryTeam); err != nil {
 			return err
 		}
 
-	case "group_change_member_role":
-		if err = json.Unmarshal(body, &u.GroupChangeMemberRole); err != nil {
+	case "team_merge_request_canceled_shown_to_secondary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestCanceledShownToSecondaryTeam); err != nil {
 			return err
 		}
 
-	case "group_create":
-		if err = json.Unmarshal(body, &u.GroupCreate); err != nil {
+	case "team_merge_request_expired":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestExpired); err != nil {
 			return err
 		}
 
-	case "group_delete":
-		if err = json.Unmarshal(body, &u.GroupDelete); err != nil {
+	case "team_merge_request_expired_shown_to_primary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestExpiredShownToPrimaryTeam); err != nil {
 			return err
 		}
 
-	case "group_description_updated":
-		if err = json.Unmarshal(body, &u.GroupDescriptionUpdated); err != nil {
+	case "team_merge_request_expired_shown_to_secondary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestExpiredShownToSecondaryTeam); err != nil {
 			return err
 		}
 
-	case "group_join_policy_updated":
-		if err = json.Unmarshal(body, &u.GroupJoinPolicyUpdated); err != nil {
+	case "team_merge_request_rejected_shown_to_primary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestRejectedShownToPrimaryTeam); err != nil {
 			return err
 		}
 
-	case "group_moved":
-		if err = json.Unmarshal(body, &u.GroupMoved); err != nil {
+	case "team_merge_request_rejected_shown_to_secondary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestRejectedShownToSecondaryTeam); err != nil {
 			return err
 		}
 
-	case "group_remove_external_id":
-		if err = json.Unmarshal(body, &u.GroupRemoveExternalId); err != nil {
+	case "team_merge_request_reminder":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestReminder); err != nil {
 			return err
 		}
 
-	case "group_remove_member":
-		if err = json.Unmarshal(body, &u.GroupRemoveMember); err != nil {
+	case "team_merge_request_reminder_shown_to_primary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestReminderShownToPrimaryTeam); err != nil {
 			return err
 		}
 
-	case "group_rename":
-		if err = json.Unmarshal(body, &u.GroupRename); err != nil {
+	case "team_merge_request_reminder_shown_to_secondary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestReminderShownToSecondaryTeam); err != nil {
 			return err
 		}
 
-	case "account_lock_or_unlocked":
-		if err = json.Unmarshal(body, &u.AccountLockOrUnlocked); err != nil {
+	case "team_merge_request_revoked":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestRevoked); err != nil {
 			return err
 		}
 
-	case "emm_error":
-		if err = json.Unmarshal(body, &u.EmmError); err != nil {
+	case "team_merge_request_sent_shown_to_primary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestSentShownToPrimaryTeam); err != nil {
 			return err
 		}
 
-	case "guest_admin_signed_in_via_trusted_teams":
-		if err = json.Unmarshal(body, &u.GuestAdminSignedInViaTrustedTeams); err != nil {
+	case "team_merge_request_sent_shown_to_secondary_team":
+		if err = json.Unmarshal(body, &u.TeamMergeRequestSentShownToSecondaryTeam); err != nil {
 			return err
 		}
 
-	case "guest_admin_signed_out_via_trusted_teams":
-		if err = json.Unmarshal(body, &u.GuestAdminSignedOutViaTrustedTeams); err != nil {
-			return err
-		}
+	}
+	return nil
+}
 
+// MarshalJSON serializes a EventType instance
+func (u *EventType) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "admin_alerting_alert_state_changed":
+		type wrap AdminAlertingAlertStateChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminAlertingAlertStateChanged)})
+	case "admin_alerting_changed_alert_config":
+		type wrap AdminAlertingChangedAlertConfigType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminAlertingChangedAlertConfig)})
+	case "admin_alerting_triggered_alert":
+		type wrap AdminAlertingTriggeredAlertType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminAlertingTriggeredAlert)})
+	case "app_blocked_by_permissions":
+		type wrap AppBlockedByPermissionsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppBlockedByPermissions)})
+	case "app_link_team":
+		type wrap AppLinkTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppLinkTeam)})
+	case "app_link_user":
+		type wrap AppLinkUserType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppLinkUser)})
+	case "app_unlink_team":
+		type wrap AppUnlinkTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppUnlinkTeam)})
+	case "app_unlink_user":
+		type wrap AppUnlinkUserType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppUnlinkUser)})
+	case "integration_connected":
+		type wrap IntegrationConnectedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.IntegrationConnected)})
+	case "integration_disconnected":
+		type wrap IntegrationDisconnectedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.IntegrationDisconnected)})
+	case "file_add_comment":
+		type wrap FileAddCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileAddComment)})
+	case "file_change_comment_subscription":
+		type wrap FileChangeCommentSubscriptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileChangeCommentSubscription)})
+	case "file_delete_comment":
+		type wrap FileDeleteCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileDeleteComment)})
+	case "file_edit_comment":
+		type wrap FileEditCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileEditComment)})
+	case "file_like_comment":
+		type wrap FileLikeCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileLikeComment)})
+	case "file_resolve_comment":
+		type wrap FileResolveCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileResolveComment)})
+	case "file_unlike_comment":
+		type wrap FileUnlikeCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileUnlikeComment)})
+	case "file_unresolve_comment":
+		type wrap FileUnresolveCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileUnresolveComment)})
+	case "governance_policy_add_folders":
+		type wrap GovernancePolicyAddFoldersType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyAddFolders)})
+	case "governance_policy_add_folder_failed":
+		type wrap GovernancePolicyAddFolderFailedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyAddFolderFailed)})
+	case "governance_policy_content_disposed":
+		type wrap GovernancePolicyContentDisposedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyContentDisposed)})
+	case "governance_policy_create":
+		type wrap GovernancePolicyCreateType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyCreate)})
+	case "governance_policy_delete":
+		type wrap GovernancePolicyDeleteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyDelete)})
+	case "governance_policy_edit_details":
+		type wrap GovernancePolicyEditDetailsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyEditDetails)})
+	case "governance_policy_edit_duration":
+		type wrap GovernancePolicyEditDurationType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyEditDuration)})
+	case "governance_policy_export_created":
+		type wrap GovernancePolicyExportCreatedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyExportCreated)})
+	case "governance_policy_export_removed":
+		type wrap GovernancePolicyExportRemovedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyExportRemoved)})
+	case "governance_policy_remove_folders":
+		type wrap GovernancePolicyRemoveFoldersType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyRemoveFolders)})
+	case "governance_policy_report_created":
+		type wrap GovernancePolicyReportCreatedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyReportCreated)})
+	case "governance_policy_zip_part_downloaded":
+		type wrap GovernancePolicyZipPartDownloadedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GovernancePolicyZipPartDownloaded)})
+	case "legal_holds_activate_a_hold":
+		type wrap LegalHoldsActivateAHoldType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsActivateAHold)})
+	case "legal_holds_add_members":
+		type wrap LegalHoldsAddMembersType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsAddMembers)})
+	case "legal_holds_change_hold_details":
+		type wrap LegalHoldsChangeHoldDetailsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsChangeHoldDetails)})
+	case "legal_holds_change_hold_name":
+		type wrap LegalHoldsChangeHoldNameType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsChangeHoldName)})
+	case "legal_holds_export_a_hold":
+		type wrap LegalHoldsExportAHoldType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsExportAHold)})
+	case "legal_holds_export_cancelled":
+		type wrap LegalHoldsExportCancelledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsExportCancelled)})
+	case "legal_holds_export_downloaded":
+		type wrap LegalHoldsExportDownloadedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsExportDownloaded)})
+	case "legal_holds_export_removed":
+		type wrap LegalHoldsExportRemovedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsExportRemoved)})
+	case "legal_holds_release_a_hold":
+		type wrap LegalHoldsReleaseAHoldType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsReleaseAHold)})
+	case "legal_holds_remove_members":
+		type wrap LegalHoldsRemoveMembersType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsRemoveMembers)})
+	case "legal_holds_report_a_hold":
+		type wrap LegalHoldsReportAHoldType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegalHoldsReportAHold)})
+	case "device_change_ip_desktop":
+		type wrap DeviceChangeIpDesktopType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceChangeIpDesktop)})
+	case "device_change_ip_mobile":
+		type wrap DeviceChangeIpMobileType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceChangeIpMobile)})
+	case "device_change_ip_web":
+		type wrap DeviceChangeIpWebType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceChangeIpWeb)})
+	case "device_delete_on_unlink_fail":
+		type wrap DeviceDeleteOnUnlinkFailType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceDeleteOnUnlinkFail)})
+	case "device_delete_on_unlink_success":
+		type wrap DeviceDeleteOnUnlinkSuccessType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceDeleteOnUnlinkSuccess)})
+	case "device_link_fail":
+		type wrap DeviceLinkFailType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceLinkFail)})
+	case "device_link_success":
+		type wrap DeviceLinkSuccessType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceLinkSuccess)})
+	case "device_management_disabled":
+		type wrap DeviceManagementDisabledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceManagementDisabled)})
+	case "device_management_enabled":
+		type wrap DeviceManagementEnabledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceManagementEnabled)})
+	case "device_sync_backup_status_changed":
+		type wrap DeviceSyncBackupStatusChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceSyncBackupStatusChanged)})
+	case "device_unlink":
+		type wrap DeviceUnlinkType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceUnlink)})
+	case "dropbox_passwords_exported":
+		type wrap DropboxPasswordsExportedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DropboxPasswordsExported)})
+	case "dropbox_passwords_new_device_enrolled":
+		type wrap DropboxPasswordsNewDeviceEnrolledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DropboxPasswordsNewDeviceEnrolled)})
+	case "emm_refresh_auth_token":
+		type wrap EmmRefreshAuthTokenType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmRefreshAuthToken)})
+	case "external_drive_backup_eligibility_status_checked":
+		type wrap ExternalDriveBackupEligibilityStatusCheckedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalDriveBackupEligibilityStatusChecked)})
+	case "external_drive_backup_status_changed":
+		type wrap ExternalDriveBackupStatusChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalDriveBackupStatusChanged)})
+	case "account_capture_change_availability":
+		type wrap AccountCaptureChangeAvailabilityType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureChangeAvailability)})
+	case "account_capture_migrate_account":
+		type wrap AccountCaptureMigrateAccountType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureMigrateAccount)})
+	case "account_capture_notification_emails_sent":
+		type wrap AccountCaptureNotificationEmailsSentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureNotificationEmailsSent)})
+	case "account_capture_relinquish_account":
+		type wrap AccountCaptureRelinquishAccountType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureRelinquishAccount)})
+	case "disabled_domain_invites":
+		type wrap DisabledDomainInvitesType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DisabledDomainInvites)})
+	case "domain_invites_approve_request_to_join_team":
+		type wrap DomainInvitesApproveRequestToJoinTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesApproveRequestToJoinTeam)})
+	case "domain_invites_decline_request_to_join_team":
+		type wrap DomainInvitesDeclineRequestToJoinTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesDeclineRequestToJoinTeam)})
+	case "domain_invites_email_existing_users":
+		type wrap DomainInvitesEmailExistingUsersType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesEmailExistingUsers)})
+	case "domain_invites_request_to_join_team":
+		type wrap DomainInvitesRequestToJoinTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesRequestToJoinTeam)})
+	case "domain_invites_set_invite_new_user_pref_to_no":
+		type wrap DomainInvitesSetInviteNewUserPrefToNoType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesSetInviteNewUserPrefToNo)})
+	case "domain_invites_set_invite_new_user_pref_to_yes":
+		type wrap DomainInvitesSetInviteNewUserPrefToYesType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainInvitesSetInviteNewUserPrefToYes)})
+	case "domain_verification_add_domain_fail":
+		type wrap DomainVerificationAddDomainFailType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainVerificationAddDomainFail)})
+	case "domain_verification_add_domain_success":
+		type wrap DomainVerificationAddDomainSuccessType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainVerificationAddDomainSuccess)})
+	case "domain_verification_remove_domain":
+		type wrap DomainVerificationRemoveDomainType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DomainVerificationRemoveDomain)})
+	case "enabled_domain_invites":
+		type wrap EnabledDomainInvitesType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EnabledDomainInvites)})
+	case "apply_naming_convention":
+		type wrap ApplyNamingConventionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ApplyNamingConvention)})
+	case "create_folder":
+		type wrap CreateFolderType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CreateFolder)})
+	case "file_add":
+		type wrap FileAddType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileAdd)})
+	case "file_copy":
+		type wrap FileCopyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileCopy)})
+	case "file_delete":
+		type wrap FileDeleteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileDelete)})
+	case "file_download":
+		type wrap FileDownloadType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileDownload)})
+	case "file_edit":
+		type wrap FileEditType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileEdit)})
+	case "file_get_copy_reference":
+		type wrap FileGetCopyReferenceType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileGetCopyReference)})
+	case "file_locking_lock_status_changed":
+		type wrap FileLockingLockStatusChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileLockingLockStatusChanged)})
+	case "file_move":
+		type wrap FileMoveType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileMove)})
+	case "file_permanently_delete":
+		type wrap FilePermanentlyDeleteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FilePermanentlyDelete)})
+	case "file_preview":
+		type wrap FilePreviewType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FilePreview)})
+	case "file_rename":
+		type wrap FileRenameType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRename)})
+	case "file_restore":
+		type wrap FileRestoreType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRestore)})
+	case "file_revert":
+		type wrap FileRevertType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRevert)})
+	case "file_rollback_changes":
+		type wrap FileRollbackChangesType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRollbackChanges)})
+	case "file_save_copy_reference":
+		type wrap FileSaveCopyReferenceType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileSaveCopyReference)})
+	case "folder_overview_description_changed":
+		type wrap FolderOverviewDescriptionChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FolderOverviewDescriptionChanged)})
+	case "folder_overview_item_pinned":
+		type wrap FolderOverviewItemPinnedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FolderOverviewItemPinned)})
+	case "folder_overview_item_unpinned":
+		type wrap FolderOverviewItemUnpinnedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FolderOverviewItemUnpinned)})
+	case "object_label_added":
+		type wrap ObjectLabelAddedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ObjectLabelAdded)})
+	case "object_label_removed":
+		type wrap ObjectLabelRemovedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ObjectLabelRemoved)})
+	case "object_label_updated_value":
+		type wrap ObjectLabelUpdatedValueType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ObjectLabelUpdatedValue)})
+	case "organize_folder_with_tidy":
+		type wrap OrganizeFolderWithTidyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OrganizeFolderWithTidy)})
+	case "rewind_folder":
+		type wrap RewindFolderType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.RewindFolder)})
+	case "undo_naming_convention":
+		type wrap UndoNamingConventionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UndoNamingConvention)})
+	case "undo_organize_folder_with_tidy":
+		type wrap UndoOrganizeFolderWithTidyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UndoOrganizeFolderWithTidy)})
+	case "user_tags_added":
+		type wrap UserTagsAddedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UserTagsAdded)})
+	case "user_tags_removed":
+		type wrap UserTagsRemovedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.UserTagsRemoved)})
+	case "email_ingest_receive_file":
+		type wrap EmailIngestReceiveFileType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmailIngestReceiveFile)})
+	case "file_request_change":
+		type wrap FileRequestChangeType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestChange)})
+	case "file_request_close":
+		type wrap FileRequestCloseType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestClose)})
+	case "file_request_create":
+		type wrap FileRequestCreateType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestCreate)})
+	case "file_request_delete":
+		type wrap FileRequestDeleteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestDelete)})
+	case "file_request_receive_file":
+		type wrap FileRequestReceiveFileType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestReceiveFile)})
+	case "group_add_external_id":
+		type wrap GroupAddExternalIdType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupAddExternalId)})
+	case "group_add_member":
+		type wrap GroupAddMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupAddMember)})
+	case "group_change_external_id":
+		type wrap GroupChangeExternalIdType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupChangeExternalId)})
+	case "group_change_management_type":
+		type wrap GroupChangeManagementTypeType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupChangeManagementType)})
+	case "group_change_member_role":
+		type wrap GroupChangeMemberRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupChangeMemberRole)})
+	case "group_create":
+		type wrap GroupCreateType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupCreate)})
+	case "group_delete":
+		type wrap GroupDeleteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupDelete)})
+	case "group_description_updated":
+		type wrap GroupDescriptionUpdatedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupDescriptionUpdated)})
+	case "group_join_policy_updated":
+		type wrap GroupJoinPolicyUpdatedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupJoinPolicyUpdated)})
+	case "group_moved":
+		type wrap GroupMovedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupMoved)})
+	case "group_remove_external_id":
+		type wrap GroupRemoveExternalIdType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupRemoveExternalId)})
+	case "group_remove_member":
+		type wrap GroupRemoveMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupRemoveMember)})
+	case "group_rename":
+		type wrap GroupRenameType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupRename)})
+	case "account_lock_or_unlocked":
+		type wrap AccountLockOrUnlockedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountLockOrUnlocked)})
+	case "emm_error":
+		type wrap EmmErrorType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmError)})
+	case "guest_admin_signed_in_via_trusted_teams":
+		type wrap GuestAdminSignedInViaTrustedTeamsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GuestAdminSignedInViaTrustedTeams)})
+	case "guest_admin_signed_out_via_trusted_teams":
+		type wrap GuestAdminSignedOutViaTrustedTeamsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GuestAdminSignedOutViaTrustedTeams)})
 	case "login_fail":
-		if err = json.Unmarshal(body, &u.LoginFail); err != nil {
-			return err
-		}
-
+		type wrap LoginFailType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LoginFail)})
 	case "login_success":
-		if err = json.Unmarshal(body, &u.LoginSuccess); err != nil {
-			return err
-		}
-
+		type wrap LoginSuccessType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LoginSuccess)})
 	case "logout":
-		if err = json.Unmarshal(body, &u.Logout); err != nil {
-			return err
-		}
-
+		type wrap LogoutType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Logout)})
 	case "reseller_support_session_end":
-		if err = json.Unmarshal(body, &u.ResellerSupportSessionEnd); err != nil {
-			return err
-		}
-
+		type wrap ResellerSupportSessionEndType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ResellerSupportSessionEnd)})
 	case "reseller_support_session_start":
-		if err = json.Unmarshal(body, &u.ResellerSupportSessionStart); err != nil {
-			return err
-		}
-
+		type wrap ResellerSupportSessionStartType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ResellerSupportSessionStart)})
 	case "sign_in_as_session_end":
-		if err = json.Unmarshal(body, &u.SignInAsSessionEnd); err != nil {
-			return err
-		}
-
+		type wrap SignInAsSessionEndType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SignInAsSessionEnd)})
 	case "sign_in_as_session_start":
-		if err = json.Unmarshal(body, &u.SignInAsSessionStart); err != nil {
-			return err
-		}
-
+		type wrap SignInAsSessionStartType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SignInAsSessionStart)})
 	case "sso_error":
-		if err = json.Unmarshal(body, &u.SsoError); err != nil {
-			return err
-		}
-
+		type wrap SsoErrorType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoError)})
 	case "create_team_invite_link":
-		if err = json.Unmarshal(body, &u.CreateTeamInviteLink); err != nil {
-			return err
-		}
-
+		type wrap CreateTeamInviteLinkType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CreateTeamInviteLink)})
 	case "delete_team_invite_link":
-		if err = json.Unmarshal(body, &u.DeleteTeamInviteLink); err != nil {
-			return err
-		}
-
+		type wrap DeleteTeamInviteLinkType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeleteTeamInviteLink)})
 	case "member_add_external_id":
-		if err = json.Unmarshal(body, &u.MemberAddExternalId); err != nil {
-			return err
-		}
-
+		type wrap MemberAddExternalIdType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberAddExternalId)})
 	case "member_add_name":
-		if err = json.Unmarshal(body, &u.MemberAddName); err != nil {
-			return err
-		}
-
+		type wrap MemberAddNameType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberAddName)})
 	case "member_change_admin_role":
-		if err = json.Unmarshal(body, &u.MemberChangeAdminRole); err != nil {
-			return err
-		}
-
+		type wrap MemberChangeAdminRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeAdminRole)})
 	case "member_change_email":
-		if err = json.Unmarshal(body, &u.MemberChangeEmail); err != nil {
-			return err
-		}
-
+		type wrap MemberChangeEmailType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeEmail)})
 	case "member_change_external_id":
-		if err = json.Unmarshal(body, &u.MemberChangeExternalId); err != nil {
-			return err
-		}
-
+		type wrap MemberChangeExternalIdType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeExternalId)})
 	case "member_change_membership_type":
-		if err = json.Unmarshal(body, &u.MemberChangeMembershipType); err != nil {
-			return err
-		}
-
+		type wrap MemberChangeMembershipTypeType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeMembershipType)})
 	case "member_change_name":
-		if err = json.Unmarshal(body, &u.MemberChangeName); err != nil {
-			return err
-		}
-
+		type wrap MemberChangeNameType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeName)})
 	case "member_change_reseller_role":
-		if err = json.Unmarshal(body, &u.MemberChangeResellerRole); err != nil {
-			return err
-		}
-
+		type wrap MemberChangeResellerRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeResellerRole)})
 	case "member_change_status":
-		if err = json.Unmarshal(body, &u.MemberChangeStatus); err != nil {
-			return err
-		}
-
+		type wrap MemberChangeStatusType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberChangeStatus)})
 	case "member_delete_manual_contacts":
-		if err = json.Unmarshal(body, &u.MemberDeleteManualContacts); err != nil {
-			return err
-		}
-
+		type wrap MemberDeleteManualContactsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberDeleteManualContacts)})
 	case "member_delete_profile_photo":
-		if err = json.Unmarshal(body, &u.MemberDeleteProfilePhoto); err != nil {
-			return err
-		}
-
+		type wrap MemberDeleteProfilePhotoType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberDeleteProfilePhoto)})
 	case "member_permanently_delete_account_contents":
-		if err = json.Unmarshal(body, &u.MemberPermanentlyDeleteAccountContents); err != nil {
-			return err
-		}
-
+		type wrap MemberPermanentlyDeleteAccountContentsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberPermanentlyDeleteAccountContents)})
 	case "member_remove_external_id":
-		if err = json.Unmarshal(body, &u.MemberRemoveExternalId); err != nil {
-			return err
-		}
-
+		type wrap MemberRemoveExternalIdType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberRemoveExternalId)})
 	case "member_set_profile_photo":
-		if err = json.Unmarshal(body, &u.MemberSetProfilePhoto); err != nil {
-			return err
-		}
-
+		type wrap MemberSetProfilePhotoType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSetProfilePhoto)})
 	case "member_space_limits_add_custom_quota":
-		if err = json.Unmarshal(body, &u.MemberSpaceLimitsAddCustomQuota); err != nil {
-			return err
-		}
-
+		type wrap MemberSpaceLimitsAddCustomQuotaType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsAddCustomQuota)})
 	case "member_space_limits_change_custom_quota":
-		if err = json.Unmarshal(body, &u.MemberSpaceLimitsChangeCustomQuota); err != nil {
-			return err
-		}
-
+		type wrap MemberSpaceLimitsChangeCustomQuotaType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsChangeCustomQuota)})
 	case "member_space_limits_change_status":
-		if err = json.Unmarshal(body, &u.MemberSpaceLimitsChangeStatus); err != nil {
-			return err
-		}
-
+		type wrap MemberSpaceLimitsChangeStatusType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsChangeStatus)})
 	case "member_space_limits_remove_custom_quota":
-		if err = json.Unmarshal(body, &u.MemberSpaceLimitsRemoveCustomQuota); err != nil {
-			return err
-		}
-
+		type wrap MemberSpaceLimitsRemoveCustomQuotaType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsRemoveCustomQuota)})
 	case "member_suggest":
-		if err = json.Unmarshal(body, &u.MemberSuggest); err != nil {
-			return err
-		}
-
+		type wrap MemberSuggestType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSuggest)})
 	case "member_transfer_account_contents":
-		if err = json.Unmarshal(body, &u.MemberTransferAccountContents); err != nil {
-			return err
-		}
-
+		type wrap MemberTransferAccountContentsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberTransferAccountContents)})
 	case "pending_secondary_email_added":
-		if err = json.Unmarshal(body, &u.PendingSecondaryEmailAdded); err != nil {
-			return err
-		}
-
+		type wrap PendingSecondaryEmailAddedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PendingSecondaryEmailAdded)})
 	case "secondary_email_deleted":
-		if err = json.Unmarshal(body, &u.SecondaryEmailDeleted); err != nil {
-			return err
-		}
-
+		type wrap SecondaryEmailDeletedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryEmailDeleted)})
 	case "secondary_email_verified":
-		if err = json.Unmarshal(body, &u.SecondaryEmailVerified); err != nil {
-			return err
-		}
-
+		type wrap SecondaryEmailVerifiedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryEmailVerified)})
 	case "secondary_mails_policy_changed":
-		if err = json.Unmarshal(body, &u.SecondaryMailsPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap SecondaryMailsPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryMailsPolicyChanged)})
 	case "binder_add_page":
-		if err = json.Unmarshal(body, &u.BinderAddPage); err != nil {
-			return err
-		}
-
+		type wrap BinderAddPageType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderAddPage)})
 	case "binder_add_section":
-		if err = json.Unmarshal(body, &u.BinderAddSection); err != nil {
-			return err
-		}
-
+		type wrap BinderAddSectionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderAddSection)})
 	case "binder_remove_page":
-		if err = json.Unmarshal(body, &u.BinderRemovePage); err != nil {
-			return err
-		}
-
+		type wrap BinderRemovePageType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderRemovePage)})
 	case "binder_remove_section":
-		if err = json.Unmarshal(body, &u.BinderRemoveSection); err != nil {
-			return err
-		}
-
+		type wrap BinderRemoveSectionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderRemoveSection)})
 	case "binder_rename_page":
-		if err = json.Unmarshal(body, &u.BinderRenamePage); err != nil {
-			return err
-		}
-
+		type wrap BinderRenamePageType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderRenamePage)})
 	case "binder_rename_section":
-		if err = json.Unmarshal(body, &u.BinderRenameSection); err != nil {
-			return err
-		}
-
+		type wrap BinderRenameSectionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderRenameSection)})
 	case "binder_reorder_page":
-		if err = json.Unmarshal(body, &u.BinderReorderPage); err != nil {
-			return err
-		}
-
+		type wrap BinderReorderPageType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderReorderPage)})
 	case "binder_reorder_section":
-		if err = json.Unmarshal(body, &u.BinderReorderSection); err != nil {
-			return err
-		}
-
+		type wrap BinderReorderSectionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.BinderReorderSection)})
 	case "paper_content_add_member":
-		if err = json.Unmarshal(body, &u.PaperContentAddMember); err != nil {
-			return err
-		}
-
+		type wrap PaperContentAddMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentAddMember)})
 	case "paper_content_add_to_folder":
-		if err = json.Unmarshal(body, &u.PaperContentAddToFolder); err != nil {
-			return err
-		}
-
+		type wrap PaperContentAddToFolderType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentAddToFolder)})
 	case "paper_content_archive":
-		if err = json.Unmarshal(body, &u.PaperContentArchive); err != nil {
-			return err
-		}
-
+		type wrap PaperContentArchiveType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentArchive)})
 	case "paper_content_create":
-		if err = json.Unmarshal(body, &u.PaperContentCreate); err != nil {
-			return err
-		}
-
+		type wrap PaperContentCreateType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentCreate)})
 	case "paper_content_permanently_delete":
-		if err = json.Unmarshal(body, &u.PaperContentPermanentlyDelete); err != nil {
-			return err
-		}
-
+		type wrap PaperContentPermanentlyDeleteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentPermanentlyDelete)})
 	case "paper_content_remove_from_folder":
-		if err = json.Unmarshal(body, &u.PaperContentRemoveFromFolder); err != nil {
-			return err
-		}
-
+		type wrap PaperContentRemoveFromFolderType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentRemoveFromFolder)})
 	case "paper_content_remove_member":
-		if err = json.Unmarshal(body, &u.PaperContentRemoveMember); err != nil {
-			return err
-		}
-
+		type wrap PaperContentRemoveMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentRemoveMember)})
 	case "paper_content_rename":
-		if err = json.Unmarshal(body, &u.PaperContentRename); err != nil {
-			return err
-		}
-
+		type wrap PaperContentRenameType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentRename)})
 	case "paper_content_restore":
-		if err = json.Unmarshal(body, &u.PaperContentRestore); err != nil {
-			return err
-		}
-
+		type wrap PaperContentRestoreType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperContentRestore)})
 	case "paper_doc_add_comment":
-		if err = json.Unmarshal(body, &u.PaperDocAddComment); err != nil {
-			return err
-		}
-
+		type wrap PaperDocAddCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocAddComment)})
 	case "paper_doc_change_member_role":
-		if err = json.Unmarshal(body, &u.PaperDocChangeMemberRole); err != nil {
-			return err
-		}
-
+		type wrap PaperDocChangeMemberRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocChangeMemberRole)})
 	case "paper_doc_change_sharing_policy":
-		if err = json.Unmarshal(body, &u.PaperDocChangeSharingPolicy); err != nil {
-			return err
-		}
-
+		type wrap PaperDocChangeSharingPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocChangeSharingPolicy)})
 	case "paper_doc_change_subscription":
-		if err = json.Unmarshal(body, &u.PaperDocChangeSubscription); err != nil {
-			return err
-		}
-
+		type wrap PaperDocChangeSubscriptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocChangeSubscription)})
 	case "paper_doc_deleted":
-		if err = json.Unmarshal(body, &u.PaperDocDeleted); err != nil {
-			return err
-		}
-
+		type wrap PaperDocDeletedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocDeleted)})
 	case "paper_doc_delete_comment":
-		if err = json.Unmarshal(body, &u.PaperDocDeleteComment); err != nil {
-			return err
-		}
-
+		type wrap PaperDocDeleteCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocDeleteComment)})
 	case "paper_doc_download":
-		if err = json.Unmarshal(body, &u.PaperDocDownload); err != nil {
-			return err
-		}
-
+		type wrap PaperDocDownloadType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocDownload)})
 	case "paper_doc_edit":
-		if err = json.Unmarshal(body, &u.PaperDocEdit); err != nil {
-			return err
-		}
-
+		type wrap PaperDocEditType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocEdit)})
 	case "paper_doc_edit_comment":
-		if err = json.Unmarshal(body, &u.PaperDocEditComment); err != nil {
-			return err
-		}
-
+		type wrap PaperDocEditCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocEditComment)})
 	case "paper_doc_followed":
-		if err = json.Unmarshal(body, &u.PaperDocFollowed); err != nil {
-			return err
-		}
-
+		type wrap PaperDocFollowedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocFollowed)})
 	case "paper_doc_mention":
-		if err = json.Unmarshal(body, &u.PaperDocMention); err != nil {
-			return err
-		}
-
+		type wrap PaperDocMentionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocMention)})
 	case "paper_doc_ownership_changed":
-		if err = json.Unmarshal(body, &u.PaperDocOwnershipChanged); err != nil {
-			return err
-		}
-
+		type wrap PaperDocOwnershipChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocOwnershipChanged)})
 	case "paper_doc_request_access":
-		if err = json.Unmarshal(body, &u.PaperDocRequestAccess); err != nil {
-			return err
-		}
-
+		type wrap PaperDocRequestAccessType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocRequestAccess)})
 	case "paper_doc_resolve_comment":
-		if err = json.Unmarshal(body, &u.PaperDocResolveComment); err != nil {
-			return err
-		}
-
+		type wrap PaperDocResolveCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocResolveComment)})
 	case "paper_doc_revert":
-		if err = json.Unmarshal(body, &u.PaperDocRevert); err != nil {
-			return err
-		}
-
+		type wrap PaperDocRevertType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocRevert)})
 	case "paper_doc_slack_share":
-		if err = json.Unmarshal(body, &u.PaperDocSlackShare); err != nil {
-			return err
-		}
-
+		type wrap PaperDocSlackShareType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocSlackShare)})
 	case "paper_doc_team_invite":
-		if err = json.Unmarshal(body, &u.PaperDocTeamInvite); err != nil {
-			return err
-		}
-
+		type wrap PaperDocTeamInviteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocTeamInvite)})
 	case "paper_doc_trashed":
-		if err = json.Unmarshal(body, &u.PaperDocTrashed); err != nil {
-			return err
-		}
-
+		type wrap PaperDocTrashedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocTrashed)})
 	case "paper_doc_unresolve_comment":
-		if err = json.Unmarshal(body, &u.PaperDocUnresolveComment); err != nil {
-			return err
-		}
-
+		type wrap PaperDocUnresolveCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocUnresolveComment)})
 	case "paper_doc_untrashed":
-		if err = json.Unmarshal(body, &u.PaperDocUntrashed); err != nil {
-			return err
-		}
-
+		type wrap PaperDocUntrashedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocUntrashed)})
 	case "paper_doc_view":
-		if err = json.Unmarshal(body, &u.PaperDocView); err != nil {
-			return err
-		}
-
+		type wrap PaperDocViewType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDocView)})
 	case "paper_external_view_allow":
-		if err = json.Unmarshal(body, &u.PaperExternalViewAllow); err != nil {
-			return err
-		}
-
+		type wrap PaperExternalViewAllowType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperExternalViewAllow)})
 	case "paper_external_view_default_team":
-		if err = json.Unmarshal(body, &u.PaperExternalViewDefaultTeam); err != nil {
-			return err
-		}
-
+		type wrap PaperExternalViewDefaultTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperExternalViewDefaultTeam)})
 	case "paper_external_view_forbid":
-		if err = json.Unmarshal(body, &u.PaperExternalViewForbid); err != nil {
-			return err
-		}
-
+		type wrap PaperExternalViewForbidType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperExternalViewForbid)})
 	case "paper_folder_change_subscription":
-		if err = json.Unmarshal(body, &u.PaperFolderChangeSubscription); err != nil {
-			return err
-		}
-
+		type wrap PaperFolderChangeSubscriptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolderChangeSubscription)})
 	case "paper_folder_deleted":
-		if err = json.Unmarshal(body, &u.PaperFolderDeleted); err != nil {
-			return err
-		}
-
+		type wrap PaperFolderDeletedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolderDeleted)})
 	case "paper_folder_followed":
-		if err = json.Unmarshal(body, &u.PaperFolderFollowed); err != nil {
-			return err
-		}
-
+		type wrap PaperFolderFollowedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolderFollowed)})
 	case "paper_folder_team_invite":
-		if err = json.Unmarshal(body, &u.PaperFolderTeamInvite); err != nil {
-			return err
-		}
-
+		type wrap PaperFolderTeamInviteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperFolderTeamInvite)})
 	case "paper_published_link_change_permission":
-		if err = json.Unmarshal(body, &u.PaperPublishedLinkChangePermission); err != nil {
-			return err
-		}
-
+		type wrap PaperPublishedLinkChangePermissionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperPublishedLinkChangePermission)})
 	case "paper_published_link_create":
-		if err = json.Unmarshal(body, &u.PaperPublishedLinkCreate); err != nil {
-			return err
-		}
-
+		type wrap PaperPublishedLinkCreateType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperPublishedLinkCreate)})
 	case "paper_published_link_disabled":
-		if err = json.Unmarshal(body, &u.PaperPublishedLinkDisabled); err != nil {
-			return err
-		}
-
+		type wrap PaperPublishedLinkDisabledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperPublishedLinkDisabled)})
 	case "paper_published_link_view":
-		if err = json.Unmarshal(body, &u.PaperPublishedLinkView); err != nil {
-			return err
-		}
-
+		type wrap PaperPublishedLinkViewType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperPublishedLinkView)})
 	case "password_change":
-		if err = json.Unmarshal(body, &u.PasswordChange); err != nil {
-			return err
-		}
-
+		type wrap PasswordChangeType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PasswordChange)})
 	case "password_reset":
-		if err = json.Unmarshal(body, &u.PasswordReset); err != nil {
-			return err
-		}
-
+		type wrap PasswordResetType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PasswordReset)})
 	case "password_reset_all":
-		if err = json.Unmarshal(body, &u.PasswordResetAll); err != nil {
-			return err
-		}
-
+		type wrap PasswordResetAllType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PasswordResetAll)})
 	case "classification_create_report":
-		if err = json.Unmarshal(body, &u.ClassificationCreateReport); err != nil {
-			return err
-		}
-
+		type wrap ClassificationCreateReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ClassificationCreateReport)})
 	case "classification_create_report_fail":
-		if err = json.Unmarshal(body, &u.ClassificationCreateReportFail); err != nil {
-			return err
-		}
-
+		type wrap ClassificationCreateReportFailType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ClassificationCreateReportFail)})
 	case "emm_create_exceptions_report":
-		if err = json.Unmarshal(body, &u.EmmCreateExceptionsReport); err != nil {
-			return err
-		}
-
+		type wrap EmmCreateExceptionsReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmCreateExceptionsReport)})
 	case "emm_create_usage_report":
-		if err = json.Unmarshal(body, &u.EmmCreateUsageReport); err != nil {
-			return err
-		}
-
+		type wrap EmmCreateUsageReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmCreateUsageReport)})
 	case "export_members_report":
-		if err = json.Unmarshal(body, &u.ExportMembersReport); err != nil {
-			return err
-		}
-
+		type wrap ExportMembersReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExportMembersReport)})
 	case "export_members_report_fail":
-		if err = json.Unmarshal(body, &u.ExportMembersReportFail); err != nil {
-			return err
-		}
-
+		type wrap ExportMembersReportFailType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExportMembersReportFail)})
 	case "external_sharing_create_report":
-		if err = json.Unmarshal(body, &u.ExternalSharingCreateReport); err != nil {
-			return err
-		}
-
+		type wrap ExternalSharingCreateReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalSharingCreateReport)})
 	case "external_sharing_report_failed":
-		if err = json.Unmarshal(body, &u.ExternalSharingReportFailed); err != nil {
-			return err
-		}
-
+		type wrap ExternalSharingReportFailedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalSharingReportFailed)})
 	case "no_expiration_link_gen_create_report":
-		if err = json.Unmarshal(body, &u.NoExpirationLinkGenCreateReport); err != nil {
-			return err
-		}
-
+		type wrap NoExpirationLinkGenCreateReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoExpirationLinkGenCreateReport)})
 	case "no_expiration_link_gen_report_failed":
-		if err = json.Unmarshal(body, &u.NoExpirationLinkGenReportFailed); err != nil {
-			return err
-		}
-
+		type wrap NoExpirationLinkGenReportFailedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoExpirationLinkGenReportFailed)})
 	case "no_password_link_gen_create_report":
-		if err = json.Unmarshal(body, &u.NoPasswordLinkGenCreateReport); err != nil {
-			return err
-		}
-
+		type wrap NoPasswordLinkGenCreateReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoPasswordLinkGenCreateReport)})
 	case "no_password_link_gen_report_failed":
-		if err = json.Unmarshal(body, &u.NoPasswordLinkGenReportFailed); err != nil {
-			return err
-		}
-
+		type wrap NoPasswordLinkGenReportFailedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoPasswordLinkGenReportFailed)})
 	case "no_password_link_view_create_report":
-		if err = json.Unmarshal(body, &u.NoPasswordLinkViewCreateReport); err != nil {
-			return err
-		}
-
+		type wrap NoPasswordLinkViewCreateReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoPasswordLinkViewCreateReport)})
 	case "no_password_link_view_report_failed":
-		if err = json.Unmarshal(body, &u.NoPasswordLinkViewReportFailed); err != nil {
-			return err
-		}
-
+		type wrap NoPasswordLinkViewReportFailedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoPasswordLinkViewReportFailed)})
 	case "outdated_link_view_create_report":
-		if err = json.Unmarshal(body, &u.OutdatedLinkViewCreateReport); err != nil {
-			return err
-		}
-
+		type wrap OutdatedLinkViewCreateReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OutdatedLinkViewCreateReport)})
 	case "outdated_link_view_report_failed":
-		if err = json.Unmarshal(body, &u.OutdatedLinkViewReportFailed); err != nil {
-			return err
-		}
-
+		type wrap OutdatedLinkViewReportFailedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OutdatedLinkViewReportFailed)})
 	case "paper_admin_export_start":
-		if err = json.Unmarshal(body, &u.PaperAdminExportStart); err != nil {
-			return err
-		}
-
+		type wrap PaperAdminExportStartType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperAdminExportStart)})
 	case "smart_sync_create_admin_privilege_report":
-		if err = json.Unmarshal(body, &u.SmartSyncCreateAdminPrivilegeReport); err != nil {
-			return err
-		}
-
+		type wrap SmartSyncCreateAdminPrivilegeReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmartSyncCreateAdminPrivilegeReport)})
 	case "team_activity_create_report":
-		if err = json.Unmarshal(body, &u.TeamActivityCreateReport); err != nil {
-			return err
-		}
-
+		type wrap TeamActivityCreateReportType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamActivityCreateReport)})
 	case "team_activity_create_report_fail":
-		if err = json.Unmarshal(body, &u.TeamActivityCreateReportFail); err != nil {
-			return err
-		}
-
+		type wrap TeamActivityCreateReportFailType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamActivityCreateReportFail)})
 	case "collection_share":
-		if err = json.Unmarshal(body, &u.CollectionShare); err != nil {
-			return err
-		}
-
+		type wrap CollectionShareType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CollectionShare)})
 	case "file_transfers_file_add":
-		if err = json.Unmarshal(body, &u.FileTransfersFileAdd); err != nil {
-			return err
-		}
-
+		type wrap FileTransfersFileAddType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersFileAdd)})
 	case "file_transfers_transfer_delete":
-		if err = json.Unmarshal(body, &u.FileTransfersTransferDelete); err != nil {
-			return err
-		}
-
+		type wrap FileTransfersTransferDeleteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersTransferDelete)})
 	case "file_transfers_transfer_download":
-		if err = json.Unmarshal(body, &u.FileTransfersTransferDownload); err != nil {
-			return err
-		}
-
+		type wrap FileTransfersTransferDownloadType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersTransferDownload)})
 	case "file_transfers_transfer_send":
-		if err = json.Unmarshal(body, &u.FileTransfersTransferSend); err != nil {
-			return err
-		}
-
+		type wrap FileTransfersTransferSendType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersTransferSend)})
 	case "file_transfers_transfer_view":
-		if err = json.Unmarshal(body, &u.FileTransfersTransferView); err != nil {
-			return err
-		}
-
+		type wrap FileTransfersTransferViewType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersTransferView)})
 	case "note_acl_invite_only":
-		if err = json.Unmarshal(body, &u.NoteAclInviteOnly); err != nil {
-			return err
-		}
-
+		type wrap NoteAclInviteOnlyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteAclInviteOnly)})
 	case "note_acl_link":
-		if err = json.Unmarshal(body, &u.NoteAclLink); err != nil {
-			return err
-		}
-
+		type wrap NoteAclLinkType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteAclLink)})
 	case "note_acl_team_link":
-		if err = json.Unmarshal(body, &u.NoteAclTeamLink); err != nil {
-			return err
-		}
-
+		type wrap NoteAclTeamLinkType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteAclTeamLink)})
 	case "note_shared":
-		if err = json.Unmarshal(body, &u.NoteShared); err != nil {
-			return err
-		}
-
+		type wrap NoteSharedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteShared)})
 	case "note_share_receive":
-		if err = json.Unmarshal(body, &u.NoteShareReceive); err != nil {
-			return err
-		}
-
+		type wrap NoteShareReceiveType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NoteShareReceive)})
 	case "open_note_shared":
-		if err = json.Unmarshal(body, &u.OpenNoteShared); err != nil {
-			return err
-		}
-
+		type wrap OpenNoteSharedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OpenNoteShared)})
 	case "sf_add_group":
-		if err = json.Unmarshal(body, &u.SfAddGroup); err != nil {
-			return err
-		}
-
+		type wrap SfAddGroupType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfAddGroup)})
 	case "sf_allow_non_members_to_view_shared_links":
-		if err = json.Unmarshal(body, &u.SfAllowNonMembersToViewSharedLinks); err != nil {
-			return err
-		}
-
+		type wrap SfAllowNonMembersToViewSharedLinksType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfAllowNonMembersToViewSharedLinks)})
 	case "sf_external_invite_warn":
-		if err = json.Unmarshal(body, &u.SfExternalInviteWarn); err != nil {
-			return err
-		}
-
+		type wrap SfExternalInviteWarnType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfExternalInviteWarn)})
 	case "sf_fb_invite":
-		if err = json.Unmarshal(body, &u.SfFbInvite); err != nil {
-			return err
-		}
-
+		type wrap SfFbInviteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfFbInvite)})
 	case "sf_fb_invite_change_role":
-		if err = json.Unmarshal(body, &u.SfFbInviteChangeRole); err != nil {
-			return err
-		}
-
+		type wrap SfFbInviteChangeRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfFbInviteChangeRole)})
 	case "sf_fb_uninvite":
-		if err = json.Unmarshal(body, &u.SfFbUninvite); err != nil {
-			return err
-		}
-
+		type wrap SfFbUninviteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfFbUninvite)})
 	case "sf_invite_group":
-		if err = json.Unmarshal(body, &u.SfInviteGroup); err != nil {
-			return err
-		}
-
+		type wrap SfInviteGroupType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfInviteGroup)})
 	case "sf_team_grant_access":
-		if err = json.Unmarshal(body, &u.SfTeamGrantAccess); err != nil {
-			return err
-		}
-
+		type wrap SfTeamGrantAccessType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamGrantAccess)})
 	case "sf_team_invite":
-		if err = json.Unmarshal(body, &u.SfTeamInvite); err != nil {
-			return err
-		}
-
+		type wrap SfTeamInviteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamInvite)})
 	case "sf_team_invite_change_role":
-		if err = json.Unmarshal(body, &u.SfTeamInviteChangeRole); err != nil {
-			return err
-		}
-
+		type wrap SfTeamInviteChangeRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamInviteChangeRole)})
 	case "sf_team_join":
-		if err = json.Unmarshal(body, &u.SfTeamJoin); err != nil {
-			return err
-		}
-
+		type wrap SfTeamJoinType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamJoin)})
 	case "sf_team_join_from_oob_link":
-		if err = json.Unmarshal(body, &u.SfTeamJoinFromOobLink); err != nil {
-			return err
-		}
-
+		type wrap SfTeamJoinFromOobLinkType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamJoinFromOobLink)})
 	case "sf_team_uninvite":
-		if err = json.Unmarshal(body, &u.SfTeamUninvite); err != nil {
-			return err
-		}
-
+		type wrap SfTeamUninviteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SfTeamUninvite)})
 	case "shared_content_add_invitees":
-		if err = json.Unmarshal(body, &u.SharedContentAddInvitees); err != nil {
-			return err
-		}
-
+		type wrap SharedContentAddInviteesType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentAddInvitees)})
 	case "shared_content_add_link_expiry":
-		if err = json.Unmarshal(body, &u.SharedContentAddLinkExpiry); err != nil {
-			return err
-		}
-
+		type wrap SharedContentAddLinkExpiryType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentAddLinkExpiry)})
 	case "shared_content_add_link_password":
-		if err = json.Unmarshal(body, &u.SharedContentAddLinkPassword); err != nil {
-			return err
-		}
-
+		type wrap SharedContentAddLinkPasswordType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentAddLinkPassword)})
 	case "shared_content_add_member":
-		if err = json.Unmarshal(body, &u.SharedContentAddMember); err != nil {
-			return err
-		}
-
+		type wrap SharedContentAddMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentAddMember)})
 	case "shared_content_change_downloads_policy":
-		if err = json.Unmarshal(body, &u.SharedContentChangeDownloadsPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharedContentChangeDownloadsPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeDownloadsPolicy)})
 	case "shared_content_change_invitee_role":
-		if err = json.Unmarshal(body, &u.SharedContentChangeInviteeRole); err != nil {
-			return err
-		}
-
+		type wrap SharedContentChangeInviteeRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeInviteeRole)})
 	case "shared_content_change_link_audience":
-		if err = json.Unmarshal(body, &u.SharedContentChangeLinkAudience); err != nil {
-			return err
-		}
-
+		type wrap SharedContentChangeLinkAudienceType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeLinkAudience)})
 	case "shared_content_change_link_expiry":
-		if err = json.Unmarshal(body, &u.SharedContentChangeLinkExpiry); err != nil {
-			return err
-		}
-
+		type wrap SharedContentChangeLinkExpiryType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeLinkExpiry)})
 	case "shared_content_change_link_password":
-		if err = json.Unmarshal(body, &u.SharedContentChangeLinkPassword); err != nil {
-			return err
-		}
-
+		type wrap SharedContentChangeLinkPasswordType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeLinkPassword)})
 	case "shared_content_change_member_role":
-		if err = json.Unmarshal(body, &u.SharedContentChangeMemberRole); err != nil {
-			return err
-		}
-
+		type wrap SharedContentChangeMemberRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeMemberRole)})
 	case "shared_content_change_viewer_info_policy":
-		if err = json.Unmarshal(body, &u.SharedContentChangeViewerInfoPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharedContentChangeViewerInfoPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentChangeViewerInfoPolicy)})
 	case "shared_content_claim_invitation":
-		if err = json.Unmarshal(body, &u.SharedContentClaimInvitation); err != nil {
-			return err
-		}
-
+		type wrap SharedContentClaimInvitationType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentClaimInvitation)})
 	case "shared_content_copy":
-		if err = json.Unmarshal(body, &u.SharedContentCopy); err != nil {
-			return err
-		}
-
+		type wrap SharedContentCopyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentCopy)})
 	case "shared_content_download":
-		if err = json.Unmarshal(body, &u.SharedContentDownload); err != nil {
-			return err
-		}
-
+		type wrap SharedContentDownloadType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentDownload)})
 	case "shared_content_relinquish_membership":
-		if err = json.Unmarshal(body, &u.SharedContentRelinquishMembership); err != nil {
-			return err
-		}
-
+		type wrap SharedContentRelinquishMembershipType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRelinquishMembership)})
 	case "shared_content_remove_invitees":
-		if err = json.Unmarshal(body, &u.SharedContentRemoveInvitees); err != nil {
-			return err
-		}
-
+		type wrap SharedContentRemoveInviteesType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRemoveInvitees)})
 	case "shared_content_remove_link_expiry":
-		if err = json.Unmarshal(body, &u.SharedContentRemoveLinkExpiry); err != nil {
-			return err
-		}
-
+		type wrap SharedContentRemoveLinkExpiryType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRemoveLinkExpiry)})
 	case "shared_content_remove_link_password":
-		if err = json.Unmarshal(body, &u.SharedContentRemoveLinkPassword); err != nil {
-			return err
-		}
-
+		type wrap SharedContentRemoveLinkPasswordType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRemoveLinkPassword)})
 	case "shared_content_remove_member":
-		if err = json.Unmarshal(body, &u.SharedContentRemoveMember); err != nil {
-			return err
-		}
-
+		type wrap SharedContentRemoveMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRemoveMember)})
 	case "shared_content_request_access":
-		if err = json.Unmarshal(body, &u.SharedContentRequestAccess); err != nil {
-			return err
-		}
-
+		type wrap SharedContentRequestAccessType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRequestAccess)})
 	case "shared_content_restore_invitees":
-		if err = json.Unmarshal(body, &u.SharedContentRestoreInvitees); err != nil {
-			return err
-		}
-
+		type wrap SharedContentRestoreInviteesType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRestoreInvitees)})
 	case "shared_content_restore_member":
-		if err = json.Unmarshal(body, &u.SharedContentRestoreMember); err != nil {
-			return err
-		}
-
+		type wrap SharedContentRestoreMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentRestoreMember)})
 	case "shared_content_unshare":
-		if err = json.Unmarshal(body, &u.SharedContentUnshare); err != nil {
-			return err
-		}
-
+		type wrap SharedContentUnshareType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentUnshare)})
 	case "shared_content_view":
-		if err = json.Unmarshal(body, &u.SharedContentView); err != nil {
-			return err
-		}
-
+		type wrap SharedContentViewType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedContentView)})
 	case "shared_folder_change_link_policy":
-		if err = json.Unmarshal(body, &u.SharedFolderChangeLinkPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderChangeLinkPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderChangeLinkPolicy)})
 	case "shared_folder_change_members_inheritance_policy":
-		if err = json.Unmarshal(body, &u.SharedFolderChangeMembersInheritancePolicy); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderChangeMembersInheritancePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderChangeMembersInheritancePolicy)})
 	case "shared_folder_change_members_management_policy":
-		if err = json.Unmarshal(body, &u.SharedFolderChangeMembersManagementPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderChangeMembersManagementPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderChangeMembersManagementPolicy)})
 	case "shared_folder_change_members_policy":
-		if err = json.Unmarshal(body, &u.SharedFolderChangeMembersPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderChangeMembersPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderChangeMembersPolicy)})
 	case "shared_folder_create":
-		if err = json.Unmarshal(body, &u.SharedFolderCreate); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderCreateType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderCreate)})
 	case "shared_folder_decline_invitation":
-		if err = json.Unmarshal(body, &u.SharedFolderDeclineInvitation); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderDeclineInvitationType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderDeclineInvitation)})
 	case "shared_folder_mount":
-		if err = json.Unmarshal(body, &u.SharedFolderMount); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderMountType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderMount)})
 	case "shared_folder_nest":
-		if err = json.Unmarshal(body, &u.SharedFolderNest); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderNestType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderNest)})
 	case "shared_folder_transfer_ownership":
-		if err = json.Unmarshal(body, &u.SharedFolderTransferOwnership); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderTransferOwnershipType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderTransferOwnership)})
 	case "shared_folder_unmount":
-		if err = json.Unmarshal(body, &u.SharedFolderUnmount); err != nil {
-			return err
-		}
-
+		type wrap SharedFolderUnmountType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedFolderUnmount)})
 	case "shared_link_add_expiry":
-		if err = json.Unmarshal(body, &u.SharedLinkAddExpiry); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkAddExpiryType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkAddExpiry)})
 	case "shared_link_change_expiry":
-		if err = json.Unmarshal(body, &u.SharedLinkChangeExpiry); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkChangeExpiryType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkChangeExpiry)})
 	case "shared_link_change_visibility":
-		if err = json.Unmarshal(body, &u.SharedLinkChangeVisibility); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkChangeVisibilityType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkChangeVisibility)})
 	case "shared_link_copy":
-		if err = json.Unmarshal(body, &u.SharedLinkCopy); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkCopyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkCopy)})
 	case "shared_link_create":
-		if err = json.Unmarshal(body, &u.SharedLinkCreate); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkCreateType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkCreate)})
 	case "shared_link_disable":
-		if err = json.Unmarshal(body, &u.SharedLinkDisable); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkDisableType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkDisable)})
 	case "shared_link_download":
-		if err = json.Unmarshal(body, &u.SharedLinkDownload); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkDownloadType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkDownload)})
 	case "shared_link_remove_expiry":
-		if err = json.Unmarshal(body, &u.SharedLinkRemoveExpiry); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkRemoveExpiryType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkRemoveExpiry)})
 	case "shared_link_settings_add_expiration":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsAddExpiration); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsAddExpirationType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsAddExpiration)})
 	case "shared_link_settings_add_password":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsAddPassword); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsAddPasswordType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsAddPassword)})
 	case "shared_link_settings_allow_download_disabled":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsAllowDownloadDisabled); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsAllowDownloadDisabledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsAllowDownloadDisabled)})
 	case "shared_link_settings_allow_download_enabled":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsAllowDownloadEnabled); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsAllowDownloadEnabledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsAllowDownloadEnabled)})
 	case "shared_link_settings_change_audience":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsChangeAudience); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsChangeAudienceType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsChangeAudience)})
 	case "shared_link_settings_change_expiration":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsChangeExpiration); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsChangeExpirationType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsChangeExpiration)})
 	case "shared_link_settings_change_password":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsChangePassword); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsChangePasswordType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsChangePassword)})
 	case "shared_link_settings_remove_expiration":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsRemoveExpiration); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsRemoveExpirationType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsRemoveExpiration)})
 	case "shared_link_settings_remove_password":
-		if err = json.Unmarshal(body, &u.SharedLinkSettingsRemovePassword); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkSettingsRemovePasswordType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkSettingsRemovePassword)})
 	case "shared_link_share":
-		if err = json.Unmarshal(body, &u.SharedLinkShare); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkShareType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkShare)})
 	case "shared_link_view":
-		if err = json.Unmarshal(body, &u.SharedLinkView); err != nil {
-			return err
-		}
-
+		type wrap SharedLinkViewType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedLinkView)})
 	case "shared_note_opened":
-		if err = json.Unmarshal(body, &u.SharedNoteOpened); err != nil {
-			return err
-		}
-
+		type wrap SharedNoteOpenedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharedNoteOpened)})
 	case "shmodel_disable_downloads":
-		if err = json.Unmarshal(body, &u.ShmodelDisableDownloads); err != nil {
-			return err
-		}
-
+		type wrap ShmodelDisableDownloadsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShmodelDisableDownloads)})
 	case "shmodel_enable_downloads":
-		if err = json.Unmarshal(body, &u.ShmodelEnableDownloads); err != nil {
-			return err
-		}
-
+		type wrap ShmodelEnableDownloadsType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShmodelEnableDownloads)})
 	case "shmodel_group_share":
-		if err = json.Unmarshal(body, &u.ShmodelGroupShare); err != nil {
-			return err
-		}
-
+		type wrap ShmodelGroupShareType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShmodelGroupShare)})
 	case "showcase_access_granted":
-		if err = json.Unmarshal(body, &u.ShowcaseAccessGranted); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseAccessGrantedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseAccessGranted)})
 	case "showcase_add_member":
-		if err = json.Unmarshal(body, &u.ShowcaseAddMember); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseAddMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseAddMember)})
 	case "showcase_archived":
-		if err = json.Unmarshal(body, &u.ShowcaseArchived); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseArchivedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseArchived)})
 	case "showcase_created":
-		if err = json.Unmarshal(body, &u.ShowcaseCreated); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseCreatedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseCreated)})
 	case "showcase_delete_comment":
-		if err = json.Unmarshal(body, &u.ShowcaseDeleteComment); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseDeleteCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseDeleteComment)})
 	case "showcase_edited":
-		if err = json.Unmarshal(body, &u.ShowcaseEdited); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseEditedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseEdited)})
 	case "showcase_edit_comment":
-		if err = json.Unmarshal(body, &u.ShowcaseEditComment); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseEditCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseEditComment)})
 	case "showcase_file_added":
-		if err = json.Unmarshal(body, &u.ShowcaseFileAdded); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseFileAddedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseFileAdded)})
 	case "showcase_file_download":
-		if err = json.Unmarshal(body, &u.ShowcaseFileDownload); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseFileDownloadType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseFileDownload)})
 	case "showcase_file_removed":
-		if err = json.Unmarshal(body, &u.ShowcaseFileRemoved); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseFileRemovedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseFileRemoved)})
 	case "showcase_file_view":
-		if err = json.Unmarshal(body, &u.ShowcaseFileView); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseFileViewType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseFileView)})
 	case "showcase_permanently_deleted":
-		if err = json.Unmarshal(body, &u.ShowcasePermanentlyDeleted); err != nil {
-			return err
-		}
-
+		type wrap ShowcasePermanentlyDeletedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcasePermanentlyDeleted)})
 	case "showcase_post_comment":
-		if err = json.Unmarshal(body, &u.ShowcasePostComment); err != nil {
-			return err
-		}
-
+		type wrap ShowcasePostCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcasePostComment)})
 	case "showcase_remove_member":
-		if err = json.Unmarshal(body, &u.ShowcaseRemoveMember); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseRemoveMemberType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseRemoveMember)})
 	case "showcase_renamed":
-		if err = json.Unmarshal(body, &u.ShowcaseRenamed); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseRenamedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseRenamed)})
 	case "showcase_request_access":
-		if err = json.Unmarshal(body, &u.ShowcaseRequestAccess); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseRequestAccessType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseRequestAccess)})
 	case "showcase_resolve_comment":
-		if err = json.Unmarshal(body, &u.ShowcaseResolveComment); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseResolveCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseResolveComment)})
 	case "showcase_restored":
-		if err = json.Unmarshal(body, &u.ShowcaseRestored); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseRestoredType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseRestored)})
 	case "showcase_trashed":
-		if err = json.Unmarshal(body, &u.ShowcaseTrashed); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseTrashedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseTrashed)})
 	case "showcase_trashed_deprecated":
-		if err = json.Unmarshal(body, &u.ShowcaseTrashedDeprecated); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseTrashedDeprecatedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseTrashedDeprecated)})
 	case "showcase_unresolve_comment":
-		if err = json.Unmarshal(body, &u.ShowcaseUnresolveComment); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseUnresolveCommentType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseUnresolveComment)})
 	case "showcase_untrashed":
-		if err = json.Unmarshal(body, &u.ShowcaseUntrashed); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseUntrashedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseUntrashed)})
 	case "showcase_untrashed_deprecated":
-		if err = json.Unmarshal(body, &u.ShowcaseUntrashedDeprecated); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseUntrashedDeprecatedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseUntrashedDeprecated)})
 	case "showcase_view":
-		if err = json.Unmarshal(body, &u.ShowcaseView); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseViewType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseView)})
 	case "sso_add_cert":
-		if err = json.Unmarshal(body, &u.SsoAddCert); err != nil {
-			return err
-		}
-
+		type wrap SsoAddCertType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoAddCert)})
 	case "sso_add_login_url":
-		if err = json.Unmarshal(body, &u.SsoAddLoginUrl); err != nil {
-			return err
-		}
-
+		type wrap SsoAddLoginUrlType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoAddLoginUrl)})
 	case "sso_add_logout_url":
-		if err = json.Unmarshal(body, &u.SsoAddLogoutUrl); err != nil {
-			return err
-		}
-
+		type wrap SsoAddLogoutUrlType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoAddLogoutUrl)})
 	case "sso_change_cert":
-		if err = json.Unmarshal(body, &u.SsoChangeCert); err != nil {
-			return err
-		}
-
+		type wrap SsoChangeCertType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangeCert)})
 	case "sso_change_login_url":
-		if err = json.Unmarshal(body, &u.SsoChangeLoginUrl); err != nil {
-			return err
-		}
-
+		type wrap SsoChangeLoginUrlType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangeLoginUrl)})
 	case "sso_change_logout_url":
-		if err = json.Unmarshal(body, &u.SsoChangeLogoutUrl); err != nil {
-			return err
-		}
-
+		type wrap SsoChangeLogoutUrlType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangeLogoutUrl)})
 	case "sso_change_saml_identity_mode":
-		if err = json.Unmarshal(body, &u.SsoChangeSamlIdentityMode); err != nil {
-			return err
-		}
-
+		type wrap SsoChangeSamlIdentityModeType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangeSamlIdentityMode)})
 	case "sso_remove_cert":
-		if err = json.Unmarshal(body, &u.SsoRemoveCert); err != nil {
-			return err
-		}
-
+		type wrap SsoRemoveCertType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoRemoveCert)})
 	case "sso_remove_login_url":
-		if err = json.Unmarshal(body, &u.SsoRemoveLoginUrl); err != nil {
-			return err
-		}
-
+		type wrap SsoRemoveLoginUrlType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoRemoveLoginUrl)})
 	case "sso_remove_logout_url":
-		if err = json.Unmarshal(body, &u.SsoRemoveLogoutUrl); err != nil {
-			return err
-		}
-
+		type wrap SsoRemoveLogoutUrlType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoRemoveLogoutUrl)})
 	case "team_folder_change_status":
-		if err = json.Unmarshal(body, &u.TeamFolderChangeStatus); err != nil {
-			return err
-		}
-
+		type wrap TeamFolderChangeStatusType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderChangeStatus)})
 	case "team_folder_create":
-		if err = json.Unmarshal(body, &u.TeamFolderCreate); err != nil {
-			return err
-		}
-
+		type wrap TeamFolderCreateType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderCreate)})
 	case "team_folder_downgrade":
-		if err = json.Unmarshal(body, &u.TeamFolderDowngrade); err != nil {
-			return err
-		}
-
+		type wrap TeamFolderDowngradeType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderDowngrade)})
 	case "team_folder_permanently_delete":
-		if err = json.Unmarshal(body, &u.TeamFolderPermanentlyDelete); err != nil {
-			return err
-		}
-
+		type wrap TeamFolderPermanentlyDeleteType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderPermanentlyDelete)})
 	case "team_folder_rename":
-		if err = json.Unmarshal(body, &u.TeamFolderRename); err != nil {
-			return err
-		}
-
+		type wrap TeamFolderRenameType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamFolderRename)})
 	case "team_selective_sync_settings_changed":
-		if err = json.Unmarshal(body, &u.TeamSelectiveSyncSettingsChanged); err != nil {
-			return err
-		}
-
+		type wrap TeamSelectiveSyncSettingsChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamSelectiveSyncSettingsChanged)})
 	case "account_capture_change_policy":
-		if err = json.Unmarshal(body, &u.AccountCaptureChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap AccountCaptureChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AccountCaptureChangePolicy)})
 	case "admin_email_reminders_changed":
-		if err = json.Unmarshal(body, &u.AdminEmailRemindersChanged); err != nil {
-			return err
-		}
-
+		type wrap AdminEmailRemindersChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AdminEmailRemindersChanged)})
 	case "allow_download_disabled":
-		if err = json.Unmarshal(body, &u.AllowDownloadDisabled); err != nil {
-			return err
-		}
-
+		type wrap AllowDownloadDisabledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AllowDownloadDisabled)})
 	case "allow_download_enabled":
-		if err = json.Unmarshal(body, &u.AllowDownloadEnabled); err != nil {
-			return err
-		}
-
+		type wrap AllowDownloadEnabledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AllowDownloadEnabled)})
 	case "app_permissions_changed":
-		if err = json.Unmarshal(body, &u.AppPermissionsChanged); err != nil {
-			return err
-		}
-
+		type wrap AppPermissionsChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.AppPermissionsChanged)})
 	case "camera_uploads_policy_changed":
-		if err = json.Unmarshal(body, &u.CameraUploadsPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap CameraUploadsPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CameraUploadsPolicyChanged)})
 	case "capture_transcript_policy_changed":
-		if err = json.Unmarshal(body, &u.CaptureTranscriptPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap CaptureTranscriptPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.CaptureTranscriptPolicyChanged)})
 	case "classification_change_policy":
-		if err = json.Unmarshal(body, &u.ClassificationChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap ClassificationChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ClassificationChangePolicy)})
 	case "computer_backup_policy_changed":
-		if err = json.Unmarshal(body, &u.ComputerBackupPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap ComputerBackupPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ComputerBackupPolicyChanged)})
 	case "content_administration_policy_changed":
-		if err = json.Unmarshal(body, &u.ContentAdministrationPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap ContentAdministrationPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ContentAdministrationPolicyChanged)})
 	case "data_placement_restriction_change_policy":
-		if err = json.Unmarshal(body, &u.DataPlacementRestrictionChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap DataPlacementRestrictionChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DataPlacementRestrictionChangePolicy)})
 	case "data_placement_restriction_satisfy_policy":
-		if err = json.Unmarshal(body, &u.DataPlacementRestrictionSatisfyPolicy); err != nil {
-			return err
-		}
-
+		type wrap DataPlacementRestrictionSatisfyPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DataPlacementRestrictionSatisfyPolicy)})
 	case "device_approvals_add_exception":
-		if err = json.Unmarshal(body, &u.DeviceApprovalsAddException); err != nil {
-			return err
-		}
-
+		type wrap DeviceApprovalsAddExceptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsAddException)})
 	case "device_approvals_change_desktop_policy":
-		if err = json.Unmarshal(body, &u.DeviceApprovalsChangeDesktopPolicy); err != nil {
-			return err
-		}
-
+		type wrap DeviceApprovalsChangeDesktopPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsChangeDesktopPolicy)})
 	case "device_approvals_change_mobile_policy":
-		if err = json.Unmarshal(body, &u.DeviceApprovalsChangeMobilePolicy); err != nil {
-			return err
-		}
-
+		type wrap DeviceApprovalsChangeMobilePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsChangeMobilePolicy)})
 	case "device_approvals_change_overage_action":
-		if err = json.Unmarshal(body, &u.DeviceApprovalsChangeOverageAction); err != nil {
-			return err
-		}
-
+		type wrap DeviceApprovalsChangeOverageActionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsChangeOverageAction)})
 	case "device_approvals_change_unlink_action":
-		if err = json.Unmarshal(body, &u.DeviceApprovalsChangeUnlinkAction); err != nil {
-			return err
-		}
-
+		type wrap DeviceApprovalsChangeUnlinkActionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsChangeUnlinkAction)})
 	case "device_approvals_remove_exception":
-		if err = json.Unmarshal(body, &u.DeviceApprovalsRemoveException); err != nil {
-			return err
-		}
-
+		type wrap DeviceApprovalsRemoveExceptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DeviceApprovalsRemoveException)})
 	case "directory_restrictions_add_members":
-		if err = json.Unmarshal(body, &u.DirectoryRestrictionsAddMembers); err != nil {
-			return err
-		}
-
+		type wrap DirectoryRestrictionsAddMembersType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DirectoryRestrictionsAddMembers)})
 	case "directory_restrictions_remove_members":
-		if err = json.Unmarshal(body, &u.DirectoryRestrictionsRemoveMembers); err != nil {
-			return err
-		}
-
+		type wrap DirectoryRestrictionsRemoveMembersType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DirectoryRestrictionsRemoveMembers)})
 	case "dropbox_passwords_policy_changed":
-		if err = json.Unmarshal(body, &u.DropboxPasswordsPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap DropboxPasswordsPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DropboxPasswordsPolicyChanged)})
 	case "email_ingest_policy_changed":
-		if err = json.Unmarshal(body, &u.EmailIngestPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap EmailIngestPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmailIngestPolicyChanged)})
 	case "emm_add_exception":
-		if err = json.Unmarshal(body, &u.EmmAddException); err != nil {
-			return err
-		}
-
+		type wrap EmmAddExceptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmAddException)})
 	case "emm_change_policy":
-		if err = json.Unmarshal(body, &u.EmmChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap EmmChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmChangePolicy)})
 	case "emm_remove_exception":
-		if err = json.Unmarshal(body, &u.EmmRemoveException); err != nil {
-			return err
-		}
-
+		type wrap EmmRemoveExceptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EmmRemoveException)})
 	case "extended_version_history_change_policy":
-		if err = json.Unmarshal(body, &u.ExtendedVersionHistoryChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap ExtendedVersionHistoryChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExtendedVersionHistoryChangePolicy)})
 	case "external_drive_backup_policy_changed":
-		if err = json.Unmarshal(body, &u.ExternalDriveBackupPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap ExternalDriveBackupPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ExternalDriveBackupPolicyChanged)})
 	case "file_comments_change_policy":
-		if err = json.Unmarshal(body, &u.FileCommentsChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap FileCommentsChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileCommentsChangePolicy)})
 	case "file_locking_policy_changed":
-		if err = json.Unmarshal(body, &u.FileLockingPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap FileLockingPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileLockingPolicyChanged)})
 	case "file_provider_migration_policy_changed":
-		if err = json.Unmarshal(body, &u.FileProviderMigrationPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap FileProviderMigrationPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileProviderMigrationPolicyChanged)})
 	case "file_requests_change_policy":
-		if err = json.Unmarshal(body, &u.FileRequestsChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap FileRequestsChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestsChangePolicy)})
 	case "file_requests_emails_enabled":
-		if err = json.Unmarshal(body, &u.FileRequestsEmailsEnabled); err != nil {
-			return err
-		}
-
+		type wrap FileRequestsEmailsEnabledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestsEmailsEnabled)})
 	case "file_requests_emails_restricted_to_team_only":
-		if err = json.Unmarshal(body, &u.FileRequestsEmailsRestrictedToTeamOnly); err != nil {
-			return err
-		}
-
+		type wrap FileRequestsEmailsRestrictedToTeamOnlyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileRequestsEmailsRestrictedToTeamOnly)})
 	case "file_transfers_policy_changed":
-		if err = json.Unmarshal(body, &u.FileTransfersPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap FileTransfersPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.FileTransfersPolicyChanged)})
 	case "google_sso_change_policy":
-		if err = json.Unmarshal(body, &u.GoogleSsoChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap GoogleSsoChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GoogleSsoChangePolicy)})
 	case "group_user_management_change_policy":
-		if err = json.Unmarshal(body, &u.GroupUserManagementChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap GroupUserManagementChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GroupUserManagementChangePolicy)})
 	case "integration_policy_changed":
-		if err = json.Unmarshal(body, &u.IntegrationPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap IntegrationPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.IntegrationPolicyChanged)})
 	case "invite_acceptance_email_policy_changed":
-		if err = json.Unmarshal(body, &u.InviteAcceptanceEmailPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap InviteAcceptanceEmailPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.InviteAcceptanceEmailPolicyChanged)})
 	case "member_requests_change_policy":
-		if err = json.Unmarshal(body, &u.MemberRequestsChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap MemberRequestsChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberRequestsChangePolicy)})
 	case "member_send_invite_policy_changed":
-		if err = json.Unmarshal(body, &u.MemberSendInvitePolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap MemberSendInvitePolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSendInvitePolicyChanged)})
 	case "member_space_limits_add_exception":
-		if err = json.Unmarshal(body, &u.MemberSpaceLimitsAddException); err != nil {
-			return err
-		}
-
+		type wrap MemberSpaceLimitsAddExceptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsAddException)})
 	case "member_space_limits_change_caps_type_policy":
-		if err = json.Unmarshal(body, &u.MemberSpaceLimitsChangeCapsTypePolicy); err != nil {
-			return err
-		}
-
+		type wrap MemberSpaceLimitsChangeCapsTypePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsChangeCapsTypePolicy)})
 	case "member_space_limits_change_policy":
-		if err = json.Unmarshal(body, &u.MemberSpaceLimitsChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap MemberSpaceLimitsChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsChangePolicy)})
 	case "member_space_limits_remove_exception":
-		if err = json.Unmarshal(body, &u.MemberSpaceLimitsRemoveException); err != nil {
-			return err
-		}
-
+		type wrap MemberSpaceLimitsRemoveExceptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSpaceLimitsRemoveException)})
 	case "member_suggestions_change_policy":
-		if err = json.Unmarshal(body, &u.MemberSuggestionsChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap MemberSuggestionsChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MemberSuggestionsChangePolicy)})
 	case "microsoft_office_addin_change_policy":
-		if err = json.Unmarshal(body, &u.MicrosoftOfficeAddinChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap MicrosoftOfficeAddinChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MicrosoftOfficeAddinChangePolicy)})
 	case "network_control_change_policy":
-		if err = json.Unmarshal(body, &u.NetworkControlChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap NetworkControlChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NetworkControlChangePolicy)})
 	case "paper_change_deployment_policy":
-		if err = json.Unmarshal(body, &u.PaperChangeDeploymentPolicy); err != nil {
-			return err
-		}
-
+		type wrap PaperChangeDeploymentPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperChangeDeploymentPolicy)})
 	case "paper_change_member_link_policy":
-		if err = json.Unmarshal(body, &u.PaperChangeMemberLinkPolicy); err != nil {
-			return err
-		}
-
+		type wrap PaperChangeMemberLinkPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperChangeMemberLinkPolicy)})
 	case "paper_change_member_policy":
-		if err = json.Unmarshal(body, &u.PaperChangeMemberPolicy); err != nil {
-			return err
-		}
-
+		type wrap PaperChangeMemberPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperChangeMemberPolicy)})
 	case "paper_change_policy":
-		if err = json.Unmarshal(body, &u.PaperChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap PaperChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperChangePolicy)})
 	case "paper_default_folder_policy_changed":
-		if err = json.Unmarshal(body, &u.PaperDefaultFolderPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap PaperDefaultFolderPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDefaultFolderPolicyChanged)})
 	case "paper_desktop_policy_changed":
-		if err = json.Unmarshal(body, &u.PaperDesktopPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap PaperDesktopPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperDesktopPolicyChanged)})
 	case "paper_enabled_users_group_addition":
-		if err = json.Unmarshal(body, &u.PaperEnabledUsersGroupAddition); err != nil {
-			return err
-		}
-
+		type wrap PaperEnabledUsersGroupAdditionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperEnabledUsersGroupAddition)})
 	case "paper_enabled_users_group_removal":
-		if err = json.Unmarshal(body, &u.PaperEnabledUsersGroupRemoval); err != nil {
-			return err
-		}
-
+		type wrap PaperEnabledUsersGroupRemovalType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PaperEnabledUsersGroupRemoval)})
 	case "password_strength_requirements_change_policy":
-		if err = json.Unmarshal(body, &u.PasswordStrengthRequirementsChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap PasswordStrengthRequirementsChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PasswordStrengthRequirementsChangePolicy)})
 	case "permanent_delete_change_policy":
-		if err = json.Unmarshal(body, &u.PermanentDeleteChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap PermanentDeleteChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PermanentDeleteChangePolicy)})
 	case "reseller_support_change_policy":
-		if err = json.Unmarshal(body, &u.ResellerSupportChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap ResellerSupportChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ResellerSupportChangePolicy)})
 	case "rewind_policy_changed":
-		if err = json.Unmarshal(body, &u.RewindPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap RewindPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.RewindPolicyChanged)})
 	case "send_for_signature_policy_changed":
-		if err = json.Unmarshal(body, &u.SendForSignaturePolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap SendForSignaturePolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SendForSignaturePolicyChanged)})
 	case "sharing_change_folder_join_policy":
-		if err = json.Unmarshal(body, &u.SharingChangeFolderJoinPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharingChangeFolderJoinPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeFolderJoinPolicy)})
 	case "sharing_change_link_allow_change_expiration_policy":
-		if err = json.Unmarshal(body, &u.SharingChangeLinkAllowChangeExpirationPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharingChangeLinkAllowChangeExpirationPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeLinkAllowChangeExpirationPolicy)})
 	case "sharing_change_link_default_expiration_policy":
-		if err = json.Unmarshal(body, &u.SharingChangeLinkDefaultExpirationPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharingChangeLinkDefaultExpirationPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeLinkDefaultExpirationPolicy)})
 	case "sharing_change_link_enforce_password_policy":
-		if err = json.Unmarshal(body, &u.SharingChangeLinkEnforcePasswordPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharingChangeLinkEnforcePasswordPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeLinkEnforcePasswordPolicy)})
 	case "sharing_change_link_policy":
-		if err = json.Unmarshal(body, &u.SharingChangeLinkPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharingChangeLinkPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeLinkPolicy)})
 	case "sharing_change_member_policy":
-		if err = json.Unmarshal(body, &u.SharingChangeMemberPolicy); err != nil {
-			return err
-		}
-
+		type wrap SharingChangeMemberPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SharingChangeMemberPolicy)})
 	case "showcase_change_download_policy":
-		if err = json.Unmarshal(body, &u.ShowcaseChangeDownloadPolicy); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseChangeDownloadPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseChangeDownloadPolicy)})
 	case "showcase_change_enabled_policy":
-		if err = json.Unmarshal(body, &u.ShowcaseChangeEnabledPolicy); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseChangeEnabledPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseChangeEnabledPolicy)})
 	case "showcase_change_external_sharing_policy":
-		if err = json.Unmarshal(body, &u.ShowcaseChangeExternalSharingPolicy); err != nil {
-			return err
-		}
-
+		type wrap ShowcaseChangeExternalSharingPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ShowcaseChangeExternalSharingPolicy)})
 	case "smarter_smart_sync_policy_changed":
-		if err = json.Unmarshal(body, &u.SmarterSmartSyncPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap SmarterSmartSyncPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmarterSmartSyncPolicyChanged)})
 	case "smart_sync_change_policy":
-		if err = json.Unmarshal(body, &u.SmartSyncChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap SmartSyncChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmartSyncChangePolicy)})
 	case "smart_sync_not_opt_out":
-		if err = json.Unmarshal(body, &u.SmartSyncNotOptOut); err != nil {
-			return err
-		}
-
+		type wrap SmartSyncNotOptOutType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmartSyncNotOptOut)})
 	case "smart_sync_opt_out":
-		if err = json.Unmarshal(body, &u.SmartSyncOptOut); err != nil {
-			return err
-		}
-
+		type wrap SmartSyncOptOutType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SmartSyncOptOut)})
 	case "sso_change_policy":
-		if err = json.Unmarshal(body, &u.SsoChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap SsoChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SsoChangePolicy)})
 	case "team_branding_policy_changed":
-		if err = json.Unmarshal(body, &u.TeamBrandingPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap TeamBrandingPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamBrandingPolicyChanged)})
 	case "team_extensions_policy_changed":
-		if err = json.Unmarshal(body, &u.TeamExtensionsPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap TeamExtensionsPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamExtensionsPolicyChanged)})
 	case "team_selective_sync_policy_changed":
-		if err = json.Unmarshal(body, &u.TeamSelectiveSyncPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap TeamSelectiveSyncPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamSelectiveSyncPolicyChanged)})
 	case "team_sharing_whitelist_subjects_changed":
-		if err = json.Unmarshal(body, &u.TeamSharingWhitelistSubjectsChanged); err != nil {
-			return err
-		}
-
+		type wrap TeamSharingWhitelistSubjectsChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamSharingWhitelistSubjectsChanged)})
 	case "tfa_add_exception":
-		if err = json.Unmarshal(body, &u.TfaAddException); err != nil {
-			return err
-		}
-
+		type wrap TfaAddExceptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaAddException)})
 	case "tfa_change_policy":
-		if err = json.Unmarshal(body, &u.TfaChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap TfaChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaChangePolicy)})
 	case "tfa_remove_exception":
-		if err = json.Unmarshal(body, &u.TfaRemoveException); err != nil {
-			return err
-		}
-
+		type wrap TfaRemoveExceptionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaRemoveException)})
 	case "two_account_change_policy":
-		if err = json.Unmarshal(body, &u.TwoAccountChangePolicy); err != nil {
-			return err
-		}
-
+		type wrap TwoAccountChangePolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TwoAccountChangePolicy)})
 	case "viewer_info_policy_changed":
-		if err = json.Unmarshal(body, &u.ViewerInfoPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap ViewerInfoPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ViewerInfoPolicyChanged)})
 	case "watermarking_policy_changed":
-		if err = json.Unmarshal(body, &u.WatermarkingPolicyChanged); err != nil {
-			return err
-		}
-
+		type wrap WatermarkingPolicyChangedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WatermarkingPolicyChanged)})
 	case "web_sessions_change_active_session_limit":
-		if err = json.Unmarshal(body, &u.WebSessionsChangeActiveSessionLimit); err != nil {
-			return err
-		}
-
+		type wrap WebSessionsChangeActiveSessionLimitType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WebSessionsChangeActiveSessionLimit)})
 	case "web_sessions_change_fixed_length_policy":
-		if err = json.Unmarshal(body, &u.WebSessionsChangeFixedLengthPolicy); err != nil {
-			return err
-		}
-
+		type wrap WebSessionsChangeFixedLengthPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WebSessionsChangeFixedLengthPolicy)})
 	case "web_sessions_change_idle_length_policy":
-		if err = json.Unmarshal(body, &u.WebSessionsChangeIdleLengthPolicy); err != nil {
-			return err
-		}
-
+		type wrap WebSessionsChangeIdleLengthPolicyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WebSessionsChangeIdleLengthPolicy)})
 	case "data_residency_migration_request_successful":
-		if err = json.Unmarshal(body, &u.DataResidencyMigrationRequestSuccessful); err != nil {
-			return err
-		}
-
+		type wrap DataResidencyMigrationRequestSuccessfulType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DataResidencyMigrationRequestSuccessful)})
 	case "data_residency_migration_request_unsuccessful":
-		if err = json.Unmarshal(body, &u.DataResidencyMigrationRequestUnsuccessful); err != nil {
-			return err
-		}
-
+		type wrap DataResidencyMigrationRequestUnsuccessfulType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DataResidencyMigrationRequestUnsuccessful)})
 	case "team_merge_from":
-		if err = json.Unmarshal(body, &u.TeamMergeFrom); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeFromType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeFrom)})
 	case "team_merge_to":
-		if err = json.Unmarshal(body, &u.TeamMergeTo); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeToType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeTo)})
 	case "team_profile_add_background":
-		if err = json.Unmarshal(body, &u.TeamProfileAddBackground); err != nil {
-			return err
-		}
-
+		type wrap TeamProfileAddBackgroundType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileAddBackground)})
 	case "team_profile_add_logo":
-		if err = json.Unmarshal(body, &u.TeamProfileAddLogo); err != nil {
-			return err
-		}
-
+		type wrap TeamProfileAddLogoType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileAddLogo)})
 	case "team_profile_change_background":
-		if err = json.Unmarshal(body, &u.TeamProfileChangeBackground); err != nil {
-			return err
-		}
-
+		type wrap TeamProfileChangeBackgroundType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileChangeBackground)})
 	case "team_profile_change_default_language":
-		if err = json.Unmarshal(body, &u.TeamProfileChangeDefaultLanguage); err != nil {
-			return err
-		}
-
+		type wrap TeamProfileChangeDefaultLanguageType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileChangeDefaultLanguage)})
 	case "team_profile_change_logo":
-		if err = json.Unmarshal(body, &u.TeamProfileChangeLogo); err != nil {
-			return err
-		}
-
+		type wrap TeamProfileChangeLogoType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileChangeLogo)})
 	case "team_profile_change_name":
-		if err = json.Unmarshal(body, &u.TeamProfileChangeName); err != nil {
-			return err
-		}
-
+		type wrap TeamProfileChangeNameType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileChangeName)})
 	case "team_profile_remove_background":
-		if err = json.Unmarshal(body, &u.TeamProfileRemoveBackground); err != nil {
-			return err
-		}
-
+		type wrap TeamProfileRemoveBackgroundType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileRemoveBackground)})
 	case "team_profile_remove_logo":
-		if err = json.Unmarshal(body, &u.TeamProfileRemoveLogo); err != nil {
-			return err
-		}
-
+		type wrap TeamProfileRemoveLogoType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamProfileRemoveLogo)})
 	case "tfa_add_backup_phone":
-		if err = json.Unmarshal(body, &u.TfaAddBackupPhone); err != nil {
-			return err
-		}
-
+		type wrap TfaAddBackupPhoneType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaAddBackupPhone)})
 	case "tfa_add_security_key":
-		if err = json.Unmarshal(body, &u.TfaAddSecurityKey); err != nil {
-			return err
-		}
-
+		type wrap TfaAddSecurityKeyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaAddSecurityKey)})
 	case "tfa_change_backup_phone":
-		if err = json.Unmarshal(body, &u.TfaChangeBackupPhone); err != nil {
-			return err
-		}
-
+		type wrap TfaChangeBackupPhoneType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaChangeBackupPhone)})
 	case "tfa_change_status":
-		if err = json.Unmarshal(body, &u.TfaChangeStatus); err != nil {
-			return err
-		}
-
+		type wrap TfaChangeStatusType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaChangeStatus)})
 	case "tfa_remove_backup_phone":
-		if err = json.Unmarshal(body, &u.TfaRemoveBackupPhone); err != nil {
-			return err
-		}
-
+		type wrap TfaRemoveBackupPhoneType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaRemoveBackupPhone)})
 	case "tfa_remove_security_key":
-		if err = json.Unmarshal(body, &u.TfaRemoveSecurityKey); err != nil {
-			return err
-		}
-
+		type wrap TfaRemoveSecurityKeyType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaRemoveSecurityKey)})
 	case "tfa_reset":
-		if err = json.Unmarshal(body, &u.TfaReset); err != nil {
-			return err
-		}
-
+		type wrap TfaResetType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TfaReset)})
 	case "changed_enterprise_admin_role":
-		if err = json.Unmarshal(body, &u.ChangedEnterpriseAdminRole); err != nil {
-			return err
-		}
-
+		type wrap ChangedEnterpriseAdminRoleType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ChangedEnterpriseAdminRole)})
 	case "changed_enterprise_connected_team_status":
-		if err = json.Unmarshal(body, &u.ChangedEnterpriseConnectedTeamStatus); err != nil {
-			return err
-		}
-
+		type wrap ChangedEnterpriseConnectedTeamStatusType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ChangedEnterpriseConnectedTeamStatus)})
 	case "ended_enterprise_admin_session":
-		if err = json.Unmarshal(body, &u.EndedEnterpriseAdminSession); err != nil {
-			return err
-		}
-
+		type wrap EndedEnterpriseAdminSessionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EndedEnterpriseAdminSession)})
 	case "ended_enterprise_admin_session_deprecated":
-		if err = json.Unmarshal(body, &u.EndedEnterpriseAdminSessionDeprecated); err != nil {
-			return err
-		}
-
+		type wrap EndedEnterpriseAdminSessionDeprecatedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EndedEnterpriseAdminSessionDeprecated)})
 	case "enterprise_settings_locking":
-		if err = json.Unmarshal(body, &u.EnterpriseSettingsLocking); err != nil {
-			return err
-		}
-
+		type wrap EnterpriseSettingsLockingType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.EnterpriseSettingsLocking)})
 	case "guest_admin_change_status":
-		if err = json.Unmarshal(body, &u.GuestAdminChangeStatus); err != nil {
-			return err
-		}
-
+		type wrap GuestAdminChangeStatusType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.GuestAdminChangeStatus)})
 	case "started_enterprise_admin_session":
-		if err = json.Unmarshal(body, &u.StartedEnterpriseAdminSession); err != nil {
-			return err
-		}
-
+		type wrap StartedEnterpriseAdminSessionType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.StartedEnterpriseAdminSession)})
 	case "team_merge_request_accepted":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestAccepted); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestAcceptedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestAccepted)})
 	case "team_merge_request_accepted_shown_to_primary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestAcceptedShownToPrimaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestAcceptedShownToPrimaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestAcceptedShownToPrimaryTeam)})
 	case "team_merge_request_accepted_shown_to_secondary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestAcceptedShownToSecondaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestAcceptedShownToSecondaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestAcceptedShownToSecondaryTeam)})
 	case "team_merge_request_auto_canceled":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestAutoCanceled); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestAutoCanceledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestAutoCanceled)})
 	case "team_merge_request_canceled":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestCanceled); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestCanceledType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestCanceled)})
 	case "team_merge_request_canceled_shown_to_primary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestCanceledShownToPrimaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestCanceledShownToPrimaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestCanceledShownToPrimaryTeam)})
 	case "team_merge_request_canceled_shown_to_secondary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestCanceledShownToSecondaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestCanceledShownToSecondaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestCanceledShownToSecondaryTeam)})
 	case "team_merge_request_expired":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestExpired); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestExpiredType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestExpired)})
 	case "team_merge_request_expired_shown_to_primary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestExpiredShownToPrimaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestExpiredShownToPrimaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestExpiredShownToPrimaryTeam)})
 	case "team_merge_request_expired_shown_to_secondary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestExpiredShownToSecondaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestExpiredShownToSecondaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestExpiredShownToSecondaryTeam)})
 	case "team_merge_request_rejected_shown_to_primary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestRejectedShownToPrimaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestRejectedShownToPrimaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestRejectedShownToPrimaryTeam)})
 	case "team_merge_request_rejected_shown_to_secondary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestRejectedShownToSecondaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestRejectedShownToSecondaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestRejectedShownToSecondaryTeam)})
 	case "team_merge_request_reminder":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestReminder); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestReminderType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestReminder)})
 	case "team_merge_request_reminder_shown_to_primary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestReminderShownToPrimaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestReminderShownToPrimaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestReminderShownToPrimaryTeam)})
 	case "team_merge_request_reminder_shown_to_secondary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestReminderShownToSecondaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestReminderShownToSecondaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestReminderShownToSecondaryTeam)})
 	case "team_merge_request_revoked":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestRevoked); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestRevokedType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestRevoked)})
 	case "team_merge_request_sent_shown_to_primary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestSentShownToPrimaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestSentShownToPrimaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestSentShownToPrimaryTeam)})
 	case "team_merge_request_sent_shown_to_secondary_team":
-		if err = json.Unmarshal(body, &u.TeamMergeRequestSentShownToSecondaryTeam); err != nil {
-			return err
-		}
-
+		type wrap TeamMergeRequestSentShownToSecondaryTeamType
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.TeamMergeRequestSentShownToSecondaryTeam)})
 	}
-	return nil
+	return json.Marshal(u.Tagged)
 }
 
 // EventTypeArg : The type of the event.
@@ -12886,6 +18882,25 @@ func (u *FedExtraDetails) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a FedExtraDetails instance
+func (u *FedExtraDetails) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "organization":
+		type wrap OrganizationDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Organization)})
+	case "team":
+		type wrap TeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Team)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FedHandshakeAction : has no documentation (yet)
 type FedHandshakeAction struct {
 	dropbox.Tagged
@@ -12953,6 +18968,31 @@ func (u *FederationStatusChangeAdditionalInfo) UnmarshalJSON(body []byte) error
 	return nil
 }
 
+// MarshalJSON serializes a FederationStatusChangeAdditionalInfo instance
+func (u *FederationStatusChangeAdditionalInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "connected_team_name":
+		type wrap ConnectedTeamName
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.ConnectedTeamName)})
+	case "non_trusted_team_details":
+		type wrap NonTrustedTeamDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.NonTrustedTeamDetails)})
+	case "organization_name":
+		type wrap OrganizationName
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.OrganizationName)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FileAddCommentDetails : Added file comment.
 type FileAddCommentDetails struct {
 	// CommentText : Comment text.
@@ -14318,6 +20358,18 @@ func (u *GetTeamEventsContinueError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetTeamEventsContinueError instance
+func (u *GetTeamEventsContinueError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "reset":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Reset time.Time `json:"reset"`
+		}{u.Tagged, u.Reset})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GetTeamEventsError : Errors that can be raised when calling `getEvents`.
 type GetTeamEventsError struct {
 	dropbox.Tagged
@@ -15624,6 +21676,15 @@ func (u *LegacyDeviceSessionLogInfo) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LegacyDeviceSessionLogInfo instance, including its `.tag`
+func (u *LegacyDeviceSessionLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap LegacyDeviceSessionLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "legacy_device_session"}, (*wrap)(u)})
+}
+
 // LegalHoldsActivateAHoldDetails : Activated a hold.
 type LegalHoldsActivateAHoldDetails struct {
 	// LegalHoldId : Hold ID.
@@ -16030,6 +22091,37 @@ func (u *LinkedDeviceLogInfo) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a LinkedDeviceLogInfo instance
+func (u *LinkedDeviceLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "desktop_device_session":
+		type wrap DesktopDeviceSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.DesktopDeviceSession)})
+	case "legacy_device_session":
+		type wrap LegacyDeviceSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.LegacyDeviceSession)})
+	case "mobile_device_session":
+		type wrap MobileDeviceSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.MobileDeviceSession)})
+	case "web_device_session":
+		type wrap WebDeviceSessionLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.WebDeviceSession)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // LockStatus : File lock status
 type LockStatus struct {
 	dropbox.Tagged
@@ -17059,6 +23151,15 @@ func NewMobileDeviceSessionLogInfo(DeviceName string, ClientType *team.MobileCli
 	return s
 }
 
+// MarshalJSON serializes a MobileDeviceSessionLogInfo instance, including its `.tag`
+func (u *MobileDeviceSessionLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap MobileDeviceSessionLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "mobile_device_session"}, (*wrap)(u)})
+}
+
 // MobileSessionLogInfo : Mobile session.
 type MobileSessionLogInfo struct {
 	SessionLogInfo
@@ -17070,6 +23171,15 @@ func NewMobileSessionLogInfo() *MobileSessionLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a MobileSessionLogInfo instance, including its `.tag`
+func (u *MobileSessionLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap MobileSessionLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "mobile"}, (*wrap)(u)})
+}
+
 // NamespaceRelativePathLogInfo : Namespace relative path details.
 type NamespaceRelativePathLogInfo struct {
 	// NsId : Namespace ID.
@@ -17401,6 +23511,15 @@ func NewNonTeamMemberLogInfo() *NonTeamMemberLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a NonTeamMemberLogInfo instance, including its `.tag`
+func (u *NonTeamMemberLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap NonTeamMemberLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "non_team_member"}, (*wrap)(u)})
+}
+
 // NonTrustedTeamDetails : The email to which the request was sent
 type NonTrustedTeamDetails struct {
 	// Team : The email to which the request was sent.
@@ -19264,6 +25383,24 @@ func (u *ParticipantLogInfo) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a ParticipantLogInfo instance
+func (u *ParticipantLogInfo) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "group":
+		type wrap GroupLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Group)})
+	case "user":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			User IsUserLogInfo `json:"user"`
+		}{u.Tagged, u.User})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // PassPolicy : has no documentation (yet)
 type PassPolicy struct {
 	dropbox.Tagged
@@ -24148,6 +30285,15 @@ func NewTeamLinkedAppLogInfo() *TeamLinkedAppLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a TeamLinkedAppLogInfo instance, including its `.tag`
+func (u *TeamLinkedAppLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap TeamLinkedAppLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "team_linked_app"}, (*wrap)(u)})
+}
+
 // TeamLogInfo : Team's logged information.
 type TeamLogInfo struct {
 	// DisplayName : Team display name.
@@ -24178,6 +30324,15 @@ func NewTeamMemberLogInfo() *TeamMemberLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a TeamMemberLogInfo instance, including its `.tag`
+func (u *TeamMemberLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap TeamMemberLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "team_member"}, (*wrap)(u)})
+}
+
 // TeamMembershipType : has no documentation (yet)
 type TeamMembershipType struct {
 	dropbox.Tagged
@@ -24274,6 +30429,25 @@ func (u *TeamMergeRequestAcceptedExtraDetails) UnmarshalJSON(body []byte) error
 	return nil
 }
 
+// MarshalJSON serializes a TeamMergeRequestAcceptedExtraDetails instance
+func (u *TeamMergeRequestAcceptedExtraDetails) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "primary_team":
+		type wrap PrimaryTeamRequestAcceptedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PrimaryTeam)})
+	case "secondary_team":
+		type wrap SecondaryTeamRequestAcceptedDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryTeam)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamMergeRequestAcceptedShownToPrimaryTeamDetails : Accepted a team merge
 // request.
 type TeamMergeRequestAcceptedShownToPrimaryTeamDetails struct {
@@ -24433,6 +30607,25 @@ func (u *TeamMergeRequestCanceledExtraDetails) UnmarshalJSON(body []byte) error
 	return nil
 }
 
+// MarshalJSON serializes a TeamMergeRequestCanceledExtraDetails instance
+func (u *TeamMergeRequestCanceledExtraDetails) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "primary_team":
+		type wrap PrimaryTeamRequestCanceledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PrimaryTeam)})
+	case "secondary_team":
+		type wrap SecondaryTeamRequestCanceledDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryTeam)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamMergeRequestCanceledShownToPrimaryTeamDetails : Canceled a team merge
 // request.
 type TeamMergeRequestCanceledShownToPrimaryTeamDetails struct {
@@ -24566,6 +30759,25 @@ func (u *TeamMergeRequestExpiredExtraDetails) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a TeamMergeRequestExpiredExtraDetails instance
+func (u *TeamMergeRequestExpiredExtraDetails) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "primary_team":
+		type wrap PrimaryTeamRequestExpiredDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PrimaryTeam)})
+	case "secondary_team":
+		type wrap SecondaryTeamRequestExpiredDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryTeam)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamMergeRequestExpiredShownToPrimaryTeamDetails : Team merge request
 // expired.
 type TeamMergeRequestExpiredShownToPrimaryTeamDetails struct {
@@ -24753,6 +30965,25 @@ func (u *TeamMergeRequestReminderExtraDetails) UnmarshalJSON(body []byte) error
 	return nil
 }
 
+// MarshalJSON serializes a TeamMergeRequestReminderExtraDetails instance
+func (u *TeamMergeRequestReminderExtraDetails) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "primary_team":
+		type wrap PrimaryTeamRequestReminderDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.PrimaryTeam)})
+	case "secondary_team":
+		type wrap SecondaryTeamRequestReminderDetails
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.SecondaryTeam)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // TeamMergeRequestReminderShownToPrimaryTeamDetails : Sent a team merge request
 // reminder.
 type TeamMergeRequestReminderShownToPrimaryTeamDetails struct {
@@ -25553,6 +31784,15 @@ func NewTrustedNonTeamMemberLogInfo(TrustedNonTeamMemberType *TrustedNonTeamMemb
 	return s
 }
 
+// MarshalJSON serializes a TrustedNonTeamMemberLogInfo instance, including its `.tag`
+func (u *TrustedNonTeamMemberLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap TrustedNonTeamMemberLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "trusted_non_team_member"}, (*wrap)(u)})
+}
+
 // TrustedNonTeamMemberType : has no documentation (yet)
 type TrustedNonTeamMemberType struct {
 	dropbox.Tagged
@@ -25692,6 +31932,15 @@ func NewUserLinkedAppLogInfo() *UserLinkedAppLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a UserLinkedAppLogInfo instance, including its `.tag`
+func (u *UserLinkedAppLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap UserLinkedAppLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "user_linked_app"}, (*wrap)(u)})
+}
+
 // UserNameLogInfo : User's name logged information
 type UserNameLogInfo struct {
 	// GivenName : Given name.
@@ -25722,6 +31971,15 @@ func NewUserOrTeamLinkedAppLogInfo() *UserOrTeamLinkedAppLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a UserOrTeamLinkedAppLogInfo instance, including its `.tag`
+func (u *UserOrTeamLinkedAppLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap UserOrTeamLinkedAppLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "user_or_team_linked_app"}, (*wrap)(u)})
+}
+
 // UserTagsAddedDetails : Tagged a file.
 type UserTagsAddedDetails struct {
 	// Values : values.
@@ -25867,6 +32125,15 @@ func NewWebDeviceSessionLogInfo(UserAgent string, Os string, Browser string) *We
 	return s
 }
 
+// MarshalJSON serializes a WebDeviceSessionLogInfo instance, including its `.tag`
+func (u *WebDeviceSessionLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap WebDeviceSessionLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "web_device_session"}, (*wrap)(u)})
+}
+
 // WebSessionLogInfo : Web session.
 type WebSessionLogInfo struct {
 	SessionLogInfo
@@ -25878,6 +32145,15 @@ func NewWebSessionLogInfo() *WebSessionLogInfo {
 	return s
 }
 
+// MarshalJSON serializes a WebSessionLogInfo instance, including its `.tag`
+func (u *WebSessionLogInfo) MarshalJSON() ([]byte, error) {
+	type wrap WebSessionLogInfo
+	return json.Marshal(struct {
+		dropbox.Tagged
+		*wrap
+	}{dropbox.Tagged{Tag: "web"}, (*wrap)(u)})
+}
+
 // WebSessionsChangeActiveSessionLimitDetails : Changed limit on active sessions
 // per member.
 type WebSessionsChangeActiveSessionLimitDetails struct {
@@ -26003,6 +32279,19 @@ func (u *WebSessionsFixedLengthPolicy) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a WebSessionsFixedLengthPolicy instance
+func (u *WebSessionsFixedLengthPolicy) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "defined":
+		type wrap DurationLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Defined)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // WebSessionsIdleLengthPolicy : Web sessions idle length policy.
 type WebSessionsIdleLengthPolicy struct {
 	dropbox.Tagged
@@ -26037,3 +32326,16 @@ func (u *WebSessionsIdleLengthPolicy) UnmarshalJSON(body []byte) error {
 	}
 	return nil
 }
+
+// MarshalJSON serializes a WebSessionsIdleLengthPolicy instance
+func (u *WebSessionsIdleLengthPolicy) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "defined":
+		type wrap DurationLogInfo
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Defined)})
+	}
+	return json.Marshal(u.Tagged)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dropbox_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/users"
+	"golang.org/x/oauth2"
+)
+
+func TestFileTokenStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tokens")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tokens")
+	key := bytes.Repeat([]byte{1}, 32)
+	store, err := dropbox.NewFileTokenStore(path, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tok, err := store.Load("dbid:1"); tok != nil || err != nil {
+		t.Errorf("Unexpected token %v, %v\n", tok, err)
+	}
+	want := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Unix(1e9, 0).UTC()}
+	if err = store.Save("dbid:1", want); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Save("dbid:2", &oauth2.Token{AccessToken: "other"}); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b, []byte("refresh")) {
+		t.Error("Token file is not encrypted")
+	}
+
+	got, err := store.Load("dbid:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.AccessToken != want.AccessToken || got.RefreshToken != want.RefreshToken || !got.Expiry.Equal(want.Expiry) {
+		t.Errorf("Unexpected token %+v\n", got)
+	}
+
+	other, err := dropbox.NewFileTokenStore(path, bytes.Repeat([]byte{2}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = other.Load("dbid:1"); err == nil {
+		t.Error("Expected error loading with another key")
+	}
+}
+
+func TestTokenStoreRefresh(t *testing.T) {
+	var refreshes int32
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			if r.URL.Path == "/1/oauth2/token" {
+				atomic.AddInt32(&refreshes, 1)
+				if r.FormValue("refresh_token") != "refresh" {
+					t.Errorf("Unexpected refresh token: %s\n", r.FormValue("refresh_token"))
+				}
+				_, _ = w.Write([]byte(`{"access_token": "new", "token_type": "bearer", "expires_in": 14400}`))
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer new" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error_summary": "expired_access_token/..", "error": {".tag": "expired_access_token"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"account_id": "dbid:1", "root_info": {".tag": "user", "root_namespace_id": "1", "home_namespace_id": "1"}}`))
+		}))
+	defer ts.Close()
+
+	store := dropbox.NewMemoryTokenStore()
+	if err := store.Save("dbid:1", &oauth2.Token{AccessToken: "old", RefreshToken: "refresh"}); err != nil {
+		t.Fatal(err)
+	}
+	config := dropbox.Config{AppKey: "key", AppSecret: "secret", TokenStore: store, TokenStoreID: "dbid:1",
+		Client: &http.Client{Transport: redirectTransport{ts.URL, ts.Client().Transport}},
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		// Separate clients share the store.
+		client := users.New(config)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, e := client.GetCurrentAccount(); e != nil {
+				t.Errorf("Unexpected error: %v\n", e)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if refreshes != 1 {
+		t.Errorf("Unexpected number of refreshes: %d\n", refreshes)
+	}
+	if tok, _ := store.Load("dbid:1"); tok.AccessToken != "new" || tok.RefreshToken != "refresh" {
+		t.Errorf("Unexpected stored token %+v\n", tok)
+	}
+}
+
+// valueStore is a TokenStore value that is not comparable, and does not
+// implement dropbox.TokenLocker.
+type valueStore struct {
+	tokens interface{}
+}
+
+func (s valueStore) Load(id string) (*oauth2.Token, error) {
+	return s.tokens.(map[string]*oauth2.Token)[id], nil
+}
+
+func (s valueStore) Save(id string, tok *oauth2.Token) error {
+	s.tokens.(map[string]*oauth2.Token)[id] = tok
+	return nil
+}
+
+func TestTokenStoreWithoutLocker(t *testing.T) {
+	store := valueStore{map[string]*oauth2.Token{"dbid:1": {AccessToken: "stored", Expiry: time.Now().Add(time.Hour)}}}
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer stored" {
+				t.Errorf("Unexpected authorization %s\n", r.Header.Get("Authorization"))
+			}
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			_, _ = w.Write([]byte(`{"account_id": "dbid:1", "root_info": {".tag": "user", "root_namespace_id": "1", "home_namespace_id": "1"}}`))
+		}))
+	defer ts.Close()
+
+	client := users.New(dropbox.Config{AppKey: "key", TokenStore: store, TokenStoreID: "dbid:1",
+		URLGenerator: func(hostType string, namespace string, route string) string {
+			return generateURL(ts.URL, namespace, route)
+		}})
+	if _, err := client.GetCurrentAccount(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -21,6 +21,7 @@
 package users
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 
@@ -32,17 +33,27 @@ import (
 type Client interface {
 	// FeaturesGetValues : Get a list of feature values that may be configured
 	// for the current account.
-	FeaturesGetValues(arg *UserFeaturesGetValuesBatchArg) (res *UserFeaturesGetValuesBatchResult, err error)
+	FeaturesGetValues(arg *UserFeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *UserFeaturesGetValuesBatchResult, err error)
+	// FeaturesGetValuesContext is like FeaturesGetValues but takes a context for cancellation and deadlines.
+	FeaturesGetValuesContext(ctx context.Context, arg *UserFeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *UserFeaturesGetValuesBatchResult, err error)
 	// GetAccount : Get information about a user's account.
-	GetAccount(arg *GetAccountArg) (res *BasicAccount, err error)
+	GetAccount(arg *GetAccountArg, opts ...dropbox.CallOption) (res *BasicAccount, err error)
+	// GetAccountContext is like GetAccount but takes a context for cancellation and deadlines.
+	GetAccountContext(ctx context.Context, arg *GetAccountArg, opts ...dropbox.CallOption) (res *BasicAccount, err error)
 	// GetAccountBatch : Get information about multiple user accounts.  At most
 	// 300 accounts may be queried per request.
-	GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccount, err error)
+	GetAccountBatch(arg *GetAccountBatchArg, opts ...dropbox.CallOption) (res []*BasicAccount, err error)
+	// GetAccountBatchContext is like GetAccountBatch but takes a context for cancellation and deadlines.
+	GetAccountBatchContext(ctx context.Context, arg *GetAccountBatchArg, opts ...dropbox.CallOption) (res []*BasicAccount, err error)
 	// GetCurrentAccount : Get information about the current user's account.
-	GetCurrentAccount() (res *FullAccount, err error)
+	GetCurrentAccount(opts ...dropbox.CallOption) (res *FullAccount, err error)
+	// GetCurrentAccountContext is like GetCurrentAccount but takes a context for cancellation and deadlines.
+	GetCurrentAccountContext(ctx context.Context, opts ...dropbox.CallOption) (res *FullAccount, err error)
 	// GetSpaceUsage : Get the space usage information for the current user's
 	// account.
-	GetSpaceUsage() (res *SpaceUsage, err error)
+	GetSpaceUsage(opts ...dropbox.CallOption) (res *SpaceUsage, err error)
+	// GetSpaceUsageContext is like GetSpaceUsage but takes a context for cancellation and deadlines.
+	GetSpaceUsageContext(ctx context.Context, opts ...dropbox.CallOption) (res *SpaceUsage, err error)
 }
 
 type apiImpl dropbox.Context
@@ -53,7 +64,26 @@ type FeaturesGetValuesAPIError struct {
 	EndpointError *UserFeaturesGetValuesBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) FeaturesGetValues(arg *UserFeaturesGetValuesBatchArg) (res *UserFeaturesGetValuesBatchResult, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *FeaturesGetValuesAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e FeaturesGetValuesAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) FeaturesGetValues(arg *UserFeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *UserFeaturesGetValuesBatchResult, err error) {
+	return dbx.FeaturesGetValuesContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) FeaturesGetValuesContext(ctx context.Context, arg *UserFeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *UserFeaturesGetValuesBatchResult, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "users",
@@ -62,11 +92,12 @@ func (dbx *apiImpl) FeaturesGetValues(arg *UserFeaturesGetValuesBatchArg) (res *
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr FeaturesGetValuesAPIError
 		err = auth.ParseError(err, &appErr)
@@ -91,7 +122,26 @@ type GetAccountAPIError struct {
 	EndpointError *GetAccountError `json:"error"`
 }
 
-func (dbx *apiImpl) GetAccount(arg *GetAccountArg) (res *BasicAccount, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetAccountAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetAccountAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetAccount(arg *GetAccountArg, opts ...dropbox.CallOption) (res *BasicAccount, err error) {
+	return dbx.GetAccountContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetAccountContext(ctx context.Context, arg *GetAccountArg, opts ...dropbox.CallOption) (res *BasicAccount, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "users",
@@ -100,11 +150,12 @@ func (dbx *apiImpl) GetAccount(arg *GetAccountArg) (res *BasicAccount, err error
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetAccountAPIError
 		err = auth.ParseError(err, &appErr)
@@ -129,7 +180,26 @@ type GetAccountBatchAPIError struct {
 	EndpointError *GetAccountBatchError `json:"error"`
 }
 
-func (dbx *apiImpl) GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccount, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetAccountBatchAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+// Is reports whether target is a dropbox.EndpointErrorMatcher matching
+// the endpoint error, or a union nested in it.
+func (e GetAccountBatchAPIError) Is(target error) bool {
+	m, ok := target.(dropbox.EndpointErrorMatcher)
+	if !ok || e.EndpointError == nil {
+		return false
+	}
+	return m.MatchEndpointError(e.EndpointError)
+}
+
+func (dbx *apiImpl) GetAccountBatch(arg *GetAccountBatchArg, opts ...dropbox.CallOption) (res []*BasicAccount, err error) {
+	return dbx.GetAccountBatchContext(context.Background(), arg, opts...)
+}
+
+func (dbx *apiImpl) GetAccountBatchContext(ctx context.Context, arg *GetAccountBatchArg, opts ...dropbox.CallOption) (res []*BasicAccount, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "users",
@@ -138,11 +208,12 @@ func (dbx *apiImpl) GetAccountBatch(arg *GetAccountBatchArg) (res []*BasicAccoun
 		Style:        "rpc",
 		Arg:          arg,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetAccountBatchAPIError
 		err = auth.ParseError(err, &appErr)
@@ -167,7 +238,16 @@ type GetCurrentAccountAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GetCurrentAccount() (res *FullAccount, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetCurrentAccountAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) GetCurrentAccount(opts ...dropbox.CallOption) (res *FullAccount, err error) {
+	return dbx.GetCurrentAccountContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) GetCurrentAccountContext(ctx context.Context, opts ...dropbox.CallOption) (res *FullAccount, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "users",
@@ -176,11 +256,12 @@ func (dbx *apiImpl) GetCurrentAccount() (res *FullAccount, err error) {
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetCurrentAccountAPIError
 		err = auth.ParseError(err, &appErr)
@@ -205,7 +286,16 @@ type GetSpaceUsageAPIError struct {
 	EndpointError struct{} `json:"error"`
 }
 
-func (dbx *apiImpl) GetSpaceUsage() (res *SpaceUsage, err error) {
+// SetAPIError sets the error summary and request metadata of e.
+func (e *GetSpaceUsageAPIError) SetAPIError(base dropbox.APIError) {
+	e.APIError = base
+}
+
+func (dbx *apiImpl) GetSpaceUsage(opts ...dropbox.CallOption) (res *SpaceUsage, err error) {
+	return dbx.GetSpaceUsageContext(context.Background(), opts...)
+}
+
+func (dbx *apiImpl) GetSpaceUsageContext(ctx context.Context, opts ...dropbox.CallOption) (res *SpaceUsage, err error) {
 	req := dropbox.Request{
 		Host:         "api",
 		Namespace:    "users",
@@ -214,11 +304,12 @@ func (dbx *apiImpl) GetSpaceUsage() (res *SpaceUsage, err error) {
 		Style:        "rpc",
 		Arg:          nil,
 		ExtraHeaders: nil,
+		Options:      opts,
 	}
 
 	var resp []byte
 	var respBody io.ReadCloser
-	resp, respBody, err = (*dropbox.Context)(dbx).Execute(req, nil)
+	resp, respBody, err = (*dropbox.Context)(dbx).ExecuteContext(ctx, req, nil)
 	if err != nil {
 		var appErr GetSpaceUsageAPIError
 		err = auth.ParseError(err, &appErr)
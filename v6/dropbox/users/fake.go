@@ -0,0 +1,117 @@
+// Copyright (c) Dropbox, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package users
+
+import (
+	"context"
+
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+)
+
+// Fake is a Client for tests. The methods of a route call its stub, the
+// field named after the route with a Func suffix, and fail with
+// dropbox.ErrNotImplemented if it is nil. All calls are recorded.
+type Fake struct {
+	dropbox.FakeCalls
+
+	FeaturesGetValuesFunc func(ctx context.Context, arg *UserFeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *UserFeaturesGetValuesBatchResult, err error)
+	GetAccountFunc        func(ctx context.Context, arg *GetAccountArg, opts ...dropbox.CallOption) (res *BasicAccount, err error)
+	GetAccountBatchFunc   func(ctx context.Context, arg *GetAccountBatchArg, opts ...dropbox.CallOption) (res []*BasicAccount, err error)
+	GetCurrentAccountFunc func(ctx context.Context, opts ...dropbox.CallOption) (res *FullAccount, err error)
+	GetSpaceUsageFunc     func(ctx context.Context, opts ...dropbox.CallOption) (res *SpaceUsage, err error)
+}
+
+var _ Client = (*Fake)(nil)
+
+// FeaturesGetValues implements Client.
+func (f *Fake) FeaturesGetValues(arg *UserFeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *UserFeaturesGetValuesBatchResult, err error) {
+	return f.FeaturesGetValuesContext(context.Background(), arg, opts...)
+}
+
+// FeaturesGetValuesContext implements Client.
+func (f *Fake) FeaturesGetValuesContext(ctx context.Context, arg *UserFeaturesGetValuesBatchArg, opts ...dropbox.CallOption) (res *UserFeaturesGetValuesBatchResult, err error) {
+	f.Record("FeaturesGetValues", opts, arg)
+	if f.FeaturesGetValuesFunc == nil {
+		err = dropbox.NotImplemented("users", "FeaturesGetValues")
+		return
+	}
+	return f.FeaturesGetValuesFunc(ctx, arg, opts...)
+}
+
+// GetAccount implements Client.
+func (f *Fake) GetAccount(arg *GetAccountArg, opts ...dropbox.CallOption) (res *BasicAccount, err error) {
+	return f.GetAccountContext(context.Background(), arg, opts...)
+}
+
+// GetAccountContext implements Client.
+func (f *Fake) GetAccountContext(ctx context.Context, arg *GetAccountArg, opts ...dropbox.CallOption) (res *BasicAccount, err error) {
+	f.Record("GetAccount", opts, arg)
+	if f.GetAccountFunc == nil {
+		err = dropbox.NotImplemented("users", "GetAccount")
+		return
+	}
+	return f.GetAccountFunc(ctx, arg, opts...)
+}
+
+// GetAccountBatch implements Client.
+func (f *Fake) GetAccountBatch(arg *GetAccountBatchArg, opts ...dropbox.CallOption) (res []*BasicAccount, err error) {
+	return f.GetAccountBatchContext(context.Background(), arg, opts...)
+}
+
+// GetAccountBatchContext implements Client.
+func (f *Fake) GetAccountBatchContext(ctx context.Context, arg *GetAccountBatchArg, opts ...dropbox.CallOption) (res []*BasicAccount, err error) {
+	f.Record("GetAccountBatch", opts, arg)
+	if f.GetAccountBatchFunc == nil {
+		err = dropbox.NotImplemented("users", "GetAccountBatch")
+		return
+	}
+	return f.GetAccountBatchFunc(ctx, arg, opts...)
+}
+
+// GetCurrentAccount implements Client.
+func (f *Fake) GetCurrentAccount(opts ...dropbox.CallOption) (res *FullAccount, err error) {
+	return f.GetCurrentAccountContext(context.Background(), opts...)
+}
+
+// GetCurrentAccountContext implements Client.
+func (f *Fake) GetCurrentAccountContext(ctx context.Context, opts ...dropbox.CallOption) (res *FullAccount, err error) {
+	f.Record("GetCurrentAccount", opts)
+	if f.GetCurrentAccountFunc == nil {
+		err = dropbox.NotImplemented("users", "GetCurrentAccount")
+		return
+	}
+	return f.GetCurrentAccountFunc(ctx, opts...)
+}
+
+// GetSpaceUsage implements Client.
+func (f *Fake) GetSpaceUsage(opts ...dropbox.CallOption) (res *SpaceUsage, err error) {
+	return f.GetSpaceUsageContext(context.Background(), opts...)
+}
+
+// GetSpaceUsageContext implements Client.
+func (f *Fake) GetSpaceUsageContext(ctx context.Context, opts ...dropbox.CallOption) (res *SpaceUsage, err error) {
+	f.Record("GetSpaceUsage", opts)
+	if f.GetSpaceUsageFunc == nil {
+		err = dropbox.NotImplemented("users", "GetSpaceUsage")
+		return
+	}
+	return f.GetSpaceUsageFunc(ctx, opts...)
+}
@@ -123,6 +123,18 @@ func (u *FileLockingValue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a FileLockingValue instance
+func (u *FileLockingValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "enabled":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Enabled bool `json:"enabled"`
+		}{u.Tagged, u.Enabled})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // FullAccount : Detailed information about the current user's account.
 type FullAccount struct {
 	Account
@@ -334,6 +346,18 @@ func (u *GetAccountBatchError) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a GetAccountBatchError instance
+func (u *GetAccountBatchError) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "no_account":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			NoAccount string `json:"no_account"`
+		}{u.Tagged, u.NoAccount})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // GetAccountError : has no documentation (yet)
 type GetAccountError struct {
 	dropbox.Tagged
@@ -430,6 +454,18 @@ func (u *PaperAsFilesValue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a PaperAsFilesValue instance
+func (u *PaperAsFilesValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "enabled":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			Enabled bool `json:"enabled"`
+		}{u.Tagged, u.Enabled})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SpaceAllocation : Space is allocated differently based on the type of
 // account.
 type SpaceAllocation struct {
@@ -474,6 +510,25 @@ func (u *SpaceAllocation) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a SpaceAllocation instance
+func (u *SpaceAllocation) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "individual":
+		type wrap IndividualSpaceAllocation
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Individual)})
+	case "team":
+		type wrap TeamSpaceAllocation
+		return json.Marshal(struct {
+			dropbox.Tagged
+			*wrap
+		}{u.Tagged, (*wrap)(u.Team)})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // SpaceUsage : Information about a user's space usage and quota.
 type SpaceUsage struct {
 	// Used : The user's total space usage (bytes).
@@ -574,6 +629,23 @@ func (u *UserFeatureValue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// MarshalJSON serializes a UserFeatureValue instance
+func (u *UserFeatureValue) MarshalJSON() ([]byte, error) {
+	switch u.Tag {
+	case "paper_as_files":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			PaperAsFiles *PaperAsFilesValue `json:"paper_as_files"`
+		}{u.Tagged, u.PaperAsFiles})
+	case "file_locking":
+		return json.Marshal(struct {
+			dropbox.Tagged
+			FileLocking *FileLockingValue `json:"file_locking"`
+		}{u.Tagged, u.FileLocking})
+	}
+	return json.Marshal(u.Tagged)
+}
+
 // UserFeaturesGetValuesBatchArg : has no documentation (yet)
 type UserFeaturesGetValuesBatchArg struct {
 	// Features : A list of features in `UserFeature`. If the list is empty,
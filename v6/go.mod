@@ -3,3 +3,5 @@ module github.com/dropbox/dropbox-sdk-go-unofficial/v6
 go 1.13
 
 require golang.org/x/oauth2 v0.7.0
+
+require github.com/google/go-cmp v0.6.0 // indirect